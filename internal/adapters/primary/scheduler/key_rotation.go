@@ -0,0 +1,59 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+)
+
+// KeyRotationJob runs the scheduled signing key rotation on every replica.
+type KeyRotationJob struct {
+	keyService services.KeyService
+	interval   time.Duration
+	logger     *slog.Logger
+}
+
+func NewKeyRotationJob(keyService services.KeyService, cfg *config.Config, logger *slog.Logger) *KeyRotationJob {
+	interval := cfg.Key.RotationCheckInterval
+	if interval <= 0 {
+		interval = domain.SigningKeyRotationCheckInterval
+	}
+
+	return &KeyRotationJob{
+		keyService: keyService,
+		interval:   interval,
+		logger:     logger,
+	}
+}
+
+// Start provisions the signing keys, then checks for rotation in the background until ctx is canceled.
+func (j *KeyRotationJob) Start(ctx context.Context) error {
+	if err := j.keyService.RotateKeys(ctx); err != nil {
+		return err
+	}
+
+	go j.run(ctx)
+	return nil
+}
+
+func (j *KeyRotationJob) run(ctx context.Context) {
+	logger := j.logger.With("job", "KeyRotation")
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.keyService.RotateKeys(ctx); err != nil {
+				logger.Error("failed to rotate signing keys", "error", err)
+			}
+		}
+	}
+}
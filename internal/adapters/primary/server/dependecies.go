@@ -1,15 +1,23 @@
 package server
 
 import (
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/scheduler"
 	appcontext "github.com/g-villarinho/oidc-server/internal/adapters/primary/server/context"
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/handlers"
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/middlewares"
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/aesgcm"
 	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/argon2"
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/httpclient"
 	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/jwt"
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/mail"
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/notification"
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/paseto"
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/pki"
 	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres"
 	postgresRepo "github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/repositories"
 	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/redis"
 	redisRepo "github.com/g-villarinho/oidc-server/internal/adapters/secondary/redis/repositories"
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/webauthn"
 	"github.com/g-villarinho/oidc-server/internal/config"
 	"github.com/g-villarinho/oidc-server/internal/core/services"
 	"github.com/g-villarinho/oidc-server/internal/logger"
@@ -26,6 +34,9 @@ func InitializeContainer() *dig.Container {
 	provideServices(container)
 	provideHandlers(container)
 	provideCrypto(container)
+	provideHTTPClients(container)
+	provideNotifiers(container)
+	provideJobs(container)
 	provideServer(container)
 	provideMiddlewares(container)
 
@@ -44,8 +55,22 @@ func provideRepositories(container *dig.Container) {
 	injector.Provide(container, postgresRepo.NewClientRepository)
 	injector.Provide(container, postgresRepo.NewUserRepository)
 	injector.Provide(container, redisRepo.NewSessionRepository)
+	injector.Provide(container, redisRepo.NewBackchannelAuthenticationRepository)
+	injector.Provide(container, redisRepo.NewLoginTicketRepository)
+	injector.Provide(container, redisRepo.NewWebAuthnChallengeRepository)
 	injector.Provide(container, postgresRepo.NewAuthorizationCodeRepository)
 	injector.Provide(container, postgresRepo.NewTokenRepository)
+	injector.Provide(container, postgresRepo.NewPairwiseSubjectRepository)
+	injector.Provide(container, postgresRepo.NewConsentRepository)
+	injector.Provide(container, postgresRepo.NewScopeRepository)
+	injector.Provide(container, postgresRepo.NewAPIResourceRepository)
+	injector.Provide(container, postgresRepo.NewAuthorizationDetailTypeRepository)
+	injector.Provide(container, postgresRepo.NewTrustedIssuerRepository)
+	injector.Provide(container, postgresRepo.NewSigningKeyRepository)
+	injector.Provide(container, postgresRepo.NewTOTPCredentialRepository)
+	injector.Provide(container, postgresRepo.NewWebAuthnCredentialRepository)
+	injector.Provide(container, postgresRepo.NewRecoveryCodeRepository)
+	injector.Provide(container, postgresRepo.NewAuditEventRepository)
 }
 
 func provideCache(container *dig.Container) {
@@ -59,6 +84,26 @@ func provideServices(container *dig.Container) {
 	injector.Provide(container, services.NewCookieService)
 	injector.Provide(container, services.NewTokenService)
 	injector.Provide(container, services.NewOAuthService)
+	injector.Provide(container, services.NewDiscoveryService)
+	injector.Provide(container, services.NewSubjectService)
+	injector.Provide(container, services.NewUserInfoService)
+	injector.Provide(container, services.NewScopeService)
+	injector.Provide(container, services.NewGrantService)
+	injector.Provide(container, services.NewResourceService)
+	injector.Provide(container, services.NewAuthorizationDetailService)
+	injector.Provide(container, services.NewIntrospectionService)
+	injector.Provide(container, services.NewTokenExchangeService)
+	injector.Provide(container, services.NewAssertionService)
+	injector.Provide(container, services.NewFederationService)
+	injector.Provide(container, services.NewDPoPService)
+	injector.Provide(container, services.NewClientCertificateService)
+	injector.Provide(container, services.NewBackchannelAuthenticationService)
+	injector.Provide(container, services.NewKeyService)
+	injector.Provide(container, services.NewResponseEncryptionService)
+	injector.Provide(container, services.NewTOTPService)
+	injector.Provide(container, services.NewWebAuthnService)
+	injector.Provide(container, services.NewRecoveryCodeService)
+	injector.Provide(container, services.NewEmailVerificationService)
 }
 
 func provideHandlers(container *dig.Container) {
@@ -67,11 +112,47 @@ func provideHandlers(container *dig.Container) {
 	injector.Provide(container, handlers.NewCookieHandler)
 	injector.Provide(container, handlers.NewHealthHandler)
 	injector.Provide(container, handlers.NewOAuthHandler)
+	injector.Provide(container, handlers.NewDiscoveryHandler)
+	injector.Provide(container, handlers.NewScopeHandler)
+	injector.Provide(container, handlers.NewGrantHandler)
+	injector.Provide(container, handlers.NewResourceHandler)
+	injector.Provide(container, handlers.NewAuthorizationDetailHandler)
+	injector.Provide(container, handlers.NewFederationHandler)
+	injector.Provide(container, handlers.NewBackchannelHandler)
+	injector.Provide(container, handlers.NewKeyHandler)
+	injector.Provide(container, handlers.NewTOTPHandler)
+	injector.Provide(container, handlers.NewWebAuthnHandler)
+	injector.Provide(container, handlers.NewRecoveryCodeHandler)
+	injector.Provide(container, handlers.NewEmailVerificationHandler)
 }
 
 func provideCrypto(container *dig.Container) {
 	injector.Provide(container, argon2.NewHasher)
+	injector.Provide(container, aesgcm.NewKeyEncrypter)
 	injector.Provide(container, jwt.NewJWTTokenGenerator)
+	injector.Provide(container, paseto.NewPASETOTokenGenerator)
+	injector.Provide(container, jwt.NewJWEEncrypter)
+	injector.Provide(container, jwt.NewAssertionVerifier)
+	injector.Provide(container, jwt.NewFederatedTokenVerifier)
+	injector.Provide(container, jwt.NewDPoPProofVerifier)
+	injector.Provide(container, pki.NewCertificateVerifier)
+	injector.Provide(container, webauthn.NewWebAuthnVerifier)
+}
+
+func provideHTTPClients(container *dig.Container) {
+	injector.Provide(container, httpclient.NewSectorIdentifierFetcher)
+	injector.Provide(container, httpclient.NewJWKSFetcher)
+	injector.Provide(container, httpclient.NewBackchannelClientNotifier)
+}
+
+func provideNotifiers(container *dig.Container) {
+	injector.Provide(container, notification.NewLocalNotifier)
+	injector.Provide(container, notification.NewLocalAccountNotifier)
+	injector.Provide(container, mail.NewMailer)
+}
+
+func provideJobs(container *dig.Container) {
+	injector.Provide(container, scheduler.NewKeyRotationJob)
 }
 
 func provideServer(container *dig.Container) {
@@ -80,4 +161,5 @@ func provideServer(container *dig.Container) {
 
 func provideMiddlewares(container *dig.Container) {
 	injector.Provide(container, middlewares.NewAuthMiddleware)
+	injector.Provide(container, middlewares.NewAdminMiddleware)
 }
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/context"
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
 	"github.com/g-villarinho/oidc-server/internal/core/domain"
@@ -16,13 +17,15 @@ import (
 type AuthHandler struct {
 	authService   services.AuthService
 	cookieHandler *CookieHandler
+	context       *context.EchoContext
 	logger        *slog.Logger
 }
 
-func NewAuthHandler(authService services.AuthService, cookieHandler *CookieHandler, logger *slog.Logger) *AuthHandler {
+func NewAuthHandler(authService services.AuthService, cookieHandler *CookieHandler, context *context.EchoContext, logger *slog.Logger) *AuthHandler {
 	return &AuthHandler{
 		authService:   authService,
 		cookieHandler: cookieHandler,
+		context:       context,
 		logger:        logger,
 	}
 }
@@ -47,7 +50,7 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		redirectURL = "/"
 	}
 
-	session, user, err := h.authService.Login(c.Request().Context(), payload.Email, payload.Password)
+	result, err := h.authService.Login(c.Request().Context(), payload.Email, payload.Password)
 	if err != nil {
 		if errors.Is(err, domain.ErrPasswordMismatch) || errors.Is(err, domain.ErrUserNotFound) {
 			logger.Warn("invalid login attempt", "error", err)
@@ -63,18 +66,198 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		return response.InternalServerError(c, "Failed to login")
 	}
 
+	if result.RequiresSecondFactor() {
+		return c.JSON(http.StatusAccepted, models.ToLoginTicketResponse(result.Ticket, redirectURL))
+	}
+
+	h.cookieHandler.Set(c, result.Session.ID.String(), result.Session.ExpiresAt)
+
+	return c.JSON(http.StatusOK, models.ToLoginResponse(result.User, redirectURL))
+}
+
+// LoginTOTP finishes a password login with a code from the user's authenticator app.
+func (h *AuthHandler) LoginTOTP(c echo.Context) error {
+	logger := h.logger.With("handler", "LoginTOTP")
+
+	var payload models.LoginTOTPPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind TOTP login payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid TOTP login payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	redirectURL, valid := security.ValidateRedirectURL(payload.Continue, nil)
+	if !valid {
+		logger.Warn("invalid redirect URL provided", "continue", payload.Continue)
+		redirectURL = "/"
+	}
+
+	session, user, err := h.authService.LoginWithTOTP(c.Request().Context(), payload.Ticket, payload.Code)
+	if err != nil {
+		if errors.Is(err, domain.ErrLoginTicketNotFound) {
+			logger.Warn("TOTP login with an unknown or expired ticket")
+			return response.Unauthorized(c, "INVALID_LOGIN_TICKET", "Your login has expired. Please sign in again.")
+		}
+
+		if errors.Is(err, domain.ErrTOTPNotEnrolled) {
+			logger.Warn("TOTP login for a user not enrolled in TOTP")
+			return response.BadRequest(c, "TOTP_NOT_ENROLLED", "Two-factor authentication with a code is not enabled for this account.")
+		}
+
+		if errors.Is(err, domain.ErrInvalidTOTPCode) {
+			logger.Warn("invalid TOTP code", "error", err)
+			return response.Unauthorized(c, "INVALID_TOTP_CODE", "Invalid verification code. Please try again.")
+		}
+
+		if errors.Is(err, domain.ErrTooManyTOTPAttempts) {
+			logger.Warn("too many TOTP attempts", "error", err)
+			return response.TooManyRequests(c, "TOO_MANY_ATTEMPTS", "Too many verification attempts. Please try again later.")
+		}
+
+		logger.Error("failed to login user with TOTP due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to login")
+	}
+
+	h.cookieHandler.Set(c, session.ID.String(), session.ExpiresAt)
+
+	return c.JSON(http.StatusOK, models.ToLoginResponse(user, redirectURL))
+}
+
+// LoginRecoveryCode finishes a password login with one of the user's recovery codes.
+func (h *AuthHandler) LoginRecoveryCode(c echo.Context) error {
+	logger := h.logger.With("handler", "LoginRecoveryCode")
+
+	var payload models.LoginRecoveryCodePayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind recovery code login payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid recovery code login payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	redirectURL, valid := security.ValidateRedirectURL(payload.Continue, nil)
+	if !valid {
+		logger.Warn("invalid redirect URL provided", "continue", payload.Continue)
+		redirectURL = "/"
+	}
+
+	session, user, err := h.authService.LoginWithRecoveryCode(c.Request().Context(), payload.Ticket, payload.Code)
+	if err != nil {
+		if errors.Is(err, domain.ErrLoginTicketNotFound) {
+			logger.Warn("recovery code login with an unknown or expired ticket")
+			return response.Unauthorized(c, "INVALID_LOGIN_TICKET", "Your login has expired. Please sign in again.")
+		}
+
+		if errors.Is(err, domain.ErrRecoveryCodesNotGenerated) {
+			logger.Warn("recovery code login for a user without recovery codes")
+			return response.BadRequest(c, "RECOVERY_CODES_NOT_GENERATED", "This account has no recovery codes left.")
+		}
+
+		if errors.Is(err, domain.ErrInvalidRecoveryCode) {
+			logger.Warn("invalid recovery code", "error", err)
+			return response.Unauthorized(c, "INVALID_RECOVERY_CODE", "Invalid recovery code. Please try again.")
+		}
+
+		if errors.Is(err, domain.ErrTooManyRecoveryCodeAttempts) {
+			logger.Warn("too many recovery code attempts", "error", err)
+			return response.TooManyRequests(c, "TOO_MANY_ATTEMPTS", "Too many verification attempts. Please try again later.")
+		}
+
+		logger.Error("failed to login user with recovery code due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to login")
+	}
+
 	h.cookieHandler.Set(c, session.ID.String(), session.ExpiresAt)
 
-	response := models.LoginResponse{
-		Continue: redirectURL,
-		User: models.UserResponse{
-			ID:    user.ID.String(),
-			Email: user.Email,
-			Name:  user.Name,
-		},
+	return c.JSON(http.StatusOK, models.ToLoginResponse(user, redirectURL))
+}
+
+// BeginWebAuthnLogin hands out the options of a passwordless or second factor passkey login.
+func (h *AuthHandler) BeginWebAuthnLogin(c echo.Context) error {
+	logger := h.logger.With("handler", "BeginWebAuthnLogin")
+
+	var payload models.WebAuthnLoginOptionsPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind webauthn login options payload", "error", err)
+		return response.InvalidBind(c)
 	}
 
-	return c.JSON(http.StatusOK, response)
+	options, err := h.authService.BeginWebAuthnLogin(c.Request().Context(), payload.Ticket)
+	if err != nil {
+		if errors.Is(err, domain.ErrLoginTicketNotFound) {
+			logger.Warn("webauthn login with an unknown or expired ticket")
+			return response.Unauthorized(c, "INVALID_LOGIN_TICKET", "Your login has expired. Please sign in again.")
+		}
+
+		if errors.Is(err, domain.ErrWebAuthnCredentialNotFound) {
+			return response.BadRequest(c, "WEBAUTHN_NOT_REGISTERED", "No passkey is registered for this account.")
+		}
+
+		logger.Error("failed to begin webauthn login due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to begin passkey login")
+	}
+
+	return c.JSON(http.StatusOK, models.ToWebAuthnLoginOptionsResponse(options))
+}
+
+// LoginWebAuthn finishes a passkey login with the authenticator's response.
+func (h *AuthHandler) LoginWebAuthn(c echo.Context) error {
+	logger := h.logger.With("handler", "LoginWebAuthn")
+
+	var payload models.WebAuthnLoginPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind webauthn login payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid webauthn login payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	assertion, err := payload.ToAssertion()
+	if err != nil {
+		logger.Warn("malformed webauthn assertion", "error", err)
+		return response.BadRequest(c, "INVALID_WEBAUTHN_RESPONSE", "The passkey response is malformed.")
+	}
+
+	redirectURL, valid := security.ValidateRedirectURL(payload.Continue, nil)
+	if !valid {
+		logger.Warn("invalid redirect URL provided", "continue", payload.Continue)
+		redirectURL = "/"
+	}
+
+	session, user, err := h.authService.LoginWithWebAuthn(c.Request().Context(), payload.Ticket, payload.ChallengeID, assertion)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrLoginTicketNotFound):
+			logger.Warn("webauthn login with an unknown or expired ticket")
+			return response.Unauthorized(c, "INVALID_LOGIN_TICKET", "Your login has expired. Please sign in again.")
+		case errors.Is(err, domain.ErrWebAuthnChallengeNotFound):
+			logger.Warn("webauthn login with an unknown or expired challenge")
+			return response.Unauthorized(c, "INVALID_WEBAUTHN_CHALLENGE", "Your passkey login has expired. Please try again.")
+		case errors.Is(err, domain.ErrInvalidWebAuthnResponse):
+			logger.Warn("invalid webauthn assertion", "error", err)
+			return response.Unauthorized(c, "INVALID_WEBAUTHN_RESPONSE", "The passkey could not be verified. Please try again.")
+		case errors.Is(err, domain.ErrEmailNotVerified):
+			logger.Warn("webauthn login with unverified email", "error", err)
+			return response.Forbidden(c, "EMAIL_NOT_VERIFIED", "Email address has not been verified.")
+		}
+
+		logger.Error("failed to login user with webauthn due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to login")
+	}
+
+	h.cookieHandler.Set(c, session.ID.String(), session.ExpiresAt)
+
+	return c.JSON(http.StatusOK, models.ToLoginResponse(user, redirectURL))
 }
 
 func (h *AuthHandler) RegisterUser(c echo.Context) error {
@@ -97,9 +280,32 @@ func (h *AuthHandler) RegisterUser(c echo.Context) error {
 			return response.BadRequest(c, "EMAIL_ALREADY_IN_USE", "The provided email is already in use. Please use a different email.")
 		}
 
+		if errors.Is(err, domain.ErrVerificationEmailNotSent) {
+			logger.Error("user registered without a verification email", "error", err)
+			return c.NoContent(http.StatusCreated)
+		}
+
 		logger.Error("failed to register user due to internal error", "error", err)
 		return response.InternalServerError(c, "Failed to register user")
 	}
 
 	return c.NoContent(http.StatusCreated)
 }
+
+func (h *AuthHandler) Logout(c echo.Context) error {
+	logger := h.logger.With("handler", "Logout")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	if err := h.authService.Logout(c.Request().Context(), session.ID); err != nil {
+		logger.Error("failed to logout user due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to logout")
+	}
+
+	h.cookieHandler.Clear(c)
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type AuthorizationDetailHandler struct {
+	authorizationDetailService services.AuthorizationDetailService
+	logger                     *slog.Logger
+}
+
+func NewAuthorizationDetailHandler(authorizationDetailService services.AuthorizationDetailService, logger *slog.Logger) *AuthorizationDetailHandler {
+	return &AuthorizationDetailHandler{
+		authorizationDetailService: authorizationDetailService,
+		logger:                     logger,
+	}
+}
+
+func (h *AuthorizationDetailHandler) CreateDetailType(c echo.Context) error {
+	logger := h.logger.With("handler", "CreateDetailType")
+
+	var payload models.CreateAuthorizationDetailTypePayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind create authorization detail type payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid create authorization detail type payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	detailType, err := h.authorizationDetailService.CreateDetailType(c.Request().Context(), models.ToCreateAuthorizationDetailTypeParams(payload))
+	if err != nil {
+		if errors.Is(err, domain.ErrAuthorizationDetailTypeAlreadyExists) {
+			logger.Warn("attempt to create duplicate authorization detail type", "type", payload.Type)
+			return response.ConflictError(c, "AUTHORIZATION_DETAIL_TYPE_ALREADY_EXISTS", "An authorization detail type with this name already exists")
+		}
+
+		logger.Error("failed to create authorization detail type due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to create authorization detail type")
+	}
+
+	return c.JSON(http.StatusCreated, models.ToAuthorizationDetailTypeResponse(detailType))
+}
+
+func (h *AuthorizationDetailHandler) GetDetailTypeByID(c echo.Context) error {
+	logger := h.logger.With("handler", "GetDetailTypeByID")
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid authorization detail type ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_AUTHORIZATION_DETAIL_TYPE_ID", "Invalid authorization detail type ID format")
+	}
+
+	detailType, err := h.authorizationDetailService.GetDetailTypeByID(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			logger.Warn("authorization detail type not found", "id", id)
+			return response.NotFound(c, "AUTHORIZATION_DETAIL_TYPE_NOT_FOUND", "Authorization detail type not found")
+		}
+
+		logger.Error("failed to get authorization detail type due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to get authorization detail type")
+	}
+
+	return c.JSON(http.StatusOK, models.ToAuthorizationDetailTypeResponse(detailType))
+}
+
+func (h *AuthorizationDetailHandler) ListDetailTypes(c echo.Context) error {
+	logger := h.logger.With("handler", "ListDetailTypes")
+
+	detailTypes, err := h.authorizationDetailService.ListDetailTypes(c.Request().Context())
+	if err != nil {
+		logger.Error("failed to list authorization detail types due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to list authorization detail types")
+	}
+
+	detailTypeResponses := make([]models.AuthorizationDetailTypeResponse, 0, len(detailTypes))
+	for _, detailType := range detailTypes {
+		detailTypeResponses = append(detailTypeResponses, models.ToAuthorizationDetailTypeResponse(detailType))
+	}
+
+	response := models.AuthorizationDetailTypeListResponse{
+		DetailTypes: detailTypeResponses,
+		Total:       len(detailTypeResponses),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *AuthorizationDetailHandler) UpdateDetailType(c echo.Context) error {
+	logger := h.logger.With("handler", "UpdateDetailType")
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid authorization detail type ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_AUTHORIZATION_DETAIL_TYPE_ID", "Invalid authorization detail type ID format")
+	}
+
+	var payload models.UpdateAuthorizationDetailTypePayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind update authorization detail type payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid update authorization detail type payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	detailType, err := h.authorizationDetailService.UpdateDetailType(c.Request().Context(), id, models.ToUpdateAuthorizationDetailTypeParams(payload))
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			logger.Warn("authorization detail type not found for update", "id", id)
+			return response.NotFound(c, "AUTHORIZATION_DETAIL_TYPE_NOT_FOUND", "Authorization detail type not found")
+		}
+
+		logger.Error("failed to update authorization detail type due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to update authorization detail type")
+	}
+
+	return c.JSON(http.StatusOK, models.ToAuthorizationDetailTypeResponse(detailType))
+}
+
+func (h *AuthorizationDetailHandler) DeleteDetailType(c echo.Context) error {
+	logger := h.logger.With("handler", "DeleteDetailType")
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid authorization detail type ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_AUTHORIZATION_DETAIL_TYPE_ID", "Invalid authorization detail type ID format")
+	}
+
+	if err := h.authorizationDetailService.DeleteDetailType(c.Request().Context(), id); err != nil {
+		logger.Error("failed to delete authorization detail type due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to delete authorization detail type")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/context"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+	"github.com/labstack/echo/v4"
+)
+
+type BackchannelHandler struct {
+	backchannelService services.BackchannelAuthenticationService
+	context            *context.EchoContext
+	logger             *slog.Logger
+}
+
+func NewBackchannelHandler(backchannelService services.BackchannelAuthenticationService, context *context.EchoContext, logger *slog.Logger) *BackchannelHandler {
+	return &BackchannelHandler{
+		backchannelService: backchannelService,
+		context:            context,
+		logger:             logger,
+	}
+}
+
+func (h *BackchannelHandler) Authenticate(c echo.Context) error {
+	logger := h.logger.With("handler", "BackchannelAuthenticate")
+
+	var payload models.BackchannelAuthenticationPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("error to bind backchannel authentication payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("validate backchannel authentication payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	params := payload.ToBackchannelAuthenticationParams()
+	params.ClientCertificate = clientCertificate(c)
+
+	authenticationResponse, err := h.backchannelService.Authenticate(c.Request().Context(), params)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidClient):
+			logger.Warn("invalid client on backchannel authentication", "error", err)
+			return response.Unauthorized(c, "INVALID_CLIENT", "The client credentials are invalid.")
+		case errors.Is(err, domain.ErrInvalidClientCertificate):
+			logger.Warn("missing or mismatched client certificate on backchannel authentication", "error", err)
+			return response.Unauthorized(c, "INVALID_CLIENT", "The client credentials are invalid.")
+		case errors.Is(err, domain.ErrUnauthorizedClient):
+			logger.Warn("unauthorized client on backchannel authentication", "client_id", payload.ClientID)
+			return response.BadRequest(c, "UNAUTHORIZED_CLIENT", "The client is not authorized to use backchannel authentication.")
+		case errors.Is(err, domain.ErrInvalidScope):
+			logger.Warn("invalid scope on backchannel authentication", "client_id", payload.ClientID)
+			return response.BadRequest(c, "INVALID_SCOPE", "The requested scope must include openid and be allowed for the client.")
+		case errors.Is(err, domain.ErrUnknownUserID):
+			logger.Warn("unknown user on backchannel authentication", "client_id", payload.ClientID)
+			return response.BadRequest(c, "UNKNOWN_USER_ID", "The login hint does not identify a known user.")
+		case errors.Is(err, domain.ErrInvalidBindingMessage):
+			logger.Warn("invalid binding message on backchannel authentication", "client_id", payload.ClientID)
+			return response.BadRequest(c, "INVALID_BINDING_MESSAGE", "The binding message is too long or contains unsupported characters.")
+		case errors.Is(err, domain.ErrInvalidBackchannelRequest):
+			logger.Warn("invalid backchannel authentication request", "error", err)
+			return response.BadRequest(c, "INVALID_REQUEST", "The backchannel authentication request is invalid.")
+		}
+
+		logger.Error("error to start backchannel authentication", "error", err)
+		return response.InternalServerError(c, "The authentication request could not be started due to an internal error.")
+	}
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	return c.JSON(http.StatusOK, authenticationResponse)
+}
+
+func (h *BackchannelHandler) GetRequest(c echo.Context) error {
+	logger := h.logger.With("handler", "GetBackchannelRequest")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	request, err := h.backchannelService.GetRequest(c.Request().Context(), session.UserID, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, domain.ErrBackchannelRequestNotFound) {
+			logger.Warn("backchannel authentication request not found")
+			return response.NotFound(c, "REQUEST_NOT_FOUND", "Authentication request not found")
+		}
+
+		logger.Error("failed to get backchannel authentication request due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to get authentication request")
+	}
+
+	return c.JSON(http.StatusOK, models.ToBackchannelRequestResponse(request))
+}
+
+func (h *BackchannelHandler) Approve(c echo.Context) error {
+	logger := h.logger.With("handler", "ApproveBackchannelRequest")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	if err := h.backchannelService.Approve(c.Request().Context(), session, c.Param("id")); err != nil {
+		if errors.Is(err, domain.ErrBackchannelRequestNotFound) {
+			logger.Warn("backchannel authentication request not found")
+			return response.NotFound(c, "REQUEST_NOT_FOUND", "Authentication request not found")
+		}
+
+		logger.Error("failed to approve backchannel authentication request due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to approve authentication request")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *BackchannelHandler) Deny(c echo.Context) error {
+	logger := h.logger.With("handler", "DenyBackchannelRequest")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	if err := h.backchannelService.Deny(c.Request().Context(), session.UserID, c.Param("id")); err != nil {
+		if errors.Is(err, domain.ErrBackchannelRequestNotFound) {
+			logger.Warn("backchannel authentication request not found")
+			return response.NotFound(c, "REQUEST_NOT_FOUND", "Authentication request not found")
+		}
+
+		logger.Error("failed to deny backchannel authentication request due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to deny authentication request")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
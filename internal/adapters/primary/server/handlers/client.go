@@ -7,6 +7,7 @@ import (
 
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
 	"github.com/g-villarinho/oidc-server/internal/core/ports"
 	"github.com/g-villarinho/oidc-server/internal/core/services"
 	"github.com/google/uuid"
@@ -39,29 +40,44 @@ func (h *ClientHandler) CreateClient(c echo.Context) error {
 		return response.ValidationError(c, err)
 	}
 
-	client, err := h.clientService.CreateClient(c.Request().Context(), models.ToCreateClientParams(payload))
+	client, clientSecret, err := h.clientService.CreateClient(c.Request().Context(), models.ToCreateClientParams(payload))
 	if err != nil {
 		if errors.Is(err, ports.ErrUniqueKeyViolation) {
 			logger.Warn("attempt to create client with duplicate client_id", "error", err)
 			return response.ConflictError(c, "CLIENT_ALREADY_EXISTS", "A client with this client_id already exists")
 		}
 
+		if errors.Is(err, domain.ErrInvalidClientKeys) {
+			logger.Warn("missing keys on client creation", "error", err)
+			return response.BadRequest(c, "INVALID_CLIENT_METADATA", "Clients using private_key_jwt or self_signed_tls_client_auth must register jwks or jwks_uri, and clients using tls_client_auth a tls_client_auth_subject_dn")
+		}
+
+		if errors.Is(err, domain.ErrInvalidBackchannelConfiguration) {
+			logger.Warn("invalid backchannel configuration on client creation", "error", err)
+			return response.BadRequest(c, "INVALID_CLIENT_METADATA", "Confidential clients using CIBA must register a backchannel_token_delivery_mode, and a backchannel_client_notification_endpoint for ping mode")
+		}
+
+		if errors.Is(err, domain.ErrInvalidClientEncryption) {
+			logger.Warn("invalid encryption metadata on client creation", "error", err)
+			return response.BadRequest(c, "INVALID_CLIENT_METADATA", "Encrypted responses need a supported alg and enc, and jwks or jwks_uri to encrypt to")
+		}
+
+		if errors.Is(err, domain.ErrInvalidSectorIdentifier) || errors.Is(err, domain.ErrInvalidRedirectURI) {
+			logger.Warn("invalid sector identifier on client creation", "error", err)
+			return response.BadRequest(c, "INVALID_CLIENT_METADATA", "The sector identifier is invalid or does not list every redirect URI")
+		}
+
+		if errors.Is(err, domain.ErrUnknownScope) || errors.Is(err, domain.ErrRestrictedScope) {
+			logger.Warn("invalid scopes on client creation", "error", err)
+			return response.BadRequest(c, "INVALID_SCOPE", "The client scopes must be registered, and first-party scopes require a first-party client")
+		}
+
 		logger.Error("failed to create client due to internal error", "error", err)
 		return response.InternalServerError(c, "Failed to create client")
 	}
 
-	clientResponse := models.ClientResponse{
-		ID:            client.ID.String(),
-		ClientID:      client.ClientID,
-		ClientName:    client.ClientName,
-		RedirectURIs:  client.RedirectURIs,
-		GrantTypes:    client.GrantTypes,
-		ResponseTypes: client.ResponseTypes,
-		Scopes:        client.Scopes,
-		LogoURL:       client.LogoURL,
-		CreatedAt:     client.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:     client.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}
+	clientResponse := models.ToClientResponse(client)
+	clientResponse.ClientSecret = clientSecret
 
 	return c.JSON(http.StatusCreated, clientResponse)
 }
@@ -87,18 +103,7 @@ func (h *ClientHandler) GetClientByID(c echo.Context) error {
 		return response.InternalServerError(c, "Failed to get client")
 	}
 
-	clientResponse := models.ClientResponse{
-		ID:            client.ID.String(),
-		ClientID:      client.ClientID,
-		ClientName:    client.ClientName,
-		RedirectURIs:  client.RedirectURIs,
-		GrantTypes:    client.GrantTypes,
-		ResponseTypes: client.ResponseTypes,
-		Scopes:        client.Scopes,
-		LogoURL:       client.LogoURL,
-		CreatedAt:     client.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:     client.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}
+	clientResponse := models.ToClientResponse(client)
 
 	return c.JSON(http.StatusOK, clientResponse)
 }
@@ -114,18 +119,7 @@ func (h *ClientHandler) ListClients(c echo.Context) error {
 
 	clientResponses := make([]models.ClientResponse, 0, len(clients))
 	for _, client := range clients {
-		clientResponses = append(clientResponses, models.ClientResponse{
-			ID:            client.ID.String(),
-			ClientID:      client.ClientID,
-			ClientName:    client.ClientName,
-			RedirectURIs:  client.RedirectURIs,
-			GrantTypes:    client.GrantTypes,
-			ResponseTypes: client.ResponseTypes,
-			Scopes:        client.Scopes,
-			LogoURL:       client.LogoURL,
-			CreatedAt:     client.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			UpdatedAt:     client.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		})
+		clientResponses = append(clientResponses, models.ToClientResponse(client))
 	}
 
 	response := models.ClientListResponse{
@@ -157,37 +151,43 @@ func (h *ClientHandler) UpdateClient(c echo.Context) error {
 		return response.ValidationError(c, err)
 	}
 
-	client, err := h.clientService.UpdateClient(
-		c.Request().Context(),
-		id,
-		payload.ClientName,
-		payload.RedirectURIs,
-		payload.GrantTypes,
-		payload.ResponseTypes,
-		payload.Scopes,
-	)
+	client, err := h.clientService.UpdateClient(c.Request().Context(), id, models.ToUpdateClientParams(payload))
 	if err != nil {
 		if errors.Is(err, ports.ErrNotFound) {
 			logger.Warn("client not found for update", "id", id)
 			return response.NotFound(c, "CLIENT_NOT_FOUND", "Client not found")
 		}
 
+		if errors.Is(err, domain.ErrInvalidClientKeys) {
+			logger.Warn("missing keys on client update", "error", err)
+			return response.BadRequest(c, "INVALID_CLIENT_METADATA", "Clients using private_key_jwt or self_signed_tls_client_auth must register jwks or jwks_uri, and clients using tls_client_auth a tls_client_auth_subject_dn. Only clients created with client_secret_jwt can use it")
+		}
+
+		if errors.Is(err, domain.ErrInvalidBackchannelConfiguration) {
+			logger.Warn("invalid backchannel configuration on client update", "error", err)
+			return response.BadRequest(c, "INVALID_CLIENT_METADATA", "Confidential clients using CIBA must register a backchannel_token_delivery_mode, and a backchannel_client_notification_endpoint for ping mode")
+		}
+
+		if errors.Is(err, domain.ErrInvalidClientEncryption) {
+			logger.Warn("invalid encryption metadata on client update", "error", err)
+			return response.BadRequest(c, "INVALID_CLIENT_METADATA", "Encrypted responses need a supported alg and enc, and jwks or jwks_uri to encrypt to")
+		}
+
+		if errors.Is(err, domain.ErrInvalidSectorIdentifier) || errors.Is(err, domain.ErrInvalidRedirectURI) {
+			logger.Warn("invalid sector identifier on client update", "error", err)
+			return response.BadRequest(c, "INVALID_CLIENT_METADATA", "The sector identifier is invalid or does not list every redirect URI")
+		}
+
+		if errors.Is(err, domain.ErrUnknownScope) || errors.Is(err, domain.ErrRestrictedScope) {
+			logger.Warn("invalid scopes on client update", "error", err)
+			return response.BadRequest(c, "INVALID_SCOPE", "The client scopes must be registered, and first-party scopes require a first-party client")
+		}
+
 		logger.Error("failed to update client due to internal error", "error", err)
 		return response.InternalServerError(c, "Failed to update client")
 	}
 
-	clientResponse := models.ClientResponse{
-		ID:            client.ID.String(),
-		ClientID:      client.ClientID,
-		ClientName:    client.ClientName,
-		RedirectURIs:  client.RedirectURIs,
-		GrantTypes:    client.GrantTypes,
-		ResponseTypes: client.ResponseTypes,
-		Scopes:        client.Scopes,
-		LogoURL:       client.LogoURL,
-		CreatedAt:     client.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:     client.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
-	}
+	clientResponse := models.ToClientResponse(client)
 
 	return c.JSON(http.StatusOK, clientResponse)
 }
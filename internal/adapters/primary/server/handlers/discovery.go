@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+	"github.com/labstack/echo/v4"
+)
+
+type DiscoveryHandler struct {
+	discoveryService services.DiscoveryService
+	logger           *slog.Logger
+}
+
+func NewDiscoveryHandler(discoveryService services.DiscoveryService, logger *slog.Logger) *DiscoveryHandler {
+	return &DiscoveryHandler{
+		discoveryService: discoveryService,
+		logger:           logger.With("handler", "discovery"),
+	}
+}
+
+func (h *DiscoveryHandler) JWKS(c echo.Context) error {
+	logger := h.logger.With("method", "JWKS")
+
+	keySet, err := h.discoveryService.GetJSONWebKeySet(c.Request().Context())
+	if err != nil {
+		logger.Error("error to get JSON web key set", "error", err)
+		return response.InternalServerError(c, "The key set could not be loaded due to an internal error.")
+	}
+
+	return c.JSON(http.StatusOK, keySet)
+}
+
+// PASETOKeys publishes the keys verifying PASETO access tokens, the PASETO counterpart of the JWKS.
+func (h *DiscoveryHandler) PASETOKeys(c echo.Context) error {
+	logger := h.logger.With("method", "PASETOKeys")
+
+	keySet, err := h.discoveryService.GetPASETOKeySet(c.Request().Context())
+	if err != nil {
+		logger.Error("error to get PASETO key set", "error", err)
+		return response.InternalServerError(c, "The key set could not be loaded due to an internal error.")
+	}
+
+	return c.JSON(http.StatusOK, keySet)
+}
+
+func (h *DiscoveryHandler) OpenIDConfiguration(c echo.Context) error {
+	logger := h.logger.With("method", "OpenIDConfiguration")
+
+	metadata, err := h.discoveryService.GetProviderMetadata(c.Request().Context())
+	if err != nil {
+		logger.Error("error to get provider metadata", "error", err)
+		return response.InternalServerError(c, "The provider configuration could not be loaded due to an internal error.")
+	}
+
+	return c.JSON(http.StatusOK, metadata)
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+	"github.com/labstack/echo/v4"
+)
+
+type EmailVerificationHandler struct {
+	emailVerificationService services.EmailVerificationService
+	logger                   *slog.Logger
+}
+
+func NewEmailVerificationHandler(emailVerificationService services.EmailVerificationService, logger *slog.Logger) *EmailVerificationHandler {
+	return &EmailVerificationHandler{
+		emailVerificationService: emailVerificationService,
+		logger:                   logger,
+	}
+}
+
+func (h *EmailVerificationHandler) VerifyEmail(c echo.Context) error {
+	logger := h.logger.With("handler", "VerifyEmail")
+
+	var payload models.VerifyEmailPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind verify email payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid verify email payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	if err := h.emailVerificationService.VerifyEmail(c.Request().Context(), payload.Token); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidEmailVerificationToken):
+			logger.Warn("invalid email verification token")
+			return response.BadRequest(c, "INVALID_VERIFICATION_TOKEN", "The verification link is invalid or has expired. Please request a new one.")
+		case errors.Is(err, domain.ErrEmailAlreadyVerified):
+			return response.ConflictError(c, "EMAIL_ALREADY_VERIFIED", "This email is already verified.")
+		}
+
+		logger.Error("failed to verify email due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to verify email")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ResendVerificationEmail answers the same for any email, so it can't be used to find accounts.
+func (h *EmailVerificationHandler) ResendVerificationEmail(c echo.Context) error {
+	logger := h.logger.With("handler", "ResendVerificationEmail")
+
+	var payload models.ResendVerificationEmailPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind resend verification email payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid resend verification email payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	if err := h.emailVerificationService.ResendVerificationEmail(c.Request().Context(), payload.Email); err != nil {
+		if errors.Is(err, domain.ErrTooManyVerificationEmails) {
+			logger.Warn("too many verification emails requested")
+			return response.TooManyRequests(c, "TOO_MANY_REQUESTS", "Too many verification emails requested. Please try again later.")
+		}
+
+		logger.Error("failed to resend verification email due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to send verification email")
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type FederationHandler struct {
+	federationService services.FederationService
+	logger            *slog.Logger
+}
+
+func NewFederationHandler(federationService services.FederationService, logger *slog.Logger) *FederationHandler {
+	return &FederationHandler{
+		federationService: federationService,
+		logger:            logger,
+	}
+}
+
+func (h *FederationHandler) CreateTrustedIssuer(c echo.Context) error {
+	logger := h.logger.With("handler", "CreateTrustedIssuer")
+
+	var payload models.CreateTrustedIssuerPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind create trusted issuer payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid create trusted issuer payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	issuer, err := h.federationService.CreateTrustedIssuer(c.Request().Context(), models.ToCreateTrustedIssuerParams(payload))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTrustedIssuerAlreadyExists):
+			logger.Warn("attempt to create duplicate trusted issuer", "issuer", payload.Issuer)
+			return response.ConflictError(c, "TRUSTED_ISSUER_ALREADY_EXISTS", "A trusted issuer with this issuer already exists")
+		case errors.Is(err, domain.ErrInvalidTrustedIssuer):
+			logger.Warn("invalid trusted issuer", "issuer", payload.Issuer)
+			return response.BadRequest(c, "INVALID_TRUSTED_ISSUER", "The issuer must be an https URL with jwks or jwks_uri and at least one audience")
+		}
+
+		logger.Error("failed to create trusted issuer due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to create trusted issuer")
+	}
+
+	return c.JSON(http.StatusCreated, models.ToTrustedIssuerResponse(issuer))
+}
+
+func (h *FederationHandler) GetTrustedIssuerByID(c echo.Context) error {
+	logger := h.logger.With("handler", "GetTrustedIssuerByID")
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid trusted issuer ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_TRUSTED_ISSUER_ID", "Invalid trusted issuer ID format")
+	}
+
+	issuer, err := h.federationService.GetTrustedIssuerByID(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			logger.Warn("trusted issuer not found", "id", id)
+			return response.NotFound(c, "TRUSTED_ISSUER_NOT_FOUND", "Trusted issuer not found")
+		}
+
+		logger.Error("failed to get trusted issuer due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to get trusted issuer")
+	}
+
+	return c.JSON(http.StatusOK, models.ToTrustedIssuerResponse(issuer))
+}
+
+func (h *FederationHandler) ListTrustedIssuers(c echo.Context) error {
+	logger := h.logger.With("handler", "ListTrustedIssuers")
+
+	issuers, err := h.federationService.ListTrustedIssuers(c.Request().Context())
+	if err != nil {
+		logger.Error("failed to list trusted issuers due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to list trusted issuers")
+	}
+
+	issuerResponses := make([]models.TrustedIssuerResponse, 0, len(issuers))
+	for _, issuer := range issuers {
+		issuerResponses = append(issuerResponses, models.ToTrustedIssuerResponse(issuer))
+	}
+
+	return c.JSON(http.StatusOK, models.TrustedIssuerListResponse{
+		Issuers: issuerResponses,
+		Total:   len(issuerResponses),
+	})
+}
+
+func (h *FederationHandler) DeleteTrustedIssuer(c echo.Context) error {
+	logger := h.logger.With("handler", "DeleteTrustedIssuer")
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid trusted issuer ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_TRUSTED_ISSUER_ID", "Invalid trusted issuer ID format")
+	}
+
+	if err := h.federationService.DeleteTrustedIssuer(c.Request().Context(), id); err != nil {
+		logger.Error("failed to delete trusted issuer due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to delete trusted issuer")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *FederationHandler) CreateTrustPolicy(c echo.Context) error {
+	logger := h.logger.With("handler", "CreateTrustPolicy")
+
+	idParam := c.Param("id")
+	issuerID, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid trusted issuer ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_TRUSTED_ISSUER_ID", "Invalid trusted issuer ID format")
+	}
+
+	var payload models.CreateTrustPolicyPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind create trust policy payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid create trust policy payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	policy, err := h.federationService.CreateTrustPolicy(c.Request().Context(), issuerID, models.ToCreateTrustPolicyParams(payload))
+	if err != nil {
+		switch {
+		case errors.Is(err, ports.ErrNotFound):
+			logger.Warn("trusted issuer not found for policy", "id", issuerID)
+			return response.NotFound(c, "TRUSTED_ISSUER_NOT_FOUND", "Trusted issuer not found")
+		case errors.Is(err, domain.ErrClientNotFound):
+			logger.Warn("client not found for trust policy", "client_id", payload.ClientID)
+			return response.BadRequest(c, "INVALID_CLIENT", "The client does not exist")
+		case errors.Is(err, domain.ErrInvalidScope):
+			logger.Warn("trust policy with scopes beyond the client", "error", err)
+			return response.BadRequest(c, "INVALID_SCOPE", "The policy scopes must be allowed for the client")
+		case errors.Is(err, domain.ErrInvalidTrustPolicy):
+			logger.Warn("invalid trust policy", "error", err)
+			return response.BadRequest(c, "INVALID_TRUST_POLICY", "The policy needs specific claim conditions and a client allowed to exchange tokens")
+		}
+
+		logger.Error("failed to create trust policy due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to create trust policy")
+	}
+
+	return c.JSON(http.StatusCreated, models.ToTrustPolicyResponse(policy))
+}
+
+func (h *FederationHandler) ListTrustPolicies(c echo.Context) error {
+	logger := h.logger.With("handler", "ListTrustPolicies")
+
+	idParam := c.Param("id")
+	issuerID, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid trusted issuer ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_TRUSTED_ISSUER_ID", "Invalid trusted issuer ID format")
+	}
+
+	policies, err := h.federationService.ListTrustPolicies(c.Request().Context(), issuerID)
+	if err != nil {
+		logger.Error("failed to list trust policies due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to list trust policies")
+	}
+
+	policyResponses := make([]models.TrustPolicyResponse, 0, len(policies))
+	for _, policy := range policies {
+		policyResponses = append(policyResponses, models.ToTrustPolicyResponse(policy))
+	}
+
+	return c.JSON(http.StatusOK, models.TrustPolicyListResponse{
+		Policies: policyResponses,
+		Total:    len(policyResponses),
+	})
+}
+
+func (h *FederationHandler) DeleteTrustPolicy(c echo.Context) error {
+	logger := h.logger.With("handler", "DeleteTrustPolicy")
+
+	idParam := c.Param("policyId")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid trust policy ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_TRUST_POLICY_ID", "Invalid trust policy ID format")
+	}
+
+	if err := h.federationService.DeleteTrustPolicy(c.Request().Context(), id); err != nil {
+		logger.Error("failed to delete trust policy due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to delete trust policy")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/context"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type GrantHandler struct {
+	grantService services.GrantService
+	context      *context.EchoContext
+	logger       *slog.Logger
+}
+
+func NewGrantHandler(grantService services.GrantService, context *context.EchoContext, logger *slog.Logger) *GrantHandler {
+	return &GrantHandler{
+		grantService: grantService,
+		context:      context,
+		logger:       logger,
+	}
+}
+
+func (h *GrantHandler) ListOfflineGrants(c echo.Context) error {
+	logger := h.logger.With("handler", "ListOfflineGrants")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	grants, err := h.grantService.ListOfflineGrants(c.Request().Context(), session.UserID)
+	if err != nil {
+		logger.Error("failed to list offline grants due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to list grants")
+	}
+
+	grantResponses := make([]models.OfflineGrantResponse, 0, len(grants))
+	for _, grant := range grants {
+		grantResponses = append(grantResponses, models.ToOfflineGrantResponse(grant))
+	}
+
+	response := models.OfflineGrantListResponse{
+		Grants: grantResponses,
+		Total:  len(grantResponses),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *GrantHandler) RevokeOfflineGrant(c echo.Context) error {
+	logger := h.logger.With("handler", "RevokeOfflineGrant")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid grant ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_GRANT_ID", "Invalid grant ID format")
+	}
+
+	if err := h.grantService.RevokeOfflineGrant(c.Request().Context(), session.UserID, id); err != nil {
+		if errors.Is(err, domain.ErrGrantNotFound) {
+			logger.Warn("offline grant not found", "id", id)
+			return response.NotFound(c, "GRANT_NOT_FOUND", "Grant not found")
+		}
+
+		logger.Error("failed to revoke offline grant due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to revoke grant")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *GrantHandler) GrantConsent(c echo.Context) error {
+	logger := h.logger.With("handler", "GrantConsent")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	var payload models.GrantConsentPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind grant consent payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid grant consent payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	if err := h.grantService.GrantConsent(c.Request().Context(), session.UserID, payload.ClientID, payload.Scopes); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrClientNotFound):
+			logger.Warn("client not found for consent", "client_id", payload.ClientID)
+			return response.BadRequest(c, "INVALID_CLIENT", "The client does not exist")
+		case errors.Is(err, domain.ErrInvalidScope):
+			logger.Warn("consent with scopes beyond the client", "client_id", payload.ClientID)
+			return response.BadRequest(c, "INVALID_SCOPE", "The consented scopes must be allowed for the client")
+		}
+
+		logger.Error("failed to grant consent due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to grant consent")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+	"github.com/labstack/echo/v4"
+)
+
+type KeyHandler struct {
+	keyService services.KeyService
+	logger     *slog.Logger
+}
+
+func NewKeyHandler(keyService services.KeyService, logger *slog.Logger) *KeyHandler {
+	return &KeyHandler{
+		keyService: keyService,
+		logger:     logger,
+	}
+}
+
+func (h *KeyHandler) ListKeys(c echo.Context) error {
+	logger := h.logger.With("handler", "ListKeys")
+
+	keys, err := h.keyService.ListKeys(c.Request().Context())
+	if err != nil {
+		logger.Error("failed to list signing keys due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to list signing keys")
+	}
+
+	return c.JSON(http.StatusOK, models.ToSigningKeyListResponse(keys))
+}
+
+// RotateKeys is the emergency rotation.
+func (h *KeyHandler) RotateKeys(c echo.Context) error {
+	logger := h.logger.With("handler", "RotateKeys")
+
+	keys, err := h.keyService.ForceRotation(c.Request().Context())
+	if err != nil {
+		if errors.Is(err, domain.ErrKeyRotationInProgress) {
+			logger.Warn("signing key rotation already in progress")
+			return response.ConflictError(c, "KEY_ROTATION_IN_PROGRESS", "A signing key rotation is already in progress")
+		}
+
+		logger.Error("failed to rotate signing keys due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to rotate signing keys")
+	}
+
+	logger.Info("signing keys rotated")
+	return c.JSON(http.StatusOK, models.ToSigningKeyListResponse(keys))
+}
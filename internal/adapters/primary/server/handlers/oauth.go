@@ -1,37 +1,55 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/context"
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
 	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
 	"github.com/g-villarinho/oidc-server/internal/core/services"
 	"github.com/g-villarinho/oidc-server/pkg/oauth"
 	"github.com/labstack/echo/v4"
 )
 
+const (
+	dpopHeader      = "DPoP"
+	dpopNonceHeader = "DPoP-Nonce"
+)
+
 type OAuthHandler struct {
-	oauthService services.OAuthService
-	context      *context.EchoContext
-	logger       *slog.Logger
-	url          config.URL
+	oauthService         services.OAuthService
+	userInfoService      services.UserInfoService
+	introspectionService services.IntrospectionService
+	dpopService          services.DPoPService
+	context              *context.EchoContext
+	logger               *slog.Logger
+	url                  config.URL
 }
 
 func NewOAuthHandler(
 	oauthService services.OAuthService,
+	userInfoService services.UserInfoService,
+	introspectionService services.IntrospectionService,
+	dpopService services.DPoPService,
 	context *context.EchoContext,
 	logger *slog.Logger,
 	config *config.Config,
 ) *OAuthHandler {
 	return &OAuthHandler{
-		oauthService: oauthService,
-		context:      context,
-		logger:       logger.With("handler", "authorization"),
-		url:          config.URL,
+		oauthService:         oauthService,
+		userInfoService:      userInfoService,
+		introspectionService: introspectionService,
+		dpopService:          dpopService,
+		context:              context,
+		logger:               logger.With("handler", "authorization"),
+		url:                  config.URL,
 	}
 }
 
@@ -63,34 +81,66 @@ func (h *OAuthHandler) Authorize(c echo.Context) error {
 		return c.String(http.StatusBadRequest, "invalid client authorization")
 	}
 
+	requiredACR, err := h.oauthService.RequiredACR(c.Request().Context(), payload.ToAuthorizeParams())
+	if err != nil {
+		logger.Error("error to resolve required acr", "error", err)
+		return response.InternalServerError(c, "The authorization workflow could not be completed due to an internal error.")
+	}
+
 	if session == nil {
 		logger.Info("no active session, redirecting to login")
+		return h.redirectToLogin(c, logger, payload, requiredACR)
+	}
 
-		continueURL := oauth.GenerateContinueURL(h.url.APIBaseURL, payload.ToContinueURLParams())
+	params := payload.ToAuthorizeParams()
 
-		loginURL, err := url.Parse(h.url.AppBaseURL)
-		if err != nil {
-			logger.Error("error to parse app base URL", "error", err)
-			return response.InternalServerError(c, "The authorization workflow could not be completed due to an internal error.")
+	authorizationResponse, err := h.oauthService.Authorize(c.Request().Context(), session, params)
+	if err != nil {
+		if errors.Is(err, domain.ErrStepUpRequired) {
+			logger.Info("session below the required acr, redirecting to step-up", "acr", session.ACR, "required_acr", requiredACR)
+			return h.redirectToLogin(c, logger, payload, requiredACR)
 		}
 
-		loginURL.Path = "/login"
-		q := loginURL.Query()
-		q.Set("continue", continueURL)
-		loginURL.RawQuery = q.Encode()
+		if errors.Is(err, domain.ErrConsentRequired) {
+			logger.Info("offline access without a stored consent, redirecting to consent")
 
-		return c.Redirect(http.StatusFound, loginURL.String())
-	}
+			consentURL, err := url.Parse(h.url.AppBaseURL)
+			if err != nil {
+				logger.Error("error to parse app base URL", "error", err)
+				return response.InternalServerError(c, "The authorization workflow could not be completed due to an internal error.")
+			}
+
+			consentURL.Path = "/consent"
+			q := consentURL.Query()
+			q.Set("client_id", params.ClientID)
+			q.Set("scope", strings.Join(params.Scopes, " "))
+			q.Set("continue", oauth.GenerateContinueURL(h.url.APIBaseURL, payload.ToContinueURLParams()))
+			consentURL.RawQuery = q.Encode()
+
+			return c.Redirect(http.StatusFound, consentURL.String())
+		}
 
-	authorizationCode, err := h.oauthService.CreateAuthorizationCode(c.Request().Context(), session.UserID, payload.ToAuthorizeParams())
-	if err != nil {
 		logger.Error("error to authorize client", "error", err)
 		return response.InternalServerError(c, "The authorization workflow could not be completed due to an internal error.")
 	}
 
-	redirectURI := oauth.GenerateCallbackURL(payload.RedirectURI, authorizationCode.Code, payload.State)
+	callbackParams := models.ToCallbackParams(authorizationResponse, payload.State)
 
-	return c.Redirect(http.StatusFound, redirectURI)
+	switch domain.BaseResponseMode(params.ResolveResponseMode()) {
+	case domain.ResponseModeFormPost:
+		page, err := oauth.GenerateFormPostHTML(payload.RedirectURI, callbackParams)
+		if err != nil {
+			logger.Error("error to render form post response", "error", err)
+			return response.InternalServerError(c, "The authorization workflow could not be completed due to an internal error.")
+		}
+
+		c.Response().Header().Set("Cache-Control", "no-store")
+		return c.HTML(http.StatusOK, page)
+	case domain.ResponseModeFragment:
+		return c.Redirect(http.StatusFound, oauth.GenerateFragmentCallbackURL(payload.RedirectURI, callbackParams))
+	default:
+		return c.Redirect(http.StatusFound, oauth.GenerateCallbackURL(payload.RedirectURI, callbackParams))
+	}
 }
 
 func (h *OAuthHandler) Token(c echo.Context) error {
@@ -107,6 +157,274 @@ func (h *OAuthHandler) Token(c echo.Context) error {
 		return response.ValidationError(c, err)
 	}
 
-	logger.Info("Token endpoint called")
-	return c.String(http.StatusOK, "Token issued successfully")
+	params := payload.ToExchangeTokenParams()
+
+	dpopJKT, err := h.verifyDPoPProof(c, domain.TokenEndpoint(h.url.APIBaseURL), "")
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUseDPoPNonce):
+			logger.Warn("DPoP proof without a current nonce on token exchange")
+			if err := h.setDPoPNonce(c); err != nil {
+				logger.Error("error to issue DPoP nonce", "error", err)
+				return response.InternalServerError(c, "The token could not be issued due to an internal error.")
+			}
+			return response.BadRequest(c, "USE_DPOP_NONCE", "The DPoP proof must include the nonce provided in the DPoP-Nonce header.")
+		case errors.Is(err, domain.ErrInvalidDPoPProof):
+			logger.Warn("invalid DPoP proof on token exchange", "error", err)
+			return response.BadRequest(c, "INVALID_DPOP_PROOF", "The DPoP proof is invalid.")
+		}
+
+		logger.Error("error to verify DPoP proof", "error", err)
+		return response.InternalServerError(c, "The token could not be issued due to an internal error.")
+	}
+	params.DPoPJKT = dpopJKT
+	params.ClientCertificate = clientCertificate(c)
+
+	tokenResponse, err := h.oauthService.ExchangeToken(c.Request().Context(), params)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidAuthorizationCode),
+			errors.Is(err, domain.ErrAuthorizationCodeAlreadyUsed),
+			errors.Is(err, domain.ErrAuthorizationCodeExpired),
+			errors.Is(err, domain.ErrInvalidRedirectURI),
+			errors.Is(err, domain.ErrInvalidPKCEVerification),
+			errors.Is(err, domain.ErrInvalidToken),
+			errors.Is(err, domain.ErrRefreshExpired),
+			errors.Is(err, domain.ErrNoRefreshToken),
+			errors.Is(err, domain.ErrInvalidAssertion),
+			errors.Is(err, domain.ErrInvalidAuthReqID):
+			logger.Warn("invalid grant on token exchange", "error", err)
+			return response.BadRequest(c, "INVALID_GRANT", "The provided authorization grant is invalid, expired or was already used.")
+		case errors.Is(err, domain.ErrAuthorizationPending):
+			return response.BadRequest(c, "AUTHORIZATION_PENDING", "The user has not yet approved the authentication request.")
+		case errors.Is(err, domain.ErrSlowDown):
+			logger.Warn("client polling too frequently on token exchange", "client_id", params.ClientID)
+			return response.BadRequest(c, "SLOW_DOWN", "The authentication request is still pending and the client must poll less frequently.")
+		case errors.Is(err, domain.ErrAccessDenied):
+			logger.Warn("user denied the backchannel authentication request", "client_id", params.ClientID)
+			return response.BadRequest(c, "ACCESS_DENIED", "The user denied the authentication request.")
+		case errors.Is(err, domain.ErrExpiredAuthReqID):
+			logger.Warn("expired auth_req_id on token exchange", "client_id", params.ClientID)
+			return response.BadRequest(c, "EXPIRED_TOKEN", "The auth_req_id has expired.")
+		case errors.Is(err, domain.ErrInvalidDPoPProof):
+			logger.Warn("missing or mismatched DPoP proof on token exchange", "error", err)
+			return response.BadRequest(c, "INVALID_DPOP_PROOF", "A DPoP proof of the key the grant is bound to is required.")
+		case errors.Is(err, domain.ErrInvalidSubjectToken):
+			logger.Warn("invalid subject token on token exchange", "error", err)
+			return response.BadRequest(c, "INVALID_REQUEST", "The subject or actor token is invalid, expired or of an unsupported type.")
+		case errors.Is(err, domain.ErrInvalidScope):
+			logger.Warn("invalid scope on token exchange", "error", err)
+			return response.BadRequest(c, "INVALID_SCOPE", "The requested scope exceeds the scope granted to the client or subject token.")
+		case errors.Is(err, domain.ErrInvalidClient):
+			logger.Warn("invalid client on token exchange", "error", err)
+			return response.Unauthorized(c, "INVALID_CLIENT", "The client credentials are invalid.")
+		case errors.Is(err, domain.ErrInvalidClientCertificate):
+			logger.Warn("missing or mismatched client certificate on token exchange", "error", err)
+			return response.BadRequest(c, "INVALID_CLIENT_CERTIFICATE", "A mutual TLS connection with the certificate the grant is bound to is required.")
+		case errors.Is(err, domain.ErrInvalidTarget):
+			logger.Warn("invalid target resource on token exchange", "error", err)
+			return response.BadRequest(c, "INVALID_TARGET", "The requested resource is invalid, unknown or was not granted.")
+		case errors.Is(err, domain.ErrInvalidAuthorizationDetails):
+			logger.Warn("invalid authorization details on token exchange", "error", err)
+			return response.BadRequest(c, "INVALID_AUTHORIZATION_DETAILS", "The authorization details are malformed, of an unknown type or were not granted.")
+		case errors.Is(err, domain.ErrUnauthorizedClient):
+			logger.Warn("unauthorized client on token exchange", "error", err)
+			return response.Unauthorized(c, "UNAUTHORIZED_CLIENT", "The client is not authorized to use this grant.")
+		case errors.Is(err, domain.ErrUnsupportedResponseType):
+			logger.Warn("unsupported grant type on token exchange", "error", err)
+			return response.BadRequest(c, "UNSUPPORTED_GRANT_TYPE", "The grant type is not supported.")
+		}
+
+		logger.Error("error to exchange token", "error", err)
+		return response.InternalServerError(c, "The token could not be issued due to an internal error.")
+	}
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	return c.JSON(http.StatusOK, tokenResponse)
+}
+
+func (h *OAuthHandler) UserInfo(c echo.Context) error {
+	logger := h.logger.With("method", "UserInfo")
+
+	accessToken, scheme, ok := authorizationToken(c)
+	if !ok {
+		c.Response().Header().Set("WWW-Authenticate", `Bearer, DPoP algs="`+strings.Join(domain.DPoPSigningAlgs(), " ")+`"`)
+		return response.Unauthorized(c, "TOKEN_MISSING", "An access token is required to access this resource.")
+	}
+
+	var proof domain.Confirmation
+	if certificate := clientCertificate(c); certificate != nil {
+		proof.CertificateThumbprint = certificate.Thumbprint()
+	}
+
+	if scheme == domain.TokenTypeDPoP {
+		var err error
+		proof.JKT, err = h.verifyDPoPProof(c, domain.UserInfoEndpoint(h.url.APIBaseURL), accessToken)
+		if err == nil && proof.JKT == "" {
+			err = fmt.Errorf("%w: missing proof", domain.ErrInvalidDPoPProof)
+		}
+
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrUseDPoPNonce):
+				logger.Warn("DPoP proof without a current nonce on userinfo")
+				if err := h.setDPoPNonce(c); err != nil {
+					logger.Error("error to issue DPoP nonce", "error", err)
+					return response.InternalServerError(c, "The user info could not be loaded due to an internal error.")
+				}
+				c.Response().Header().Set("WWW-Authenticate", `DPoP error="use_dpop_nonce"`)
+				return response.Unauthorized(c, "USE_DPOP_NONCE", "The DPoP proof must include the nonce provided in the DPoP-Nonce header.")
+			case errors.Is(err, domain.ErrInvalidDPoPProof):
+				logger.Warn("invalid DPoP proof on userinfo", "error", err)
+				c.Response().Header().Set("WWW-Authenticate", `DPoP error="invalid_dpop_proof"`)
+				return response.Unauthorized(c, "INVALID_DPOP_PROOF", "The DPoP proof is invalid.")
+			}
+
+			logger.Error("error to verify DPoP proof", "error", err)
+			return response.InternalServerError(c, "The user info could not be loaded due to an internal error.")
+		}
+	}
+
+	userInfo, err := h.userInfoService.GetUserInfo(c.Request().Context(), accessToken, proof)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidToken):
+			logger.Warn("invalid access token on userinfo", "error", err)
+			c.Response().Header().Set("WWW-Authenticate", scheme+` error="invalid_token"`)
+			return response.Unauthorized(c, "INVALID_TOKEN", "The access token is invalid, expired or was revoked.")
+		case errors.Is(err, domain.ErrInsufficientScope):
+			logger.Warn("insufficient scope on userinfo", "error", err)
+			c.Response().Header().Set("WWW-Authenticate", scheme+` error="insufficient_scope"`)
+			return response.Forbidden(c, "INSUFFICIENT_SCOPE", "The access token was not granted the openid scope.")
+		}
+
+		logger.Error("error to get userinfo", "error", err)
+		return response.InternalServerError(c, "The user info could not be loaded due to an internal error.")
+	}
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	if userInfo.JWT != "" {
+		return c.Blob(http.StatusOK, "application/jwt", []byte(userInfo.JWT))
+	}
+
+	return c.JSON(http.StatusOK, userInfo.Claims)
+}
+
+func (h *OAuthHandler) Introspect(c echo.Context) error {
+	logger := h.logger.With("method", "Introspect")
+
+	var payload models.IntrospectTokenPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("error to bind introspection payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("validate introspection payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	params := payload.ToIntrospectTokenParams()
+	params.ClientCertificate = clientCertificate(c)
+
+	introspection, err := h.introspectionService.IntrospectToken(c.Request().Context(), params)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidClient) {
+			logger.Warn("invalid client on token introspection", "error", err)
+			return response.Unauthorized(c, "INVALID_CLIENT", "The client credentials are invalid.")
+		}
+
+		logger.Error("error to introspect token", "error", err)
+		return response.InternalServerError(c, "The token could not be introspected due to an internal error.")
+	}
+
+	c.Response().Header().Set("Cache-Control", "no-store")
+	return c.JSON(http.StatusOK, introspection)
+}
+
+// authorizationToken extracts the access token and its scheme from the Authorization header or form.
+func authorizationToken(c echo.Context) (string, string, bool) {
+	scheme, token, found := strings.Cut(c.Request().Header.Get(echo.HeaderAuthorization), " ")
+	if found && token != "" {
+		switch {
+		case strings.EqualFold(scheme, domain.TokenTypeBearer):
+			return token, domain.TokenTypeBearer, true
+		case strings.EqualFold(scheme, domain.TokenTypeDPoP):
+			return token, domain.TokenTypeDPoP, true
+		}
+	}
+
+	if c.Request().Method == http.MethodPost {
+		if token := c.FormValue("access_token"); token != "" {
+			return token, domain.TokenTypeBearer, true
+		}
+	}
+
+	return "", "", false
+}
+
+// clientCertificate returns the certificate the client presented in the mutual TLS handshake.
+func clientCertificate(c echo.Context) *domain.ClientCertificate {
+	if c.Request().TLS == nil {
+		return nil
+	}
+	return domain.NewClientCertificate(c.Request().TLS.PeerCertificates)
+}
+
+// verifyDPoPProof verifies the request's DPoP proof, if any, and returns the thumbprint of its key.
+func (h *OAuthHandler) verifyDPoPProof(c echo.Context, endpoint, accessToken string) (string, error) {
+	proofs := c.Request().Header.Values(dpopHeader)
+	if len(proofs) == 0 {
+		return "", nil
+	}
+
+	if len(proofs) > 1 {
+		return "", fmt.Errorf("%w: more than one proof", domain.ErrInvalidDPoPProof)
+	}
+
+	proof, err := h.dpopService.VerifyProof(c.Request().Context(), domain.DPoPProofParams{
+		Proof:       proofs[0],
+		Method:      c.Request().Method,
+		URL:         endpoint,
+		AccessToken: accessToken,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return proof.Thumbprint, nil
+}
+
+// setDPoPNonce challenges the client to retry with a fresh server nonce.
+func (h *OAuthHandler) setDPoPNonce(c echo.Context) error {
+	nonce, err := h.dpopService.IssueNonce(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(dpopNonceHeader, nonce)
+	return nil
+}
+
+func (h *OAuthHandler) redirectToLogin(c echo.Context, logger *slog.Logger, payload models.AuthorizePayload, requiredACR string) error {
+	continueURLParams := payload.ToContinueURLParams()
+	if requiredACR != "" {
+		// The client's default_acr_values become explicit on the continue URL.
+		continueURLParams.ACRValues = []string{requiredACR}
+	}
+
+	continueURL := oauth.GenerateContinueURL(h.url.APIBaseURL, continueURLParams)
+
+	loginURL, err := url.Parse(h.url.AppBaseURL)
+	if err != nil {
+		logger.Error("error to parse app base URL", "error", err)
+		return response.InternalServerError(c, "The authorization workflow could not be completed due to an internal error.")
+	}
+
+	loginURL.Path = "/login"
+	q := loginURL.Query()
+	q.Set("continue", continueURL)
+	loginURL.RawQuery = q.Encode()
+
+	return c.Redirect(http.StatusFound, loginURL.String())
 }
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/context"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+	"github.com/labstack/echo/v4"
+)
+
+type RecoveryCodeHandler struct {
+	recoveryCodeService services.RecoveryCodeService
+	context             *context.EchoContext
+	logger              *slog.Logger
+}
+
+func NewRecoveryCodeHandler(recoveryCodeService services.RecoveryCodeService, context *context.EchoContext, logger *slog.Logger) *RecoveryCodeHandler {
+	return &RecoveryCodeHandler{
+		recoveryCodeService: recoveryCodeService,
+		context:             context,
+		logger:              logger,
+	}
+}
+
+func (h *RecoveryCodeHandler) Status(c echo.Context) error {
+	logger := h.logger.With("handler", "RecoveryCodeStatus")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	remaining, err := h.recoveryCodeService.Remaining(c.Request().Context(), session.UserID)
+	if err != nil {
+		logger.Error("failed to count recovery codes due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to get recovery codes")
+	}
+
+	return c.JSON(http.StatusOK, models.RecoveryCodesStatusResponse{Remaining: remaining})
+}
+
+// Regenerate replaces the user's recovery codes, invalidating the old set.
+func (h *RecoveryCodeHandler) Regenerate(c echo.Context) error {
+	logger := h.logger.With("handler", "RegenerateRecoveryCodes")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	recoveryCodes, err := h.recoveryCodeService.Regenerate(c.Request().Context(), session.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrRecoveryCodesNotGenerated) {
+			return response.BadRequest(c, "RECOVERY_CODES_NOT_GENERATED", "Enable two-factor authentication to get recovery codes.")
+		}
+
+		logger.Error("failed to regenerate recovery codes due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to regenerate recovery codes")
+	}
+
+	return c.JSON(http.StatusOK, models.RecoveryCodesResponse{RecoveryCodes: recoveryCodes})
+}
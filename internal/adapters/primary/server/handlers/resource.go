@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type ResourceHandler struct {
+	resourceService services.ResourceService
+	logger          *slog.Logger
+}
+
+func NewResourceHandler(resourceService services.ResourceService, logger *slog.Logger) *ResourceHandler {
+	return &ResourceHandler{
+		resourceService: resourceService,
+		logger:          logger,
+	}
+}
+
+func (h *ResourceHandler) CreateResource(c echo.Context) error {
+	logger := h.logger.With("handler", "CreateResource")
+
+	var payload models.CreateResourcePayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind create resource payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid create resource payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	resource, err := h.resourceService.CreateResource(c.Request().Context(), models.ToCreateResourceParams(payload))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrResourceAlreadyExists):
+			logger.Warn("attempt to create resource with duplicate identifier", "identifier", payload.Identifier)
+			return response.ConflictError(c, "RESOURCE_ALREADY_EXISTS", "A resource with this identifier already exists")
+		case errors.Is(err, domain.ErrInvalidTarget):
+			logger.Warn("invalid resource identifier", "identifier", payload.Identifier)
+			return response.BadRequest(c, "INVALID_RESOURCE_IDENTIFIER", "The resource identifier must be an absolute URI without a fragment")
+		case errors.Is(err, domain.ErrUnknownScope):
+			logger.Warn("resource with unknown scope", "error", err)
+			return response.BadRequest(c, "INVALID_SCOPE", "The resource scopes must be registered")
+		case errors.Is(err, domain.ErrClientNotFound):
+			logger.Warn("client not found for resource", "client_id", payload.ClientID)
+			return response.BadRequest(c, "INVALID_CLIENT", "The client does not exist")
+		}
+
+		logger.Error("failed to create resource due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to create resource")
+	}
+
+	return c.JSON(http.StatusCreated, models.ToResourceResponse(resource))
+}
+
+func (h *ResourceHandler) GetResourceByID(c echo.Context) error {
+	logger := h.logger.With("handler", "GetResourceByID")
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid resource ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_RESOURCE_ID", "Invalid resource ID format")
+	}
+
+	resource, err := h.resourceService.GetResourceByID(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			logger.Warn("resource not found", "id", id)
+			return response.NotFound(c, "RESOURCE_NOT_FOUND", "Resource not found")
+		}
+
+		logger.Error("failed to get resource due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to get resource")
+	}
+
+	return c.JSON(http.StatusOK, models.ToResourceResponse(resource))
+}
+
+func (h *ResourceHandler) ListResources(c echo.Context) error {
+	logger := h.logger.With("handler", "ListResources")
+
+	resources, err := h.resourceService.ListResources(c.Request().Context())
+	if err != nil {
+		logger.Error("failed to list resources due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to list resources")
+	}
+
+	resourceResponses := make([]models.ResourceResponse, 0, len(resources))
+	for _, resource := range resources {
+		resourceResponses = append(resourceResponses, models.ToResourceResponse(resource))
+	}
+
+	response := models.ResourceListResponse{
+		Resources: resourceResponses,
+		Total:     len(resourceResponses),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *ResourceHandler) UpdateResource(c echo.Context) error {
+	logger := h.logger.With("handler", "UpdateResource")
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid resource ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_RESOURCE_ID", "Invalid resource ID format")
+	}
+
+	var payload models.UpdateResourcePayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind update resource payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid update resource payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	resource, err := h.resourceService.UpdateResource(c.Request().Context(), id, models.ToUpdateResourceParams(payload))
+	if err != nil {
+		switch {
+		case errors.Is(err, ports.ErrNotFound):
+			logger.Warn("resource not found for update", "id", id)
+			return response.NotFound(c, "RESOURCE_NOT_FOUND", "Resource not found")
+		case errors.Is(err, domain.ErrUnknownScope):
+			logger.Warn("resource with unknown scope", "error", err)
+			return response.BadRequest(c, "INVALID_SCOPE", "The resource scopes must be registered")
+		case errors.Is(err, domain.ErrClientNotFound):
+			logger.Warn("client not found for resource", "client_id", payload.ClientID)
+			return response.BadRequest(c, "INVALID_CLIENT", "The client does not exist")
+		}
+
+		logger.Error("failed to update resource due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to update resource")
+	}
+
+	return c.JSON(http.StatusOK, models.ToResourceResponse(resource))
+}
+
+func (h *ResourceHandler) DeleteResource(c echo.Context) error {
+	logger := h.logger.With("handler", "DeleteResource")
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid resource ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_RESOURCE_ID", "Invalid resource ID format")
+	}
+
+	if err := h.resourceService.DeleteResource(c.Request().Context(), id); err != nil {
+		logger.Error("failed to delete resource due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to delete resource")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type ScopeHandler struct {
+	scopeService services.ScopeService
+	logger       *slog.Logger
+}
+
+func NewScopeHandler(scopeService services.ScopeService, logger *slog.Logger) *ScopeHandler {
+	return &ScopeHandler{
+		scopeService: scopeService,
+		logger:       logger,
+	}
+}
+
+func (h *ScopeHandler) CreateScope(c echo.Context) error {
+	logger := h.logger.With("handler", "CreateScope")
+
+	var payload models.CreateScopePayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind create scope payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid create scope payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	scope, err := h.scopeService.CreateScope(c.Request().Context(), models.ToCreateScopeParams(payload))
+	if err != nil {
+		if errors.Is(err, domain.ErrScopeAlreadyExists) {
+			logger.Warn("attempt to create scope with duplicate name", "name", payload.Name)
+			return response.ConflictError(c, "SCOPE_ALREADY_EXISTS", "A scope with this name already exists")
+		}
+
+		logger.Error("failed to create scope due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to create scope")
+	}
+
+	return c.JSON(http.StatusCreated, models.ToScopeResponse(scope))
+}
+
+func (h *ScopeHandler) GetScopeByID(c echo.Context) error {
+	logger := h.logger.With("handler", "GetScopeByID")
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid scope ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_SCOPE_ID", "Invalid scope ID format")
+	}
+
+	scope, err := h.scopeService.GetScopeByID(c.Request().Context(), id)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			logger.Warn("scope not found", "id", id)
+			return response.NotFound(c, "SCOPE_NOT_FOUND", "Scope not found")
+		}
+
+		logger.Error("failed to get scope due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to get scope")
+	}
+
+	return c.JSON(http.StatusOK, models.ToScopeResponse(scope))
+}
+
+func (h *ScopeHandler) ListScopes(c echo.Context) error {
+	logger := h.logger.With("handler", "ListScopes")
+
+	scopes, err := h.scopeService.ListScopes(c.Request().Context())
+	if err != nil {
+		logger.Error("failed to list scopes due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to list scopes")
+	}
+
+	scopeResponses := make([]models.ScopeResponse, 0, len(scopes))
+	for _, scope := range scopes {
+		scopeResponses = append(scopeResponses, models.ToScopeResponse(scope))
+	}
+
+	response := models.ScopeListResponse{
+		Scopes: scopeResponses,
+		Total:  len(scopeResponses),
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *ScopeHandler) UpdateScope(c echo.Context) error {
+	logger := h.logger.With("handler", "UpdateScope")
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid scope ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_SCOPE_ID", "Invalid scope ID format")
+	}
+
+	var payload models.UpdateScopePayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind update scope payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid update scope payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	scope, err := h.scopeService.UpdateScope(c.Request().Context(), id, models.ToUpdateScopeParams(payload))
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			logger.Warn("scope not found for update", "id", id)
+			return response.NotFound(c, "SCOPE_NOT_FOUND", "Scope not found")
+		}
+
+		logger.Error("failed to update scope due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to update scope")
+	}
+
+	return c.JSON(http.StatusOK, models.ToScopeResponse(scope))
+}
+
+func (h *ScopeHandler) DeleteScope(c echo.Context) error {
+	logger := h.logger.With("handler", "DeleteScope")
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid scope ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_SCOPE_ID", "Invalid scope ID format")
+	}
+
+	if err := h.scopeService.DeleteScope(c.Request().Context(), id); err != nil {
+		logger.Error("failed to delete scope due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to delete scope")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/context"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+	"github.com/labstack/echo/v4"
+)
+
+type TOTPHandler struct {
+	totpService services.TOTPService
+	context     *context.EchoContext
+	logger      *slog.Logger
+}
+
+func NewTOTPHandler(totpService services.TOTPService, context *context.EchoContext, logger *slog.Logger) *TOTPHandler {
+	return &TOTPHandler{
+		totpService: totpService,
+		context:     context,
+		logger:      logger,
+	}
+}
+
+func (h *TOTPHandler) Enroll(c echo.Context) error {
+	logger := h.logger.With("handler", "EnrollTOTP")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	enrollment, err := h.totpService.Enroll(c.Request().Context(), session.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrTOTPAlreadyEnrolled) {
+			logger.Warn("attempt to enroll TOTP twice", "user_id", session.UserID)
+			return response.ConflictError(c, "TOTP_ALREADY_ENROLLED", "Two-factor authentication is already enabled.")
+		}
+
+		logger.Error("failed to enroll TOTP due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to enroll TOTP")
+	}
+
+	return c.JSON(http.StatusOK, models.TOTPEnrollmentResponse{
+		Secret:     enrollment.Secret,
+		OTPAuthURI: enrollment.URI,
+	})
+}
+
+func (h *TOTPHandler) ConfirmEnrollment(c echo.Context) error {
+	logger := h.logger.With("handler", "ConfirmTOTPEnrollment")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	var payload models.ConfirmTOTPPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind confirm TOTP payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid confirm TOTP payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	recoveryCodes, err := h.totpService.ConfirmEnrollment(c.Request().Context(), session.UserID, payload.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTOTPNotEnrolled):
+			return response.NotFound(c, "TOTP_NOT_ENROLLED", "Start the two-factor enrollment first.")
+		case errors.Is(err, domain.ErrTOTPAlreadyEnrolled):
+			return response.ConflictError(c, "TOTP_ALREADY_ENROLLED", "Two-factor authentication is already enabled.")
+		case errors.Is(err, domain.ErrInvalidTOTPCode):
+			logger.Warn("invalid TOTP code on enrollment", "user_id", session.UserID)
+			return response.BadRequest(c, "INVALID_TOTP_CODE", "Invalid verification code. Please try again.")
+		case errors.Is(err, domain.ErrTooManyTOTPAttempts):
+			logger.Warn("too many TOTP attempts on enrollment", "user_id", session.UserID)
+			return response.TooManyRequests(c, "TOO_MANY_ATTEMPTS", "Too many verification attempts. Please try again later.")
+		}
+
+		logger.Error("failed to confirm TOTP enrollment due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to confirm TOTP enrollment")
+	}
+
+	if recoveryCodes == nil {
+		return c.NoContent(http.StatusNoContent)
+	}
+
+	return c.JSON(http.StatusOK, models.RecoveryCodesResponse{RecoveryCodes: recoveryCodes})
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/context"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/services"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type WebAuthnHandler struct {
+	webAuthnService services.WebAuthnService
+	context         *context.EchoContext
+	logger          *slog.Logger
+}
+
+func NewWebAuthnHandler(webAuthnService services.WebAuthnService, context *context.EchoContext, logger *slog.Logger) *WebAuthnHandler {
+	return &WebAuthnHandler{
+		webAuthnService: webAuthnService,
+		context:         context,
+		logger:          logger,
+	}
+}
+
+func (h *WebAuthnHandler) BeginRegistration(c echo.Context) error {
+	logger := h.logger.With("handler", "BeginWebAuthnRegistration")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	options, err := h.webAuthnService.BeginRegistration(c.Request().Context(), session.UserID)
+	if err != nil {
+		logger.Error("failed to begin webauthn registration due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to begin passkey registration")
+	}
+
+	return c.JSON(http.StatusOK, models.ToWebAuthnRegistrationOptionsResponse(options))
+}
+
+func (h *WebAuthnHandler) FinishRegistration(c echo.Context) error {
+	logger := h.logger.With("handler", "FinishWebAuthnRegistration")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	var payload models.WebAuthnRegistrationPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind webauthn registration payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid webauthn registration payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	attestation, err := payload.ToAttestation()
+	if err != nil {
+		logger.Warn("malformed webauthn attestation", "error", err)
+		return response.BadRequest(c, "INVALID_WEBAUTHN_RESPONSE", "The passkey response is malformed.")
+	}
+
+	credential, recoveryCodes, err := h.webAuthnService.FinishRegistration(c.Request().Context(), session.UserID, payload.ChallengeID, payload.Name, attestation)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrWebAuthnChallengeNotFound):
+			return response.BadRequest(c, "INVALID_WEBAUTHN_CHALLENGE", "The passkey registration has expired. Please try again.")
+		case errors.Is(err, domain.ErrInvalidWebAuthnResponse):
+			logger.Warn("invalid webauthn attestation", "user_id", session.UserID, "error", err)
+			return response.BadRequest(c, "INVALID_WEBAUTHN_RESPONSE", "The passkey could not be verified. Please try again.")
+		case errors.Is(err, domain.ErrWebAuthnCredentialExists):
+			return response.ConflictError(c, "WEBAUTHN_CREDENTIAL_EXISTS", "This passkey is already registered.")
+		}
+
+		logger.Error("failed to finish webauthn registration due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to register passkey")
+	}
+
+	return c.JSON(http.StatusCreated, models.WebAuthnRegistrationResponse{
+		WebAuthnCredentialResponse: models.ToWebAuthnCredentialResponse(credential),
+		RecoveryCodes:              recoveryCodes,
+	})
+}
+
+func (h *WebAuthnHandler) ListCredentials(c echo.Context) error {
+	logger := h.logger.With("handler", "ListWebAuthnCredentials")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	credentials, err := h.webAuthnService.ListCredentials(c.Request().Context(), session.UserID)
+	if err != nil {
+		logger.Error("failed to list webauthn credentials due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to list passkeys")
+	}
+
+	credentialResponses := make([]models.WebAuthnCredentialResponse, 0, len(credentials))
+	for _, credential := range credentials {
+		credentialResponses = append(credentialResponses, models.ToWebAuthnCredentialResponse(credential))
+	}
+
+	return c.JSON(http.StatusOK, models.WebAuthnCredentialListResponse{
+		Credentials: credentialResponses,
+		Total:       len(credentialResponses),
+	})
+}
+
+func (h *WebAuthnHandler) RenameCredential(c echo.Context) error {
+	logger := h.logger.With("handler", "RenameWebAuthnCredential")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid webauthn credential ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_CREDENTIAL_ID", "Invalid credential ID format")
+	}
+
+	var payload models.RenameWebAuthnCredentialPayload
+	if err := c.Bind(&payload); err != nil {
+		logger.Error("failed to bind rename webauthn credential payload", "error", err)
+		return response.InvalidBind(c)
+	}
+
+	if err := c.Validate(&payload); err != nil {
+		logger.Error("invalid rename webauthn credential payload", "error", err)
+		return response.ValidationError(c, err)
+	}
+
+	if err := h.webAuthnService.RenameCredential(c.Request().Context(), session.UserID, id, payload.Name); err != nil {
+		if errors.Is(err, domain.ErrWebAuthnCredentialNotFound) {
+			logger.Warn("webauthn credential not found", "id", id)
+			return response.NotFound(c, "CREDENTIAL_NOT_FOUND", "Passkey not found")
+		}
+
+		logger.Error("failed to rename webauthn credential due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to rename passkey")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *WebAuthnHandler) DeleteCredential(c echo.Context) error {
+	logger := h.logger.With("handler", "DeleteWebAuthnCredential")
+
+	session := h.context.GetSession(c)
+	if session == nil {
+		return response.Unauthorized(c, "TOKEN_MISSING", "You need to be logged in to access this resource")
+	}
+
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		logger.Warn("invalid webauthn credential ID format", "id", idParam, "error", err)
+		return response.BadRequest(c, "INVALID_CREDENTIAL_ID", "Invalid credential ID format")
+	}
+
+	if err := h.webAuthnService.DeleteCredential(c.Request().Context(), session.UserID, id); err != nil {
+		if errors.Is(err, domain.ErrWebAuthnCredentialNotFound) {
+			logger.Warn("webauthn credential not found", "id", id)
+			return response.NotFound(c, "CREDENTIAL_NOT_FOUND", "Passkey not found")
+		}
+
+		logger.Error("failed to delete webauthn credential due to internal error", "error", err)
+		return response.InternalServerError(c, "Failed to delete passkey")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,36 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/response"
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/labstack/echo/v4"
+)
+
+type AdminMiddleware struct {
+	apiKey string
+}
+
+func NewAdminMiddleware(config *config.Config) *AdminMiddleware {
+	return &AdminMiddleware{
+		apiKey: config.Admin.APIKey,
+	}
+}
+
+// RequireAdmin lets through requests bearing the admin API key.
+func (m *AdminMiddleware) RequireAdmin(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		token, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if !ok || token == "" {
+			return response.Unauthorized(c, "TOKEN_MISSING", "You need an admin API key to access this resource")
+		}
+
+		if m.apiKey == "" || subtle.ConstantTimeCompare([]byte(token), []byte(m.apiKey)) != 1 {
+			return response.Unauthorized(c, "INVALID_TOKEN", "The provided token is invalid")
+		}
+
+		return next(c)
+	}
+}
@@ -0,0 +1,62 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireAdmin(t *testing.T) {
+	serve := func(apiKey, authorization string) int {
+		e := echo.New()
+		middleware := NewAdminMiddleware(&config.Config{Admin: config.Admin{APIKey: apiKey}})
+		e.POST("/v1/admin/keys/rotate", func(c echo.Context) error {
+			return c.NoContent(http.StatusOK)
+		}, middleware.RequireAdmin)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/admin/keys/rotate", nil)
+		if authorization != "" {
+			req.Header.Set(echo.HeaderAuthorization, authorization)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	t.Run("should refuse a request without credentials", func(t *testing.T) {
+		// Act
+		status := serve("admin-key", "")
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, status)
+	})
+
+	t.Run("should refuse a wrong admin key", func(t *testing.T) {
+		// Act
+		status := serve("admin-key", "Bearer other-key")
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, status)
+	})
+
+	t.Run("should refuse every request when no admin key is configured", func(t *testing.T) {
+		// Act
+		status := serve("", "Bearer ")
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, status)
+	})
+
+	t.Run("should let through a request with the admin key", func(t *testing.T) {
+		// Act
+		status := serve("admin-key", "Bearer admin-key")
+
+		// Assert
+		assert.Equal(t, http.StatusOK, status)
+	})
+}
@@ -8,9 +8,11 @@ import (
 
 func Cors(config *config.Config) echo.MiddlewareFunc {
 	return middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins:     config.Cors.AllowedOrigins,
-		AllowMethods:     config.Cors.AllowedMethods,
-		AllowHeaders:     config.Cors.AllowedHeaders,
+		AllowOrigins: config.Cors.AllowedOrigins,
+		AllowMethods: config.Cors.AllowedMethods,
+		AllowHeaders: config.Cors.AllowedHeaders,
+		// Browser DPoP clients need to read the nonce challenges.
+		ExposeHeaders:    []string{"DPoP-Nonce", echo.HeaderWWWAuthenticate},
 		AllowCredentials: true,
 	})
 }
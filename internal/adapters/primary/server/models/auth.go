@@ -1,5 +1,11 @@
 package models
 
+import (
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
 type LoginPayload struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=8"`
@@ -11,8 +17,74 @@ type LoginResponse struct {
 	Continue string       `json:"continue"`
 }
 
+type LoginTOTPPayload struct {
+	Ticket   string `json:"ticket" validate:"required"`
+	Code     string `json:"code" validate:"required,numeric,len=6"`
+	Continue string `json:"continue" validate:"required,url"`
+}
+
+type LoginRecoveryCodePayload struct {
+	Ticket   string `json:"ticket" validate:"required"`
+	Code     string `json:"code" validate:"required,max=32"`
+	Continue string `json:"continue" validate:"required,url"`
+}
+
+// LoginTicketResponse answers a password login that still needs a second factor, named in Methods.
+type LoginTicketResponse struct {
+	Ticket    string   `json:"ticket"`
+	Methods   []string `json:"methods"`
+	ExpiresAt string   `json:"expires_at"`
+	Continue  string   `json:"continue"`
+}
+
+type ConfirmTOTPPayload struct {
+	Code string `json:"code" validate:"required,numeric,len=6"`
+}
+
+type TOTPEnrollmentResponse struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+// RecoveryCodesResponse carries a new set of recovery codes.
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type RecoveryCodesStatusResponse struct {
+	Remaining int `json:"remaining"`
+}
+
 type RegisterPayload struct {
 	Email    string `json:"email" validate:"required,email"`
 	Name     string `json:"name" validate:"required"`
 	Password string `json:"password" validate:"required,min=8,strong_password"`
 }
+
+func ToLoginTicketResponse(ticket *domain.LoginTicket, continueURL string) LoginTicketResponse {
+	return LoginTicketResponse{
+		Ticket:    ticket.ID,
+		Methods:   ticket.Methods,
+		ExpiresAt: ticket.ExpiresAt.Format(time.RFC3339),
+		Continue:  continueURL,
+	}
+}
+
+func ToLoginResponse(user *domain.User, continueURL string) LoginResponse {
+	return LoginResponse{
+		Continue: continueURL,
+		User: UserResponse{
+			ID:    user.ID.String(),
+			Email: user.Email,
+			Name:  user.Name,
+		},
+	}
+}
+
+type VerifyEmailPayload struct {
+	Token string `json:"token" validate:"required,max=1024"`
+}
+
+type ResendVerificationEmailPayload struct {
+	Email string `json:"email" validate:"required,email"`
+}
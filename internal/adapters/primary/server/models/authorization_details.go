@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type CreateAuthorizationDetailTypePayload struct {
+	Type        string   `json:"type" validate:"required,max=255"`
+	Description string   `json:"description" validate:"omitempty"`
+	Fields      []string `json:"fields" validate:"omitempty,dive,required,ne=type"`
+}
+
+type UpdateAuthorizationDetailTypePayload struct {
+	Description string   `json:"description" validate:"omitempty"`
+	Fields      []string `json:"fields" validate:"omitempty,dive,required,ne=type"`
+}
+
+type AuthorizationDetailTypeResponse struct {
+	ID          string   `json:"id"`
+	Type        string   `json:"type"`
+	Description string   `json:"description"`
+	Fields      []string `json:"fields"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+type AuthorizationDetailTypeListResponse struct {
+	DetailTypes []AuthorizationDetailTypeResponse `json:"authorization_detail_types"`
+	Total       int                               `json:"total"`
+}
+
+func ToCreateAuthorizationDetailTypeParams(req CreateAuthorizationDetailTypePayload) domain.CreateAuthorizationDetailTypeParams {
+	return domain.CreateAuthorizationDetailTypeParams{
+		Type:        req.Type,
+		Description: req.Description,
+		Fields:      req.Fields,
+	}
+}
+
+func ToUpdateAuthorizationDetailTypeParams(req UpdateAuthorizationDetailTypePayload) domain.UpdateAuthorizationDetailTypeParams {
+	return domain.UpdateAuthorizationDetailTypeParams{
+		Description: req.Description,
+		Fields:      req.Fields,
+	}
+}
+
+func ToAuthorizationDetailTypeResponse(detailType *domain.AuthorizationDetailType) AuthorizationDetailTypeResponse {
+	fields := detailType.Fields
+	if fields == nil {
+		fields = []string{}
+	}
+
+	return AuthorizationDetailTypeResponse{
+		ID:          detailType.ID.String(),
+		Type:        detailType.Type,
+		Description: detailType.Description,
+		Fields:      fields,
+		CreatedAt:   detailType.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   detailType.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type BackchannelAuthenticationPayload struct {
+	ClientID                string `form:"client_id" validate:"required"`
+	ClientSecret            string `form:"client_secret" validate:"omitempty"`
+	ClientAssertion         string `form:"client_assertion" validate:"omitempty"`
+	ClientAssertionType     string `form:"client_assertion_type" validate:"required_with=ClientAssertion"`
+	Scope                   string `form:"scope" validate:"required"`
+	LoginHint               string `form:"login_hint" validate:"required"`
+	BindingMessage          string `form:"binding_message" validate:"omitempty"`
+	RequestedExpiry         int64  `form:"requested_expiry" validate:"omitempty,gt=0"`
+	ClientNotificationToken string `form:"client_notification_token" validate:"omitempty,max=1024"`
+}
+
+type BackchannelRequestResponse struct {
+	AuthReqID      string   `json:"auth_req_id"`
+	ClientID       string   `json:"client_id"`
+	ClientName     string   `json:"client_name"`
+	Scopes         []string `json:"scopes"`
+	BindingMessage string   `json:"binding_message,omitempty"`
+	CreatedAt      string   `json:"created_at"`
+	ExpiresAt      string   `json:"expires_at"`
+}
+
+func (p *BackchannelAuthenticationPayload) ToBackchannelAuthenticationParams() domain.BackchannelAuthenticationParams {
+	return domain.BackchannelAuthenticationParams{
+		ClientID:                p.ClientID,
+		ClientSecret:            p.ClientSecret,
+		ClientAssertion:         p.ClientAssertion,
+		ClientAssertionType:     p.ClientAssertionType,
+		Scopes:                  strings.Fields(p.Scope),
+		LoginHint:               p.LoginHint,
+		BindingMessage:          p.BindingMessage,
+		RequestedExpiry:         p.RequestedExpiry,
+		ClientNotificationToken: p.ClientNotificationToken,
+	}
+}
+
+func ToBackchannelRequestResponse(request *domain.BackchannelAuthenticationRequest) BackchannelRequestResponse {
+	return BackchannelRequestResponse{
+		AuthReqID:      request.AuthReqID,
+		ClientID:       request.ClientID,
+		ClientName:     request.ClientName,
+		Scopes:         request.Scopes,
+		BindingMessage: request.BindingMessage,
+		CreatedAt:      request.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:      request.ExpiresAt.Format(time.RFC3339),
+	}
+}
@@ -1,35 +1,116 @@
 package models
 
-import "github.com/g-villarinho/oidc-server/internal/core/domain"
+import (
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
 
 type CreateClientPayload struct {
-	ClientName    string   `json:"client_name" validate:"required"`
-	RedirectURIs  []string `json:"redirect_uris" validate:"required,min=1"`
-	GrantTypes    []string `json:"grant_types" validate:"required,min=1"`
-	ResponseTypes []string `json:"response_types" validate:"required,min=1"`
-	Scopes        []string `json:"scopes" validate:"required,min=1"`
-	LogoURL       string   `json:"logo_url"`
+	ClientName                            string                `json:"client_name" validate:"required"`
+	RedirectURIs                          []string              `json:"redirect_uris" validate:"required,min=1"`
+	GrantTypes                            []string              `json:"grant_types" validate:"required,min=1"`
+	ResponseTypes                         []string              `json:"response_types" validate:"required,min=1,dive,response_type"`
+	ResponseModes                         []string              `json:"response_modes" validate:"omitempty,dive,oneof=query fragment form_post query.jwt fragment.jwt form_post.jwt"`
+	Scopes                                []string              `json:"scopes" validate:"omitempty,dive,required"`
+	LogoURL                               string                `json:"logo_url"`
+	SubjectType                           string                `json:"subject_type" validate:"omitempty,oneof=public pairwise"`
+	SectorIdentifierURI                   string                `json:"sector_identifier_uri" validate:"omitempty,url"`
+	FirstParty                            bool                  `json:"first_party"`
+	TokenPolicy                           TokenPolicyPayload    `json:"token_policy"`
+	ExchangeAudiences                     []string              `json:"exchange_audiences" validate:"omitempty,dive,url"`
+	TokenEndpointAuthMethod               string                `json:"token_endpoint_auth_method" validate:"omitempty,oneof=none client_secret_post client_secret_jwt private_key_jwt tls_client_auth self_signed_tls_client_auth"`
+	JWKS                                  *domain.JSONWebKeySet `json:"jwks"`
+	JWKSURI                               string                `json:"jwks_uri" validate:"omitempty,url"`
+	DPoPBoundAccessTokens                 bool                  `json:"dpop_bound_access_tokens"`
+	TLSClientAuthSubjectDN                string                `json:"tls_client_auth_subject_dn"`
+	TLSClientCertificateBoundAccessTokens bool                  `json:"tls_client_certificate_bound_access_tokens"`
+	BackchannelTokenDeliveryMode          string                `json:"backchannel_token_delivery_mode" validate:"omitempty,oneof=poll ping"`
+	BackchannelClientNotificationEndpoint string                `json:"backchannel_client_notification_endpoint" validate:"required_if=BackchannelTokenDeliveryMode ping,omitempty,url"`
+	IDTokenEncryptedResponseAlg           string                `json:"id_token_encrypted_response_alg" validate:"omitempty,oneof=RSA-OAEP-256 ECDH-ES"`
+	IDTokenEncryptedResponseEnc           string                `json:"id_token_encrypted_response_enc" validate:"required_with=IDTokenEncryptedResponseAlg,omitempty,oneof=A256GCM"`
+	UserInfoEncryptedResponseAlg          string                `json:"userinfo_encrypted_response_alg" validate:"omitempty,oneof=RSA-OAEP-256 ECDH-ES"`
+	UserInfoEncryptedResponseEnc          string                `json:"userinfo_encrypted_response_enc" validate:"required_with=UserInfoEncryptedResponseAlg,omitempty,oneof=A256GCM"`
+	DefaultACRValues                      []string              `json:"default_acr_values" validate:"omitempty,dive,oneof=urn:oidc-server:acr:pwd urn:oidc-server:acr:mfa"`
 }
 
 type UpdateClientPayload struct {
-	ClientName    string   `json:"client_name" validate:"required"`
-	RedirectURIs  []string `json:"redirect_uris" validate:"required,min=1"`
-	GrantTypes    []string `json:"grant_types" validate:"required,min=1"`
-	ResponseTypes []string `json:"response_types" validate:"required,min=1"`
-	Scopes        []string `json:"scopes" validate:"required,min=1"`
+	ClientName                            string                `json:"client_name" validate:"required"`
+	RedirectURIs                          []string              `json:"redirect_uris" validate:"required,min=1"`
+	GrantTypes                            []string              `json:"grant_types" validate:"required,min=1"`
+	ResponseTypes                         []string              `json:"response_types" validate:"required,min=1,dive,response_type"`
+	ResponseModes                         []string              `json:"response_modes" validate:"omitempty,dive,oneof=query fragment form_post query.jwt fragment.jwt form_post.jwt"`
+	Scopes                                []string              `json:"scopes" validate:"required,min=1"`
+	SubjectType                           string                `json:"subject_type" validate:"omitempty,oneof=public pairwise"`
+	SectorIdentifierURI                   string                `json:"sector_identifier_uri" validate:"omitempty,url"`
+	FirstParty                            bool                  `json:"first_party"`
+	TokenPolicy                           TokenPolicyPayload    `json:"token_policy"`
+	ExchangeAudiences                     []string              `json:"exchange_audiences" validate:"omitempty,dive,url"`
+	TokenEndpointAuthMethod               string                `json:"token_endpoint_auth_method" validate:"omitempty,oneof=none client_secret_post client_secret_jwt private_key_jwt tls_client_auth self_signed_tls_client_auth"`
+	JWKS                                  *domain.JSONWebKeySet `json:"jwks"`
+	JWKSURI                               string                `json:"jwks_uri" validate:"omitempty,url"`
+	DPoPBoundAccessTokens                 bool                  `json:"dpop_bound_access_tokens"`
+	TLSClientAuthSubjectDN                string                `json:"tls_client_auth_subject_dn"`
+	TLSClientCertificateBoundAccessTokens bool                  `json:"tls_client_certificate_bound_access_tokens"`
+	BackchannelTokenDeliveryMode          string                `json:"backchannel_token_delivery_mode" validate:"omitempty,oneof=poll ping"`
+	BackchannelClientNotificationEndpoint string                `json:"backchannel_client_notification_endpoint" validate:"required_if=BackchannelTokenDeliveryMode ping,omitempty,url"`
+	IDTokenEncryptedResponseAlg           string                `json:"id_token_encrypted_response_alg" validate:"omitempty,oneof=RSA-OAEP-256 ECDH-ES"`
+	IDTokenEncryptedResponseEnc           string                `json:"id_token_encrypted_response_enc" validate:"required_with=IDTokenEncryptedResponseAlg,omitempty,oneof=A256GCM"`
+	UserInfoEncryptedResponseAlg          string                `json:"userinfo_encrypted_response_alg" validate:"omitempty,oneof=RSA-OAEP-256 ECDH-ES"`
+	UserInfoEncryptedResponseEnc          string                `json:"userinfo_encrypted_response_enc" validate:"required_with=UserInfoEncryptedResponseAlg,omitempty,oneof=A256GCM"`
+	DefaultACRValues                      []string              `json:"default_acr_values" validate:"omitempty,dive,oneof=urn:oidc-server:acr:pwd urn:oidc-server:acr:mfa"`
 }
 
 type ClientResponse struct {
-	ID            string   `json:"id"`
-	ClientID      string   `json:"client_id"`
-	ClientName    string   `json:"client_name"`
-	RedirectURIs  []string `json:"redirect_uris"`
-	GrantTypes    []string `json:"grant_types"`
-	ResponseTypes []string `json:"response_types"`
-	Scopes        []string `json:"scopes"`
-	LogoURL       string   `json:"logo_url"`
-	CreatedAt     string   `json:"created_at"`
-	UpdatedAt     string   `json:"updated_at"`
+	ID                                    string                `json:"id"`
+	ClientID                              string                `json:"client_id"`
+	ClientSecret                          string                `json:"client_secret,omitempty"`
+	ClientName                            string                `json:"client_name"`
+	RedirectURIs                          []string              `json:"redirect_uris"`
+	GrantTypes                            []string              `json:"grant_types"`
+	ResponseTypes                         []string              `json:"response_types"`
+	ResponseModes                         []string              `json:"response_modes"`
+	Scopes                                []string              `json:"scopes"`
+	LogoURL                               string                `json:"logo_url"`
+	SubjectType                           string                `json:"subject_type"`
+	SectorIdentifierURI                   string                `json:"sector_identifier_uri,omitempty"`
+	FirstParty                            bool                  `json:"first_party"`
+	TokenPolicy                           TokenPolicyResponse   `json:"token_policy"`
+	ExchangeAudiences                     []string              `json:"exchange_audiences"`
+	TokenEndpointAuthMethod               string                `json:"token_endpoint_auth_method"`
+	JWKS                                  *domain.JSONWebKeySet `json:"jwks,omitempty"`
+	JWKSURI                               string                `json:"jwks_uri,omitempty"`
+	DPoPBoundAccessTokens                 bool                  `json:"dpop_bound_access_tokens"`
+	TLSClientAuthSubjectDN                string                `json:"tls_client_auth_subject_dn,omitempty"`
+	TLSClientCertificateBoundAccessTokens bool                  `json:"tls_client_certificate_bound_access_tokens"`
+	BackchannelTokenDeliveryMode          string                `json:"backchannel_token_delivery_mode,omitempty"`
+	BackchannelClientNotificationEndpoint string                `json:"backchannel_client_notification_endpoint,omitempty"`
+	IDTokenEncryptedResponseAlg           string                `json:"id_token_encrypted_response_alg,omitempty"`
+	IDTokenEncryptedResponseEnc           string                `json:"id_token_encrypted_response_enc,omitempty"`
+	UserInfoEncryptedResponseAlg          string                `json:"userinfo_encrypted_response_alg,omitempty"`
+	UserInfoEncryptedResponseEnc          string                `json:"userinfo_encrypted_response_enc,omitempty"`
+	DefaultACRValues                      []string              `json:"default_acr_values,omitempty"`
+	CreatedAt                             string                `json:"created_at"`
+	UpdatedAt                             string                `json:"updated_at"`
+}
+
+// TokenPolicyPayload overrides the server token lifetimes for a client, in seconds.
+type TokenPolicyPayload struct {
+	AccessTokenLifetime     int32  `json:"access_token_lifetime" validate:"gte=0"`
+	RefreshTokenLifetime    int32  `json:"refresh_token_lifetime" validate:"gte=0"`
+	RefreshTokenIdleTimeout int32  `json:"refresh_token_idle_timeout" validate:"gte=0"`
+	IDTokenLifetime         int32  `json:"id_token_lifetime" validate:"gte=0"`
+	IssueRefreshTokens      *bool  `json:"issue_refresh_tokens"`
+	AccessTokenFormat       string `json:"access_token_format" validate:"omitempty,oneof=jwt opaque paseto"`
+}
+
+type TokenPolicyResponse struct {
+	AccessTokenLifetime     int32  `json:"access_token_lifetime"`
+	RefreshTokenLifetime    int32  `json:"refresh_token_lifetime"`
+	RefreshTokenIdleTimeout int32  `json:"refresh_token_idle_timeout"`
+	IDTokenLifetime         int32  `json:"id_token_lifetime"`
+	IssueRefreshTokens      bool   `json:"issue_refresh_tokens"`
+	AccessTokenFormat       string `json:"access_token_format"`
 }
 
 type ClientListResponse struct {
@@ -39,11 +120,129 @@ type ClientListResponse struct {
 
 func ToCreateClientParams(req CreateClientPayload) domain.CreateClientParams {
 	return domain.CreateClientParams{
-		ClientName:    req.ClientName,
-		RedirectURIs:  req.RedirectURIs,
-		GrantTypes:    req.GrantTypes,
-		ResponseTypes: req.ResponseTypes,
-		Scopes:        req.Scopes,
-		LogoURL:       req.LogoURL,
+		ClientName:                            req.ClientName,
+		RedirectURIs:                          req.RedirectURIs,
+		GrantTypes:                            req.GrantTypes,
+		ResponseTypes:                         req.ResponseTypes,
+		ResponseModes:                         req.ResponseModes,
+		Scopes:                                req.Scopes,
+		LogoURL:                               req.LogoURL,
+		SubjectType:                           req.SubjectType,
+		SectorIdentifierURI:                   req.SectorIdentifierURI,
+		FirstParty:                            req.FirstParty,
+		TokenPolicy:                           req.TokenPolicy.toTokenPolicy(),
+		ExchangeAudiences:                     req.ExchangeAudiences,
+		TokenEndpointAuthMethod:               req.TokenEndpointAuthMethod,
+		JWKS:                                  req.JWKS,
+		JWKSURI:                               req.JWKSURI,
+		DPoPBoundAccessTokens:                 req.DPoPBoundAccessTokens,
+		TLSClientAuthSubjectDN:                req.TLSClientAuthSubjectDN,
+		TLSClientCertificateBoundAccessTokens: req.TLSClientCertificateBoundAccessTokens,
+		BackchannelTokenDeliveryMode:          req.BackchannelTokenDeliveryMode,
+		BackchannelClientNotificationEndpoint: req.BackchannelClientNotificationEndpoint,
+		IDTokenEncryption: domain.ResponseEncryption{
+			Alg: req.IDTokenEncryptedResponseAlg,
+			Enc: req.IDTokenEncryptedResponseEnc,
+		},
+		UserInfoEncryption: domain.ResponseEncryption{
+			Alg: req.UserInfoEncryptedResponseAlg,
+			Enc: req.UserInfoEncryptedResponseEnc,
+		},
+		DefaultACRValues: req.DefaultACRValues,
+	}
+}
+
+func ToUpdateClientParams(req UpdateClientPayload) domain.UpdateClientParams {
+	return domain.UpdateClientParams{
+		ClientName:                            req.ClientName,
+		RedirectURIs:                          req.RedirectURIs,
+		GrantTypes:                            req.GrantTypes,
+		ResponseTypes:                         req.ResponseTypes,
+		ResponseModes:                         req.ResponseModes,
+		Scopes:                                req.Scopes,
+		SubjectType:                           req.SubjectType,
+		SectorIdentifierURI:                   req.SectorIdentifierURI,
+		FirstParty:                            req.FirstParty,
+		TokenPolicy:                           req.TokenPolicy.toTokenPolicy(),
+		ExchangeAudiences:                     req.ExchangeAudiences,
+		TokenEndpointAuthMethod:               req.TokenEndpointAuthMethod,
+		JWKS:                                  req.JWKS,
+		JWKSURI:                               req.JWKSURI,
+		DPoPBoundAccessTokens:                 req.DPoPBoundAccessTokens,
+		TLSClientAuthSubjectDN:                req.TLSClientAuthSubjectDN,
+		TLSClientCertificateBoundAccessTokens: req.TLSClientCertificateBoundAccessTokens,
+		BackchannelTokenDeliveryMode:          req.BackchannelTokenDeliveryMode,
+		BackchannelClientNotificationEndpoint: req.BackchannelClientNotificationEndpoint,
+		IDTokenEncryption: domain.ResponseEncryption{
+			Alg: req.IDTokenEncryptedResponseAlg,
+			Enc: req.IDTokenEncryptedResponseEnc,
+		},
+		UserInfoEncryption: domain.ResponseEncryption{
+			Alg: req.UserInfoEncryptedResponseAlg,
+			Enc: req.UserInfoEncryptedResponseEnc,
+		},
+		DefaultACRValues: req.DefaultACRValues,
+	}
+}
+
+func ToClientResponse(client *domain.Client) ClientResponse {
+	return ClientResponse{
+		ID:                                    client.ID.String(),
+		ClientID:                              client.ClientID,
+		ClientName:                            client.ClientName,
+		RedirectURIs:                          client.RedirectURIs,
+		GrantTypes:                            client.GrantTypes,
+		ResponseTypes:                         client.ResponseTypes,
+		ResponseModes:                         client.ResponseModes,
+		Scopes:                                client.Scopes,
+		LogoURL:                               client.LogoURL,
+		SubjectType:                           client.SubjectType,
+		SectorIdentifierURI:                   client.SectorIdentifierURI,
+		FirstParty:                            client.FirstParty,
+		TokenPolicy:                           toTokenPolicyResponse(client.TokenPolicy),
+		ExchangeAudiences:                     client.ExchangeAudiences,
+		TokenEndpointAuthMethod:               client.TokenEndpointAuthMethod,
+		JWKS:                                  client.JWKS,
+		JWKSURI:                               client.JWKSURI,
+		DPoPBoundAccessTokens:                 client.DPoPBoundAccessTokens,
+		TLSClientAuthSubjectDN:                client.TLSClientAuthSubjectDN,
+		TLSClientCertificateBoundAccessTokens: client.TLSClientCertificateBoundAccessTokens,
+		BackchannelTokenDeliveryMode:          client.BackchannelTokenDeliveryMode,
+		BackchannelClientNotificationEndpoint: client.BackchannelClientNotificationEndpoint,
+		IDTokenEncryptedResponseAlg:           client.IDTokenEncryption.Alg,
+		IDTokenEncryptedResponseEnc:           client.IDTokenEncryption.Enc,
+		UserInfoEncryptedResponseAlg:          client.UserInfoEncryption.Alg,
+		UserInfoEncryptedResponseEnc:          client.UserInfoEncryption.Enc,
+		DefaultACRValues:                      client.DefaultACRValues,
+		CreatedAt:                             client.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:                             client.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// toTokenPolicy converts the payload, issuing refresh tokens unless the client explicitly opts out.
+func (p TokenPolicyPayload) toTokenPolicy() domain.TokenPolicy {
+	issueRefreshTokens := true
+	if p.IssueRefreshTokens != nil {
+		issueRefreshTokens = *p.IssueRefreshTokens
+	}
+
+	return domain.TokenPolicy{
+		AccessTokenLifetime:     time.Duration(p.AccessTokenLifetime) * time.Second,
+		RefreshTokenLifetime:    time.Duration(p.RefreshTokenLifetime) * time.Second,
+		RefreshTokenIdleTimeout: time.Duration(p.RefreshTokenIdleTimeout) * time.Second,
+		IDTokenLifetime:         time.Duration(p.IDTokenLifetime) * time.Second,
+		IssueRefreshTokens:      issueRefreshTokens,
+		AccessTokenFormat:       p.AccessTokenFormat,
+	}
+}
+
+func toTokenPolicyResponse(policy domain.TokenPolicy) TokenPolicyResponse {
+	return TokenPolicyResponse{
+		AccessTokenLifetime:     int32(policy.AccessTokenLifetime / time.Second),
+		RefreshTokenLifetime:    int32(policy.RefreshTokenLifetime / time.Second),
+		RefreshTokenIdleTimeout: int32(policy.RefreshTokenIdleTimeout / time.Second),
+		IDTokenLifetime:         int32(policy.IDTokenLifetime / time.Second),
+		IssueRefreshTokens:      policy.IssueRefreshTokens,
+		AccessTokenFormat:       policy.AccessTokenFormat,
 	}
 }
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type CreateTrustedIssuerPayload struct {
+	Issuer    string                `json:"issuer" validate:"required,url"`
+	JWKS      *domain.JSONWebKeySet `json:"jwks"`
+	JWKSURI   string                `json:"jwks_uri" validate:"omitempty,url"`
+	Audiences []string              `json:"audiences" validate:"required,min=1,dive,required"`
+}
+
+type TrustedIssuerResponse struct {
+	ID        string                `json:"id"`
+	Issuer    string                `json:"issuer"`
+	JWKS      *domain.JSONWebKeySet `json:"jwks,omitempty"`
+	JWKSURI   string                `json:"jwks_uri,omitempty"`
+	Audiences []string              `json:"audiences"`
+	CreatedAt string                `json:"created_at"`
+	UpdatedAt string                `json:"updated_at"`
+}
+
+type TrustedIssuerListResponse struct {
+	Issuers []TrustedIssuerResponse `json:"issuers"`
+	Total   int                     `json:"total"`
+}
+
+// CreateTrustPolicyPayload maps tokens whose claims meet the conditions to the client.
+type CreateTrustPolicyPayload struct {
+	ClientID   string            `json:"client_id" validate:"required"`
+	Conditions map[string]string `json:"conditions" validate:"required,min=1,dive,keys,required,endkeys,required"`
+	Scopes     []string          `json:"scopes" validate:"omitempty,dive,required"`
+}
+
+type TrustPolicyResponse struct {
+	ID         string            `json:"id"`
+	IssuerID   string            `json:"issuer_id"`
+	ClientID   string            `json:"client_id"`
+	Conditions map[string]string `json:"conditions"`
+	Scopes     []string          `json:"scopes"`
+	CreatedAt  string            `json:"created_at"`
+}
+
+type TrustPolicyListResponse struct {
+	Policies []TrustPolicyResponse `json:"policies"`
+	Total    int                   `json:"total"`
+}
+
+func ToCreateTrustedIssuerParams(req CreateTrustedIssuerPayload) domain.CreateTrustedIssuerParams {
+	return domain.CreateTrustedIssuerParams{
+		Issuer:    req.Issuer,
+		JWKS:      req.JWKS,
+		JWKSURI:   req.JWKSURI,
+		Audiences: req.Audiences,
+	}
+}
+
+func ToCreateTrustPolicyParams(req CreateTrustPolicyPayload) domain.CreateTrustPolicyParams {
+	return domain.CreateTrustPolicyParams{
+		ClientID:   req.ClientID,
+		Conditions: req.Conditions,
+		Scopes:     req.Scopes,
+	}
+}
+
+func ToTrustedIssuerResponse(issuer *domain.TrustedIssuer) TrustedIssuerResponse {
+	return TrustedIssuerResponse{
+		ID:        issuer.ID.String(),
+		Issuer:    issuer.Issuer,
+		JWKS:      issuer.JWKS,
+		JWKSURI:   issuer.JWKSURI,
+		Audiences: issuer.Audiences,
+		CreatedAt: issuer.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: issuer.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToTrustPolicyResponse(policy *domain.TrustPolicy) TrustPolicyResponse {
+	scopes := policy.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	return TrustPolicyResponse{
+		ID:         policy.ID.String(),
+		IssuerID:   policy.IssuerID.String(),
+		ClientID:   policy.ClientID,
+		Conditions: policy.Conditions,
+		Scopes:     scopes,
+		CreatedAt:  policy.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type OfflineGrantResponse struct {
+	ID                   string                      `json:"id"`
+	ClientID             string                      `json:"client_id"`
+	ClientName           string                      `json:"client_name"`
+	Scopes               []string                    `json:"scopes"`
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details,omitempty"`
+	CreatedAt            string                      `json:"created_at"`
+	LastUsedAt           *string                     `json:"last_used_at,omitempty"`
+	ExpiresAt            string                      `json:"expires_at"`
+}
+
+type GrantConsentPayload struct {
+	ClientID string   `json:"client_id" validate:"required"`
+	Scopes   []string `json:"scopes" validate:"required,min=1"`
+}
+
+type OfflineGrantListResponse struct {
+	Grants []OfflineGrantResponse `json:"grants"`
+	Total  int                    `json:"total"`
+}
+
+func ToOfflineGrantResponse(grant *domain.OfflineGrant) OfflineGrantResponse {
+	var lastUsedAt *string
+	if grant.LastUsedAt != nil {
+		formatted := grant.LastUsedAt.Format(time.RFC3339)
+		lastUsedAt = &formatted
+	}
+
+	return OfflineGrantResponse{
+		ID:                   grant.ID.String(),
+		ClientID:             grant.ClientID,
+		ClientName:           grant.ClientName,
+		Scopes:               grant.Scopes,
+		AuthorizationDetails: grant.AuthorizationDetails,
+		CreatedAt:            grant.CreatedAt.Format(time.RFC3339),
+		LastUsedAt:           lastUsedAt,
+		ExpiresAt:            grant.ExpiresAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type SigningKeyResponse struct {
+	ID            string `json:"id"`
+	KeyID         string `json:"kid"`
+	Algorithm     string `json:"alg"`
+	State         string `json:"state"`
+	ActivatedAt   string `json:"activated_at,omitempty"`
+	DeactivatedAt string `json:"deactivated_at,omitempty"`
+	RetiredAt     string `json:"retired_at,omitempty"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+type SigningKeyListResponse struct {
+	Keys  []SigningKeyResponse `json:"keys"`
+	Total int                  `json:"total"`
+}
+
+func ToSigningKeyResponse(key *domain.SigningKey) SigningKeyResponse {
+	return SigningKeyResponse{
+		ID:            key.ID.String(),
+		KeyID:         key.KeyID,
+		Algorithm:     key.Algorithm,
+		State:         key.State,
+		ActivatedAt:   formatOptionalTime(key.ActivatedAt),
+		DeactivatedAt: formatOptionalTime(key.DeactivatedAt),
+		RetiredAt:     formatOptionalTime(key.RetiredAt),
+		CreatedAt:     key.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     key.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToSigningKeyListResponse(keys []*domain.SigningKey) SigningKeyListResponse {
+	keyResponses := make([]SigningKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		keyResponses = append(keyResponses, ToSigningKeyResponse(key))
+	}
+
+	return SigningKeyListResponse{
+		Keys:  keyResponses,
+		Total: len(keyResponses),
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
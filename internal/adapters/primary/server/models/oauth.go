@@ -8,24 +8,51 @@ import (
 )
 
 type AuthorizePayload struct {
-	ClientID            string `query:"client_id" validate:"required"`
-	RedirectURI         string `query:"redirect_uri" validate:"required,url"`
-	ResponseType        string `query:"response_type" validate:"required,oneof=code token id_token"`
-	Scope               string `query:"scope" validate:"required"`
-	State               string `query:"state"`
-	Nonce               string `query:"nonce"`
-	CodeChallenge       string `query:"code_challenge"`
-	CodeChallengeMethod string `query:"code_challenge_method" validate:"omitempty,oneof=plain S256"`
+	ClientID             string   `query:"client_id" validate:"required"`
+	RedirectURI          string   `query:"redirect_uri" validate:"required,url"`
+	ResponseType         string   `query:"response_type" validate:"required,response_type"`
+	ResponseMode         string   `query:"response_mode" validate:"omitempty,oneof=query fragment form_post jwt query.jwt fragment.jwt form_post.jwt"`
+	Scope                string   `query:"scope" validate:"required"`
+	State                string   `query:"state"`
+	Nonce                string   `query:"nonce"`
+	CodeChallenge        string   `query:"code_challenge"`
+	CodeChallengeMethod  string   `query:"code_challenge_method" validate:"omitempty,oneof=plain S256"`
+	Claims               string   `query:"claims" validate:"omitempty,json"`
+	Prompt               string   `query:"prompt"`
+	Resources            []string `query:"resource" validate:"omitempty,dive,url"`
+	AuthorizationDetails string   `query:"authorization_details" validate:"omitempty,json"`
+	ACRValues            string   `query:"acr_values"`
 }
 
 type ExchangeTokenPayload struct {
-	GrantType    string `form:"grant_type" validate:"required,oneof=authorization_code refresh_token"`
-	Code         string `form:"code" validate:"required_if=GrantType authorization_code"`
-	RedirectURI  string `form:"redirect_uri" validate:"required_if=GrantType authorization_code,omitempty,url"`
-	ClientID     string `form:"client_id" validate:"required"`
-	ClientSecret string `form:"client_secret" validate:"omitempty"`
-	CodeVerifier string `form:"code_verifier" validate:"omitempty"`
-	RefreshToken string `form:"refresh_token" validate:"required_if=GrantType refresh_token"`
+	GrantType            string   `form:"grant_type" validate:"required,oneof=authorization_code refresh_token urn:ietf:params:oauth:grant-type:token-exchange urn:ietf:params:oauth:grant-type:jwt-bearer urn:openid:params:grant-type:ciba"`
+	Code                 string   `form:"code" validate:"required_if=GrantType authorization_code"`
+	RedirectURI          string   `form:"redirect_uri" validate:"required_if=GrantType authorization_code,omitempty,url"`
+	ClientID             string   `form:"client_id" validate:"required_unless=SubjectTokenType urn:ietf:params:oauth:token-type:jwt"`
+	ClientSecret         string   `form:"client_secret" validate:"omitempty"`
+	ClientAssertion      string   `form:"client_assertion" validate:"omitempty"`
+	ClientAssertionType  string   `form:"client_assertion_type" validate:"required_with=ClientAssertion"`
+	Assertion            string   `form:"assertion" validate:"required_if=GrantType urn:ietf:params:oauth:grant-type:jwt-bearer"`
+	AuthReqID            string   `form:"auth_req_id" validate:"required_if=GrantType urn:openid:params:grant-type:ciba"`
+	CodeVerifier         string   `form:"code_verifier" validate:"omitempty"`
+	RefreshToken         string   `form:"refresh_token" validate:"required_if=GrantType refresh_token"`
+	Resources            []string `form:"resource" validate:"omitempty,dive,url"`
+	AuthorizationDetails string   `form:"authorization_details" validate:"omitempty,json"`
+	SubjectToken         string   `form:"subject_token" validate:"required_if=GrantType urn:ietf:params:oauth:grant-type:token-exchange"`
+	SubjectTokenType     string   `form:"subject_token_type" validate:"required_with=SubjectToken"`
+	ActorToken           string   `form:"actor_token" validate:"omitempty"`
+	ActorTokenType       string   `form:"actor_token_type" validate:"required_with=ActorToken"`
+	Audiences            []string `form:"audience" validate:"omitempty,dive,required"`
+	Scope                string   `form:"scope" validate:"omitempty"`
+}
+
+type IntrospectTokenPayload struct {
+	Token               string `form:"token" validate:"required"`
+	TokenTypeHint       string `form:"token_type_hint" validate:"omitempty,oneof=access_token refresh_token"`
+	ClientID            string `form:"client_id" validate:"required"`
+	ClientSecret        string `form:"client_secret" validate:"required_without=ClientAssertion"`
+	ClientAssertion     string `form:"client_assertion" validate:"omitempty"`
+	ClientAssertionType string `form:"client_assertion_type" validate:"required_with=ClientAssertion"`
 }
 
 func (p *AuthorizePayload) GetScopes() []string {
@@ -36,28 +63,92 @@ func (p *AuthorizePayload) GetScopes() []string {
 	return strings.Fields(p.Scope)
 }
 
+func (p *AuthorizePayload) GetACRValues() []string {
+	return strings.Fields(p.ACRValues)
+}
+
 func (p *AuthorizePayload) ToContinueURLParams() oauth.ContinueURLParams {
 	return oauth.ContinueURLParams{
-		ClientID:            p.ClientID,
-		RedirectURI:         p.RedirectURI,
-		ResponseType:        p.ResponseType,
-		Scopes:              p.GetScopes(),
-		State:               p.State,
-		Nonce:               p.Nonce,
-		CodeChallenge:       p.CodeChallenge,
-		CodeChallengeMethod: p.CodeChallengeMethod,
+		ClientID:             p.ClientID,
+		RedirectURI:          p.RedirectURI,
+		ResponseType:         p.ResponseType,
+		ResponseMode:         p.ResponseMode,
+		Scopes:               p.GetScopes(),
+		State:                p.State,
+		Nonce:                p.Nonce,
+		CodeChallenge:        p.CodeChallenge,
+		CodeChallengeMethod:  p.CodeChallengeMethod,
+		Claims:               p.Claims,
+		Prompt:               p.Prompt,
+		Resources:            p.Resources,
+		AuthorizationDetails: p.AuthorizationDetails,
+		ACRValues:            p.GetACRValues(),
 	}
 }
 
 func (p *AuthorizePayload) ToAuthorizeParams() domain.AuthorizeParams {
 	return domain.AuthorizeParams{
+		ClientID:             p.ClientID,
+		RedirectURI:          p.RedirectURI,
+		ResponseType:         p.ResponseType,
+		ResponseMode:         p.ResponseMode,
+		Scopes:               p.GetScopes(),
+		State:                p.State,
+		Nonce:                p.Nonce,
+		CodeChallenge:        p.CodeChallenge,
+		CodeChallengeMethod:  p.CodeChallengeMethod,
+		Claims:               p.Claims,
+		Prompt:               p.Prompt,
+		Resources:            p.Resources,
+		AuthorizationDetails: p.AuthorizationDetails,
+		ACRValues:            p.GetACRValues(),
+	}
+}
+
+func (p *ExchangeTokenPayload) ToExchangeTokenParams() domain.ExchangeTokenParams {
+	return domain.ExchangeTokenParams{
+		GrantType:            p.GrantType,
+		Code:                 p.Code,
+		RedirectURI:          p.RedirectURI,
+		ClientID:             p.ClientID,
+		ClientSecret:         p.ClientSecret,
+		ClientAssertion:      p.ClientAssertion,
+		ClientAssertionType:  p.ClientAssertionType,
+		Assertion:            p.Assertion,
+		AuthReqID:            p.AuthReqID,
+		CodeVerifier:         p.CodeVerifier,
+		RefreshToken:         p.RefreshToken,
+		Resources:            p.Resources,
+		AuthorizationDetails: p.AuthorizationDetails,
+		SubjectToken:         p.SubjectToken,
+		SubjectTokenType:     p.SubjectTokenType,
+		ActorToken:           p.ActorToken,
+		ActorTokenType:       p.ActorTokenType,
+		Audiences:            p.Audiences,
+		Scopes:               strings.Fields(p.Scope),
+	}
+}
+
+func ToCallbackParams(response *domain.AuthorizationResponse, state string) oauth.CallbackParams {
+	return oauth.CallbackParams{
+		Code:        response.Code,
+		AccessToken: response.AccessToken,
+		TokenType:   response.TokenType,
+		ExpiresIn:   response.ExpiresIn,
+		IDToken:     response.IDToken,
+		Error:       response.Error,
+		State:       state,
+		Response:    response.Response,
+	}
+}
+
+func (p *IntrospectTokenPayload) ToIntrospectTokenParams() domain.IntrospectTokenParams {
+	return domain.IntrospectTokenParams{
+		Token:               p.Token,
+		TokenTypeHint:       p.TokenTypeHint,
 		ClientID:            p.ClientID,
-		RedirectURI:         p.RedirectURI,
-		ResponseType:        p.ResponseType,
-		Scopes:              p.GetScopes(),
-		State:               p.State,
-		Nonce:               p.Nonce,
-		CodeChallenge:       p.CodeChallenge,
-		CodeChallengeMethod: p.CodeChallengeMethod,
+		ClientSecret:        p.ClientSecret,
+		ClientAssertion:     p.ClientAssertion,
+		ClientAssertionType: p.ClientAssertionType,
 	}
 }
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type CreateResourcePayload struct {
+	Identifier        string   `json:"identifier" validate:"required,url"`
+	Name              string   `json:"name" validate:"required,max=255"`
+	Scopes            []string `json:"scopes" validate:"omitempty,dive,required"`
+	AccessTokenFormat string   `json:"access_token_format" validate:"omitempty,oneof=jwt opaque paseto"`
+	ClientID          string   `json:"client_id" validate:"omitempty,max=255"`
+}
+
+type UpdateResourcePayload struct {
+	Name              string   `json:"name" validate:"required,max=255"`
+	Scopes            []string `json:"scopes" validate:"omitempty,dive,required"`
+	AccessTokenFormat string   `json:"access_token_format" validate:"omitempty,oneof=jwt opaque paseto"`
+	ClientID          string   `json:"client_id" validate:"omitempty,max=255"`
+}
+
+type ResourceResponse struct {
+	ID                string   `json:"id"`
+	Identifier        string   `json:"identifier"`
+	Name              string   `json:"name"`
+	Scopes            []string `json:"scopes"`
+	AccessTokenFormat string   `json:"access_token_format,omitempty"`
+	ClientID          string   `json:"client_id,omitempty"`
+	CreatedAt         string   `json:"created_at"`
+	UpdatedAt         string   `json:"updated_at"`
+}
+
+type ResourceListResponse struct {
+	Resources []ResourceResponse `json:"resources"`
+	Total     int                `json:"total"`
+}
+
+func ToCreateResourceParams(req CreateResourcePayload) domain.CreateAPIResourceParams {
+	return domain.CreateAPIResourceParams{
+		Identifier:        req.Identifier,
+		Name:              req.Name,
+		Scopes:            req.Scopes,
+		AccessTokenFormat: req.AccessTokenFormat,
+		ClientID:          req.ClientID,
+	}
+}
+
+func ToUpdateResourceParams(req UpdateResourcePayload) domain.UpdateAPIResourceParams {
+	return domain.UpdateAPIResourceParams{
+		Name:              req.Name,
+		Scopes:            req.Scopes,
+		AccessTokenFormat: req.AccessTokenFormat,
+		ClientID:          req.ClientID,
+	}
+}
+
+func ToResourceResponse(resource *domain.APIResource) ResourceResponse {
+	scopes := resource.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	return ResourceResponse{
+		ID:                resource.ID.String(),
+		Identifier:        resource.Identifier,
+		Name:              resource.Name,
+		Scopes:            scopes,
+		AccessTokenFormat: resource.AccessTokenFormat,
+		ClientID:          resource.ClientID,
+		CreatedAt:         resource.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         resource.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type CreateScopePayload struct {
+	Name           string            `json:"name" validate:"required,max=100,excludesall=0x20"`
+	Descriptions   map[string]string `json:"descriptions" validate:"required,min=1,dive,keys,required,endkeys,required"`
+	Claims         []string          `json:"claims" validate:"omitempty,dive,required"`
+	Default        bool              `json:"default"`
+	FirstPartyOnly bool              `json:"first_party_only"`
+}
+
+type UpdateScopePayload struct {
+	Descriptions   map[string]string `json:"descriptions" validate:"required,min=1,dive,keys,required,endkeys,required"`
+	Claims         []string          `json:"claims" validate:"omitempty,dive,required"`
+	Default        bool              `json:"default"`
+	FirstPartyOnly bool              `json:"first_party_only"`
+}
+
+type ScopeResponse struct {
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	Descriptions   map[string]string `json:"descriptions"`
+	Claims         []string          `json:"claims"`
+	Default        bool              `json:"default"`
+	FirstPartyOnly bool              `json:"first_party_only"`
+	CreatedAt      string            `json:"created_at"`
+	UpdatedAt      string            `json:"updated_at"`
+}
+
+type ScopeListResponse struct {
+	Scopes []ScopeResponse `json:"scopes"`
+	Total  int             `json:"total"`
+}
+
+func ToCreateScopeParams(req CreateScopePayload) domain.CreateScopeParams {
+	return domain.CreateScopeParams{
+		Name:           req.Name,
+		Descriptions:   req.Descriptions,
+		Claims:         req.Claims,
+		Default:        req.Default,
+		FirstPartyOnly: req.FirstPartyOnly,
+	}
+}
+
+func ToUpdateScopeParams(req UpdateScopePayload) domain.UpdateScopeParams {
+	return domain.UpdateScopeParams{
+		Descriptions:   req.Descriptions,
+		Claims:         req.Claims,
+		Default:        req.Default,
+		FirstPartyOnly: req.FirstPartyOnly,
+	}
+}
+
+func ToScopeResponse(scope *domain.Scope) ScopeResponse {
+	claims := scope.Claims
+	if claims == nil {
+		claims = []string{}
+	}
+
+	return ScopeResponse{
+		ID:             scope.ID.String(),
+		Name:           scope.Name,
+		Descriptions:   scope.Descriptions,
+		Claims:         claims,
+		Default:        scope.Default,
+		FirstPartyOnly: scope.FirstPartyOnly,
+		CreatedAt:      scope.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      scope.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,263 @@
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+const webAuthnCredentialType = "public-key"
+
+// The types below follow the JSON serialization of the WebAuthn spec.
+
+type WebAuthnRegistrationOptionsResponse struct {
+	ChallengeID string                  `json:"challenge_id"`
+	Options     WebAuthnCreationOptions `json:"options"`
+}
+
+type WebAuthnCreationOptions struct {
+	Challenge              string                         `json:"challenge"`
+	RP                     WebAuthnRelyingParty           `json:"rp"`
+	User                   WebAuthnUserEntity             `json:"user"`
+	PubKeyCredParams       []WebAuthnCredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                          `json:"timeout"`
+	ExcludeCredentials     []WebAuthnCredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection WebAuthnAuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                         `json:"attestation"`
+}
+
+type WebAuthnRelyingParty struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type WebAuthnUserEntity struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type WebAuthnCredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+type WebAuthnCredentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+type WebAuthnAuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+type WebAuthnLoginOptionsResponse struct {
+	ChallengeID string                 `json:"challenge_id"`
+	Options     WebAuthnRequestOptions `json:"options"`
+}
+
+type WebAuthnRequestOptions struct {
+	Challenge        string                         `json:"challenge"`
+	RPID             string                         `json:"rpId"`
+	Timeout          int64                          `json:"timeout"`
+	AllowCredentials []WebAuthnCredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                         `json:"userVerification"`
+}
+
+type WebAuthnRegistrationPayload struct {
+	ChallengeID string                        `json:"challenge_id" validate:"required"`
+	Name        string                        `json:"name" validate:"omitempty,max=255"`
+	Credential  WebAuthnAttestationCredential `json:"credential"`
+}
+
+type WebAuthnAttestationCredential struct {
+	RawID    string                      `json:"rawId" validate:"required"`
+	Type     string                      `json:"type" validate:"required,eq=public-key"`
+	Response WebAuthnAttestationResponse `json:"response"`
+}
+
+type WebAuthnAttestationResponse struct {
+	ClientDataJSON    string   `json:"clientDataJSON" validate:"required"`
+	AttestationObject string   `json:"attestationObject" validate:"required"`
+	Transports        []string `json:"transports"`
+}
+
+type WebAuthnLoginOptionsPayload struct {
+	// Ticket finishes a password login with a passkey as its second factor.
+	Ticket string `json:"ticket"`
+}
+
+type WebAuthnLoginPayload struct {
+	Ticket      string                      `json:"ticket"`
+	ChallengeID string                      `json:"challenge_id" validate:"required"`
+	Credential  WebAuthnAssertionCredential `json:"credential"`
+	Continue    string                      `json:"continue" validate:"required,url"`
+}
+
+type WebAuthnAssertionCredential struct {
+	RawID    string                    `json:"rawId" validate:"required"`
+	Type     string                    `json:"type" validate:"required,eq=public-key"`
+	Response WebAuthnAssertionResponse `json:"response"`
+}
+
+type WebAuthnAssertionResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON" validate:"required"`
+	AuthenticatorData string `json:"authenticatorData" validate:"required"`
+	Signature         string `json:"signature" validate:"required"`
+	UserHandle        string `json:"userHandle"`
+}
+
+type RenameWebAuthnCredentialPayload struct {
+	Name string `json:"name" validate:"required,max=255"`
+}
+
+type WebAuthnCredentialResponse struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Transports []string `json:"transports"`
+	CreatedAt  string   `json:"created_at"`
+	LastUsedAt *string  `json:"last_used_at,omitempty"`
+}
+
+// WebAuthnRegistrationResponse answers a registration with the new credential and any recovery codes.
+type WebAuthnRegistrationResponse struct {
+	WebAuthnCredentialResponse
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+type WebAuthnCredentialListResponse struct {
+	Credentials []WebAuthnCredentialResponse `json:"credentials"`
+	Total       int                          `json:"total"`
+}
+
+func (p *WebAuthnRegistrationPayload) ToAttestation() (*domain.WebAuthnAttestation, error) {
+	clientData, err := decodeBase64URL(p.Credential.Response.ClientDataJSON)
+	if err != nil {
+		return nil, fmt.Errorf("decode clientDataJSON: %w", err)
+	}
+
+	attestationObject, err := decodeBase64URL(p.Credential.Response.AttestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("decode attestationObject: %w", err)
+	}
+
+	return &domain.WebAuthnAttestation{
+		ClientDataJSON:    clientData,
+		AttestationObject: attestationObject,
+		Transports:        p.Credential.Response.Transports,
+	}, nil
+}
+
+func (p *WebAuthnLoginPayload) ToAssertion() (*domain.WebAuthnAssertion, error) {
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"rawId", p.Credential.RawID},
+		{"clientDataJSON", p.Credential.Response.ClientDataJSON},
+		{"authenticatorData", p.Credential.Response.AuthenticatorData},
+		{"signature", p.Credential.Response.Signature},
+		{"userHandle", p.Credential.Response.UserHandle},
+	}
+
+	decoded := make([][]byte, len(fields))
+	for i, field := range fields {
+		value, err := decodeBase64URL(field.value)
+		if err != nil {
+			return nil, fmt.Errorf("decode %s: %w", field.name, err)
+		}
+
+		decoded[i] = value
+	}
+
+	return &domain.WebAuthnAssertion{
+		CredentialID:      decoded[0],
+		ClientDataJSON:    decoded[1],
+		AuthenticatorData: decoded[2],
+		Signature:         decoded[3],
+		UserHandle:        decoded[4],
+	}, nil
+}
+
+func ToWebAuthnRegistrationOptionsResponse(options *domain.WebAuthnRegistrationOptions) WebAuthnRegistrationOptionsResponse {
+	params := make([]WebAuthnCredentialParameter, 0, len(domain.WebAuthnAlgorithms))
+	for _, alg := range domain.WebAuthnAlgorithms {
+		params = append(params, WebAuthnCredentialParameter{Type: webAuthnCredentialType, Alg: alg})
+	}
+
+	return WebAuthnRegistrationOptionsResponse{
+		ChallengeID: options.ChallengeID,
+		Options: WebAuthnCreationOptions{
+			Challenge: base64.RawURLEncoding.EncodeToString(options.Challenge),
+			RP: WebAuthnRelyingParty{
+				ID:   options.RPID,
+				Name: options.RPName,
+			},
+			User: WebAuthnUserEntity{
+				ID:          base64.RawURLEncoding.EncodeToString(options.User.ID[:]),
+				Name:        options.User.Email,
+				DisplayName: options.User.Name,
+			},
+			PubKeyCredParams:   params,
+			Timeout:            options.Timeout.Milliseconds(),
+			ExcludeCredentials: toWebAuthnCredentialDescriptors(options.ExcludeCredentials),
+			AuthenticatorSelection: WebAuthnAuthenticatorSelection{
+				ResidentKey:      "preferred",
+				UserVerification: domain.WebAuthnUserVerificationPreferred,
+			},
+			Attestation: "none",
+		},
+	}
+}
+
+func ToWebAuthnLoginOptionsResponse(options *domain.WebAuthnLoginOptions) WebAuthnLoginOptionsResponse {
+	return WebAuthnLoginOptionsResponse{
+		ChallengeID: options.ChallengeID,
+		Options: WebAuthnRequestOptions{
+			Challenge:        base64.RawURLEncoding.EncodeToString(options.Challenge),
+			RPID:             options.RPID,
+			Timeout:          options.Timeout.Milliseconds(),
+			AllowCredentials: toWebAuthnCredentialDescriptors(options.AllowCredentials),
+			UserVerification: options.UserVerification,
+		},
+	}
+}
+
+func ToWebAuthnCredentialResponse(credential *domain.WebAuthnCredential) WebAuthnCredentialResponse {
+	var lastUsedAt *string
+	if credential.LastUsedAt != nil {
+		formatted := credential.LastUsedAt.Format(time.RFC3339)
+		lastUsedAt = &formatted
+	}
+
+	return WebAuthnCredentialResponse{
+		ID:         credential.ID.String(),
+		Name:       credential.Name,
+		Transports: credential.Transports,
+		CreatedAt:  credential.CreatedAt.Format(time.RFC3339),
+		LastUsedAt: lastUsedAt,
+	}
+}
+
+func toWebAuthnCredentialDescriptors(credentials []*domain.WebAuthnCredential) []WebAuthnCredentialDescriptor {
+	descriptors := make([]WebAuthnCredentialDescriptor, 0, len(credentials))
+	for _, credential := range credentials {
+		descriptors = append(descriptors, WebAuthnCredentialDescriptor{
+			Type:       webAuthnCredentialType,
+			ID:         base64.RawURLEncoding.EncodeToString(credential.CredentialID),
+			Transports: credential.Transports,
+		})
+	}
+
+	return descriptors
+}
+
+// decodeBase64URL decodes base64url with or without padding, as browsers differ in which they send.
+func decodeBase64URL(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+}
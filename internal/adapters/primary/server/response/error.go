@@ -121,6 +121,21 @@ func Forbidden(c echo.Context, code, message string) error {
 	return c.JSON(problem.Status, problem)
 }
 
+func TooManyRequests(c echo.Context, code, message string) error {
+	problem := np.NewProblem(
+		"https://developer.mozilla.org/pt-BR/docs/Web/HTTP/Reference/Status/429",
+		"Too Many Requests",
+		http.StatusTooManyRequests,
+		withCode(code),
+		np.WithDetail(message),
+		np.WithInstance(c.Request().URL.Path),
+	)
+
+	c.Response().Header().Set("Content-Type", np.ContentTypeProblemJSON)
+	c.Response().WriteHeader(problem.Status)
+	return c.JSON(problem.Status, problem)
+}
+
 func InvalidBind(c echo.Context) error {
 	problem := np.NewProblem(
 		"https://developer.mozilla.org/pt-BR/docs/Web/HTTP/Reference/Status/400",
@@ -15,10 +15,102 @@ func registerClientRoutes(e *echo.Group, clientHandler *handlers.ClientHandler)
 	clientsV1Group.DELETE("/:id", clientHandler.DeleteClient)
 }
 
-func registerAuthRoutes(e *echo.Group, authHandler *handlers.AuthHandler) {
+func registerScopeRoutes(e *echo.Group, scopeHandler *handlers.ScopeHandler) {
+	scopesV1Group := e.Group("/v1/admin/scopes")
+	scopesV1Group.POST("", scopeHandler.CreateScope)
+	scopesV1Group.GET("", scopeHandler.ListScopes)
+	scopesV1Group.GET("/:id", scopeHandler.GetScopeByID)
+	scopesV1Group.PUT("/:id", scopeHandler.UpdateScope)
+	scopesV1Group.DELETE("/:id", scopeHandler.DeleteScope)
+}
+
+func registerResourceRoutes(e *echo.Group, resourceHandler *handlers.ResourceHandler) {
+	resourcesV1Group := e.Group("/v1/admin/resources")
+	resourcesV1Group.POST("", resourceHandler.CreateResource)
+	resourcesV1Group.GET("", resourceHandler.ListResources)
+	resourcesV1Group.GET("/:id", resourceHandler.GetResourceByID)
+	resourcesV1Group.PUT("/:id", resourceHandler.UpdateResource)
+	resourcesV1Group.DELETE("/:id", resourceHandler.DeleteResource)
+}
+
+func registerAuthorizationDetailRoutes(e *echo.Group, authorizationDetailHandler *handlers.AuthorizationDetailHandler) {
+	detailTypesV1Group := e.Group("/v1/admin/authorization-detail-types")
+	detailTypesV1Group.POST("", authorizationDetailHandler.CreateDetailType)
+	detailTypesV1Group.GET("", authorizationDetailHandler.ListDetailTypes)
+	detailTypesV1Group.GET("/:id", authorizationDetailHandler.GetDetailTypeByID)
+	detailTypesV1Group.PUT("/:id", authorizationDetailHandler.UpdateDetailType)
+	detailTypesV1Group.DELETE("/:id", authorizationDetailHandler.DeleteDetailType)
+}
+
+func registerFederationRoutes(e *echo.Group, federationHandler *handlers.FederationHandler) {
+	issuersV1Group := e.Group("/v1/admin/federation/issuers")
+	issuersV1Group.POST("", federationHandler.CreateTrustedIssuer)
+	issuersV1Group.GET("", federationHandler.ListTrustedIssuers)
+	issuersV1Group.GET("/:id", federationHandler.GetTrustedIssuerByID)
+	issuersV1Group.DELETE("/:id", federationHandler.DeleteTrustedIssuer)
+	issuersV1Group.POST("/:id/policies", federationHandler.CreateTrustPolicy)
+	issuersV1Group.GET("/:id/policies", federationHandler.ListTrustPolicies)
+	issuersV1Group.DELETE("/:id/policies/:policyId", federationHandler.DeleteTrustPolicy)
+}
+
+func registerKeyRoutes(e *echo.Group, keyHandler *handlers.KeyHandler, adminMiddleware *middlewares.AdminMiddleware) {
+	keysV1Group := e.Group("/v1/admin/keys", adminMiddleware.RequireAdmin)
+	keysV1Group.GET("", keyHandler.ListKeys)
+	keysV1Group.POST("/rotate", keyHandler.RotateKeys)
+}
+
+func registerAuthRoutes(e *echo.Group, authHandler *handlers.AuthHandler, authMiddleware *middlewares.AuthMiddleware) {
 	authV1Group := e.Group("/v1/auth")
 	authV1Group.POST("/login", authHandler.Login)
+	authV1Group.POST("/login/totp", authHandler.LoginTOTP)
+	authV1Group.POST("/login/recovery-code", authHandler.LoginRecoveryCode)
+	authV1Group.POST("/login/webauthn/options", authHandler.BeginWebAuthnLogin)
+	authV1Group.POST("/login/webauthn", authHandler.LoginWebAuthn)
 	authV1Group.POST("/register", authHandler.RegisterUser)
+	authV1Group.POST("/logout", authHandler.Logout, authMiddleware.RequireAuthentication)
+}
+
+func registerEmailVerificationRoutes(e *echo.Group, emailVerificationHandler *handlers.EmailVerificationHandler) {
+	verifyEmailV1Group := e.Group("/v1/auth/verify-email")
+	verifyEmailV1Group.POST("", emailVerificationHandler.VerifyEmail)
+	verifyEmailV1Group.POST("/resend", emailVerificationHandler.ResendVerificationEmail)
+}
+
+func registerTOTPRoutes(e *echo.Group, totpHandler *handlers.TOTPHandler, authMiddleware *middlewares.AuthMiddleware) {
+	totpV1Group := e.Group("/v1/auth/totp", authMiddleware.RequireAuthentication)
+	totpV1Group.POST("/enroll", totpHandler.Enroll)
+	totpV1Group.POST("/confirm", totpHandler.ConfirmEnrollment)
+}
+
+func registerWebAuthnRoutes(e *echo.Group, webAuthnHandler *handlers.WebAuthnHandler, authMiddleware *middlewares.AuthMiddleware) {
+	webAuthnV1Group := e.Group("/v1/auth/webauthn", authMiddleware.RequireAuthentication)
+	webAuthnV1Group.POST("/register/options", webAuthnHandler.BeginRegistration)
+	webAuthnV1Group.POST("/register", webAuthnHandler.FinishRegistration)
+	webAuthnV1Group.GET("/credentials", webAuthnHandler.ListCredentials)
+	webAuthnV1Group.PUT("/credentials/:id", webAuthnHandler.RenameCredential)
+	webAuthnV1Group.DELETE("/credentials/:id", webAuthnHandler.DeleteCredential)
+}
+
+func registerRecoveryCodeRoutes(e *echo.Group, recoveryCodeHandler *handlers.RecoveryCodeHandler, authMiddleware *middlewares.AuthMiddleware) {
+	recoveryCodeV1Group := e.Group("/v1/auth/recovery-codes", authMiddleware.RequireAuthentication)
+	recoveryCodeV1Group.GET("", recoveryCodeHandler.Status)
+	recoveryCodeV1Group.POST("/regenerate", recoveryCodeHandler.Regenerate)
+}
+
+func registerGrantRoutes(e *echo.Group, grantHandler *handlers.GrantHandler, authMiddleware *middlewares.AuthMiddleware) {
+	grantsV1Group := e.Group("/v1/grants", authMiddleware.RequireAuthentication)
+	grantsV1Group.GET("", grantHandler.ListOfflineGrants)
+	grantsV1Group.POST("/consent", grantHandler.GrantConsent)
+	grantsV1Group.DELETE("/:id", grantHandler.RevokeOfflineGrant)
+}
+
+func registerBackchannelRoutes(e *echo.Group, backchannelHandler *handlers.BackchannelHandler, authMiddleware *middlewares.AuthMiddleware) {
+	e.POST("/v1/oauth/bc-authorize", backchannelHandler.Authenticate)
+
+	requestsV1Group := e.Group("/v1/oauth/backchannel/requests", authMiddleware.RequireAuthentication)
+	requestsV1Group.GET("/:id", backchannelHandler.GetRequest)
+	requestsV1Group.POST("/:id/approve", backchannelHandler.Approve)
+	requestsV1Group.POST("/:id/deny", backchannelHandler.Deny)
 }
 
 func registerHealthRoutes(e *echo.Group, healthHandler *handlers.HealthHandler) {
@@ -30,4 +122,13 @@ func registerOAuthRoutes(e *echo.Group, oauthHandler *handlers.OAuthHandler, aut
 	oauthV1Group := e.Group("/v1/oauth")
 	oauthV1Group.GET("/authorize", oauthHandler.Authorize, authMiddleware.OptionalAuthentication)
 	oauthV1Group.POST("/token", oauthHandler.Token)
+	oauthV1Group.POST("/introspect", oauthHandler.Introspect)
+	oauthV1Group.GET("/userinfo", oauthHandler.UserInfo)
+	oauthV1Group.POST("/userinfo", oauthHandler.UserInfo)
+}
+
+func registerDiscoveryRoutes(e *echo.Group, discoveryHandler *handlers.DiscoveryHandler) {
+	e.GET("/.well-known/jwks.json", discoveryHandler.JWKS)
+	e.GET("/.well-known/paseto-keys.json", discoveryHandler.PASETOKeys)
+	e.GET("/.well-known/openid-configuration", discoveryHandler.OpenIDConfiguration)
 }
@@ -2,12 +2,14 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/g-villarinho/oidc-server/internal/adapters/primary/scheduler"
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/handlers"
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/middlewares"
 	"github.com/g-villarinho/oidc-server/internal/config"
@@ -21,18 +23,34 @@ import (
 type ServerParams struct {
 	dig.In
 
-	Config         *config.Config
-	AuthHandler    *handlers.AuthHandler
-	ClientHandler  *handlers.ClientHandler
-	HealthHandler  *handlers.HealthHandler
-	OAuthHandler   *handlers.OAuthHandler
-	AuthMiddleware *middlewares.AuthMiddleware
+	Config                     *config.Config
+	AuthHandler                *handlers.AuthHandler
+	TOTPHandler                *handlers.TOTPHandler
+	WebAuthnHandler            *handlers.WebAuthnHandler
+	RecoveryCodeHandler        *handlers.RecoveryCodeHandler
+	EmailVerificationHandler   *handlers.EmailVerificationHandler
+	ClientHandler              *handlers.ClientHandler
+	ScopeHandler               *handlers.ScopeHandler
+	ResourceHandler            *handlers.ResourceHandler
+	AuthorizationDetailHandler *handlers.AuthorizationDetailHandler
+	FederationHandler          *handlers.FederationHandler
+	GrantHandler               *handlers.GrantHandler
+	HealthHandler              *handlers.HealthHandler
+	OAuthHandler               *handlers.OAuthHandler
+	BackchannelHandler         *handlers.BackchannelHandler
+	DiscoveryHandler           *handlers.DiscoveryHandler
+	KeyHandler                 *handlers.KeyHandler
+	KeyRotationJob             *scheduler.KeyRotationJob
+	AuthMiddleware             *middlewares.AuthMiddleware
+	AdminMiddleware            *middlewares.AdminMiddleware
 }
 
 type Server struct {
 	echo            *echo.Echo
 	port            int
 	shutdownTimeout time.Duration
+	tls             config.TLS
+	keyRotationJob  *scheduler.KeyRotationJob
 }
 
 func NewServer(params ServerParams) *Server {
@@ -48,15 +66,29 @@ func NewServer(params ServerParams) *Server {
 	e.Use(middlewares.RateLimiter(&params.Config.RateLimit))
 
 	group := e.Group("/api")
-	registerAuthRoutes(group, params.AuthHandler)
+	registerAuthRoutes(group, params.AuthHandler, params.AuthMiddleware)
+	registerEmailVerificationRoutes(group, params.EmailVerificationHandler)
+	registerTOTPRoutes(group, params.TOTPHandler, params.AuthMiddleware)
+	registerWebAuthnRoutes(group, params.WebAuthnHandler, params.AuthMiddleware)
+	registerRecoveryCodeRoutes(group, params.RecoveryCodeHandler, params.AuthMiddleware)
 	registerClientRoutes(group, params.ClientHandler)
+	registerScopeRoutes(group, params.ScopeHandler)
+	registerResourceRoutes(group, params.ResourceHandler)
+	registerAuthorizationDetailRoutes(group, params.AuthorizationDetailHandler)
+	registerFederationRoutes(group, params.FederationHandler)
+	registerGrantRoutes(group, params.GrantHandler, params.AuthMiddleware)
 	registerHealthRoutes(group, params.HealthHandler)
 	registerOAuthRoutes(group, params.OAuthHandler, params.AuthMiddleware)
+	registerBackchannelRoutes(group, params.BackchannelHandler, params.AuthMiddleware)
+	registerDiscoveryRoutes(group, params.DiscoveryHandler)
+	registerKeyRoutes(group, params.KeyHandler, params.AdminMiddleware)
 
 	return &Server{
 		echo:            e,
 		port:            port,
 		shutdownTimeout: params.Config.Server.ShutdownTimeout,
+		tls:             params.Config.Server.TLS,
+		keyRotationJob:  params.KeyRotationJob,
 	}
 }
 
@@ -64,9 +96,27 @@ func (s *Server) Start() error {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	if err := s.keyRotationJob.Start(jobsCtx); err != nil {
+		return fmt.Errorf("provision signing keys: %w", err)
+	}
+
+	// Echo only shuts down its own servers, so those are the ones started.
+	httpServer := s.echo.Server
+	if s.tls.Enabled() {
+		tlsConfig, err := newTLSConfig(s.tls)
+		if err != nil {
+			return fmt.Errorf("configure TLS: %w", err)
+		}
+		httpServer = s.echo.TLSServer
+		httpServer.TLSConfig = tlsConfig
+	}
+	httpServer.Addr = fmt.Sprintf(":%d", s.port)
+
 	go func() {
-		address := fmt.Sprintf(":%d", s.port)
-		if err := s.echo.Start(address); err != nil {
+		if err := s.echo.StartServer(httpServer); err != nil {
 			s.echo.Logger.Info("Shutting down the server")
 		}
 	}()
@@ -84,3 +134,17 @@ func (s *Server) Start() error {
 	s.echo.Logger.Info("Server exited gracefully")
 	return nil
 }
+
+// newTLSConfig asks clients for a certificate without requiring one or verifying it during the handshake.
+func newTLSConfig(cfg config.TLS) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate: %w", err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequestClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package aesgcm
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+const encryptionKeySize = 32
+
+type KeyEncrypter struct {
+	aead cipher.AEAD
+}
+
+func NewKeyEncrypter(cfg *config.Config) (ports.KeyEncrypter, error) {
+	key, err := base64.StdEncoding.DecodeString(cfg.Key.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode key encryption key: %w", err)
+	}
+
+	if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("key encryption key must be %d bytes, got %d", encryptionKeySize, len(key))
+	}
+
+	return newKeyEncrypter(key)
+}
+
+func newKeyEncrypter(key []byte) (*KeyEncrypter, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create AES cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create GCM: %w", err)
+	}
+
+	return &KeyEncrypter{aead: aead}, nil
+}
+
+// Encrypt seals the plaintext with AES-256-GCM under a random nonce, which is prepended to the ciphertext.
+func (e *KeyEncrypter) Encrypt(ctx context.Context, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	return e.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (e *KeyEncrypter) Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < e.aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	nonce, sealed := ciphertext[:e.aead.NonceSize()], ciphertext[e.aead.NonceSize():]
+	plaintext, err := e.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open ciphertext: %w", err)
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,48 @@
+package aesgcm
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyEncrypter(t *testing.T) {
+	t.Run("should decrypt what it encrypted", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		encrypter, err := newKeyEncrypter(bytes.Repeat([]byte{1}, encryptionKeySize))
+		require.NoError(t, err)
+
+		// Act
+		ciphertext, err := encrypter.Encrypt(ctx, []byte("private key"))
+		require.NoError(t, err)
+		plaintext, err := encrypter.Decrypt(ctx, ciphertext)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []byte("private key"), plaintext)
+		assert.NotContains(t, string(ciphertext), "private key")
+	})
+
+	t.Run("should reject a ciphertext sealed with another key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		encrypter, err := newKeyEncrypter(bytes.Repeat([]byte{1}, encryptionKeySize))
+		require.NoError(t, err)
+		other, err := newKeyEncrypter(bytes.Repeat([]byte{2}, encryptionKeySize))
+		require.NoError(t, err)
+
+		ciphertext, err := other.Encrypt(ctx, []byte("private key"))
+		require.NoError(t, err)
+
+		// Act
+		plaintext, err := encrypter.Decrypt(ctx, ciphertext)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, plaintext)
+	})
+}
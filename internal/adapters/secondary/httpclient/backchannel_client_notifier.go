@@ -0,0 +1,51 @@
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+const backchannelNotificationTimeout = 5 * time.Second
+
+type BackchannelClientNotifier struct {
+	client *http.Client
+}
+
+func NewBackchannelClientNotifier() ports.BackchannelClientNotifier {
+	return &BackchannelClientNotifier{
+		client: &http.Client{Timeout: backchannelNotificationTimeout},
+	}
+}
+
+// NotifyClient sends the CIBA ping callback to the client notification endpoint.
+func (n *BackchannelClientNotifier) NotifyClient(ctx context.Context, endpoint, clientNotificationToken, authReqID string) error {
+	body, err := json.Marshal(map[string]string{"auth_req_id": authReqID})
+	if err != nil {
+		return fmt.Errorf("marshal client notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create client notification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+clientNotificationToken)
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("notify client: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("notify client: unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
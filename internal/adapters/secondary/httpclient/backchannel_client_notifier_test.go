@@ -0,0 +1,52 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifyClient(t *testing.T) {
+	t.Run("should post the auth_req_id with the client notification token", func(t *testing.T) {
+		// Arrange
+		var authorization string
+		var body map[string]string
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		notifier := &BackchannelClientNotifier{client: server.Client()}
+
+		// Act
+		err := notifier.NotifyClient(context.Background(), server.URL, "notification-token", "auth-req-id")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer notification-token", authorization)
+		assert.Equal(t, "auth-req-id", body["auth_req_id"])
+	})
+
+	t.Run("should fail when the client does not accept the notification", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		notifier := &BackchannelClientNotifier{client: server.Client()}
+
+		// Act
+		err := notifier.NotifyClient(context.Background(), server.URL, "notification-token", "auth-req-id")
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
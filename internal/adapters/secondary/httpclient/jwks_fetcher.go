@@ -0,0 +1,54 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+const (
+	jwksTimeout = 5 * time.Second
+	jwksMaxSize = 1 << 20
+)
+
+type JWKSFetcher struct {
+	client *http.Client
+}
+
+func NewJWKSFetcher() ports.JWKSFetcher {
+	return &JWKSFetcher{
+		client: &http.Client{Timeout: jwksTimeout},
+	}
+}
+
+// FetchJWKS retrieves the JSON Web Key Set a client publishes at its jwks_uri.
+func (f *JWKSFetcher) FetchJWKS(ctx context.Context, jwksURI string) (*domain.JSONWebKeySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create JWKS request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch JWKS: unexpected status %d", resp.StatusCode)
+	}
+
+	var keySet domain.JSONWebKeySet
+	if err := json.NewDecoder(io.LimitReader(resp.Body, jwksMaxSize)).Decode(&keySet); err != nil {
+		return nil, fmt.Errorf("decode JWKS: %w", err)
+	}
+
+	return &keySet, nil
+}
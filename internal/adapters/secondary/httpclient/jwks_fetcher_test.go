@@ -0,0 +1,51 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchJWKS(t *testing.T) {
+	t.Run("should return the keys published by the client", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"keys":[{"kty":"EC","kid":"key-1","crv":"P-256","x":"f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU","y":"x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"}]}`))
+		}))
+		defer server.Close()
+
+		fetcher := &JWKSFetcher{client: server.Client()}
+
+		// Act
+		keySet, err := fetcher.FetchJWKS(context.Background(), server.URL)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, keySet.Keys, 1)
+		assert.Equal(t, "EC", keySet.Keys[0].KeyType)
+		assert.Equal(t, "key-1", keySet.Keys[0].KeyID)
+		assert.Equal(t, "P-256", keySet.Keys[0].Curve)
+	})
+
+	t.Run("should fail when the client does not answer with 200", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		fetcher := &JWKSFetcher{client: server.Client()}
+
+		// Act
+		keySet, err := fetcher.FetchJWKS(context.Background(), server.URL)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, keySet)
+	})
+}
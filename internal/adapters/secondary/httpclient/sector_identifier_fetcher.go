@@ -0,0 +1,53 @@
+package httpclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+const (
+	sectorIdentifierTimeout = 5 * time.Second
+	sectorIdentifierMaxSize = 1 << 20
+)
+
+type SectorIdentifierFetcher struct {
+	client *http.Client
+}
+
+func NewSectorIdentifierFetcher() ports.SectorIdentifierFetcher {
+	return &SectorIdentifierFetcher{
+		client: &http.Client{Timeout: sectorIdentifierTimeout},
+	}
+}
+
+// FetchRedirectURIs retrieves the JSON array of redirect URIs published at a sector_identifier_uri.
+func (f *SectorIdentifierFetcher) FetchRedirectURIs(ctx context.Context, sectorIdentifierURI string) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sectorIdentifierURI, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create sector identifier request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch sector identifier: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch sector identifier: unexpected status %d", resp.StatusCode)
+	}
+
+	var redirectURIs []string
+	if err := json.NewDecoder(io.LimitReader(resp.Body, sectorIdentifierMaxSize)).Decode(&redirectURIs); err != nil {
+		return nil, fmt.Errorf("decode sector identifier: %w", err)
+	}
+
+	return redirectURIs, nil
+}
@@ -0,0 +1,65 @@
+package httpclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchRedirectURIs(t *testing.T) {
+	t.Run("should return the redirect URIs published by the sector", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`["https://app.example.com/callback","https://admin.example.com/callback"]`))
+		}))
+		defer server.Close()
+
+		fetcher := &SectorIdentifierFetcher{client: server.Client()}
+
+		// Act
+		redirectURIs, err := fetcher.FetchRedirectURIs(context.Background(), server.URL)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://app.example.com/callback", "https://admin.example.com/callback"}, redirectURIs)
+	})
+
+	t.Run("should fail when the sector does not answer with 200", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		fetcher := &SectorIdentifierFetcher{client: server.Client()}
+
+		// Act
+		redirectURIs, err := fetcher.FetchRedirectURIs(context.Background(), server.URL)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, redirectURIs)
+	})
+
+	t.Run("should fail when the document is not a JSON array of strings", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"redirect_uris":["https://app.example.com/callback"]}`))
+		}))
+		defer server.Close()
+
+		fetcher := &SectorIdentifierFetcher{client: server.Client()}
+
+		// Act
+		redirectURIs, err := fetcher.FetchRedirectURIs(context.Background(), server.URL)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, redirectURIs)
+	})
+}
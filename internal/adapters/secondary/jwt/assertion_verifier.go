@@ -0,0 +1,176 @@
+package jwt
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type AssertionVerifier struct{}
+
+func NewAssertionVerifier() ports.AssertionVerifier {
+	return &AssertionVerifier{}
+}
+
+// VerifyAssertion checks the signature of an RFC 7523 JWT against the client's key set and returns its claims.
+func (v *AssertionVerifier) VerifyAssertion(ctx context.Context, assertion string, keySet *domain.JSONWebKeySet) (*domain.AssertionClaims, error) {
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(assertion, claims, func(token *jwt.Token) (any, error) {
+		keyID, _ := token.Header["kid"].(string)
+		key, err := findVerificationKey(keySet, keyID, token.Method)
+		if err != nil {
+			return nil, err
+		}
+		return publicKey(key)
+	},
+		jwt.WithValidMethods(domain.AssertionSigningAlgs()),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30*time.Second),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAssertion, err)
+	}
+
+	return assertionClaims(claims), nil
+}
+
+// VerifyMACAssertion checks the HMAC of a client_secret_jwt assertion with the client's secret.
+func (v *AssertionVerifier) VerifyMACAssertion(ctx context.Context, assertion string, secret []byte) (*domain.AssertionClaims, error) {
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(assertion, claims, func(token *jwt.Token) (any, error) {
+		return secret, nil
+	},
+		jwt.WithValidMethods(domain.AssertionMACAlgs()),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30*time.Second),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidAssertion, err)
+	}
+
+	return assertionClaims(claims), nil
+}
+
+func assertionClaims(claims *jwt.RegisteredClaims) *domain.AssertionClaims {
+	return &domain.AssertionClaims{
+		Issuer:    claims.Issuer,
+		Subject:   claims.Subject,
+		Audience:  claims.Audience,
+		ExpiresAt: claims.ExpiresAt.Time,
+		JWTID:     claims.ID,
+	}
+}
+
+// findVerificationKey picks the key named by kid or, when the assertion carries none, the only key of a matching type.
+func findVerificationKey(keySet *domain.JSONWebKeySet, keyID string, method jwt.SigningMethod) (*domain.JSONWebKey, error) {
+	if keySet == nil {
+		return nil, errors.New("no client keys")
+	}
+
+	keyType := "RSA"
+	if _, ok := method.(*jwt.SigningMethodECDSA); ok {
+		keyType = "EC"
+	}
+
+	var found *domain.JSONWebKey
+	for i, key := range keySet.Keys {
+		if key.KeyType != keyType || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+
+		if keyID != "" {
+			if key.KeyID == keyID {
+				return &keySet.Keys[i], nil
+			}
+			continue
+		}
+
+		if found != nil {
+			return nil, errors.New("ambiguous client key, kid required")
+		}
+		found = &keySet.Keys[i]
+	}
+
+	if found == nil {
+		return nil, errors.New("no matching client key")
+	}
+
+	return found, nil
+}
+
+func publicKey(key *domain.JSONWebKey) (any, error) {
+	switch key.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(key.Modulus)
+		if err != nil {
+			return nil, fmt.Errorf("decode RSA modulus: %w", err)
+		}
+
+		e, err := decodeBigInt(key.Exponent)
+		if err != nil {
+			return nil, fmt.Errorf("decode RSA exponent: %w", err)
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		curve, err := ellipticCurve(key.Curve)
+		if err != nil {
+			return nil, err
+		}
+
+		x, err := decodeBigInt(key.X)
+		if err != nil {
+			return nil, fmt.Errorf("decode EC x coordinate: %w", err)
+		}
+
+		y, err := decodeBigInt(key.Y)
+		if err != nil {
+			return nil, fmt.Errorf("decode EC y coordinate: %w", err)
+		}
+
+		publicKey := &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		if _, err := publicKey.ECDH(); err != nil {
+			return nil, fmt.Errorf("invalid EC key: %w", err)
+		}
+
+		return publicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", key.KeyType)
+	}
+}
+
+func ellipticCurve(name string) (elliptic.Curve, error) {
+	switch name {
+	case "P-256":
+		return elliptic.P256(), nil
+	case "P-384":
+		return elliptic.P384(), nil
+	case "P-521":
+		return elliptic.P521(), nil
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", name)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	if value == "" {
+		return nil, errors.New("missing value")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,72 @@
+package jwt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyMACAssertion(t *testing.T) {
+	secret := []byte("a-client-secret-long-enough-for-hs256")
+	verifier := NewAssertionVerifier()
+
+	t.Run("should return the claims of an assertion MACed with the client secret", func(t *testing.T) {
+		// Arrange
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+			Issuer:    "partner-service",
+			Subject:   "partner-service",
+			Audience:  jwt.ClaimStrings{"https://auth.example.com/api/v1/oauth/token"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			ID:        "jti-1",
+		})
+		assertion, err := token.SignedString(secret)
+		require.NoError(t, err)
+
+		// Act
+		claims, err := verifier.VerifyMACAssertion(context.Background(), assertion, secret)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "partner-service", claims.Subject)
+		assert.Equal(t, "jti-1", claims.JWTID)
+	})
+
+	t.Run("should reject an assertion MACed with another secret", func(t *testing.T) {
+		// Arrange
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+			Subject:   "partner-service",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		})
+		assertion, err := token.SignedString([]byte("another-secret-of-the-same-client"))
+		require.NoError(t, err)
+
+		// Act
+		claims, err := verifier.VerifyMACAssertion(context.Background(), assertion, secret)
+
+		// Assert
+		assert.Nil(t, claims)
+		assert.ErrorIs(t, err, domain.ErrInvalidAssertion)
+	})
+
+	t.Run("should reject an unsigned assertion", func(t *testing.T) {
+		// Arrange
+		token := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.RegisteredClaims{
+			Subject:   "partner-service",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		})
+		assertion, err := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+		require.NoError(t, err)
+
+		// Act
+		claims, err := verifier.VerifyMACAssertion(context.Background(), assertion, secret)
+
+		// Assert
+		assert.Nil(t, claims)
+		assert.ErrorIs(t, err, domain.ErrInvalidAssertion)
+	})
+}
@@ -0,0 +1,79 @@
+package jwt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type DPoPProofVerifier struct{}
+
+func NewDPoPProofVerifier() ports.DPoPProofVerifier {
+	return &DPoPProofVerifier{}
+}
+
+// VerifyDPoPProof checks a DPoP proof against the key in its jwk header.
+func (v *DPoPProofVerifier) VerifyDPoPProof(ctx context.Context, proof string) (*domain.DPoPProof, error) {
+	var key domain.JSONWebKey
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(proof, claims, func(token *jwt.Token) (any, error) {
+		if typ, _ := token.Header["typ"].(string); typ != domain.DPoPProofType {
+			return nil, fmt.Errorf("unexpected typ %q", typ)
+		}
+
+		header, ok := token.Header["jwk"].(map[string]any)
+		if !ok {
+			return nil, errors.New("missing jwk header")
+		}
+
+		if _, private := header["d"]; private {
+			return nil, errors.New("jwk header holds a private key")
+		}
+
+		data, err := json.Marshal(header)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(data, &key); err != nil {
+			return nil, fmt.Errorf("decode jwk header: %w", err)
+		}
+
+		return publicKey(&key)
+	}, jwt.WithValidMethods(domain.DPoPSigningAlgs()))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidDPoPProof, err)
+	}
+
+	issuedAt, err := claims.GetIssuedAt()
+	if err != nil || issuedAt == nil {
+		return nil, fmt.Errorf("%w: missing iat", domain.ErrInvalidDPoPProof)
+	}
+
+	thumbprint, err := key.Thumbprint()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidDPoPProof, err)
+	}
+
+	method, _ := claims["htm"].(string)
+	url, _ := claims["htu"].(string)
+	jwtID, _ := claims["jti"].(string)
+	nonce, _ := claims["nonce"].(string)
+	accessTokenHash, _ := claims["ath"].(string)
+
+	return &domain.DPoPProof{
+		Thumbprint:      thumbprint,
+		Method:          method,
+		URL:             url,
+		IssuedAt:        issuedAt.UTC(),
+		JWTID:           jwtID,
+		Nonce:           nonce,
+		AccessTokenHash: accessTokenHash,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package jwt
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyDPoPProof(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	publicJWK := domain.JSONWebKey{
+		KeyType: "EC",
+		Curve:   "P-256",
+		X:       base64.RawURLEncoding.EncodeToString(privateKey.PublicKey.X.FillBytes(make([]byte, 32))),
+		Y:       base64.RawURLEncoding.EncodeToString(privateKey.PublicKey.Y.FillBytes(make([]byte, 32))),
+	}
+
+	signProof := func(t *testing.T, header map[string]any) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+			"htm": "POST",
+			"htu": "https://auth.example.com/api/v1/oauth/token",
+			"iat": time.Now().Unix(),
+			"jti": "jti-1",
+			"ath": domain.DPoPAccessTokenHash("access-token"),
+		})
+		for name, value := range header {
+			token.Header[name] = value
+		}
+		signed, err := token.SignedString(privateKey)
+		require.NoError(t, err)
+		return signed
+	}
+
+	verifier := NewDPoPProofVerifier()
+
+	t.Run("should return the proof claims and the key thumbprint", func(t *testing.T) {
+		// Arrange
+		proof := signProof(t, map[string]any{"typ": domain.DPoPProofType, "jwk": publicJWK})
+		expectedThumbprint, err := publicJWK.Thumbprint()
+		require.NoError(t, err)
+
+		// Act
+		verified, err := verifier.VerifyDPoPProof(context.Background(), proof)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, expectedThumbprint, verified.Thumbprint)
+		assert.Equal(t, "POST", verified.Method)
+		assert.Equal(t, "https://auth.example.com/api/v1/oauth/token", verified.URL)
+		assert.Equal(t, "jti-1", verified.JWTID)
+		assert.Equal(t, domain.DPoPAccessTokenHash("access-token"), verified.AccessTokenHash)
+	})
+
+	t.Run("should reject a proof without the dpop+jwt type", func(t *testing.T) {
+		// Arrange
+		proof := signProof(t, map[string]any{"jwk": publicJWK})
+
+		// Act
+		verified, err := verifier.VerifyDPoPProof(context.Background(), proof)
+
+		// Assert
+		assert.Nil(t, verified)
+		assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+	})
+
+	t.Run("should reject a proof signed by a key other than its jwk", func(t *testing.T) {
+		// Arrange
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		otherJWK := publicJWK
+		otherJWK.X = base64.RawURLEncoding.EncodeToString(otherKey.PublicKey.X.FillBytes(make([]byte, 32)))
+		otherJWK.Y = base64.RawURLEncoding.EncodeToString(otherKey.PublicKey.Y.FillBytes(make([]byte, 32)))
+		proof := signProof(t, map[string]any{"typ": domain.DPoPProofType, "jwk": otherJWK})
+
+		// Act
+		verified, err := verifier.VerifyDPoPProof(context.Background(), proof)
+
+		// Assert
+		assert.Nil(t, verified)
+		assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+	})
+}
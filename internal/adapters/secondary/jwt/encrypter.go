@@ -0,0 +1,198 @@
+package jwt
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+const contentEncryptionKeySize = 32
+
+type jweHeader struct {
+	Algorithm           string             `json:"alg"`
+	EncryptionAlgorithm string             `json:"enc"`
+	ContentType         string             `json:"cty"`
+	KeyID               string             `json:"kid,omitempty"`
+	EphemeralPublicKey  *domain.JSONWebKey `json:"epk,omitempty"`
+}
+
+type JWEEncrypter struct{}
+
+func NewJWEEncrypter() ports.TokenEncrypter {
+	return &JWEEncrypter{}
+}
+
+// Encrypt nests the token in a JWE (RFC 7516) with A256GCM content encryption.
+func (e *JWEEncrypter) Encrypt(ctx context.Context, token string, keySet *domain.JSONWebKeySet, encryption domain.ResponseEncryption) (string, error) {
+	if encryption.Enc != domain.ContentEncryptionA256GCM {
+		return "", fmt.Errorf("unsupported content encryption %q", encryption.Enc)
+	}
+
+	key, err := findEncryptionKey(keySet, encryption.Alg)
+	if err != nil {
+		return "", err
+	}
+
+	recipientKey, err := publicKey(key)
+	if err != nil {
+		return "", fmt.Errorf("parse encryption key: %w", err)
+	}
+
+	header := jweHeader{
+		Algorithm:           encryption.Alg,
+		EncryptionAlgorithm: encryption.Enc,
+		ContentType:         domain.ContentTypeJWT,
+		KeyID:               key.KeyID,
+	}
+
+	var contentEncryptionKey, encryptedKey []byte
+	switch encryption.Alg {
+	case domain.KeyManagementAlgRSAOAEP256:
+		contentEncryptionKey = make([]byte, contentEncryptionKeySize)
+		if _, err := rand.Read(contentEncryptionKey); err != nil {
+			return "", fmt.Errorf("generate content encryption key: %w", err)
+		}
+
+		encryptedKey, err = rsa.EncryptOAEP(sha256.New(), rand.Reader, recipientKey.(*rsa.PublicKey), contentEncryptionKey, nil)
+		if err != nil {
+			return "", fmt.Errorf("wrap content encryption key: %w", err)
+		}
+	case domain.KeyManagementAlgECDHES:
+		contentEncryptionKey, header.EphemeralPublicKey, err = agreeContentEncryptionKey(recipientKey.(*ecdsa.PublicKey), key.Curve, encryption.Enc)
+		if err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("unsupported key management algorithm %q", encryption.Alg)
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("marshal JWE header: %w", err)
+	}
+	protectedHeader := base64.RawURLEncoding.EncodeToString(headerJSON)
+
+	block, err := aes.NewCipher(contentEncryptionKey)
+	if err != nil {
+		return "", fmt.Errorf("create cipher: %w", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", fmt.Errorf("create GCM: %w", err)
+	}
+
+	iv := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(iv); err != nil {
+		return "", fmt.Errorf("generate IV: %w", err)
+	}
+
+	// The protected header is the additional authenticated data.
+	sealed := gcm.Seal(nil, iv, []byte(token), []byte(protectedHeader))
+	ciphertext, tag := sealed[:len(sealed)-gcm.Overhead()], sealed[len(sealed)-gcm.Overhead():]
+
+	return strings.Join([]string{
+		protectedHeader,
+		base64.RawURLEncoding.EncodeToString(encryptedKey),
+		base64.RawURLEncoding.EncodeToString(iv),
+		base64.RawURLEncoding.EncodeToString(ciphertext),
+		base64.RawURLEncoding.EncodeToString(tag),
+	}, "."), nil
+}
+
+// agreeContentEncryptionKey performs ECDH-ES in direct key agreement mode (RFC 7518 section 4.6).
+func agreeContentEncryptionKey(recipient *ecdsa.PublicKey, curve, enc string) ([]byte, *domain.JSONWebKey, error) {
+	recipientKey, err := recipient.ECDH()
+	if err != nil {
+		return nil, nil, fmt.Errorf("convert encryption key: %w", err)
+	}
+
+	ephemeralKey, err := recipientKey.Curve().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generate ephemeral key: %w", err)
+	}
+
+	sharedSecret, err := ephemeralKey.ECDH(recipientKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("agree on shared secret: %w", err)
+	}
+
+	return concatKDF(sharedSecret, enc, nil, nil, contentEncryptionKeySize), ephemeralPublicJWK(ephemeralKey.PublicKey(), curve), nil
+}
+
+// concatKDF derives a key from the shared secret with the single-step KDF of NIST SP 800-56A.
+func concatKDF(sharedSecret []byte, algorithmID string, partyUInfo, partyVInfo []byte, keySize int) []byte {
+	var otherInfo []byte
+	otherInfo = appendLengthPrefixed(otherInfo, []byte(algorithmID))
+	otherInfo = appendLengthPrefixed(otherInfo, partyUInfo)
+	otherInfo = appendLengthPrefixed(otherInfo, partyVInfo)
+	otherInfo = binary.BigEndian.AppendUint32(otherInfo, uint32(keySize*8))
+
+	var key []byte
+	for counter := uint32(1); len(key) < keySize; counter++ {
+		hash := sha256.New()
+		hash.Write(binary.BigEndian.AppendUint32(nil, counter))
+		hash.Write(sharedSecret)
+		hash.Write(otherInfo)
+		key = hash.Sum(key)
+	}
+
+	return key[:keySize]
+}
+
+func appendLengthPrefixed(data, value []byte) []byte {
+	data = binary.BigEndian.AppendUint32(data, uint32(len(value)))
+	return append(data, value...)
+}
+
+func ephemeralPublicJWK(publicKey *ecdh.PublicKey, curve string) *domain.JSONWebKey {
+	// The uncompressed point is 0x04 followed by the X and Y coordinates.
+	point := publicKey.Bytes()[1:]
+	size := len(point) / 2
+
+	return &domain.JSONWebKey{
+		KeyType: "EC",
+		Curve:   curve,
+		X:       base64.RawURLEncoding.EncodeToString(point[:size]),
+		Y:       base64.RawURLEncoding.EncodeToString(point[size:]),
+	}
+}
+
+// findEncryptionKey picks the first encryption key of the type the algorithm needs.
+func findEncryptionKey(keySet *domain.JSONWebKeySet, alg string) (*domain.JSONWebKey, error) {
+	if keySet == nil {
+		return nil, domain.ErrEncryptionKeyNotFound
+	}
+
+	keyType := "RSA"
+	if alg == domain.KeyManagementAlgECDHES {
+		keyType = "EC"
+	}
+
+	for i, key := range keySet.Keys {
+		if key.KeyType != keyType || (key.Use != "" && key.Use != "enc") {
+			continue
+		}
+
+		if key.Algorithm != "" && key.Algorithm != alg {
+			continue
+		}
+
+		return &keySet.Keys[i], nil
+	}
+
+	return nil, domain.ErrEncryptionKeyNotFound
+}
@@ -0,0 +1,161 @@
+package jwt
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncrypt(t *testing.T) {
+	const signedToken = "header.payload.signature"
+
+	// decrypt opens a compact JWE given how its content encryption key is
+	// recovered from the header and the encrypted key.
+	decrypt := func(t *testing.T, jwe string, contentEncryptionKey func(header jweHeader, encryptedKey []byte) []byte) (jweHeader, string) {
+		parts := strings.Split(jwe, ".")
+		require.Len(t, parts, 5)
+
+		decoded := make([][]byte, len(parts))
+		for i, part := range parts {
+			var err error
+			decoded[i], err = base64.RawURLEncoding.DecodeString(part)
+			require.NoError(t, err)
+		}
+
+		var header jweHeader
+		require.NoError(t, json.Unmarshal(decoded[0], &header))
+
+		block, err := aes.NewCipher(contentEncryptionKey(header, decoded[1]))
+		require.NoError(t, err)
+		gcm, err := cipher.NewGCM(block)
+		require.NoError(t, err)
+
+		plaintext, err := gcm.Open(nil, decoded[2], append(decoded[3], decoded[4]...), []byte(parts[0]))
+		require.NoError(t, err)
+
+		return header, string(plaintext)
+	}
+
+	encrypter := NewJWEEncrypter()
+
+	t.Run("should encrypt to an RSA key with RSA-OAEP-256", func(t *testing.T) {
+		// Arrange
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		encryptionKey := domain.NewRSAPublicJWK(&privateKey.PublicKey, "", "enc-key")
+		encryptionKey.Use = "enc"
+		keySet := &domain.JSONWebKeySet{Keys: []domain.JSONWebKey{encryptionKey}}
+
+		// Act
+		jwe, err := encrypter.Encrypt(context.Background(), signedToken, keySet, domain.ResponseEncryption{
+			Alg: domain.KeyManagementAlgRSAOAEP256,
+			Enc: domain.ContentEncryptionA256GCM,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		header, plaintext := decrypt(t, jwe, func(_ jweHeader, encryptedKey []byte) []byte {
+			key, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, privateKey, encryptedKey, nil)
+			require.NoError(t, err)
+			return key
+		})
+		assert.Equal(t, signedToken, plaintext)
+		assert.Equal(t, domain.KeyManagementAlgRSAOAEP256, header.Algorithm)
+		assert.Equal(t, domain.ContentEncryptionA256GCM, header.EncryptionAlgorithm)
+		assert.Equal(t, domain.ContentTypeJWT, header.ContentType)
+		assert.Equal(t, "enc-key", header.KeyID)
+	})
+
+	t.Run("should agree on the key with an EC key with ECDH-ES", func(t *testing.T) {
+		// Arrange
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		keySet := &domain.JSONWebKeySet{Keys: []domain.JSONWebKey{{
+			KeyType: "EC",
+			Curve:   "P-256",
+			X:       base64.RawURLEncoding.EncodeToString(privateKey.PublicKey.X.FillBytes(make([]byte, 32))),
+			Y:       base64.RawURLEncoding.EncodeToString(privateKey.PublicKey.Y.FillBytes(make([]byte, 32))),
+		}}}
+
+		// Act
+		jwe, err := encrypter.Encrypt(context.Background(), signedToken, keySet, domain.ResponseEncryption{
+			Alg: domain.KeyManagementAlgECDHES,
+			Enc: domain.ContentEncryptionA256GCM,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		header, plaintext := decrypt(t, jwe, func(header jweHeader, encryptedKey []byte) []byte {
+			assert.Empty(t, encryptedKey)
+			require.NotNil(t, header.EphemeralPublicKey)
+
+			ephemeralKey, err := publicKey(header.EphemeralPublicKey)
+			require.NoError(t, err)
+			ephemeralECDH, err := ephemeralKey.(*ecdsa.PublicKey).ECDH()
+			require.NoError(t, err)
+			recipientKey, err := privateKey.ECDH()
+			require.NoError(t, err)
+			sharedSecret, err := recipientKey.ECDH(ephemeralECDH)
+			require.NoError(t, err)
+
+			return concatKDF(sharedSecret, domain.ContentEncryptionA256GCM, nil, nil, contentEncryptionKeySize)
+		})
+		assert.Equal(t, signedToken, plaintext)
+		assert.Equal(t, domain.KeyManagementAlgECDHES, header.Algorithm)
+	})
+
+	t.Run("should return ErrEncryptionKeyNotFound without a key for the algorithm", func(t *testing.T) {
+		// Arrange
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		signingKey := domain.NewRSAPublicJWK(&privateKey.PublicKey, "RS256", "sig-key")
+		keySet := &domain.JSONWebKeySet{Keys: []domain.JSONWebKey{signingKey}}
+
+		// Act
+		_, err = encrypter.Encrypt(context.Background(), signedToken, keySet, domain.ResponseEncryption{
+			Alg: domain.KeyManagementAlgRSAOAEP256,
+			Enc: domain.ContentEncryptionA256GCM,
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrEncryptionKeyNotFound)
+	})
+}
+
+func TestConcatKDF(t *testing.T) {
+	t.Run("should match the RFC 7518 appendix C example", func(t *testing.T) {
+		// Arrange
+		receiver, err := ecdh.P256().NewPrivateKey(mustDecode(t, "VEmDZpDXXK8p8N0Cndsxs924q6nS1RXFASRl6BfUqdw"))
+		require.NoError(t, err)
+		sender, err := ecdh.P256().NewPrivateKey(mustDecode(t, "0_NxaRPUMQoAJt50Gz8YiTr8gRTwyEaCumd-MToTmIo"))
+		require.NoError(t, err)
+		sharedSecret, err := sender.ECDH(receiver.PublicKey())
+		require.NoError(t, err)
+
+		// Act
+		key := concatKDF(sharedSecret, "A128GCM", []byte("Alice"), []byte("Bob"), 16)
+
+		// Assert
+		assert.Equal(t, "VqqN6vgjbSBcIijNcacQGg", base64.RawURLEncoding.EncodeToString(key))
+	})
+}
+
+func mustDecode(t *testing.T, value string) []byte {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	require.NoError(t, err)
+	return data
+}
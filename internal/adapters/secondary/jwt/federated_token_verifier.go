@@ -0,0 +1,67 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type FederatedTokenVerifier struct{}
+
+func NewFederatedTokenVerifier() ports.FederatedTokenVerifier {
+	return &FederatedTokenVerifier{}
+}
+
+func (v *FederatedTokenVerifier) PeekIssuer(token string) (string, error) {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return "", fmt.Errorf("%w: %w", domain.ErrUntrustedToken, err)
+	}
+
+	issuer, err := claims.GetIssuer()
+	if err != nil || issuer == "" {
+		return "", fmt.Errorf("%w: missing issuer", domain.ErrUntrustedToken)
+	}
+
+	return issuer, nil
+}
+
+// VerifyFederatedToken checks a token from an external issuer against the issuer's key set.
+func (v *FederatedTokenVerifier) VerifyFederatedToken(ctx context.Context, token string, keySet *domain.JSONWebKeySet) (*domain.FederatedClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (any, error) {
+		keyID, _ := token.Header["kid"].(string)
+		key, err := findVerificationKey(keySet, keyID, token.Method)
+		if err != nil {
+			return nil, err
+		}
+		return publicKey(key)
+	},
+		jwt.WithValidMethods(domain.AssertionSigningAlgs()),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(30*time.Second),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrUntrustedToken, err)
+	}
+
+	issuer, _ := claims.GetIssuer()
+	subject, _ := claims.GetSubject()
+	audience, _ := claims.GetAudience()
+	expiresAt, _ := claims.GetExpirationTime()
+	if subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", domain.ErrUntrustedToken)
+	}
+
+	return &domain.FederatedClaims{
+		Issuer:    issuer,
+		Subject:   subject,
+		Audience:  audience,
+		ExpiresAt: expiresAt.Time,
+		Claims:    claims,
+	}, nil
+}
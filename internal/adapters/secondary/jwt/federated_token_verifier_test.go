@@ -0,0 +1,110 @@
+package jwt
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/httpclient"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyFederatedToken(t *testing.T) {
+	const issuer = "https://token.actions.githubusercontent.com"
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	// The external issuer publishes its keys over HTTP, as a CI provider would.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(domain.JSONWebKeySet{Keys: []domain.JSONWebKey{{
+			KeyType:   "EC",
+			KeyID:     "ci-key",
+			Algorithm: "ES256",
+			Curve:     "P-256",
+			X:         base64.RawURLEncoding.EncodeToString(privateKey.PublicKey.X.FillBytes(make([]byte, 32))),
+			Y:         base64.RawURLEncoding.EncodeToString(privateKey.PublicKey.Y.FillBytes(make([]byte, 32))),
+		}}})
+	}))
+	defer server.Close()
+
+	keySet, err := httpclient.NewJWKSFetcher().FetchJWKS(context.Background(), server.URL)
+	require.NoError(t, err)
+
+	signToken := func(t *testing.T, key *ecdsa.PrivateKey, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+		token.Header["kid"] = "ci-key"
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return signed
+	}
+
+	newTestClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":        issuer,
+			"sub":        "repo:acme/deployer:ref:refs/heads/main",
+			"aud":        "https://auth.example.com",
+			"exp":        time.Now().Add(5 * time.Minute).Unix(),
+			"repository": "acme/deployer",
+		}
+	}
+
+	verifier := NewFederatedTokenVerifier()
+
+	t.Run("should return all claims of a token signed by the issuer keys", func(t *testing.T) {
+		// Arrange
+		token := signToken(t, privateKey, newTestClaims())
+
+		// Act
+		peeked, peekErr := verifier.PeekIssuer(token)
+		claims, err := verifier.VerifyFederatedToken(context.Background(), token, keySet)
+
+		// Assert
+		require.NoError(t, peekErr)
+		require.NoError(t, err)
+		assert.Equal(t, issuer, peeked)
+		assert.Equal(t, issuer, claims.Issuer)
+		assert.Equal(t, "repo:acme/deployer:ref:refs/heads/main", claims.Subject)
+		assert.Equal(t, []string{"https://auth.example.com"}, claims.Audience)
+		assert.Equal(t, "acme/deployer", claims.Claims["repository"])
+	})
+
+	t.Run("should reject a token signed by another key", func(t *testing.T) {
+		// Arrange
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		token := signToken(t, otherKey, newTestClaims())
+
+		// Act
+		claims, err := verifier.VerifyFederatedToken(context.Background(), token, keySet)
+
+		// Assert
+		assert.Nil(t, claims)
+		assert.ErrorIs(t, err, domain.ErrUntrustedToken)
+	})
+
+	t.Run("should reject a token without expiry", func(t *testing.T) {
+		// Arrange
+		claims := newTestClaims()
+		delete(claims, "exp")
+		token := signToken(t, privateKey, claims)
+
+		// Act
+		verified, err := verifier.VerifyFederatedToken(context.Background(), token, keySet)
+
+		// Assert
+		assert.Nil(t, verified)
+		assert.ErrorIs(t, err, domain.ErrUntrustedToken)
+	})
+}
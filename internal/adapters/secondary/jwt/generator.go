@@ -3,13 +3,15 @@ package jwt
 import (
 	"context"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
-	"slices"
 	"strings"
 	"time"
 
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/signingkey"
 	"github.com/g-villarinho/oidc-server/internal/config"
 	"github.com/g-villarinho/oidc-server/internal/core/domain"
 	"github.com/g-villarinho/oidc-server/internal/core/ports"
@@ -17,34 +19,73 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	authorizationResponseDuration = 10 * time.Minute
+	accessTokenType               = "at+jwt"
+)
+
 type JWTTokenGenerator struct {
-	jwtConfig *config.JWT
+	jwtConfig            *config.JWT
+	signingKeyRepository ports.SigningKeyRepository
+	privateKeys          *signingkey.Cache
 }
 
-func NewJWTTokenGenerator(cfg *config.Config) ports.TokenGenerator {
+func NewJWTTokenGenerator(cfg *config.Config, signingKeyRepository ports.SigningKeyRepository, keyEncrypter ports.KeyEncrypter) ports.TokenGenerator {
 	return &JWTTokenGenerator{
-		jwtConfig: &cfg.JWT,
+		jwtConfig:            &cfg.JWT,
+		signingKeyRepository: signingKeyRepository,
+		privateKeys:          signingkey.NewCache(keyEncrypter),
 	}
 }
 
-func (j *JWTTokenGenerator) GenerateAccessToken(ctx context.Context, userID uuid.UUID, clientID string, scopes []string) (string, error) {
-	secret := []byte(j.jwtConfig.Secret)
-
+// GenerateAccessToken issues an access token in the JWT profile of RFC 9068.
+func (j *JWTTokenGenerator) GenerateAccessToken(ctx context.Context, params domain.AccessTokenParams) (string, error) {
 	claims := jwt.MapClaims{
-		"iss":   j.jwtConfig.Issuer,
-		"sub":   userID.String(),
-		"aud":   clientID,
-		"exp":   time.Now().Add(j.jwtConfig.AccessTokenDuration).Unix(),
-		"iat":   time.Now().Unix(),
-		"scope": strings.Join(scopes, " "),
-		"jti":   uuid.New().String(),
+		"iss":       j.jwtConfig.Issuer,
+		"sub":       params.Subject,
+		"aud":       domain.AudienceClaim(params.Audience),
+		"exp":       time.Now().Add(params.ExpiresIn).Unix(),
+		"iat":       time.Now().Unix(),
+		"client_id": params.ClientID,
+		"scope":     strings.Join(params.Scopes, " "),
+		"jti":       uuid.New().String(),
+	}
+
+	if !params.AuthTime.IsZero() {
+		claims["auth_time"] = params.AuthTime.Unix()
+	}
+
+	if params.ACR != "" {
+		claims["acr"] = params.ACR
+	}
+
+	if params.Actor != nil {
+		claims["act"] = params.Actor
+	}
+
+	if len(params.AuthorizationDetails) > 0 {
+		claims["authorization_details"] = params.AuthorizationDetails
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(secret)
+	if params.DPoPJKT != "" || params.CertificateThumbprint != "" {
+		claims["cnf"] = domain.Confirmation{JKT: params.DPoPJKT, CertificateThumbprint: params.CertificateThumbprint}
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["typ"] = accessTokenType
+	return j.sign(ctx, token)
 }
 
 func (j *JWTTokenGenerator) GenerateRefreshToken(ctx context.Context) (string, error) {
+	return randomToken()
+}
+
+// GenerateOpaqueToken issues a reference access token.
+func (j *JWTTokenGenerator) GenerateOpaqueToken(ctx context.Context) (string, error) {
+	return randomToken()
+}
+
+func randomToken() (string, error) {
 	bytes := make([]byte, 32)
 	if _, err := rand.Read(bytes); err != nil {
 		return "", fmt.Errorf("generate random bytes: %w", err)
@@ -65,26 +106,198 @@ func (j *JWTTokenGenerator) GenerateRefreshToken(ctx context.Context) (string, e
 	return token, nil
 }
 
-func (j *JWTTokenGenerator) GenerateIDToken(ctx context.Context, user *domain.User, clientID, nonce string, scopes []string) (string, error) {
-	secret := []byte(j.jwtConfig.Secret)
+// GenerateIDToken issues the ID token with the authentication the user went through.
+func (j *JWTTokenGenerator) GenerateIDToken(ctx context.Context, user *domain.User, params domain.IDTokenParams) (string, error) {
+	audience := params.Audience
+	if len(audience) == 0 {
+		audience = []string{params.ClientID}
+	}
+
+	claims := jwt.MapClaims{
+		"iss": j.jwtConfig.Issuer,
+		"sub": params.Subject,
+		"aud": domain.AudienceClaim(audience),
+		"azp": params.ClientID,
+		"exp": time.Now().Add(params.ExpiresIn).Unix(),
+		"iat": time.Now().Unix(),
+	}
+
+	if !params.AuthTime.IsZero() {
+		claims["auth_time"] = params.AuthTime.Unix()
+	}
+
+	if params.ACR != "" {
+		claims["acr"] = params.ACR
+	}
+
+	if len(params.AMR) > 0 {
+		claims["amr"] = params.AMR
+	}
+
+	if params.SessionID != uuid.Nil {
+		claims["sid"] = params.SessionID.String()
+	}
+
+	if params.Nonce != "" {
+		claims["nonce"] = params.Nonce
+	}
+
+	if params.AccessToken != "" {
+		claims["at_hash"] = leftHalfHash(params.AccessToken)
+	}
+
+	if params.Code != "" {
+		claims["c_hash"] = leftHalfHash(params.Code)
+	}
+
+	for name, value := range params.Claims {
+		claims[name] = value
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	return j.sign(ctx, token)
+}
+
+// ParseIDToken verifies an ID token issued by this server against its published keys and returns its claims.
+func (j *JWTTokenGenerator) ParseIDToken(ctx context.Context, idToken string) (*domain.IDTokenClaims, error) {
+	keySet, err := j.GetJSONWebKeySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (any, error) {
+		keyID, _ := token.Header["kid"].(string)
+		key, err := findVerificationKey(keySet, keyID, token.Method)
+		if err != nil {
+			return nil, err
+		}
+		return publicKey(key)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(j.jwtConfig.Issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("parse ID token: %w", err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil {
+		return nil, fmt.Errorf("get ID token subject: %w", err)
+	}
+
+	// The client an ID token was issued to is its authorized party, or its only audience in ID tokens without one.
+	clientID, _ := claims["azp"].(string)
+	if clientID == "" {
+		audience, err := claims.GetAudience()
+		if err != nil || len(audience) != 1 {
+			return nil, fmt.Errorf("get ID token audience: %w", jwt.ErrTokenInvalidAudience)
+		}
+		clientID = audience[0]
+	}
+
+	expiresAt, err := claims.GetExpirationTime()
+	if err != nil {
+		return nil, fmt.Errorf("get ID token expiration: %w", err)
+	}
+
+	idTokenClaims := &domain.IDTokenClaims{
+		Subject:   subject,
+		ClientID:  clientID,
+		ExpiresAt: expiresAt.Time,
+	}
+
+	if authTime, ok := claims["auth_time"].(float64); ok {
+		idTokenClaims.AuthTime = time.Unix(int64(authTime), 0).UTC()
+	}
+
+	if acr, ok := claims["acr"].(string); ok {
+		idTokenClaims.ACR = acr
+	}
+
+	return idTokenClaims, nil
+}
 
+// GenerateAuthorizationResponse signs the authorization response parameters as a JARM response.
+func (j *JWTTokenGenerator) GenerateAuthorizationResponse(ctx context.Context, clientID string, params map[string]string) (string, error) {
 	claims := jwt.MapClaims{
 		"iss": j.jwtConfig.Issuer,
-		"sub": user.ID.String(),
 		"aud": clientID,
-		"exp": time.Now().Add(j.jwtConfig.IDTokenDuration).Unix(),
+		"exp": time.Now().Add(authorizationResponseDuration).Unix(),
 		"iat": time.Now().Unix(),
 	}
 
-	if nonce != "" {
-		claims["nonce"] = nonce
+	for name, value := range params {
+		claims[name] = value
 	}
 
-	if slices.Contains(scopes, "email") {
-		claims["email"] = user.Email
-		claims["email_verified"] = user.EmailVerified
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	return j.sign(ctx, token)
+}
+
+// GenerateUserInfoResponse signs the userinfo claims for clients that receive them as a JWT.
+func (j *JWTTokenGenerator) GenerateUserInfoResponse(ctx context.Context, clientID string, claims map[string]any) (string, error) {
+	signedClaims := jwt.MapClaims{
+		"iss": j.jwtConfig.Issuer,
+		"aud": clientID,
+		"iat": time.Now().Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(secret)
+	for name, value := range claims {
+		signedClaims[name] = value
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, signedClaims)
+	return j.sign(ctx, token)
+}
+
+// GetJSONWebKeySet publishes the next, current and retiring keys.
+func (j *JWTTokenGenerator) GetJSONWebKeySet(ctx context.Context) (*domain.JSONWebKeySet, error) {
+	keys, err := j.signingKeyRepository.ListByStates(ctx, domain.SigningAlgorithmRS256, domain.PublishedSigningKeyStates)
+	if err != nil {
+		return nil, fmt.Errorf("list published signing keys: %w", err)
+	}
+
+	return domain.SigningKeySet(keys), nil
+}
+
+// sign signs the token with the current key, naming it in the kid header.
+func (j *JWTTokenGenerator) sign(ctx context.Context, token *jwt.Token) (string, error) {
+	signingKey, err := j.signingKeyRepository.GetCurrent(ctx, domain.SigningAlgorithmRS256)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return "", domain.ErrSigningKeyNotFound
+		}
+
+		return "", fmt.Errorf("get current signing key: %w", err)
+	}
+
+	privateKey, err := j.privateKey(ctx, signingKey)
+	if err != nil {
+		return "", err
+	}
+
+	token.Header["kid"] = signingKey.KeyID
+	return token.SignedString(privateKey)
+}
+
+func (j *JWTTokenGenerator) privateKey(ctx context.Context, signingKey *domain.SigningKey) (*rsa.PrivateKey, error) {
+	signer, err := j.privateKeys.PrivateKey(ctx, signingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, ok := signer.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("signing key %s is not an RSA key", signingKey.KeyID)
+	}
+
+	return privateKey, nil
+}
+
+// leftHalfHash computes the at_hash/c_hash value for RS256.
+func leftHalfHash(value string) string {
+	hash := sha256.Sum256([]byte(value))
+	return base64.RawURLEncoding.EncodeToString(hash[:len(hash)/2])
 }
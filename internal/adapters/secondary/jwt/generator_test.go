@@ -0,0 +1,135 @@
+package jwt
+
+import (
+	"context"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateIDToken(t *testing.T) {
+	cfg := &config.Config{JWT: config.JWT{Issuer: "https://auth.example.com"}}
+	privateKey, err := domain.GenerateSigningPrivateKey(domain.SigningAlgorithmRS256)
+	require.NoError(t, err)
+	der, err := domain.MarshalSigningPrivateKey(privateKey)
+	require.NoError(t, err)
+	signingKey, err := domain.NewSigningKey(privateKey, []byte("encrypted"), domain.SigningAlgorithmRS256, domain.SigningKeyStateCurrent)
+	require.NoError(t, err)
+
+	parseClaims := func(t *testing.T, idToken string) jwt.MapClaims {
+		claims := jwt.MapClaims{}
+		token, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (any, error) {
+			return privateKey.Public().(*rsa.PublicKey), nil
+		}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}))
+		require.NoError(t, err)
+		assert.Equal(t, signingKey.KeyID, token.Header["kid"])
+		return claims
+	}
+
+	t.Run("should carry the authentication of the user", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKeyRepository := mocks.NewSigningKeyRepositoryMock(t)
+		keyEncrypter := mocks.NewKeyEncrypterMock(t)
+		generator := NewJWTTokenGenerator(cfg, signingKeyRepository, keyEncrypter)
+
+		signingKeyRepository.EXPECT().GetCurrent(ctx, domain.SigningAlgorithmRS256).Return(signingKey, nil)
+		keyEncrypter.EXPECT().Decrypt(ctx, []byte("encrypted")).Return(der, nil)
+
+		sessionID := uuid.New()
+		authTime := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+		// Act
+		idToken, err := generator.GenerateIDToken(ctx, &domain.User{}, domain.IDTokenParams{
+			Subject:     "user-1",
+			ClientID:    "client-1",
+			ExpiresIn:   time.Hour,
+			AuthTime:    authTime,
+			ACR:         domain.ACRPassword,
+			AMR:         []string{domain.AMRPassword, domain.AMROTP},
+			SessionID:   sessionID,
+			AccessToken: "access-token",
+			Code:        "code",
+		})
+
+		// Assert
+		require.NoError(t, err)
+		claims := parseClaims(t, idToken)
+		assert.Equal(t, "client-1", claims["aud"])
+		assert.Equal(t, "client-1", claims["azp"])
+		assert.Equal(t, float64(authTime.Unix()), claims["auth_time"])
+		assert.Equal(t, domain.ACRPassword, claims["acr"])
+		assert.Equal(t, []any{domain.AMRPassword, domain.AMROTP}, claims["amr"])
+		assert.Equal(t, sessionID.String(), claims["sid"])
+		assert.Equal(t, leftHalfHash("access-token"), claims["at_hash"])
+		assert.Equal(t, leftHalfHash("code"), claims["c_hash"])
+	})
+
+	t.Run("should name every audience and the client as authorized party", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKeyRepository := mocks.NewSigningKeyRepositoryMock(t)
+		keyEncrypter := mocks.NewKeyEncrypterMock(t)
+		generator := NewJWTTokenGenerator(cfg, signingKeyRepository, keyEncrypter)
+
+		signingKeyRepository.EXPECT().GetCurrent(ctx, domain.SigningAlgorithmRS256).Return(signingKey, nil)
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmRS256, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{signingKey}, nil)
+		keyEncrypter.EXPECT().Decrypt(ctx, []byte("encrypted")).Return(der, nil)
+
+		params := domain.IDTokenParams{
+			Subject:   "user-1",
+			ClientID:  "client-1",
+			Audience:  []string{"client-1", "https://api.example.com"},
+			ExpiresIn: time.Hour,
+		}
+
+		// Act
+		idToken, err := generator.GenerateIDToken(ctx, &domain.User{}, params)
+
+		// Assert
+		require.NoError(t, err)
+		claims := parseClaims(t, idToken)
+		assert.Equal(t, []any{"client-1", "https://api.example.com"}, claims["aud"])
+		assert.Equal(t, "client-1", claims["azp"])
+
+		parsed, err := generator.ParseIDToken(ctx, idToken)
+		require.NoError(t, err)
+		assert.Equal(t, "client-1", parsed.ClientID)
+	})
+
+	t.Run("should reject an ID token signed with a key that isn't published", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		otherKey, err := domain.GenerateSigningPrivateKey(domain.SigningAlgorithmRS256)
+		require.NoError(t, err)
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss": cfg.JWT.Issuer,
+			"sub": "user-1",
+			"aud": "client-1",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		token.Header["kid"] = signingKey.KeyID
+		idToken, err := token.SignedString(otherKey)
+		require.NoError(t, err)
+
+		signingKeyRepository := mocks.NewSigningKeyRepositoryMock(t)
+		generator := NewJWTTokenGenerator(cfg, signingKeyRepository, nil)
+
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmRS256, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{signingKey}, nil)
+
+		// Act
+		claims, err := generator.ParseIDToken(ctx, idToken)
+
+		// Assert
+		assert.Nil(t, claims)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,73 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+// LocalMailer stands in for an SMTP server in development and tests.
+type LocalMailer struct {
+	dir    string
+	from   string
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+func NewLocalMailer(config *config.Config, logger *slog.Logger) ports.Mailer {
+	return &LocalMailer{
+		dir:    config.Mail.Dir,
+		from:   config.MailFrom(),
+		logger: logger.With("mailer", "local"),
+		now:    time.Now,
+	}
+}
+
+func (m *LocalMailer) Send(ctx context.Context, message *domain.EmailMessage) error {
+	now := m.now()
+
+	data, err := buildMessage(m.from, message, now)
+	if err != nil {
+		return fmt.Errorf("build message: %w", err)
+	}
+
+	var path string
+	if m.dir != "" {
+		path, err = m.write(data, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.logger.InfoContext(ctx, "email sent",
+		"to", message.To,
+		"subject", message.Subject,
+		"body", message.Body,
+		"file", path,
+	)
+	return nil
+}
+
+func (m *LocalMailer) write(data []byte, now time.Time) (string, error) {
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return "", fmt.Errorf("create mail directory: %w", err)
+	}
+
+	file, err := os.CreateTemp(m.dir, now.UTC().Format("20060102T150405")+"-*.eml")
+	if err != nil {
+		return "", fmt.Errorf("create mail file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		return "", fmt.Errorf("write mail file: %w", err)
+	}
+
+	return file.Name(), nil
+}
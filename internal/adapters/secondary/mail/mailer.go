@@ -0,0 +1,17 @@
+package mail
+
+import (
+	"log/slog"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+// NewMailer picks the SMTP mailer when an SMTP server is configured and the local one otherwise.
+func NewMailer(config *config.Config, logger *slog.Logger) ports.Mailer {
+	if config.Mail.SMTP.Host != "" {
+		return NewSMTPMailer(config)
+	}
+
+	return NewLocalMailer(config, logger)
+}
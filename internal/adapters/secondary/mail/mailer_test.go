@@ -0,0 +1,167 @@
+package mail
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMessage() *domain.EmailMessage {
+	return &domain.EmailMessage{
+		To:      "ana@example.com",
+		Subject: "Verificação de email",
+		Body:    "Open https://app.example.com/verify-email?token=abc.def\n",
+	}
+}
+
+// smtpTranscript is what a fake SMTP server was told.
+type smtpTranscript struct {
+	from string
+	to   string
+	data string
+}
+
+// startSMTPServer accepts a single SMTP session without TLS or AUTH and
+// reports it once the client quits.
+func startSMTPServer(t *testing.T) (string, <-chan smtpTranscript) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	transcripts := make(chan smtpTranscript, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		text := textproto.NewConn(conn)
+		var transcript smtpTranscript
+
+		text.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := text.ReadLine()
+			if err != nil {
+				return
+			}
+
+			switch command := strings.ToUpper(line); {
+			case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+				text.PrintfLine("250 localhost")
+			case strings.HasPrefix(command, "MAIL FROM:"):
+				transcript.from = line[len("MAIL FROM:"):]
+				text.PrintfLine("250 OK")
+			case strings.HasPrefix(command, "RCPT TO:"):
+				transcript.to = line[len("RCPT TO:"):]
+				text.PrintfLine("250 OK")
+			case command == "DATA":
+				text.PrintfLine("354 Go ahead")
+				lines, err := text.ReadDotLines()
+				if err != nil {
+					return
+				}
+				transcript.data = strings.Join(lines, "\n")
+				text.PrintfLine("250 OK")
+			case command == "QUIT":
+				text.PrintfLine("221 Bye")
+				transcripts <- transcript
+				return
+			default:
+				text.PrintfLine("502 Command not implemented")
+			}
+		}
+	}()
+
+	return listener.Addr().String(), transcripts
+}
+
+func TestSMTPMailer(t *testing.T) {
+	t.Run("should deliver the message to the SMTP server", func(t *testing.T) {
+		// Arrange
+		addr, transcripts := startSMTPServer(t)
+		host, port, err := net.SplitHostPort(addr)
+		require.NoError(t, err)
+		portNumber, err := strconv.Atoi(port)
+		require.NoError(t, err)
+
+		mailer := NewSMTPMailer(&config.Config{
+			Mail: config.Mail{
+				From: "Accounts <accounts@example.com>",
+				SMTP: config.SMTP{Host: host, Port: portNumber},
+			},
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// Act
+		err = mailer.Send(ctx, newTestMessage())
+
+		// Assert
+		require.NoError(t, err)
+		transcript := <-transcripts
+		assert.Equal(t, "<accounts@example.com>", transcript.from)
+		assert.Equal(t, "<ana@example.com>", transcript.to)
+		assert.Contains(t, transcript.data, "To: ana@example.com")
+		assert.Contains(t, transcript.data, "Subject: =?utf-8?q?Verifica=C3=A7=C3=A3o_de_email?=")
+		assert.Contains(t, transcript.data, "token=3Dabc.def")
+	})
+}
+
+func TestLocalMailer(t *testing.T) {
+	t.Run("should write the message to the mail directory", func(t *testing.T) {
+		// Arrange
+		dir := filepath.Join(t.TempDir(), "mail")
+		mailer := NewLocalMailer(&config.Config{
+			Mail: config.Mail{Dir: dir},
+			URL:  config.URL{AppBaseURL: "https://app.example.com"},
+		}, slog.New(slog.DiscardHandler))
+
+		// Act
+		err := mailer.Send(context.Background(), newTestMessage())
+
+		// Assert
+		require.NoError(t, err)
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+
+		data, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "From: no-reply@app.example.com\r\n")
+		assert.Contains(t, string(data), "To: ana@example.com\r\n")
+		assert.Contains(t, string(data), "https://app.example.com/verify-email?token=3Dabc.def")
+	})
+
+	t.Run("should reject a header with a line break", func(t *testing.T) {
+		// Arrange
+		dir := t.TempDir()
+		mailer := NewLocalMailer(&config.Config{Mail: config.Mail{Dir: dir}}, slog.New(slog.DiscardHandler))
+
+		message := newTestMessage()
+		message.Subject = "Hello\r\nBcc: eve@example.com"
+
+		// Act
+		err := mailer.Send(context.Background(), message)
+
+		// Assert
+		assert.ErrorIs(t, err, errHeaderLineBreak)
+		files, _ := os.ReadDir(dir)
+		assert.Empty(t, files)
+	})
+}
@@ -0,0 +1,45 @@
+package mail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"strings"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+var errHeaderLineBreak = errors.New("header value contains a line break")
+
+// buildMessage renders the message as an RFC 5322 email with a quoted printable UTF-8 body.
+func buildMessage(from string, message *domain.EmailMessage, now time.Time) ([]byte, error) {
+	for _, value := range []string{from, message.To, message.Subject} {
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, errHeaderLineBreak
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", message.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	b.WriteString("\r\n")
+
+	body := quotedprintable.NewWriter(&b)
+	if _, err := body.Write([]byte(message.Body)); err != nil {
+		return nil, fmt.Errorf("encode body: %w", err)
+	}
+
+	if err := body.Close(); err != nil {
+		return nil, fmt.Errorf("encode body: %w", err)
+	}
+
+	return b.Bytes(), nil
+}
@@ -0,0 +1,121 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+const defaultSMTPPort = 587
+
+// SMTPMailer sends emails through an SMTP server, upgrading the connection with STARTTLS when the server offers it.
+type SMTPMailer struct {
+	host string
+	addr string
+	from string
+	auth smtp.Auth
+	now  func() time.Time
+}
+
+func NewSMTPMailer(config *config.Config) ports.Mailer {
+	smtpConfig := config.Mail.SMTP
+
+	port := smtpConfig.Port
+	if port == 0 {
+		port = defaultSMTPPort
+	}
+
+	var auth smtp.Auth
+	if smtpConfig.Username != "" {
+		auth = smtp.PlainAuth("", smtpConfig.Username, smtpConfig.Password, smtpConfig.Host)
+	}
+
+	return &SMTPMailer{
+		host: smtpConfig.Host,
+		addr: net.JoinHostPort(smtpConfig.Host, strconv.Itoa(port)),
+		from: config.MailFrom(),
+		auth: auth,
+		now:  time.Now,
+	}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, message *domain.EmailMessage) error {
+	sender, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return fmt.Errorf("parse sender address: %w", err)
+	}
+
+	recipient, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return fmt.Errorf("parse recipient address: %w", err)
+	}
+
+	data, err := buildMessage(sender.String(), message, m.now())
+	if err != nil {
+		return fmt.Errorf("build message: %w", err)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return fmt.Errorf("connect to SMTP server: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return fmt.Errorf("set SMTP deadline: %w", err)
+		}
+	}
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return fmt.Errorf("start TLS: %w", err)
+		}
+	}
+
+	if m.auth != nil {
+		if err := client.Auth(m.auth); err != nil {
+			return fmt.Errorf("authenticate: %w", err)
+		}
+	}
+
+	if err := client.Mail(sender.Address); err != nil {
+		return fmt.Errorf("set sender: %w", err)
+	}
+
+	if err := client.Rcpt(recipient.Address); err != nil {
+		return fmt.Errorf("set recipient: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("start message data: %w", err)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("write message: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("send message: %w", err)
+	}
+
+	return client.Quit()
+}
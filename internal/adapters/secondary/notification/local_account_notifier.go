@@ -0,0 +1,28 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// LocalAccountNotifier stands in for email delivery of account notices.
+type LocalAccountNotifier struct {
+	logger *slog.Logger
+}
+
+func NewLocalAccountNotifier(logger *slog.Logger) ports.AccountNotifier {
+	return &LocalAccountNotifier{
+		logger: logger.With("notifier", "local"),
+	}
+}
+
+func (n *LocalAccountNotifier) NotifyRecoveryCodesLow(ctx context.Context, userID uuid.UUID, remaining int) error {
+	n.logger.InfoContext(ctx, "recovery codes running low",
+		"user_id", userID,
+		"remaining", remaining,
+	)
+	return nil
+}
@@ -0,0 +1,30 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+// LocalNotifier stands in for a push or SMS provider.
+type LocalNotifier struct {
+	logger *slog.Logger
+}
+
+func NewLocalNotifier(logger *slog.Logger) ports.AuthenticationNotifier {
+	return &LocalNotifier{
+		logger: logger.With("notifier", "local"),
+	}
+}
+
+func (n *LocalNotifier) NotifyAuthenticationRequest(ctx context.Context, request *domain.BackchannelAuthenticationRequest) error {
+	n.logger.InfoContext(ctx, "backchannel authentication requested",
+		"auth_req_id", request.AuthReqID,
+		"user_id", request.UserID,
+		"client_id", request.ClientID,
+		"binding_message", request.BindingMessage,
+	)
+	return nil
+}
@@ -0,0 +1,155 @@
+package paseto
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/signingkey"
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+const v4PublicHeader = "v4.public."
+
+type PASETOTokenGenerator struct {
+	// TokenGenerator issues everything other than access tokens, which stay JWTs whatever the access token format.
+	ports.TokenGenerator
+	jwtConfig            *config.JWT
+	signingKeyRepository ports.SigningKeyRepository
+	privateKeys          *signingkey.Cache
+}
+
+func NewPASETOTokenGenerator(
+	cfg *config.Config,
+	tokenGenerator ports.TokenGenerator,
+	signingKeyRepository ports.SigningKeyRepository,
+	keyEncrypter ports.KeyEncrypter,
+) ports.PASETOTokenGenerator {
+	return &PASETOTokenGenerator{
+		TokenGenerator:       tokenGenerator,
+		jwtConfig:            &cfg.JWT,
+		signingKeyRepository: signingKeyRepository,
+		privateKeys:          signingkey.NewCache(keyEncrypter),
+	}
+}
+
+// GenerateAccessToken issues a PASETO v4.public access token carrying the claims of the JWT access tokens.
+func (p *PASETOTokenGenerator) GenerateAccessToken(ctx context.Context, params domain.AccessTokenParams) (string, error) {
+	now := time.Now().UTC()
+
+	claims := map[string]any{
+		"iss":       p.jwtConfig.Issuer,
+		"sub":       params.Subject,
+		"aud":       domain.AudienceClaim(params.Audience),
+		"exp":       now.Add(params.ExpiresIn).Format(time.RFC3339),
+		"iat":       now.Format(time.RFC3339),
+		"client_id": params.ClientID,
+		"scope":     strings.Join(params.Scopes, " "),
+		"jti":       uuid.New().String(),
+	}
+
+	if !params.AuthTime.IsZero() {
+		claims["auth_time"] = params.AuthTime.Unix()
+	}
+
+	if params.ACR != "" {
+		claims["acr"] = params.ACR
+	}
+
+	if params.Actor != nil {
+		claims["act"] = params.Actor
+	}
+
+	if len(params.AuthorizationDetails) > 0 {
+		claims["authorization_details"] = params.AuthorizationDetails
+	}
+
+	if params.DPoPJKT != "" || params.CertificateThumbprint != "" {
+		claims["cnf"] = domain.Confirmation{JKT: params.DPoPJKT, CertificateThumbprint: params.CertificateThumbprint}
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("marshal access token claims: %w", err)
+	}
+
+	signingKey, err := p.signingKeyRepository.GetCurrent(ctx, domain.SigningAlgorithmPASETOV4Public)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return "", domain.ErrSigningKeyNotFound
+		}
+
+		return "", fmt.Errorf("get current signing key: %w", err)
+	}
+
+	privateKey, err := p.privateKey(ctx, signingKey)
+	if err != nil {
+		return "", err
+	}
+
+	footer, err := json.Marshal(map[string]string{"kid": signingKey.KeyID})
+	if err != nil {
+		return "", fmt.Errorf("marshal access token footer: %w", err)
+	}
+
+	return signV4Public(privateKey, payload, footer), nil
+}
+
+// GetPASETOKeySet publishes the next, current and retiring PASETO keys, as the JWKS does for the JWT keys.
+func (p *PASETOTokenGenerator) GetPASETOKeySet(ctx context.Context) (*domain.PASETOKeySet, error) {
+	keys, err := p.signingKeyRepository.ListByStates(ctx, domain.SigningAlgorithmPASETOV4Public, domain.PublishedSigningKeyStates)
+	if err != nil {
+		return nil, fmt.Errorf("list published signing keys: %w", err)
+	}
+
+	return domain.PASETOSigningKeySet(keys), nil
+}
+
+func (p *PASETOTokenGenerator) privateKey(ctx context.Context, signingKey *domain.SigningKey) (ed25519.PrivateKey, error) {
+	signer, err := p.privateKeys.PrivateKey(ctx, signingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, ok := signer.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("signing key %s is not an Ed25519 key", signingKey.KeyID)
+	}
+
+	return privateKey, nil
+}
+
+// signV4Public signs the payload as a v4.public token.
+func signV4Public(privateKey ed25519.PrivateKey, payload, footer []byte) string {
+	signature := ed25519.Sign(privateKey, preAuthEncode([]byte(v4PublicHeader), payload, footer, nil))
+
+	token := v4PublicHeader + base64.RawURLEncoding.EncodeToString(append(payload, signature...))
+	if len(footer) > 0 {
+		token += "." + base64.RawURLEncoding.EncodeToString(footer)
+	}
+
+	return token
+}
+
+// preAuthEncode is PASETO's PAE.
+func preAuthEncode(pieces ...[]byte) []byte {
+	encoded := le64(nil, len(pieces))
+	for _, piece := range pieces {
+		encoded = le64(encoded, len(piece))
+		encoded = append(encoded, piece...)
+	}
+	return encoded
+}
+
+func le64(b []byte, n int) []byte {
+	return binary.LittleEndian.AppendUint64(b, uint64(n)&^(1<<63))
+}
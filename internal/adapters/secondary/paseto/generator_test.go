@@ -0,0 +1,136 @@
+package paseto
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignV4Public(t *testing.T) {
+	t.Run("should match the PASETO v4.public test vector", func(t *testing.T) {
+		// Arrange
+		// Test vector 4-S-1 of the PASETO specification.
+		seed, err := hex.DecodeString("b4cbfb43df4ce210727d953e4a713307fa19bb7d9f85041438d9e11b942a3774")
+		require.NoError(t, err)
+		payload := []byte(`{"data":"this is a signed message","exp":"2022-01-01T00:00:00+00:00"}`)
+
+		// Act
+		token := signV4Public(ed25519.NewKeyFromSeed(seed), payload, nil)
+
+		// Assert
+		assert.Equal(t, "v4.public.eyJkYXRhIjoidGhpcyBpcyBhIHNpZ25lZCBtZXNzYWdlIiwiZXhwIjoiMjAyMi0wMS0wMVQwMDowMDowMCswMDowMCJ9bg_XBBzds8lTZShVlwwKSgeKpLT3yukTw6JUz3W4h_ExsQV-P0V54zemZDcAxFaSeef1QlXEFtkqxT1ciiQEDA", token)
+	})
+}
+
+func TestGenerateAccessToken(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := domain.MarshalSigningPrivateKey(privateKey)
+	require.NoError(t, err)
+	signingKey, err := domain.NewSigningKey(privateKey, []byte("encrypted"), domain.SigningAlgorithmPASETOV4Public, domain.SigningKeyStateCurrent)
+	require.NoError(t, err)
+
+	t.Run("should sign the access token claims with the current PASETO key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKeyRepository := mocks.NewSigningKeyRepositoryMock(t)
+		keyEncrypter := mocks.NewKeyEncrypterMock(t)
+		generator := NewPASETOTokenGenerator(&config.Config{JWT: config.JWT{Issuer: "https://auth.example.com"}}, nil, signingKeyRepository, keyEncrypter)
+
+		signingKeyRepository.EXPECT().GetCurrent(ctx, domain.SigningAlgorithmPASETOV4Public).Return(signingKey, nil)
+		keyEncrypter.EXPECT().Decrypt(ctx, []byte("encrypted")).Return(der, nil)
+
+		// Act
+		token, err := generator.GenerateAccessToken(ctx, domain.AccessTokenParams{
+			Subject:   "user-1",
+			ClientID:  "client-1",
+			Scopes:    []string{"openid", "profile"},
+			Audience:  []string{"https://api.example.com"},
+			DPoPJKT:   "jkt",
+			ExpiresIn: time.Hour,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		parts := strings.Split(token, ".")
+		require.Len(t, parts, 4)
+		assert.Equal(t, []string{"v4", "public"}, parts[:2])
+
+		body, err := base64.RawURLEncoding.DecodeString(parts[2])
+		require.NoError(t, err)
+		footer, err := base64.RawURLEncoding.DecodeString(parts[3])
+		require.NoError(t, err)
+		payload, signature := body[:len(body)-ed25519.SignatureSize], body[len(body)-ed25519.SignatureSize:]
+		assert.True(t, ed25519.Verify(publicKey, preAuthEncode([]byte(v4PublicHeader), payload, footer, nil), signature))
+		assert.JSONEq(t, `{"kid":"`+signingKey.KeyID+`"}`, string(footer))
+
+		var claims map[string]any
+		require.NoError(t, json.Unmarshal(payload, &claims))
+		assert.Equal(t, "https://auth.example.com", claims["iss"])
+		assert.Equal(t, "user-1", claims["sub"])
+		assert.Equal(t, "https://api.example.com", claims["aud"])
+		assert.Equal(t, "client-1", claims["client_id"])
+		assert.Equal(t, "openid profile", claims["scope"])
+		assert.Equal(t, map[string]any{"jkt": "jkt"}, claims["cnf"])
+		expiresAt, err := time.Parse(time.RFC3339, claims["exp"].(string))
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+	})
+
+	t.Run("should return ErrSigningKeyNotFound without a current PASETO key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKeyRepository := mocks.NewSigningKeyRepositoryMock(t)
+		generator := NewPASETOTokenGenerator(&config.Config{}, nil, signingKeyRepository, nil)
+
+		signingKeyRepository.EXPECT().GetCurrent(ctx, domain.SigningAlgorithmPASETOV4Public).Return(nil, ports.ErrNotFound)
+
+		// Act
+		token, err := generator.GenerateAccessToken(ctx, domain.AccessTokenParams{Subject: "user-1"})
+
+		// Assert
+		assert.Empty(t, token)
+		assert.ErrorIs(t, err, domain.ErrSigningKeyNotFound)
+	})
+}
+
+func TestGetPASETOKeySet(t *testing.T) {
+	t.Run("should publish the keys as PASERK public keys", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		signingKey, err := domain.NewSigningKey(privateKey, nil, domain.SigningAlgorithmPASETOV4Public, domain.SigningKeyStateNext)
+		require.NoError(t, err)
+		signingKeyRepository := mocks.NewSigningKeyRepositoryMock(t)
+		generator := NewPASETOTokenGenerator(&config.Config{}, nil, signingKeyRepository, nil)
+
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmPASETOV4Public, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{signingKey}, nil)
+
+		// Act
+		keySet, err := generator.GetPASETOKeySet(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, keySet.Keys, 1)
+		assert.Equal(t, domain.PASETOKey{
+			KeyID:     signingKey.KeyID,
+			Version:   domain.PASETOVersion4,
+			Purpose:   domain.PASETOPurposePublic,
+			PublicKey: "k4.public." + base64.RawURLEncoding.EncodeToString(publicKey),
+		}, keySet.Keys[0])
+	})
+}
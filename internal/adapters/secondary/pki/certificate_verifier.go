@@ -0,0 +1,58 @@
+package pki
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+type CertificateVerifier struct {
+	roots *x509.CertPool
+}
+
+// NewCertificateVerifier trusts the CAs in the configured client CA bundle.
+func NewCertificateVerifier(cfg *config.Config) (ports.CertificateVerifier, error) {
+	if cfg.Server.TLS.ClientCAFile == "" {
+		return &CertificateVerifier{}, nil
+	}
+
+	bundle, err := os.ReadFile(cfg.Server.TLS.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("read client CA bundle: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(bundle) {
+		return nil, fmt.Errorf("client CA bundle has no PEM certificates")
+	}
+
+	return &CertificateVerifier{roots: roots}, nil
+}
+
+// VerifyClientCertificate checks the certificate chains up to a trusted CA and may be used for client authentication.
+func (v *CertificateVerifier) VerifyClientCertificate(ctx context.Context, certificate *domain.ClientCertificate) error {
+	if v.roots == nil {
+		return fmt.Errorf("%w: no client CA configured", domain.ErrInvalidClientCertificate)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, intermediate := range certificate.Intermediates {
+		intermediates.AddCert(intermediate)
+	}
+
+	_, err := certificate.Leaf.Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidClientCertificate, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package pki
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Client CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{certificate: certificate, key: key}
+}
+
+func (ca *testCA) issue(t *testing.T, subject pkix.Name, usage x509.ExtKeyUsage) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      subject,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return certificate
+}
+
+func TestVerifyClientCertificate(t *testing.T) {
+	ca := newTestCA(t)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.certificate)
+
+	verifier := &CertificateVerifier{roots: roots}
+	subject := pkix.Name{CommonName: "partner-client", Organization: []string{"Partner Bank"}}
+
+	t.Run("should accept a client certificate issued by the trusted CA", func(t *testing.T) {
+		// Arrange
+		certificate := domain.NewClientCertificate([]*x509.Certificate{ca.issue(t, subject, x509.ExtKeyUsageClientAuth)})
+
+		// Act
+		err := verifier.VerifyClientCertificate(context.Background(), certificate)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "CN=partner-client,O=Partner Bank", certificate.SubjectDN())
+	})
+
+	t.Run("should reject a certificate issued by another CA", func(t *testing.T) {
+		// Arrange
+		otherCA := newTestCA(t)
+		certificate := domain.NewClientCertificate([]*x509.Certificate{otherCA.issue(t, subject, x509.ExtKeyUsageClientAuth)})
+
+		// Act
+		err := verifier.VerifyClientCertificate(context.Background(), certificate)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidClientCertificate)
+	})
+
+	t.Run("should reject a certificate not meant for client authentication", func(t *testing.T) {
+		// Arrange
+		certificate := domain.NewClientCertificate([]*x509.Certificate{ca.issue(t, subject, x509.ExtKeyUsageServerAuth)})
+
+		// Act
+		err := verifier.VerifyClientCertificate(context.Background(), certificate)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidClientCertificate)
+	})
+
+	t.Run("should reject every certificate without a client CA", func(t *testing.T) {
+		// Arrange
+		certificate := domain.NewClientCertificate([]*x509.Certificate{ca.issue(t, subject, x509.ExtKeyUsageClientAuth)})
+
+		// Act
+		err := (&CertificateVerifier{}).VerifyClientCertificate(context.Background(), certificate)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidClientCertificate)
+	})
+}
@@ -21,22 +21,36 @@ INSERT INTO authorization_codes (
     nonce,
     code_challenge,
     code_challenge_method,
-    expires_at
+    expires_at,
+    claims,
+    session_id,
+    resources,
+    auth_time,
+    acr,
+    amr,
+    authorization_details
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
-) RETURNING code, client_id, user_id, redirect_uri, scopes, nonce, code_challenge, code_challenge_method, used, expires_at, created_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+) RETURNING code, client_id, user_id, redirect_uri, scopes, nonce, code_challenge, code_challenge_method, claims, session_id, resources, auth_time, acr, amr, authorization_details, used, expires_at, created_at
 `
 
 type CreateAuthorizationCodeParams struct {
-	Code                string           `json:"code"`
-	ClientID            string           `json:"client_id"`
-	UserID              pgtype.UUID      `json:"user_id"`
-	RedirectUri         string           `json:"redirect_uri"`
-	Scopes              []string         `json:"scopes"`
-	Nonce               pgtype.Text      `json:"nonce"`
-	CodeChallenge       pgtype.Text      `json:"code_challenge"`
-	CodeChallengeMethod pgtype.Text      `json:"code_challenge_method"`
-	ExpiresAt           pgtype.Timestamp `json:"expires_at"`
+	Code                 string           `json:"code"`
+	ClientID             string           `json:"client_id"`
+	UserID               pgtype.UUID      `json:"user_id"`
+	RedirectUri          string           `json:"redirect_uri"`
+	Scopes               []string         `json:"scopes"`
+	Nonce                pgtype.Text      `json:"nonce"`
+	CodeChallenge        pgtype.Text      `json:"code_challenge"`
+	CodeChallengeMethod  pgtype.Text      `json:"code_challenge_method"`
+	ExpiresAt            pgtype.Timestamp `json:"expires_at"`
+	Claims               []byte           `json:"claims"`
+	SessionID            pgtype.UUID      `json:"session_id"`
+	Resources            []string         `json:"resources"`
+	AuthTime             pgtype.Timestamp `json:"auth_time"`
+	Acr                  pgtype.Text      `json:"acr"`
+	Amr                  []string         `json:"amr"`
+	AuthorizationDetails []byte           `json:"authorization_details"`
 }
 
 func (q *Queries) CreateAuthorizationCode(ctx context.Context, arg CreateAuthorizationCodeParams) (AuthorizationCode, error) {
@@ -50,6 +64,13 @@ func (q *Queries) CreateAuthorizationCode(ctx context.Context, arg CreateAuthori
 		arg.CodeChallenge,
 		arg.CodeChallengeMethod,
 		arg.ExpiresAt,
+		arg.Claims,
+		arg.SessionID,
+		arg.Resources,
+		arg.AuthTime,
+		arg.Acr,
+		arg.Amr,
+		arg.AuthorizationDetails,
 	)
 	var i AuthorizationCode
 	err := row.Scan(
@@ -61,6 +82,13 @@ func (q *Queries) CreateAuthorizationCode(ctx context.Context, arg CreateAuthori
 		&i.Nonce,
 		&i.CodeChallenge,
 		&i.CodeChallengeMethod,
+		&i.Claims,
+		&i.SessionID,
+		&i.Resources,
+		&i.AuthTime,
+		&i.Acr,
+		&i.Amr,
+		&i.AuthorizationDetails,
 		&i.Used,
 		&i.ExpiresAt,
 		&i.CreatedAt,
@@ -90,7 +118,7 @@ func (q *Queries) DeleteExpiredAuthorizationCodes(ctx context.Context) error {
 
 const getAuthorizationCode = `-- name: GetAuthorizationCode :one
 SELECT 
-    ac.code, ac.client_id, ac.user_id, ac.redirect_uri, ac.scopes, ac.nonce, ac.code_challenge, ac.code_challenge_method, ac.used, ac.expires_at, ac.created_at,
+    ac.code, ac.client_id, ac.user_id, ac.redirect_uri, ac.scopes, ac.nonce, ac.code_challenge, ac.code_challenge_method, ac.claims, ac.session_id, ac.resources, ac.auth_time, ac.acr, ac.amr, ac.authorization_details, ac.used, ac.expires_at, ac.created_at,
     c.client_id as client_client_id,
     c.redirect_uris as client_redirect_uris,
     u.email as user_email
@@ -103,20 +131,27 @@ LIMIT 1
 `
 
 type GetAuthorizationCodeRow struct {
-	Code                string           `json:"code"`
-	ClientID            string           `json:"client_id"`
-	UserID              pgtype.UUID      `json:"user_id"`
-	RedirectUri         string           `json:"redirect_uri"`
-	Scopes              []string         `json:"scopes"`
-	Nonce               pgtype.Text      `json:"nonce"`
-	CodeChallenge       pgtype.Text      `json:"code_challenge"`
-	CodeChallengeMethod pgtype.Text      `json:"code_challenge_method"`
-	Used                bool             `json:"used"`
-	ExpiresAt           pgtype.Timestamp `json:"expires_at"`
-	CreatedAt           pgtype.Timestamp `json:"created_at"`
-	ClientClientID      string           `json:"client_client_id"`
-	ClientRedirectUris  []string         `json:"client_redirect_uris"`
-	UserEmail           string           `json:"user_email"`
+	Code                 string           `json:"code"`
+	ClientID             string           `json:"client_id"`
+	UserID               pgtype.UUID      `json:"user_id"`
+	RedirectUri          string           `json:"redirect_uri"`
+	Scopes               []string         `json:"scopes"`
+	Nonce                pgtype.Text      `json:"nonce"`
+	CodeChallenge        pgtype.Text      `json:"code_challenge"`
+	CodeChallengeMethod  pgtype.Text      `json:"code_challenge_method"`
+	Claims               []byte           `json:"claims"`
+	SessionID            pgtype.UUID      `json:"session_id"`
+	Resources            []string         `json:"resources"`
+	AuthTime             pgtype.Timestamp `json:"auth_time"`
+	Acr                  pgtype.Text      `json:"acr"`
+	Amr                  []string         `json:"amr"`
+	AuthorizationDetails []byte           `json:"authorization_details"`
+	Used                 bool             `json:"used"`
+	ExpiresAt            pgtype.Timestamp `json:"expires_at"`
+	CreatedAt            pgtype.Timestamp `json:"created_at"`
+	ClientClientID       string           `json:"client_client_id"`
+	ClientRedirectUris   []string         `json:"client_redirect_uris"`
+	UserEmail            string           `json:"user_email"`
 }
 
 func (q *Queries) GetAuthorizationCode(ctx context.Context, code string) (GetAuthorizationCodeRow, error) {
@@ -131,6 +166,13 @@ func (q *Queries) GetAuthorizationCode(ctx context.Context, code string) (GetAut
 		&i.Nonce,
 		&i.CodeChallenge,
 		&i.CodeChallengeMethod,
+		&i.Claims,
+		&i.SessionID,
+		&i.Resources,
+		&i.AuthTime,
+		&i.Acr,
+		&i.Amr,
+		&i.AuthorizationDetails,
 		&i.Used,
 		&i.ExpiresAt,
 		&i.CreatedAt,
@@ -20,23 +20,73 @@ INSERT INTO oauth_clients (
     redirect_uris,
     grant_types,
     response_types,
+    response_modes,
     scopes,
-    logo_url
+    logo_url,
+    subject_type,
+    sector_identifier_uri,
+    first_party,
+    access_token_lifetime,
+    refresh_token_lifetime,
+    refresh_token_idle_timeout,
+    id_token_lifetime,
+    issue_refresh_tokens,
+    access_token_format,
+    exchange_audiences,
+    token_endpoint_auth_method,
+    jwks,
+    jwks_uri,
+    dpop_bound_access_tokens,
+    tls_client_auth_subject_dn,
+    tls_client_certificate_bound_access_tokens,
+    backchannel_token_delivery_mode,
+    backchannel_client_notification_endpoint,
+    id_token_encrypted_response_alg,
+    id_token_encrypted_response_enc,
+    userinfo_encrypted_response_alg,
+    userinfo_encrypted_response_enc,
+    default_acr_values,
+    encrypted_secret_key
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
-) RETURNING id, client_id, client_secret, client_name, redirect_uris, grant_types, response_types, scopes, logo_url, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34
+) RETURNING id, client_id, client_secret, client_name, redirect_uris, grant_types, response_types, response_modes, scopes, logo_url, subject_type, sector_identifier_uri, first_party, access_token_lifetime, refresh_token_lifetime, refresh_token_idle_timeout, id_token_lifetime, issue_refresh_tokens, access_token_format, exchange_audiences, token_endpoint_auth_method, jwks, jwks_uri, dpop_bound_access_tokens, tls_client_auth_subject_dn, tls_client_certificate_bound_access_tokens, backchannel_token_delivery_mode, backchannel_client_notification_endpoint, id_token_encrypted_response_alg, id_token_encrypted_response_enc, userinfo_encrypted_response_alg, userinfo_encrypted_response_enc, default_acr_values, encrypted_secret_key, created_at, updated_at
 `
 
 type CreateClientParams struct {
-	ID            pgtype.UUID `json:"id"`
-	ClientID      string      `json:"client_id"`
-	ClientSecret  string      `json:"client_secret"`
-	ClientName    string      `json:"client_name"`
-	RedirectUris  []string    `json:"redirect_uris"`
-	GrantTypes    []string    `json:"grant_types"`
-	ResponseTypes []string    `json:"response_types"`
-	Scopes        []string    `json:"scopes"`
-	LogoUrl       string      `json:"logo_url"`
+	ID                                    pgtype.UUID `json:"id"`
+	ClientID                              string      `json:"client_id"`
+	ClientSecret                          string      `json:"client_secret"`
+	ClientName                            string      `json:"client_name"`
+	RedirectUris                          []string    `json:"redirect_uris"`
+	GrantTypes                            []string    `json:"grant_types"`
+	ResponseTypes                         []string    `json:"response_types"`
+	ResponseModes                         []string    `json:"response_modes"`
+	Scopes                                []string    `json:"scopes"`
+	LogoUrl                               string      `json:"logo_url"`
+	SubjectType                           string      `json:"subject_type"`
+	SectorIdentifierUri                   string      `json:"sector_identifier_uri"`
+	FirstParty                            bool        `json:"first_party"`
+	AccessTokenLifetime                   int32       `json:"access_token_lifetime"`
+	RefreshTokenLifetime                  int32       `json:"refresh_token_lifetime"`
+	RefreshTokenIdleTimeout               int32       `json:"refresh_token_idle_timeout"`
+	IDTokenLifetime                       int32       `json:"id_token_lifetime"`
+	IssueRefreshTokens                    bool        `json:"issue_refresh_tokens"`
+	AccessTokenFormat                     string      `json:"access_token_format"`
+	ExchangeAudiences                     []string    `json:"exchange_audiences"`
+	TokenEndpointAuthMethod               string      `json:"token_endpoint_auth_method"`
+	Jwks                                  []byte      `json:"jwks"`
+	JwksUri                               string      `json:"jwks_uri"`
+	DpopBoundAccessTokens                 bool        `json:"dpop_bound_access_tokens"`
+	TlsClientAuthSubjectDn                string      `json:"tls_client_auth_subject_dn"`
+	TlsClientCertificateBoundAccessTokens bool        `json:"tls_client_certificate_bound_access_tokens"`
+	BackchannelTokenDeliveryMode          string      `json:"backchannel_token_delivery_mode"`
+	BackchannelClientNotificationEndpoint string      `json:"backchannel_client_notification_endpoint"`
+	IDTokenEncryptedResponseAlg           string      `json:"id_token_encrypted_response_alg"`
+	IDTokenEncryptedResponseEnc           string      `json:"id_token_encrypted_response_enc"`
+	UserinfoEncryptedResponseAlg          string      `json:"userinfo_encrypted_response_alg"`
+	UserinfoEncryptedResponseEnc          string      `json:"userinfo_encrypted_response_enc"`
+	DefaultAcrValues                      []string    `json:"default_acr_values"`
+	EncryptedSecretKey                    []byte      `json:"encrypted_secret_key"`
 }
 
 func (q *Queries) CreateClient(ctx context.Context, arg CreateClientParams) (OauthClient, error) {
@@ -48,8 +98,33 @@ func (q *Queries) CreateClient(ctx context.Context, arg CreateClientParams) (Oau
 		arg.RedirectUris,
 		arg.GrantTypes,
 		arg.ResponseTypes,
+		arg.ResponseModes,
 		arg.Scopes,
 		arg.LogoUrl,
+		arg.SubjectType,
+		arg.SectorIdentifierUri,
+		arg.FirstParty,
+		arg.AccessTokenLifetime,
+		arg.RefreshTokenLifetime,
+		arg.RefreshTokenIdleTimeout,
+		arg.IDTokenLifetime,
+		arg.IssueRefreshTokens,
+		arg.AccessTokenFormat,
+		arg.ExchangeAudiences,
+		arg.TokenEndpointAuthMethod,
+		arg.Jwks,
+		arg.JwksUri,
+		arg.DpopBoundAccessTokens,
+		arg.TlsClientAuthSubjectDn,
+		arg.TlsClientCertificateBoundAccessTokens,
+		arg.BackchannelTokenDeliveryMode,
+		arg.BackchannelClientNotificationEndpoint,
+		arg.IDTokenEncryptedResponseAlg,
+		arg.IDTokenEncryptedResponseEnc,
+		arg.UserinfoEncryptedResponseAlg,
+		arg.UserinfoEncryptedResponseEnc,
+		arg.DefaultAcrValues,
+		arg.EncryptedSecretKey,
 	)
 	var i OauthClient
 	err := row.Scan(
@@ -60,8 +135,33 @@ func (q *Queries) CreateClient(ctx context.Context, arg CreateClientParams) (Oau
 		&i.RedirectUris,
 		&i.GrantTypes,
 		&i.ResponseTypes,
+		&i.ResponseModes,
 		&i.Scopes,
 		&i.LogoUrl,
+		&i.SubjectType,
+		&i.SectorIdentifierUri,
+		&i.FirstParty,
+		&i.AccessTokenLifetime,
+		&i.RefreshTokenLifetime,
+		&i.RefreshTokenIdleTimeout,
+		&i.IDTokenLifetime,
+		&i.IssueRefreshTokens,
+		&i.AccessTokenFormat,
+		&i.ExchangeAudiences,
+		&i.TokenEndpointAuthMethod,
+		&i.Jwks,
+		&i.JwksUri,
+		&i.DpopBoundAccessTokens,
+		&i.TlsClientAuthSubjectDn,
+		&i.TlsClientCertificateBoundAccessTokens,
+		&i.BackchannelTokenDeliveryMode,
+		&i.BackchannelClientNotificationEndpoint,
+		&i.IDTokenEncryptedResponseAlg,
+		&i.IDTokenEncryptedResponseEnc,
+		&i.UserinfoEncryptedResponseAlg,
+		&i.UserinfoEncryptedResponseEnc,
+		&i.DefaultAcrValues,
+		&i.EncryptedSecretKey,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -79,7 +179,7 @@ func (q *Queries) DeleteClient(ctx context.Context, id pgtype.UUID) error {
 }
 
 const getClientByClientID = `-- name: GetClientByClientID :one
-SELECT id, client_id, client_secret, client_name, redirect_uris, grant_types, response_types, scopes, logo_url, created_at, updated_at FROM oauth_clients
+SELECT id, client_id, client_secret, client_name, redirect_uris, grant_types, response_types, response_modes, scopes, logo_url, subject_type, sector_identifier_uri, first_party, access_token_lifetime, refresh_token_lifetime, refresh_token_idle_timeout, id_token_lifetime, issue_refresh_tokens, access_token_format, exchange_audiences, token_endpoint_auth_method, jwks, jwks_uri, dpop_bound_access_tokens, tls_client_auth_subject_dn, tls_client_certificate_bound_access_tokens, backchannel_token_delivery_mode, backchannel_client_notification_endpoint, id_token_encrypted_response_alg, id_token_encrypted_response_enc, userinfo_encrypted_response_alg, userinfo_encrypted_response_enc, default_acr_values, encrypted_secret_key, created_at, updated_at FROM oauth_clients
 WHERE client_id = $1 LIMIT 1
 `
 
@@ -94,8 +194,33 @@ func (q *Queries) GetClientByClientID(ctx context.Context, clientID string) (Oau
 		&i.RedirectUris,
 		&i.GrantTypes,
 		&i.ResponseTypes,
+		&i.ResponseModes,
 		&i.Scopes,
 		&i.LogoUrl,
+		&i.SubjectType,
+		&i.SectorIdentifierUri,
+		&i.FirstParty,
+		&i.AccessTokenLifetime,
+		&i.RefreshTokenLifetime,
+		&i.RefreshTokenIdleTimeout,
+		&i.IDTokenLifetime,
+		&i.IssueRefreshTokens,
+		&i.AccessTokenFormat,
+		&i.ExchangeAudiences,
+		&i.TokenEndpointAuthMethod,
+		&i.Jwks,
+		&i.JwksUri,
+		&i.DpopBoundAccessTokens,
+		&i.TlsClientAuthSubjectDn,
+		&i.TlsClientCertificateBoundAccessTokens,
+		&i.BackchannelTokenDeliveryMode,
+		&i.BackchannelClientNotificationEndpoint,
+		&i.IDTokenEncryptedResponseAlg,
+		&i.IDTokenEncryptedResponseEnc,
+		&i.UserinfoEncryptedResponseAlg,
+		&i.UserinfoEncryptedResponseEnc,
+		&i.DefaultAcrValues,
+		&i.EncryptedSecretKey,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -103,7 +228,7 @@ func (q *Queries) GetClientByClientID(ctx context.Context, clientID string) (Oau
 }
 
 const getClientByID = `-- name: GetClientByID :one
-SELECT id, client_id, client_secret, client_name, redirect_uris, grant_types, response_types, scopes, logo_url, created_at, updated_at FROM oauth_clients
+SELECT id, client_id, client_secret, client_name, redirect_uris, grant_types, response_types, response_modes, scopes, logo_url, subject_type, sector_identifier_uri, first_party, access_token_lifetime, refresh_token_lifetime, refresh_token_idle_timeout, id_token_lifetime, issue_refresh_tokens, access_token_format, exchange_audiences, token_endpoint_auth_method, jwks, jwks_uri, dpop_bound_access_tokens, tls_client_auth_subject_dn, tls_client_certificate_bound_access_tokens, backchannel_token_delivery_mode, backchannel_client_notification_endpoint, id_token_encrypted_response_alg, id_token_encrypted_response_enc, userinfo_encrypted_response_alg, userinfo_encrypted_response_enc, default_acr_values, encrypted_secret_key, created_at, updated_at FROM oauth_clients
 WHERE id = $1 LIMIT 1
 `
 
@@ -118,8 +243,33 @@ func (q *Queries) GetClientByID(ctx context.Context, id pgtype.UUID) (OauthClien
 		&i.RedirectUris,
 		&i.GrantTypes,
 		&i.ResponseTypes,
+		&i.ResponseModes,
 		&i.Scopes,
 		&i.LogoUrl,
+		&i.SubjectType,
+		&i.SectorIdentifierUri,
+		&i.FirstParty,
+		&i.AccessTokenLifetime,
+		&i.RefreshTokenLifetime,
+		&i.RefreshTokenIdleTimeout,
+		&i.IDTokenLifetime,
+		&i.IssueRefreshTokens,
+		&i.AccessTokenFormat,
+		&i.ExchangeAudiences,
+		&i.TokenEndpointAuthMethod,
+		&i.Jwks,
+		&i.JwksUri,
+		&i.DpopBoundAccessTokens,
+		&i.TlsClientAuthSubjectDn,
+		&i.TlsClientCertificateBoundAccessTokens,
+		&i.BackchannelTokenDeliveryMode,
+		&i.BackchannelClientNotificationEndpoint,
+		&i.IDTokenEncryptedResponseAlg,
+		&i.IDTokenEncryptedResponseEnc,
+		&i.UserinfoEncryptedResponseAlg,
+		&i.UserinfoEncryptedResponseEnc,
+		&i.DefaultAcrValues,
+		&i.EncryptedSecretKey,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -127,7 +277,7 @@ func (q *Queries) GetClientByID(ctx context.Context, id pgtype.UUID) (OauthClien
 }
 
 const listClients = `-- name: ListClients :many
-SELECT id, client_id, client_secret, client_name, redirect_uris, grant_types, response_types, scopes, logo_url, created_at, updated_at FROM oauth_clients
+SELECT id, client_id, client_secret, client_name, redirect_uris, grant_types, response_types, response_modes, scopes, logo_url, subject_type, sector_identifier_uri, first_party, access_token_lifetime, refresh_token_lifetime, refresh_token_idle_timeout, id_token_lifetime, issue_refresh_tokens, access_token_format, exchange_audiences, token_endpoint_auth_method, jwks, jwks_uri, dpop_bound_access_tokens, tls_client_auth_subject_dn, tls_client_certificate_bound_access_tokens, backchannel_token_delivery_mode, backchannel_client_notification_endpoint, id_token_encrypted_response_alg, id_token_encrypted_response_enc, userinfo_encrypted_response_alg, userinfo_encrypted_response_enc, default_acr_values, encrypted_secret_key, created_at, updated_at FROM oauth_clients
 ORDER BY created_at DESC
 `
 
@@ -148,8 +298,33 @@ func (q *Queries) ListClients(ctx context.Context) ([]OauthClient, error) {
 			&i.RedirectUris,
 			&i.GrantTypes,
 			&i.ResponseTypes,
+			&i.ResponseModes,
 			&i.Scopes,
 			&i.LogoUrl,
+			&i.SubjectType,
+			&i.SectorIdentifierUri,
+			&i.FirstParty,
+			&i.AccessTokenLifetime,
+			&i.RefreshTokenLifetime,
+			&i.RefreshTokenIdleTimeout,
+			&i.IDTokenLifetime,
+			&i.IssueRefreshTokens,
+			&i.AccessTokenFormat,
+			&i.ExchangeAudiences,
+			&i.TokenEndpointAuthMethod,
+			&i.Jwks,
+			&i.JwksUri,
+			&i.DpopBoundAccessTokens,
+			&i.TlsClientAuthSubjectDn,
+			&i.TlsClientCertificateBoundAccessTokens,
+			&i.BackchannelTokenDeliveryMode,
+			&i.BackchannelClientNotificationEndpoint,
+			&i.IDTokenEncryptedResponseAlg,
+			&i.IDTokenEncryptedResponseEnc,
+			&i.UserinfoEncryptedResponseAlg,
+			&i.UserinfoEncryptedResponseEnc,
+			&i.DefaultAcrValues,
+			&i.EncryptedSecretKey,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -170,19 +345,67 @@ SET
     redirect_uris = $3,
     grant_types = $4,
     response_types = $5,
-    scopes = $6,
+    response_modes = $6,
+    scopes = $7,
+    subject_type = $8,
+    sector_identifier_uri = $9,
+    first_party = $10,
+    access_token_lifetime = $11,
+    refresh_token_lifetime = $12,
+    refresh_token_idle_timeout = $13,
+    id_token_lifetime = $14,
+    issue_refresh_tokens = $15,
+    access_token_format = $16,
+    exchange_audiences = $17,
+    token_endpoint_auth_method = $18,
+    jwks = $19,
+    jwks_uri = $20,
+    dpop_bound_access_tokens = $21,
+    tls_client_auth_subject_dn = $22,
+    tls_client_certificate_bound_access_tokens = $23,
+    backchannel_token_delivery_mode = $24,
+    backchannel_client_notification_endpoint = $25,
+    id_token_encrypted_response_alg = $26,
+    id_token_encrypted_response_enc = $27,
+    userinfo_encrypted_response_alg = $28,
+    userinfo_encrypted_response_enc = $29,
+    default_acr_values = $30,
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, client_id, client_secret, client_name, redirect_uris, grant_types, response_types, scopes, logo_url, created_at, updated_at
+RETURNING id, client_id, client_secret, client_name, redirect_uris, grant_types, response_types, response_modes, scopes, logo_url, subject_type, sector_identifier_uri, first_party, access_token_lifetime, refresh_token_lifetime, refresh_token_idle_timeout, id_token_lifetime, issue_refresh_tokens, access_token_format, exchange_audiences, token_endpoint_auth_method, jwks, jwks_uri, dpop_bound_access_tokens, tls_client_auth_subject_dn, tls_client_certificate_bound_access_tokens, backchannel_token_delivery_mode, backchannel_client_notification_endpoint, id_token_encrypted_response_alg, id_token_encrypted_response_enc, userinfo_encrypted_response_alg, userinfo_encrypted_response_enc, default_acr_values, encrypted_secret_key, created_at, updated_at
 `
 
 type UpdateClientParams struct {
-	ID            pgtype.UUID `json:"id"`
-	ClientName    string      `json:"client_name"`
-	RedirectUris  []string    `json:"redirect_uris"`
-	GrantTypes    []string    `json:"grant_types"`
-	ResponseTypes []string    `json:"response_types"`
-	Scopes        []string    `json:"scopes"`
+	ID                                    pgtype.UUID `json:"id"`
+	ClientName                            string      `json:"client_name"`
+	RedirectUris                          []string    `json:"redirect_uris"`
+	GrantTypes                            []string    `json:"grant_types"`
+	ResponseTypes                         []string    `json:"response_types"`
+	ResponseModes                         []string    `json:"response_modes"`
+	Scopes                                []string    `json:"scopes"`
+	SubjectType                           string      `json:"subject_type"`
+	SectorIdentifierUri                   string      `json:"sector_identifier_uri"`
+	FirstParty                            bool        `json:"first_party"`
+	AccessTokenLifetime                   int32       `json:"access_token_lifetime"`
+	RefreshTokenLifetime                  int32       `json:"refresh_token_lifetime"`
+	RefreshTokenIdleTimeout               int32       `json:"refresh_token_idle_timeout"`
+	IDTokenLifetime                       int32       `json:"id_token_lifetime"`
+	IssueRefreshTokens                    bool        `json:"issue_refresh_tokens"`
+	AccessTokenFormat                     string      `json:"access_token_format"`
+	ExchangeAudiences                     []string    `json:"exchange_audiences"`
+	TokenEndpointAuthMethod               string      `json:"token_endpoint_auth_method"`
+	Jwks                                  []byte      `json:"jwks"`
+	JwksUri                               string      `json:"jwks_uri"`
+	DpopBoundAccessTokens                 bool        `json:"dpop_bound_access_tokens"`
+	TlsClientAuthSubjectDn                string      `json:"tls_client_auth_subject_dn"`
+	TlsClientCertificateBoundAccessTokens bool        `json:"tls_client_certificate_bound_access_tokens"`
+	BackchannelTokenDeliveryMode          string      `json:"backchannel_token_delivery_mode"`
+	BackchannelClientNotificationEndpoint string      `json:"backchannel_client_notification_endpoint"`
+	IDTokenEncryptedResponseAlg           string      `json:"id_token_encrypted_response_alg"`
+	IDTokenEncryptedResponseEnc           string      `json:"id_token_encrypted_response_enc"`
+	UserinfoEncryptedResponseAlg          string      `json:"userinfo_encrypted_response_alg"`
+	UserinfoEncryptedResponseEnc          string      `json:"userinfo_encrypted_response_enc"`
+	DefaultAcrValues                      []string    `json:"default_acr_values"`
 }
 
 func (q *Queries) UpdateClient(ctx context.Context, arg UpdateClientParams) (OauthClient, error) {
@@ -192,7 +415,31 @@ func (q *Queries) UpdateClient(ctx context.Context, arg UpdateClientParams) (Oau
 		arg.RedirectUris,
 		arg.GrantTypes,
 		arg.ResponseTypes,
+		arg.ResponseModes,
 		arg.Scopes,
+		arg.SubjectType,
+		arg.SectorIdentifierUri,
+		arg.FirstParty,
+		arg.AccessTokenLifetime,
+		arg.RefreshTokenLifetime,
+		arg.RefreshTokenIdleTimeout,
+		arg.IDTokenLifetime,
+		arg.IssueRefreshTokens,
+		arg.AccessTokenFormat,
+		arg.ExchangeAudiences,
+		arg.TokenEndpointAuthMethod,
+		arg.Jwks,
+		arg.JwksUri,
+		arg.DpopBoundAccessTokens,
+		arg.TlsClientAuthSubjectDn,
+		arg.TlsClientCertificateBoundAccessTokens,
+		arg.BackchannelTokenDeliveryMode,
+		arg.BackchannelClientNotificationEndpoint,
+		arg.IDTokenEncryptedResponseAlg,
+		arg.IDTokenEncryptedResponseEnc,
+		arg.UserinfoEncryptedResponseAlg,
+		arg.UserinfoEncryptedResponseEnc,
+		arg.DefaultAcrValues,
 	)
 	var i OauthClient
 	err := row.Scan(
@@ -203,8 +450,33 @@ func (q *Queries) UpdateClient(ctx context.Context, arg UpdateClientParams) (Oau
 		&i.RedirectUris,
 		&i.GrantTypes,
 		&i.ResponseTypes,
+		&i.ResponseModes,
 		&i.Scopes,
 		&i.LogoUrl,
+		&i.SubjectType,
+		&i.SectorIdentifierUri,
+		&i.FirstParty,
+		&i.AccessTokenLifetime,
+		&i.RefreshTokenLifetime,
+		&i.RefreshTokenIdleTimeout,
+		&i.IDTokenLifetime,
+		&i.IssueRefreshTokens,
+		&i.AccessTokenFormat,
+		&i.ExchangeAudiences,
+		&i.TokenEndpointAuthMethod,
+		&i.Jwks,
+		&i.JwksUri,
+		&i.DpopBoundAccessTokens,
+		&i.TlsClientAuthSubjectDn,
+		&i.TlsClientCertificateBoundAccessTokens,
+		&i.BackchannelTokenDeliveryMode,
+		&i.BackchannelClientNotificationEndpoint,
+		&i.IDTokenEncryptedResponseAlg,
+		&i.IDTokenEncryptedResponseEnc,
+		&i.UserinfoEncryptedResponseAlg,
+		&i.UserinfoEncryptedResponseEnc,
+		&i.DefaultAcrValues,
+		&i.EncryptedSecretKey,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
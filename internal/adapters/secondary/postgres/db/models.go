@@ -8,42 +8,162 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiResource struct {
+	ID                pgtype.UUID      `json:"id"`
+	Identifier        string           `json:"identifier"`
+	Name              string           `json:"name"`
+	Scopes            []string         `json:"scopes"`
+	AccessTokenFormat string           `json:"access_token_format"`
+	ClientID          string           `json:"client_id"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+}
+
+type AuditEvent struct {
+	ID        pgtype.UUID      `json:"id"`
+	UserID    pgtype.UUID      `json:"user_id"`
+	Type      string           `json:"type"`
+	Details   []byte           `json:"details"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
 type AuthorizationCode struct {
-	Code                string           `json:"code"`
-	ClientID            string           `json:"client_id"`
-	UserID              pgtype.UUID      `json:"user_id"`
-	RedirectUri         string           `json:"redirect_uri"`
-	Scopes              []string         `json:"scopes"`
-	Nonce               pgtype.Text      `json:"nonce"`
-	CodeChallenge       pgtype.Text      `json:"code_challenge"`
-	CodeChallengeMethod pgtype.Text      `json:"code_challenge_method"`
-	Used                bool             `json:"used"`
-	ExpiresAt           pgtype.Timestamp `json:"expires_at"`
-	CreatedAt           pgtype.Timestamp `json:"created_at"`
+	Code                 string           `json:"code"`
+	ClientID             string           `json:"client_id"`
+	UserID               pgtype.UUID      `json:"user_id"`
+	RedirectUri          string           `json:"redirect_uri"`
+	Scopes               []string         `json:"scopes"`
+	Nonce                pgtype.Text      `json:"nonce"`
+	CodeChallenge        pgtype.Text      `json:"code_challenge"`
+	CodeChallengeMethod  pgtype.Text      `json:"code_challenge_method"`
+	Claims               []byte           `json:"claims"`
+	SessionID            pgtype.UUID      `json:"session_id"`
+	Resources            []string         `json:"resources"`
+	AuthTime             pgtype.Timestamp `json:"auth_time"`
+	Acr                  pgtype.Text      `json:"acr"`
+	Amr                  []string         `json:"amr"`
+	AuthorizationDetails []byte           `json:"authorization_details"`
+	Used                 bool             `json:"used"`
+	ExpiresAt            pgtype.Timestamp `json:"expires_at"`
+	CreatedAt            pgtype.Timestamp `json:"created_at"`
+}
+
+type AuthorizationDetailType struct {
+	ID          pgtype.UUID      `json:"id"`
+	Type        string           `json:"type"`
+	Description string           `json:"description"`
+	Fields      []string         `json:"fields"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+}
+
+type Consent struct {
+	UserID    pgtype.UUID      `json:"user_id"`
+	ClientID  string           `json:"client_id"`
+	Scopes    []string         `json:"scopes"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
 }
 
 type OauthClient struct {
-	ID            pgtype.UUID      `json:"id"`
-	ClientID      string           `json:"client_id"`
-	ClientSecret  string           `json:"client_secret"`
-	ClientName    string           `json:"client_name"`
-	RedirectUris  []string         `json:"redirect_uris"`
-	GrantTypes    []string         `json:"grant_types"`
-	ResponseTypes []string         `json:"response_types"`
-	Scopes        []string         `json:"scopes"`
-	LogoUrl       string           `json:"logo_url"`
-	CreatedAt     pgtype.Timestamp `json:"created_at"`
-	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
+	ID                                    pgtype.UUID      `json:"id"`
+	ClientID                              string           `json:"client_id"`
+	ClientSecret                          string           `json:"client_secret"`
+	ClientName                            string           `json:"client_name"`
+	RedirectUris                          []string         `json:"redirect_uris"`
+	GrantTypes                            []string         `json:"grant_types"`
+	ResponseTypes                         []string         `json:"response_types"`
+	ResponseModes                         []string         `json:"response_modes"`
+	Scopes                                []string         `json:"scopes"`
+	LogoUrl                               string           `json:"logo_url"`
+	SubjectType                           string           `json:"subject_type"`
+	SectorIdentifierUri                   string           `json:"sector_identifier_uri"`
+	FirstParty                            bool             `json:"first_party"`
+	AccessTokenLifetime                   int32            `json:"access_token_lifetime"`
+	RefreshTokenLifetime                  int32            `json:"refresh_token_lifetime"`
+	RefreshTokenIdleTimeout               int32            `json:"refresh_token_idle_timeout"`
+	IDTokenLifetime                       int32            `json:"id_token_lifetime"`
+	IssueRefreshTokens                    bool             `json:"issue_refresh_tokens"`
+	AccessTokenFormat                     string           `json:"access_token_format"`
+	ExchangeAudiences                     []string         `json:"exchange_audiences"`
+	TokenEndpointAuthMethod               string           `json:"token_endpoint_auth_method"`
+	Jwks                                  []byte           `json:"jwks"`
+	JwksUri                               string           `json:"jwks_uri"`
+	DpopBoundAccessTokens                 bool             `json:"dpop_bound_access_tokens"`
+	TlsClientAuthSubjectDn                string           `json:"tls_client_auth_subject_dn"`
+	TlsClientCertificateBoundAccessTokens bool             `json:"tls_client_certificate_bound_access_tokens"`
+	BackchannelTokenDeliveryMode          string           `json:"backchannel_token_delivery_mode"`
+	BackchannelClientNotificationEndpoint string           `json:"backchannel_client_notification_endpoint"`
+	IDTokenEncryptedResponseAlg           string           `json:"id_token_encrypted_response_alg"`
+	IDTokenEncryptedResponseEnc           string           `json:"id_token_encrypted_response_enc"`
+	UserinfoEncryptedResponseAlg          string           `json:"userinfo_encrypted_response_alg"`
+	UserinfoEncryptedResponseEnc          string           `json:"userinfo_encrypted_response_enc"`
+	DefaultAcrValues                      []string         `json:"default_acr_values"`
+	EncryptedSecretKey                    []byte           `json:"encrypted_secret_key"`
+	CreatedAt                             pgtype.Timestamp `json:"created_at"`
+	UpdatedAt                             pgtype.Timestamp `json:"updated_at"`
+}
+
+type PairwiseSubject struct {
+	SectorIdentifier string           `json:"sector_identifier"`
+	Subject          string           `json:"subject"`
+	UserID           pgtype.UUID      `json:"user_id"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+}
+
+type RecoveryCode struct {
+	ID        pgtype.UUID      `json:"id"`
+	UserID    pgtype.UUID      `json:"user_id"`
+	CodeHash  string           `json:"code_hash"`
+	UsedAt    pgtype.Timestamp `json:"used_at"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+}
+
+type Scope struct {
+	ID             pgtype.UUID      `json:"id"`
+	Name           string           `json:"name"`
+	Descriptions   []byte           `json:"descriptions"`
+	Claims         []string         `json:"claims"`
+	IsDefault      bool             `json:"is_default"`
+	FirstPartyOnly bool             `json:"first_party_only"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	UpdatedAt      pgtype.Timestamp `json:"updated_at"`
+}
+
+type SigningKey struct {
+	ID                  pgtype.UUID      `json:"id"`
+	Kid                 string           `json:"kid"`
+	Algorithm           string           `json:"algorithm"`
+	State               string           `json:"state"`
+	PublicKey           []byte           `json:"public_key"`
+	EncryptedPrivateKey []byte           `json:"encrypted_private_key"`
+	ActivatedAt         pgtype.Timestamp `json:"activated_at"`
+	DeactivatedAt       pgtype.Timestamp `json:"deactivated_at"`
+	RetiredAt           pgtype.Timestamp `json:"retired_at"`
+	CreatedAt           pgtype.Timestamp `json:"created_at"`
+	UpdatedAt           pgtype.Timestamp `json:"updated_at"`
 }
 
 type Token struct {
 	ID                    pgtype.UUID      `json:"id"`
 	AccessTokenHash       string           `json:"access_token_hash"`
-	RefreshTokenHash      string           `json:"refresh_token_hash"`
+	RefreshTokenHash      pgtype.Text      `json:"refresh_token_hash"`
 	AuthorizationCode     pgtype.Text      `json:"authorization_code"`
 	ClientID              string           `json:"client_id"`
 	UserID                pgtype.UUID      `json:"user_id"`
 	Scopes                []string         `json:"scopes"`
+	Claims                []byte           `json:"claims"`
+	SessionID             pgtype.UUID      `json:"session_id"`
+	Offline               bool             `json:"offline"`
+	Resources             []string         `json:"resources"`
+	AuthTime              pgtype.Timestamp `json:"auth_time"`
+	Acr                   pgtype.Text      `json:"acr"`
+	Amr                   []string         `json:"amr"`
+	Actor                 []byte           `json:"actor"`
+	DpopJkt               pgtype.Text      `json:"dpop_jkt"`
+	X5tS256               pgtype.Text      `json:"x5t_s256"`
+	AuthorizationDetails  []byte           `json:"authorization_details"`
+	FamilyID              pgtype.UUID      `json:"family_id"`
 	TokenType             string           `json:"token_type"`
 	AccessTokenExpiresAt  pgtype.Timestamp `json:"access_token_expires_at"`
 	RefreshTokenExpiresAt pgtype.Timestamp `json:"refresh_token_expires_at"`
@@ -54,6 +174,35 @@ type Token struct {
 	LastUsedAt            pgtype.Timestamp `json:"last_used_at"`
 }
 
+type TotpCredential struct {
+	ID              pgtype.UUID      `json:"id"`
+	UserID          pgtype.UUID      `json:"user_id"`
+	EncryptedSecret []byte           `json:"encrypted_secret"`
+	LastUsedStep    int64            `json:"last_used_step"`
+	ConfirmedAt     pgtype.Timestamp `json:"confirmed_at"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	UpdatedAt       pgtype.Timestamp `json:"updated_at"`
+}
+
+type TrustPolicy struct {
+	ID         pgtype.UUID      `json:"id"`
+	IssuerID   pgtype.UUID      `json:"issuer_id"`
+	ClientID   string           `json:"client_id"`
+	Conditions []byte           `json:"conditions"`
+	Scopes     []string         `json:"scopes"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type TrustedIssuer struct {
+	ID        pgtype.UUID      `json:"id"`
+	Issuer    string           `json:"issuer"`
+	Jwks      []byte           `json:"jwks"`
+	JwksUri   string           `json:"jwks_uri"`
+	Audiences []string         `json:"audiences"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
+	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+}
+
 type User struct {
 	ID            pgtype.UUID      `json:"id"`
 	Email         string           `json:"email"`
@@ -63,3 +212,16 @@ type User struct {
 	CreatedAt     pgtype.Timestamp `json:"created_at"`
 	UpdatedAt     pgtype.Timestamp `json:"updated_at"`
 }
+
+type WebauthnCredential struct {
+	ID           pgtype.UUID      `json:"id"`
+	UserID       pgtype.UUID      `json:"user_id"`
+	CredentialID []byte           `json:"credential_id"`
+	PublicKey    []byte           `json:"public_key"`
+	SignCount    int64            `json:"sign_count"`
+	Transports   []string         `json:"transports"`
+	Name         string           `json:"name"`
+	LastUsedAt   pgtype.Timestamp `json:"last_used_at"`
+	CreatedAt    pgtype.Timestamp `json:"created_at"`
+	UpdatedAt    pgtype.Timestamp `json:"updated_at"`
+}
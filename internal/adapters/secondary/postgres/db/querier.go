@@ -13,14 +13,24 @@ import (
 type Querier interface {
 	CountActiveTokensByClient(ctx context.Context, clientID string) (int64, error)
 	CountActiveTokensByUser(ctx context.Context, userID pgtype.UUID) (int64, error)
+	CreateAPIResource(ctx context.Context, arg CreateAPIResourceParams) (ApiResource, error)
 	CreateAuthorizationCode(ctx context.Context, arg CreateAuthorizationCodeParams) (AuthorizationCode, error)
 	CreateClient(ctx context.Context, arg CreateClientParams) (OauthClient, error)
+	CreatePairwiseSubject(ctx context.Context, arg CreatePairwiseSubjectParams) error
+	CreateScope(ctx context.Context, arg CreateScopeParams) (Scope, error)
 	CreateToken(ctx context.Context, arg CreateTokenParams) (Token, error)
+	CreateTrustPolicy(ctx context.Context, arg CreateTrustPolicyParams) (TrustPolicy, error)
+	CreateTrustedIssuer(ctx context.Context, arg CreateTrustedIssuerParams) (TrustedIssuer, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DeleteAPIResource(ctx context.Context, id pgtype.UUID) error
 	DeleteAuthorizationCode(ctx context.Context, code string) error
 	DeleteClient(ctx context.Context, id pgtype.UUID) error
 	DeleteExpiredAuthorizationCodes(ctx context.Context) error
 	DeleteExpiredTokens(ctx context.Context) error
+	DeleteScope(ctx context.Context, id pgtype.UUID) error
+	DeleteTrustPolicy(ctx context.Context, id pgtype.UUID) error
+	DeleteTrustedIssuer(ctx context.Context, id pgtype.UUID) error
+	GetAPIResourceByID(ctx context.Context, id pgtype.UUID) (ApiResource, error)
 	GetActiveTokensByClient(ctx context.Context, clientID string) ([]Token, error)
 	GetActiveTokensByUser(ctx context.Context, userID pgtype.UUID) ([]Token, error)
 	GetAuthorizationCode(ctx context.Context, code string) (GetAuthorizationCodeRow, error)
@@ -28,21 +38,35 @@ type Querier interface {
 	GetByID(ctx context.Context, id pgtype.UUID) (User, error)
 	GetClientByClientID(ctx context.Context, clientID string) (OauthClient, error)
 	GetClientByID(ctx context.Context, id pgtype.UUID) (OauthClient, error)
+	GetOfflineTokensByUser(ctx context.Context, userID pgtype.UUID) ([]Token, error)
+	GetPairwiseSubject(ctx context.Context, arg GetPairwiseSubjectParams) (PairwiseSubject, error)
+	GetScopeByID(ctx context.Context, id pgtype.UUID) (Scope, error)
 	GetTokenByAccessTokenHash(ctx context.Context, accessTokenHash string) (Token, error)
 	GetTokenByID(ctx context.Context, id pgtype.UUID) (Token, error)
-	GetTokenByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (Token, error)
+	GetTokenByRefreshTokenHash(ctx context.Context, refreshTokenHash pgtype.Text) (Token, error)
 	GetTokenWithDetails(ctx context.Context, id pgtype.UUID) (GetTokenWithDetailsRow, error)
+	GetTrustedIssuerByID(ctx context.Context, id pgtype.UUID) (TrustedIssuer, error)
+	GetTrustedIssuerByIssuer(ctx context.Context, issuer string) (TrustedIssuer, error)
+	ListAPIResources(ctx context.Context) ([]ApiResource, error)
+	ListAPIResourcesByIdentifiers(ctx context.Context, identifiers []string) ([]ApiResource, error)
 	ListClients(ctx context.Context) ([]OauthClient, error)
+	ListScopes(ctx context.Context) ([]Scope, error)
+	ListScopesByNames(ctx context.Context, names []string) ([]Scope, error)
+	ListTrustPoliciesByIssuerID(ctx context.Context, issuerID pgtype.UUID) ([]TrustPolicy, error)
+	ListTrustedIssuers(ctx context.Context) ([]TrustedIssuer, error)
 	MarkAuthorizationCodeAsUsed(ctx context.Context, code string) error
 	RevokeToken(ctx context.Context, arg RevokeTokenParams) error
 	RevokeTokenByAccessTokenHash(ctx context.Context, arg RevokeTokenByAccessTokenHashParams) error
 	RevokeTokensByAuthorizationCode(ctx context.Context, arg RevokeTokensByAuthorizationCodeParams) error
 	RevokeTokensByClient(ctx context.Context, arg RevokeTokensByClientParams) error
+	RevokeTokensBySession(ctx context.Context, arg RevokeTokensBySessionParams) error
 	RevokeTokensByUser(ctx context.Context, arg RevokeTokensByUserParams) error
+	UpdateAPIResource(ctx context.Context, arg UpdateAPIResourceParams) (ApiResource, error)
 	UpdateClient(ctx context.Context, arg UpdateClientParams) (OauthClient, error)
 	UpdateLastUsedAt(ctx context.Context, id pgtype.UUID) error
 	UpdateLastUsedAtByAccessTokenHash(ctx context.Context, accessTokenHash string) error
 	UpdatePassword(ctx context.Context, arg UpdatePasswordParams) (User, error)
+	UpdateScope(ctx context.Context, arg UpdateScopeParams) (Scope, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 	VerifyEmail(ctx context.Context, id pgtype.UUID) (User, error)
 }
@@ -50,16 +50,28 @@ INSERT INTO tokens (
     scopes,
     token_type,
     access_token_expires_at,
-    refresh_token_expires_at
+    refresh_token_expires_at,
+    claims,
+    session_id,
+    offline,
+    resources,
+    auth_time,
+    acr,
+    amr,
+    actor,
+    dpop_jkt,
+    x5t_s256,
+    authorization_details,
+    family_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
-) RETURNING id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
+) RETURNING id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, claims, session_id, offline, resources, auth_time, acr, amr, actor, dpop_jkt, x5t_s256, authorization_details, family_id, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at
 `
 
 type CreateTokenParams struct {
 	ID                    pgtype.UUID      `json:"id"`
 	AccessTokenHash       string           `json:"access_token_hash"`
-	RefreshTokenHash      string           `json:"refresh_token_hash"`
+	RefreshTokenHash      pgtype.Text      `json:"refresh_token_hash"`
 	AuthorizationCode     pgtype.Text      `json:"authorization_code"`
 	ClientID              string           `json:"client_id"`
 	UserID                pgtype.UUID      `json:"user_id"`
@@ -67,6 +79,18 @@ type CreateTokenParams struct {
 	TokenType             string           `json:"token_type"`
 	AccessTokenExpiresAt  pgtype.Timestamp `json:"access_token_expires_at"`
 	RefreshTokenExpiresAt pgtype.Timestamp `json:"refresh_token_expires_at"`
+	Claims                []byte           `json:"claims"`
+	SessionID             pgtype.UUID      `json:"session_id"`
+	Offline               bool             `json:"offline"`
+	Resources             []string         `json:"resources"`
+	AuthTime              pgtype.Timestamp `json:"auth_time"`
+	Acr                   pgtype.Text      `json:"acr"`
+	Amr                   []string         `json:"amr"`
+	Actor                 []byte           `json:"actor"`
+	DpopJkt               pgtype.Text      `json:"dpop_jkt"`
+	X5tS256               pgtype.Text      `json:"x5t_s256"`
+	AuthorizationDetails  []byte           `json:"authorization_details"`
+	FamilyID              pgtype.UUID      `json:"family_id"`
 }
 
 func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) (Token, error) {
@@ -81,6 +105,18 @@ func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) (Token
 		arg.TokenType,
 		arg.AccessTokenExpiresAt,
 		arg.RefreshTokenExpiresAt,
+		arg.Claims,
+		arg.SessionID,
+		arg.Offline,
+		arg.Resources,
+		arg.AuthTime,
+		arg.Acr,
+		arg.Amr,
+		arg.Actor,
+		arg.DpopJkt,
+		arg.X5tS256,
+		arg.AuthorizationDetails,
+		arg.FamilyID,
 	)
 	var i Token
 	err := row.Scan(
@@ -91,6 +127,18 @@ func (q *Queries) CreateToken(ctx context.Context, arg CreateTokenParams) (Token
 		&i.ClientID,
 		&i.UserID,
 		&i.Scopes,
+		&i.Claims,
+		&i.SessionID,
+		&i.Offline,
+		&i.Resources,
+		&i.AuthTime,
+		&i.Acr,
+		&i.Amr,
+		&i.Actor,
+		&i.DpopJkt,
+		&i.X5tS256,
+		&i.AuthorizationDetails,
+		&i.FamilyID,
 		&i.TokenType,
 		&i.AccessTokenExpiresAt,
 		&i.RefreshTokenExpiresAt,
@@ -115,7 +163,7 @@ func (q *Queries) DeleteExpiredTokens(ctx context.Context) error {
 }
 
 const getActiveTokensByClient = `-- name: GetActiveTokensByClient :many
-SELECT id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at FROM tokens
+SELECT id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, claims, session_id, offline, resources, auth_time, acr, amr, actor, dpop_jkt, x5t_s256, authorization_details, family_id, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at FROM tokens
 WHERE client_id = $1
   AND revoked = FALSE
   AND access_token_expires_at > NOW()
@@ -139,6 +187,18 @@ func (q *Queries) GetActiveTokensByClient(ctx context.Context, clientID string)
 			&i.ClientID,
 			&i.UserID,
 			&i.Scopes,
+			&i.Claims,
+			&i.SessionID,
+			&i.Offline,
+			&i.Resources,
+			&i.AuthTime,
+			&i.Acr,
+			&i.Amr,
+			&i.Actor,
+			&i.DpopJkt,
+			&i.X5tS256,
+			&i.AuthorizationDetails,
+			&i.FamilyID,
 			&i.TokenType,
 			&i.AccessTokenExpiresAt,
 			&i.RefreshTokenExpiresAt,
@@ -159,7 +219,7 @@ func (q *Queries) GetActiveTokensByClient(ctx context.Context, clientID string)
 }
 
 const getActiveTokensByUser = `-- name: GetActiveTokensByUser :many
-SELECT id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at FROM tokens
+SELECT id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, claims, session_id, offline, resources, auth_time, acr, amr, actor, dpop_jkt, x5t_s256, authorization_details, family_id, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at FROM tokens
 WHERE user_id = $1
   AND revoked = FALSE
   AND access_token_expires_at > NOW()
@@ -183,6 +243,75 @@ func (q *Queries) GetActiveTokensByUser(ctx context.Context, userID pgtype.UUID)
 			&i.ClientID,
 			&i.UserID,
 			&i.Scopes,
+			&i.Claims,
+			&i.SessionID,
+			&i.Offline,
+			&i.Resources,
+			&i.AuthTime,
+			&i.Acr,
+			&i.Amr,
+			&i.Actor,
+			&i.DpopJkt,
+			&i.X5tS256,
+			&i.AuthorizationDetails,
+			&i.FamilyID,
+			&i.TokenType,
+			&i.AccessTokenExpiresAt,
+			&i.RefreshTokenExpiresAt,
+			&i.Revoked,
+			&i.RevokedAt,
+			&i.RevokedReason,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOfflineTokensByUser = `-- name: GetOfflineTokensByUser :many
+SELECT id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, claims, session_id, offline, resources, auth_time, acr, amr, actor, dpop_jkt, x5t_s256, authorization_details, family_id, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at FROM tokens
+WHERE user_id = $1
+  AND offline = TRUE
+  AND revoked = FALSE
+  AND refresh_token_expires_at > NOW()
+ORDER BY created_at DESC
+`
+
+func (q *Queries) GetOfflineTokensByUser(ctx context.Context, userID pgtype.UUID) ([]Token, error) {
+	rows, err := q.db.Query(ctx, getOfflineTokensByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Token
+	for rows.Next() {
+		var i Token
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccessTokenHash,
+			&i.RefreshTokenHash,
+			&i.AuthorizationCode,
+			&i.ClientID,
+			&i.UserID,
+			&i.Scopes,
+			&i.Claims,
+			&i.SessionID,
+			&i.Offline,
+			&i.Resources,
+			&i.AuthTime,
+			&i.Acr,
+			&i.Amr,
+			&i.Actor,
+			&i.DpopJkt,
+			&i.X5tS256,
+			&i.AuthorizationDetails,
+			&i.FamilyID,
 			&i.TokenType,
 			&i.AccessTokenExpiresAt,
 			&i.RefreshTokenExpiresAt,
@@ -203,7 +332,7 @@ func (q *Queries) GetActiveTokensByUser(ctx context.Context, userID pgtype.UUID)
 }
 
 const getTokenByAccessTokenHash = `-- name: GetTokenByAccessTokenHash :one
-SELECT id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at FROM tokens
+SELECT id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, claims, session_id, offline, resources, auth_time, acr, amr, actor, dpop_jkt, x5t_s256, authorization_details, family_id, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at FROM tokens
 WHERE access_token_hash = $1
   AND revoked = FALSE
 LIMIT 1
@@ -220,6 +349,18 @@ func (q *Queries) GetTokenByAccessTokenHash(ctx context.Context, accessTokenHash
 		&i.ClientID,
 		&i.UserID,
 		&i.Scopes,
+		&i.Claims,
+		&i.SessionID,
+		&i.Offline,
+		&i.Resources,
+		&i.AuthTime,
+		&i.Acr,
+		&i.Amr,
+		&i.Actor,
+		&i.DpopJkt,
+		&i.X5tS256,
+		&i.AuthorizationDetails,
+		&i.FamilyID,
 		&i.TokenType,
 		&i.AccessTokenExpiresAt,
 		&i.RefreshTokenExpiresAt,
@@ -233,7 +374,7 @@ func (q *Queries) GetTokenByAccessTokenHash(ctx context.Context, accessTokenHash
 }
 
 const getTokenByID = `-- name: GetTokenByID :one
-SELECT id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at FROM tokens
+SELECT id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, claims, session_id, offline, resources, auth_time, acr, amr, actor, dpop_jkt, x5t_s256, authorization_details, family_id, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at FROM tokens
 WHERE id = $1
 LIMIT 1
 `
@@ -249,6 +390,18 @@ func (q *Queries) GetTokenByID(ctx context.Context, id pgtype.UUID) (Token, erro
 		&i.ClientID,
 		&i.UserID,
 		&i.Scopes,
+		&i.Claims,
+		&i.SessionID,
+		&i.Offline,
+		&i.Resources,
+		&i.AuthTime,
+		&i.Acr,
+		&i.Amr,
+		&i.Actor,
+		&i.DpopJkt,
+		&i.X5tS256,
+		&i.AuthorizationDetails,
+		&i.FamilyID,
 		&i.TokenType,
 		&i.AccessTokenExpiresAt,
 		&i.RefreshTokenExpiresAt,
@@ -262,14 +415,13 @@ func (q *Queries) GetTokenByID(ctx context.Context, id pgtype.UUID) (Token, erro
 }
 
 const getTokenByRefreshTokenHash = `-- name: GetTokenByRefreshTokenHash :one
-SELECT id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at FROM tokens
+SELECT id, access_token_hash, refresh_token_hash, authorization_code, client_id, user_id, scopes, claims, session_id, offline, resources, auth_time, acr, amr, actor, dpop_jkt, x5t_s256, authorization_details, family_id, token_type, access_token_expires_at, refresh_token_expires_at, revoked, revoked_at, revoked_reason, created_at, last_used_at FROM tokens
 WHERE refresh_token_hash = $1
-  AND revoked = FALSE
   AND refresh_token_expires_at > NOW()
 LIMIT 1
 `
 
-func (q *Queries) GetTokenByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (Token, error) {
+func (q *Queries) GetTokenByRefreshTokenHash(ctx context.Context, refreshTokenHash pgtype.Text) (Token, error) {
 	row := q.db.QueryRow(ctx, getTokenByRefreshTokenHash, refreshTokenHash)
 	var i Token
 	err := row.Scan(
@@ -280,6 +432,18 @@ func (q *Queries) GetTokenByRefreshTokenHash(ctx context.Context, refreshTokenHa
 		&i.ClientID,
 		&i.UserID,
 		&i.Scopes,
+		&i.Claims,
+		&i.SessionID,
+		&i.Offline,
+		&i.Resources,
+		&i.AuthTime,
+		&i.Acr,
+		&i.Amr,
+		&i.Actor,
+		&i.DpopJkt,
+		&i.X5tS256,
+		&i.AuthorizationDetails,
+		&i.FamilyID,
 		&i.TokenType,
 		&i.AccessTokenExpiresAt,
 		&i.RefreshTokenExpiresAt,
@@ -294,7 +458,7 @@ func (q *Queries) GetTokenByRefreshTokenHash(ctx context.Context, refreshTokenHa
 
 const getTokenWithDetails = `-- name: GetTokenWithDetails :one
 SELECT
-    t.id, t.access_token_hash, t.refresh_token_hash, t.authorization_code, t.client_id, t.user_id, t.scopes, t.token_type, t.access_token_expires_at, t.refresh_token_expires_at, t.revoked, t.revoked_at, t.revoked_reason, t.created_at, t.last_used_at,
+    t.id, t.access_token_hash, t.refresh_token_hash, t.authorization_code, t.client_id, t.user_id, t.scopes, t.claims, t.session_id, t.offline, t.resources, t.auth_time, t.acr, t.amr, t.actor, t.dpop_jkt, t.x5t_s256, t.authorization_details, t.family_id, t.token_type, t.access_token_expires_at, t.refresh_token_expires_at, t.revoked, t.revoked_at, t.revoked_reason, t.created_at, t.last_used_at,
     u.email as user_email,
     u.name as user_name,
     c.client_name as client_name
@@ -308,11 +472,23 @@ LIMIT 1
 type GetTokenWithDetailsRow struct {
 	ID                    pgtype.UUID      `json:"id"`
 	AccessTokenHash       string           `json:"access_token_hash"`
-	RefreshTokenHash      string           `json:"refresh_token_hash"`
+	RefreshTokenHash      pgtype.Text      `json:"refresh_token_hash"`
 	AuthorizationCode     pgtype.Text      `json:"authorization_code"`
 	ClientID              string           `json:"client_id"`
 	UserID                pgtype.UUID      `json:"user_id"`
 	Scopes                []string         `json:"scopes"`
+	Claims                []byte           `json:"claims"`
+	SessionID             pgtype.UUID      `json:"session_id"`
+	Offline               bool             `json:"offline"`
+	Resources             []string         `json:"resources"`
+	AuthTime              pgtype.Timestamp `json:"auth_time"`
+	Acr                   pgtype.Text      `json:"acr"`
+	Amr                   []string         `json:"amr"`
+	Actor                 []byte           `json:"actor"`
+	DpopJkt               pgtype.Text      `json:"dpop_jkt"`
+	X5tS256               pgtype.Text      `json:"x5t_s256"`
+	AuthorizationDetails  []byte           `json:"authorization_details"`
+	FamilyID              pgtype.UUID      `json:"family_id"`
 	TokenType             string           `json:"token_type"`
 	AccessTokenExpiresAt  pgtype.Timestamp `json:"access_token_expires_at"`
 	RefreshTokenExpiresAt pgtype.Timestamp `json:"refresh_token_expires_at"`
@@ -337,6 +513,18 @@ func (q *Queries) GetTokenWithDetails(ctx context.Context, id pgtype.UUID) (GetT
 		&i.ClientID,
 		&i.UserID,
 		&i.Scopes,
+		&i.Claims,
+		&i.SessionID,
+		&i.Offline,
+		&i.Resources,
+		&i.AuthTime,
+		&i.Acr,
+		&i.Amr,
+		&i.Actor,
+		&i.DpopJkt,
+		&i.X5tS256,
+		&i.AuthorizationDetails,
+		&i.FamilyID,
 		&i.TokenType,
 		&i.AccessTokenExpiresAt,
 		&i.RefreshTokenExpiresAt,
@@ -352,6 +540,29 @@ func (q *Queries) GetTokenWithDetails(ctx context.Context, id pgtype.UUID) (GetT
 	return i, err
 }
 
+const revokeActiveToken = `-- name: RevokeActiveToken :one
+UPDATE tokens
+SET
+    revoked = TRUE,
+    revoked_at = NOW(),
+    revoked_reason = $2
+WHERE id = $1
+  AND revoked_at IS NULL
+RETURNING id
+`
+
+type RevokeActiveTokenParams struct {
+	ID            pgtype.UUID `json:"id"`
+	RevokedReason pgtype.Text `json:"revoked_reason"`
+}
+
+func (q *Queries) RevokeActiveToken(ctx context.Context, arg RevokeActiveTokenParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, revokeActiveToken, arg.ID, arg.RevokedReason)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
 const revokeToken = `-- name: RevokeToken :exec
 UPDATE tokens
 SET
@@ -430,6 +641,47 @@ func (q *Queries) RevokeTokensByClient(ctx context.Context, arg RevokeTokensByCl
 	return err
 }
 
+const revokeTokensByFamily = `-- name: RevokeTokensByFamily :exec
+UPDATE tokens
+SET
+    revoked = TRUE,
+    revoked_at = NOW(),
+    revoked_reason = $2
+WHERE (family_id = $1 OR id = $1)
+  AND revoked = FALSE
+`
+
+type RevokeTokensByFamilyParams struct {
+	FamilyID      pgtype.UUID `json:"family_id"`
+	RevokedReason pgtype.Text `json:"revoked_reason"`
+}
+
+func (q *Queries) RevokeTokensByFamily(ctx context.Context, arg RevokeTokensByFamilyParams) error {
+	_, err := q.db.Exec(ctx, revokeTokensByFamily, arg.FamilyID, arg.RevokedReason)
+	return err
+}
+
+const revokeTokensBySession = `-- name: RevokeTokensBySession :exec
+UPDATE tokens
+SET
+    revoked = TRUE,
+    revoked_at = NOW(),
+    revoked_reason = $2
+WHERE session_id = $1
+  AND offline = FALSE
+  AND revoked = FALSE
+`
+
+type RevokeTokensBySessionParams struct {
+	SessionID     pgtype.UUID `json:"session_id"`
+	RevokedReason pgtype.Text `json:"revoked_reason"`
+}
+
+func (q *Queries) RevokeTokensBySession(ctx context.Context, arg RevokeTokensBySessionParams) error {
+	_, err := q.db.Exec(ctx, revokeTokensBySession, arg.SessionID, arg.RevokedReason)
+	return err
+}
+
 const revokeTokensByUser = `-- name: RevokeTokensByUser :exec
 UPDATE tokens
 SET
@@ -0,0 +1,44 @@
+-- name: CreateAPIResource :one
+INSERT INTO api_resources (
+    id,
+    identifier,
+    name,
+    scopes,
+    access_token_format,
+    client_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetAPIResourceByID :one
+SELECT * FROM api_resources
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAPIResources :many
+SELECT * FROM api_resources
+ORDER BY identifier;
+
+-- name: ListAPIResourcesByClientID :many
+SELECT * FROM api_resources
+WHERE client_id = $1
+ORDER BY identifier;
+
+-- name: ListAPIResourcesByIdentifiers :many
+SELECT * FROM api_resources
+WHERE identifier = ANY(@identifiers::text[])
+ORDER BY identifier;
+
+-- name: UpdateAPIResource :one
+UPDATE api_resources
+SET 
+    name = $2,
+    scopes = $3,
+    access_token_format = $4,
+    client_id = $5,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteAPIResource :exec
+DELETE FROM api_resources
+WHERE id = $1;
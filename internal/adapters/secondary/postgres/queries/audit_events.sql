@@ -0,0 +1,9 @@
+-- name: CreateAuditEvent :exec
+INSERT INTO audit_events (
+    id,
+    user_id,
+    type,
+    details
+) VALUES (
+    $1, $2, $3, $4
+);
@@ -8,9 +8,16 @@ INSERT INTO authorization_codes (
     nonce,
     code_challenge,
     code_challenge_method,
-    expires_at
+    expires_at,
+    claims,
+    session_id,
+    resources,
+    auth_time,
+    acr,
+    amr,
+    authorization_details
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
 ) RETURNING *;
 
 -- name: GetAuthorizationCode :one
@@ -0,0 +1,35 @@
+-- name: CreateAuthorizationDetailType :one
+INSERT INTO authorization_detail_types (
+    id,
+    type,
+    description,
+    fields
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetAuthorizationDetailTypeByID :one
+SELECT * FROM authorization_detail_types
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthorizationDetailTypes :many
+SELECT * FROM authorization_detail_types
+ORDER BY type;
+
+-- name: ListAuthorizationDetailTypesByTypes :many
+SELECT * FROM authorization_detail_types
+WHERE type = ANY(@types::text[])
+ORDER BY type;
+
+-- name: UpdateAuthorizationDetailType :one
+UPDATE authorization_detail_types
+SET 
+    description = $2,
+    fields = $3,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteAuthorizationDetailType :exec
+DELETE FROM authorization_detail_types
+WHERE id = $1;
@@ -15,10 +15,35 @@ INSERT INTO oauth_clients (
     redirect_uris,
     grant_types,
     response_types,
+    response_modes,
     scopes,
-    logo_url
+    logo_url,
+    subject_type,
+    sector_identifier_uri,
+    first_party,
+    access_token_lifetime,
+    refresh_token_lifetime,
+    refresh_token_idle_timeout,
+    id_token_lifetime,
+    issue_refresh_tokens,
+    access_token_format,
+    exchange_audiences,
+    token_endpoint_auth_method,
+    jwks,
+    jwks_uri,
+    dpop_bound_access_tokens,
+    tls_client_auth_subject_dn,
+    tls_client_certificate_bound_access_tokens,
+    backchannel_token_delivery_mode,
+    backchannel_client_notification_endpoint,
+    id_token_encrypted_response_alg,
+    id_token_encrypted_response_enc,
+    userinfo_encrypted_response_alg,
+    userinfo_encrypted_response_enc,
+    default_acr_values,
+    encrypted_secret_key
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34
 ) RETURNING *;
 
 -- name: ListClients :many
@@ -32,7 +57,31 @@ SET
     redirect_uris = $3,
     grant_types = $4,
     response_types = $5,
-    scopes = $6,
+    response_modes = $6,
+    scopes = $7,
+    subject_type = $8,
+    sector_identifier_uri = $9,
+    first_party = $10,
+    access_token_lifetime = $11,
+    refresh_token_lifetime = $12,
+    refresh_token_idle_timeout = $13,
+    id_token_lifetime = $14,
+    issue_refresh_tokens = $15,
+    access_token_format = $16,
+    exchange_audiences = $17,
+    token_endpoint_auth_method = $18,
+    jwks = $19,
+    jwks_uri = $20,
+    dpop_bound_access_tokens = $21,
+    tls_client_auth_subject_dn = $22,
+    tls_client_certificate_bound_access_tokens = $23,
+    backchannel_token_delivery_mode = $24,
+    backchannel_client_notification_endpoint = $25,
+    id_token_encrypted_response_alg = $26,
+    id_token_encrypted_response_enc = $27,
+    userinfo_encrypted_response_alg = $28,
+    userinfo_encrypted_response_enc = $29,
+    default_acr_values = $30,
     updated_at = NOW()
 WHERE id = $1
 RETURNING *;
@@ -0,0 +1,21 @@
+-- name: DeleteConsent :exec
+DELETE FROM consents
+WHERE user_id = $1
+  AND client_id = $2;
+
+-- name: GetConsent :one
+SELECT * FROM consents
+WHERE user_id = $1
+  AND client_id = $2
+LIMIT 1;
+
+-- name: UpsertConsent :exec
+INSERT INTO consents (
+    user_id,
+    client_id,
+    scopes
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (user_id, client_id) DO UPDATE
+SET scopes = EXCLUDED.scopes,
+    updated_at = NOW();
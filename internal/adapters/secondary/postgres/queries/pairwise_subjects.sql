@@ -0,0 +1,20 @@
+-- name: CreatePairwiseSubject :exec
+INSERT INTO pairwise_subjects (
+    sector_identifier,
+    subject,
+    user_id
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT DO NOTHING;
+
+-- name: GetPairwiseSubject :one
+SELECT * FROM pairwise_subjects
+WHERE sector_identifier = $1
+  AND subject = $2
+LIMIT 1;
+
+-- name: GetPairwiseSubjectByUser :one
+SELECT * FROM pairwise_subjects
+WHERE sector_identifier = $1
+  AND user_id = $2
+LIMIT 1;
@@ -0,0 +1,21 @@
+-- name: ReplaceRecoveryCodes :exec
+WITH deleted AS (
+    DELETE FROM recovery_codes
+    WHERE user_id = @user_id
+)
+INSERT INTO recovery_codes (
+    id,
+    user_id,
+    code_hash
+)
+SELECT unnest(@ids::uuid[]), @user_id, unnest(@code_hashes::text[]);
+
+-- name: ListRecoveryCodesByUserID :many
+SELECT * FROM recovery_codes
+WHERE user_id = $1
+ORDER BY created_at;
+
+-- name: UseRecoveryCode :execrows
+UPDATE recovery_codes
+SET used_at = NOW()
+WHERE id = $1 AND used_at IS NULL;
@@ -0,0 +1,39 @@
+-- name: CreateScope :one
+INSERT INTO scopes (
+    id,
+    name,
+    descriptions,
+    claims,
+    is_default,
+    first_party_only
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetScopeByID :one
+SELECT * FROM scopes
+WHERE id = $1 LIMIT 1;
+
+-- name: ListScopes :many
+SELECT * FROM scopes
+ORDER BY name;
+
+-- name: ListScopesByNames :many
+SELECT * FROM scopes
+WHERE name = ANY(@names::text[])
+ORDER BY name;
+
+-- name: UpdateScope :one
+UPDATE scopes
+SET 
+    descriptions = $2,
+    claims = $3,
+    is_default = $4,
+    first_party_only = $5,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteScope :exec
+DELETE FROM scopes
+WHERE id = $1;
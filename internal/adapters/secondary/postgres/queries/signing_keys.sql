@@ -0,0 +1,37 @@
+-- name: CreateSigningKey :one
+INSERT INTO signing_keys (
+    id,
+    kid,
+    algorithm,
+    state,
+    public_key,
+    encrypted_private_key,
+    activated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetCurrentSigningKey :one
+SELECT * FROM signing_keys
+WHERE algorithm = $1 AND state = 'current'
+ORDER BY activated_at DESC
+LIMIT 1;
+
+-- name: ListSigningKeys :many
+SELECT * FROM signing_keys
+ORDER BY created_at DESC;
+
+-- name: ListSigningKeysByStates :many
+SELECT * FROM signing_keys
+WHERE algorithm = @algorithm AND state = ANY(@states::text[])
+ORDER BY created_at DESC;
+
+-- name: UpdateSigningKeyState :exec
+UPDATE signing_keys
+SET 
+    state = $2,
+    activated_at = $3,
+    deactivated_at = $4,
+    retired_at = $5,
+    updated_at = NOW()
+WHERE id = $1;
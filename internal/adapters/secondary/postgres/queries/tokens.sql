@@ -9,9 +9,21 @@ INSERT INTO tokens (
     scopes,
     token_type,
     access_token_expires_at,
-    refresh_token_expires_at
+    refresh_token_expires_at,
+    claims,
+    session_id,
+    offline,
+    resources,
+    auth_time,
+    acr,
+    amr,
+    actor,
+    dpop_jkt,
+    x5t_s256,
+    authorization_details,
+    family_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22
 ) RETURNING *;
 
 -- name: GetTokenByAccessTokenHash :one
@@ -23,7 +35,6 @@ LIMIT 1;
 -- name: GetTokenByRefreshTokenHash :one
 SELECT * FROM tokens
 WHERE refresh_token_hash = $1
-  AND revoked = FALSE
   AND refresh_token_expires_at > NOW()
 LIMIT 1;
 
@@ -40,6 +51,16 @@ SET
     revoked_reason = $2
 WHERE id = $1;
 
+-- name: RevokeActiveToken :one
+UPDATE tokens
+SET
+    revoked = TRUE,
+    revoked_at = NOW(),
+    revoked_reason = $2
+WHERE id = $1
+  AND revoked_at IS NULL
+RETURNING id;
+
 -- name: RevokeTokenByAccessTokenHash :exec
 UPDATE tokens
 SET
@@ -57,6 +78,16 @@ SET
 WHERE user_id = $1
   AND revoked = FALSE;
 
+-- name: RevokeTokensBySession :exec
+UPDATE tokens
+SET
+    revoked = TRUE,
+    revoked_at = NOW(),
+    revoked_reason = $2
+WHERE session_id = $1
+  AND offline = FALSE
+  AND revoked = FALSE;
+
 -- name: RevokeTokensByClient :exec
 UPDATE tokens
 SET
@@ -75,6 +106,15 @@ SET
 WHERE authorization_code = $1
   AND revoked = FALSE;
 
+-- name: RevokeTokensByFamily :exec
+UPDATE tokens
+SET
+    revoked = TRUE,
+    revoked_at = NOW(),
+    revoked_reason = $2
+WHERE (family_id = $1 OR id = $1)
+  AND revoked = FALSE;
+
 -- name: UpdateLastUsedAt :exec
 UPDATE tokens
 SET last_used_at = NOW()
@@ -97,6 +137,14 @@ WHERE user_id = $1
   AND access_token_expires_at > NOW()
 ORDER BY created_at DESC;
 
+-- name: GetOfflineTokensByUser :many
+SELECT * FROM tokens
+WHERE user_id = $1
+  AND offline = TRUE
+  AND revoked = FALSE
+  AND refresh_token_expires_at > NOW()
+ORDER BY created_at DESC;
+
 -- name: GetActiveTokensByClient :many
 SELECT * FROM tokens
 WHERE client_id = $1
@@ -0,0 +1,31 @@
+-- name: CreateTOTPCredential :one
+INSERT INTO totp_credentials (
+    id,
+    user_id,
+    encrypted_secret
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: GetTOTPCredentialByUserID :one
+SELECT * FROM totp_credentials
+WHERE user_id = $1 LIMIT 1;
+
+-- name: ConfirmTOTPCredential :exec
+UPDATE totp_credentials
+SET
+    confirmed_at = NOW(),
+    last_used_step = $2,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: UseTOTPCredentialStep :execrows
+UPDATE totp_credentials
+SET
+    last_used_step = $2,
+    updated_at = NOW()
+WHERE id = $1 AND last_used_step < $2;
+
+-- name: DeleteTOTPCredential :exec
+DELETE FROM totp_credentials
+WHERE id = $1;
@@ -0,0 +1,46 @@
+-- name: CreateTrustedIssuer :one
+INSERT INTO trusted_issuers (
+    id,
+    issuer,
+    jwks,
+    jwks_uri,
+    audiences
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetTrustedIssuerByID :one
+SELECT * FROM trusted_issuers
+WHERE id = $1 LIMIT 1;
+
+-- name: GetTrustedIssuerByIssuer :one
+SELECT * FROM trusted_issuers
+WHERE issuer = $1 LIMIT 1;
+
+-- name: ListTrustedIssuers :many
+SELECT * FROM trusted_issuers
+ORDER BY issuer;
+
+-- name: DeleteTrustedIssuer :exec
+DELETE FROM trusted_issuers
+WHERE id = $1;
+
+-- name: CreateTrustPolicy :one
+INSERT INTO trust_policies (
+    id,
+    issuer_id,
+    client_id,
+    conditions,
+    scopes
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListTrustPoliciesByIssuerID :many
+SELECT * FROM trust_policies
+WHERE issuer_id = $1
+ORDER BY created_at;
+
+-- name: DeleteTrustPolicy :exec
+DELETE FROM trust_policies
+WHERE id = $1;
@@ -0,0 +1,40 @@
+-- name: CreateWebAuthnCredential :one
+INSERT INTO webauthn_credentials (
+    id,
+    user_id,
+    credential_id,
+    public_key,
+    sign_count,
+    transports,
+    name
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetWebAuthnCredentialByCredentialID :one
+SELECT * FROM webauthn_credentials
+WHERE credential_id = $1 LIMIT 1;
+
+-- name: ListWebAuthnCredentialsByUserID :many
+SELECT * FROM webauthn_credentials
+WHERE user_id = $1
+ORDER BY created_at;
+
+-- name: UpdateWebAuthnCredentialSignCount :exec
+UPDATE webauthn_credentials
+SET
+    sign_count = $2,
+    last_used_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: RenameWebAuthnCredential :execrows
+UPDATE webauthn_credentials
+SET
+    name = $3,
+    updated_at = NOW()
+WHERE id = $1 AND user_id = $2;
+
+-- name: DeleteWebAuthnCredential :execrows
+DELETE FROM webauthn_credentials
+WHERE id = $1 AND user_id = $2;
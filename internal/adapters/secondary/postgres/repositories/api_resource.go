@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/db"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type APIResourceRepository struct {
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+func NewAPIResourceRepository(pool *pgxpool.Pool) ports.APIResourceRepository {
+	return &APIResourceRepository{
+		queries: db.New(pool),
+		pool:    pool,
+	}
+}
+
+func (r *APIResourceRepository) Create(ctx context.Context, resource *domain.APIResource) error {
+	_, err := r.queries.CreateAPIResource(ctx, db.CreateAPIResourceParams{
+		ID:                pgtype.UUID{Bytes: resource.ID, Valid: true},
+		Identifier:        resource.Identifier,
+		Name:              resource.Name,
+		Scopes:            nonNilStrings(resource.Scopes),
+		AccessTokenFormat: resource.AccessTokenFormat,
+		ClientID:          resource.ClientID,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrUniqueKeyViolation
+		}
+
+		return fmt.Errorf("create API resource: %w", err)
+	}
+
+	return nil
+}
+
+func (r *APIResourceRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.APIResource, error) {
+	resource, err := r.queries.GetAPIResourceByID(ctx, pgtype.UUID{Bytes: id, Valid: true})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ports.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("get API resource by ID: %w", err)
+	}
+
+	return r.toDomain(resource), nil
+}
+
+func (r *APIResourceRepository) List(ctx context.Context) ([]*domain.APIResource, error) {
+	resources, err := r.queries.ListAPIResources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list API resources: %w", err)
+	}
+
+	return r.toDomainList(resources), nil
+}
+
+func (r *APIResourceRepository) ListByClientID(ctx context.Context, clientID string) ([]*domain.APIResource, error) {
+	resources, err := r.queries.ListAPIResourcesByClientID(ctx, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("list API resources by client ID: %w", err)
+	}
+
+	return r.toDomainList(resources), nil
+}
+
+func (r *APIResourceRepository) ListByIdentifiers(ctx context.Context, identifiers []string) ([]*domain.APIResource, error) {
+	resources, err := r.queries.ListAPIResourcesByIdentifiers(ctx, nonNilStrings(identifiers))
+	if err != nil {
+		return nil, fmt.Errorf("list API resources by identifiers: %w", err)
+	}
+
+	return r.toDomainList(resources), nil
+}
+
+func (r *APIResourceRepository) Update(ctx context.Context, resource *domain.APIResource) error {
+	_, err := r.queries.UpdateAPIResource(ctx, db.UpdateAPIResourceParams{
+		ID:                pgtype.UUID{Bytes: resource.ID, Valid: true},
+		Name:              resource.Name,
+		Scopes:            nonNilStrings(resource.Scopes),
+		AccessTokenFormat: resource.AccessTokenFormat,
+		ClientID:          resource.ClientID,
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return ports.ErrNotFound
+		}
+
+		return fmt.Errorf("update API resource: %w", err)
+	}
+
+	return nil
+}
+
+func (r *APIResourceRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteAPIResource(ctx, pgtype.UUID{Bytes: id, Valid: true}); err != nil {
+		return fmt.Errorf("delete API resource: %w", err)
+	}
+
+	return nil
+}
+
+func (r *APIResourceRepository) toDomainList(resources []db.ApiResource) []*domain.APIResource {
+	result := make([]*domain.APIResource, 0, len(resources))
+	for _, resource := range resources {
+		result = append(result, r.toDomain(resource))
+	}
+
+	return result
+}
+
+func (r *APIResourceRepository) toDomain(resource db.ApiResource) *domain.APIResource {
+	return &domain.APIResource{
+		ID:                resource.ID.Bytes,
+		Identifier:        resource.Identifier,
+		Name:              resource.Name,
+		Scopes:            resource.Scopes,
+		AccessTokenFormat: resource.AccessTokenFormat,
+		ClientID:          resource.ClientID,
+		CreatedAt:         resource.CreatedAt.Time,
+		UpdatedAt:         resource.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/db"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AuditEventRepository struct {
+	queries *db.Queries
+}
+
+func NewAuditEventRepository(pool *pgxpool.Pool) ports.AuditEventRepository {
+	return &AuditEventRepository{
+		queries: db.New(pool),
+	}
+}
+
+func (r *AuditEventRepository) Create(ctx context.Context, event *domain.AuditEvent) error {
+	details := event.Details
+	if details == nil {
+		details = map[string]any{}
+	}
+
+	data, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("marshal audit event details: %w", err)
+	}
+
+	err = r.queries.CreateAuditEvent(ctx, db.CreateAuditEventParams{
+		ID:      pgtype.UUID{Bytes: event.ID, Valid: true},
+		UserID:  pgtype.UUID{Bytes: event.UserID, Valid: true},
+		Type:    event.Type,
+		Details: data,
+	})
+	if err != nil {
+		return fmt.Errorf("create audit event: %w", err)
+	}
+
+	return nil
+}
@@ -52,16 +52,33 @@ func (r *AuthorizationCodeRepository) Create(ctx context.Context, code *domain.A
 		scopes = []string{}
 	}
 
-	_, err := r.queries.CreateAuthorizationCode(ctx, db.CreateAuthorizationCodeParams{
-		Code:                code.Code,
-		ClientID:            code.ClientID,
-		UserID:              userID,
-		RedirectUri:         code.RedirectURI,
-		Scopes:              scopes,
-		Nonce:               nonce,
-		CodeChallenge:       codeChallenge,
-		CodeChallengeMethod: codeChallengeMethod,
-		ExpiresAt:           expiresAt,
+	claims, err := marshalClaimsRequest(code.Claims)
+	if err != nil {
+		return err
+	}
+
+	authorizationDetails, err := marshalAuthorizationDetails(code.AuthorizationDetails)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.queries.CreateAuthorizationCode(ctx, db.CreateAuthorizationCodeParams{
+		Code:                 code.Code,
+		ClientID:             code.ClientID,
+		UserID:               userID,
+		RedirectUri:          code.RedirectURI,
+		Scopes:               scopes,
+		Nonce:                nonce,
+		CodeChallenge:        codeChallenge,
+		CodeChallengeMethod:  codeChallengeMethod,
+		Claims:               claims,
+		SessionID:            nullableUUID(code.SessionID),
+		Resources:            nonNilStrings(code.Resources),
+		AuthTime:             nullableTimestamp(code.AuthTime),
+		Acr:                  pgtype.Text{String: code.ACR, Valid: code.ACR != ""},
+		Amr:                  nonNilStrings(code.AMR),
+		AuthorizationDetails: authorizationDetails,
+		ExpiresAt:            expiresAt,
 	})
 
 	return err
@@ -77,18 +94,35 @@ func (a *AuthorizationCodeRepository) GetByCode(ctx context.Context, code string
 		return nil, err
 	}
 
+	claims, err := unmarshalClaimsRequest(ac.Claims)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationDetails, err := unmarshalAuthorizationDetails(ac.AuthorizationDetails)
+	if err != nil {
+		return nil, err
+	}
+
 	return &domain.AuthorizationCode{
-		Code:                ac.Code,
-		ClientID:            ac.ClientID,
-		UserID:              ac.UserID.Bytes,
-		RedirectURI:         ac.RedirectUri,
-		Scopes:              ac.Scopes,
-		Nonce:               ac.Nonce.String,
-		CodeChallenge:       ac.CodeChallenge.String,
-		CodeChallengeMethod: ac.CodeChallengeMethod.String,
-		Used:                ac.Used,
-		ExpiresAt:           ac.ExpiresAt.Time,
-		CreatedAt:           ac.CreatedAt.Time,
+		Code:                 ac.Code,
+		ClientID:             ac.ClientID,
+		UserID:               ac.UserID.Bytes,
+		RedirectURI:          ac.RedirectUri,
+		Scopes:               ac.Scopes,
+		Nonce:                ac.Nonce.String,
+		CodeChallenge:        ac.CodeChallenge.String,
+		CodeChallengeMethod:  ac.CodeChallengeMethod.String,
+		Claims:               claims,
+		SessionID:            ac.SessionID.Bytes,
+		Resources:            ac.Resources,
+		AuthTime:             ac.AuthTime.Time,
+		ACR:                  ac.Acr.String,
+		AMR:                  ac.Amr,
+		AuthorizationDetails: authorizationDetails,
+		Used:                 ac.Used,
+		ExpiresAt:            ac.ExpiresAt.Time,
+		CreatedAt:            ac.CreatedAt.Time,
 	}, nil
 }
 
@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/db"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AuthorizationDetailTypeRepository struct {
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+func NewAuthorizationDetailTypeRepository(pool *pgxpool.Pool) ports.AuthorizationDetailTypeRepository {
+	return &AuthorizationDetailTypeRepository{
+		queries: db.New(pool),
+		pool:    pool,
+	}
+}
+
+func (r *AuthorizationDetailTypeRepository) Create(ctx context.Context, detailType *domain.AuthorizationDetailType) error {
+	_, err := r.queries.CreateAuthorizationDetailType(ctx, db.CreateAuthorizationDetailTypeParams{
+		ID:          pgtype.UUID{Bytes: detailType.ID, Valid: true},
+		Type:        detailType.Type,
+		Description: detailType.Description,
+		Fields:      nonNilStrings(detailType.Fields),
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrUniqueKeyViolation
+		}
+
+		return fmt.Errorf("create authorization detail type: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AuthorizationDetailTypeRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.AuthorizationDetailType, error) {
+	detailType, err := r.queries.GetAuthorizationDetailTypeByID(ctx, pgtype.UUID{Bytes: id, Valid: true})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ports.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("get authorization detail type by ID: %w", err)
+	}
+
+	return r.toDomain(detailType), nil
+}
+
+func (r *AuthorizationDetailTypeRepository) List(ctx context.Context) ([]*domain.AuthorizationDetailType, error) {
+	detailTypes, err := r.queries.ListAuthorizationDetailTypes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list authorization detail types: %w", err)
+	}
+
+	return r.toDomainList(detailTypes), nil
+}
+
+func (r *AuthorizationDetailTypeRepository) ListByTypes(ctx context.Context, types []string) ([]*domain.AuthorizationDetailType, error) {
+	detailTypes, err := r.queries.ListAuthorizationDetailTypesByTypes(ctx, nonNilStrings(types))
+	if err != nil {
+		return nil, fmt.Errorf("list authorization detail types by types: %w", err)
+	}
+
+	return r.toDomainList(detailTypes), nil
+}
+
+func (r *AuthorizationDetailTypeRepository) Update(ctx context.Context, detailType *domain.AuthorizationDetailType) error {
+	_, err := r.queries.UpdateAuthorizationDetailType(ctx, db.UpdateAuthorizationDetailTypeParams{
+		ID:          pgtype.UUID{Bytes: detailType.ID, Valid: true},
+		Description: detailType.Description,
+		Fields:      nonNilStrings(detailType.Fields),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return ports.ErrNotFound
+		}
+
+		return fmt.Errorf("update authorization detail type: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AuthorizationDetailTypeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteAuthorizationDetailType(ctx, pgtype.UUID{Bytes: id, Valid: true}); err != nil {
+		return fmt.Errorf("delete authorization detail type: %w", err)
+	}
+
+	return nil
+}
+
+func (r *AuthorizationDetailTypeRepository) toDomainList(detailTypes []db.AuthorizationDetailType) []*domain.AuthorizationDetailType {
+	result := make([]*domain.AuthorizationDetailType, 0, len(detailTypes))
+	for _, detailType := range detailTypes {
+		result = append(result, r.toDomain(detailType))
+	}
+
+	return result
+}
+
+func (r *AuthorizationDetailTypeRepository) toDomain(detailType db.AuthorizationDetailType) *domain.AuthorizationDetailType {
+	return &domain.AuthorizationDetailType{
+		ID:          detailType.ID.Bytes,
+		Type:        detailType.Type,
+		Description: detailType.Description,
+		Fields:      detailType.Fields,
+		CreatedAt:   detailType.CreatedAt.Time,
+		UpdatedAt:   detailType.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+func marshalClaimsRequest(claims *domain.ClaimsRequest) ([]byte, error) {
+	if claims == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return nil, fmt.Errorf("marshal claims request: %w", err)
+	}
+
+	return data, nil
+}
+
+func unmarshalClaimsRequest(data []byte) (*domain.ClaimsRequest, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var claims domain.ClaimsRequest
+	if err := json.Unmarshal(data, &claims); err != nil {
+		return nil, fmt.Errorf("unmarshal claims request: %w", err)
+	}
+
+	return &claims, nil
+}
+
+func marshalActor(actor *domain.Actor) ([]byte, error) {
+	if actor == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(actor)
+	if err != nil {
+		return nil, fmt.Errorf("marshal actor: %w", err)
+	}
+
+	return data, nil
+}
+
+func unmarshalActor(data []byte) (*domain.Actor, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var actor domain.Actor
+	if err := json.Unmarshal(data, &actor); err != nil {
+		return nil, fmt.Errorf("unmarshal actor: %w", err)
+	}
+
+	return &actor, nil
+}
+
+func marshalKeySet(keySet *domain.JSONWebKeySet) ([]byte, error) {
+	if keySet == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(keySet)
+	if err != nil {
+		return nil, fmt.Errorf("marshal key set: %w", err)
+	}
+
+	return data, nil
+}
+
+func unmarshalKeySet(data []byte) (*domain.JSONWebKeySet, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var keySet domain.JSONWebKeySet
+	if err := json.Unmarshal(data, &keySet); err != nil {
+		return nil, fmt.Errorf("unmarshal key set: %w", err)
+	}
+
+	return &keySet, nil
+}
+
+func marshalAuthorizationDetails(details domain.AuthorizationDetails) ([]byte, error) {
+	if len(details) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(details)
+	if err != nil {
+		return nil, fmt.Errorf("marshal authorization details: %w", err)
+	}
+
+	return data, nil
+}
+
+func unmarshalAuthorizationDetails(data []byte) (domain.AuthorizationDetails, error) {
+	details, err := domain.ParseAuthorizationDetails(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal authorization details: %w", err)
+	}
+
+	return details, nil
+}
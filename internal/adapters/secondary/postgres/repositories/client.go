@@ -1,8 +1,10 @@
 package repositories
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/db"
 	"github.com/g-villarinho/oidc-server/internal/core/domain"
@@ -30,16 +32,46 @@ func (r *ClientRepository) Create(ctx context.Context, client *domain.Client) er
 		Valid: true,
 	}
 
-	_, err := r.queries.CreateClient(ctx, db.CreateClientParams{
-		ID:            pgUUID,
-		ClientID:      client.ClientID,
-		ClientSecret:  client.ClientSecret,
-		ClientName:    client.ClientName,
-		RedirectUris:  client.RedirectURIs,
-		GrantTypes:    client.GrantTypes,
-		ResponseTypes: client.ResponseTypes,
-		Scopes:        client.Scopes,
-		LogoUrl:       client.LogoURL,
+	jwks, err := marshalKeySet(client.JWKS)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.queries.CreateClient(ctx, db.CreateClientParams{
+		ID:                                    pgUUID,
+		ClientID:                              client.ClientID,
+		ClientSecret:                          client.ClientSecret,
+		ClientName:                            client.ClientName,
+		RedirectUris:                          client.RedirectURIs,
+		GrantTypes:                            client.GrantTypes,
+		ResponseTypes:                         client.ResponseTypes,
+		ResponseModes:                         client.ResponseModes,
+		Scopes:                                client.Scopes,
+		LogoUrl:                               client.LogoURL,
+		SubjectType:                           client.SubjectType,
+		SectorIdentifierUri:                   client.SectorIdentifierURI,
+		FirstParty:                            client.FirstParty,
+		AccessTokenLifetime:                   durationToSeconds(client.TokenPolicy.AccessTokenLifetime),
+		RefreshTokenLifetime:                  durationToSeconds(client.TokenPolicy.RefreshTokenLifetime),
+		RefreshTokenIdleTimeout:               durationToSeconds(client.TokenPolicy.RefreshTokenIdleTimeout),
+		IDTokenLifetime:                       durationToSeconds(client.TokenPolicy.IDTokenLifetime),
+		IssueRefreshTokens:                    client.TokenPolicy.IssueRefreshTokens,
+		AccessTokenFormat:                     cmp.Or(client.TokenPolicy.AccessTokenFormat, domain.AccessTokenFormatJWT),
+		ExchangeAudiences:                     nonNilStrings(client.ExchangeAudiences),
+		TokenEndpointAuthMethod:               cmp.Or(client.TokenEndpointAuthMethod, domain.TokenEndpointAuthMethodClientSecretPost),
+		Jwks:                                  jwks,
+		JwksUri:                               client.JWKSURI,
+		DpopBoundAccessTokens:                 client.DPoPBoundAccessTokens,
+		TlsClientAuthSubjectDn:                client.TLSClientAuthSubjectDN,
+		TlsClientCertificateBoundAccessTokens: client.TLSClientCertificateBoundAccessTokens,
+		BackchannelTokenDeliveryMode:          client.BackchannelTokenDeliveryMode,
+		BackchannelClientNotificationEndpoint: client.BackchannelClientNotificationEndpoint,
+		IDTokenEncryptedResponseAlg:           client.IDTokenEncryption.Alg,
+		IDTokenEncryptedResponseEnc:           client.IDTokenEncryption.Enc,
+		UserinfoEncryptedResponseAlg:          client.UserInfoEncryption.Alg,
+		UserinfoEncryptedResponseEnc:          client.UserInfoEncryption.Enc,
+		DefaultAcrValues:                      nonNilStrings(client.DefaultACRValues),
+		EncryptedSecretKey:                    client.EncryptedSecretKey,
 	})
 
 	return err
@@ -55,17 +87,7 @@ func (r *ClientRepository) GetByClientID(ctx context.Context, clientID string) (
 		return nil, fmt.Errorf("get client by clientID: %w", err)
 	}
 
-	return &domain.Client{
-		ID:            client.ID.Bytes,
-		ClientID:      client.ClientID,
-		ClientSecret:  client.ClientSecret,
-		ClientName:    client.ClientName,
-		RedirectURIs:  client.RedirectUris,
-		GrantTypes:    client.GrantTypes,
-		ResponseTypes: client.ResponseTypes,
-		Scopes:        client.Scopes,
-		LogoURL:       client.LogoUrl,
-	}, nil
+	return r.toDomain(client)
 }
 
 func (r *ClientRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Client, error) {
@@ -82,19 +104,7 @@ func (r *ClientRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.C
 		return nil, fmt.Errorf("get client by ID: %w", err)
 	}
 
-	return &domain.Client{
-		ID:            client.ID.Bytes,
-		ClientID:      client.ClientID,
-		ClientSecret:  client.ClientSecret,
-		ClientName:    client.ClientName,
-		RedirectURIs:  client.RedirectUris,
-		GrantTypes:    client.GrantTypes,
-		ResponseTypes: client.ResponseTypes,
-		Scopes:        client.Scopes,
-		LogoURL:       client.LogoUrl,
-		CreatedAt:     client.CreatedAt.Time,
-		UpdatedAt:     client.UpdatedAt.Time,
-	}, nil
+	return r.toDomain(client)
 }
 
 func (r *ClientRepository) List(ctx context.Context) ([]*domain.Client, error) {
@@ -105,19 +115,11 @@ func (r *ClientRepository) List(ctx context.Context) ([]*domain.Client, error) {
 
 	result := make([]*domain.Client, 0, len(clients))
 	for _, client := range clients {
-		result = append(result, &domain.Client{
-			ID:            client.ID.Bytes,
-			ClientID:      client.ClientID,
-			ClientSecret:  client.ClientSecret,
-			ClientName:    client.ClientName,
-			RedirectURIs:  client.RedirectUris,
-			GrantTypes:    client.GrantTypes,
-			ResponseTypes: client.ResponseTypes,
-			Scopes:        client.Scopes,
-			LogoURL:       client.LogoUrl,
-			CreatedAt:     client.CreatedAt.Time,
-			UpdatedAt:     client.UpdatedAt.Time,
-		})
+		domainClient, err := r.toDomain(client)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, domainClient)
 	}
 
 	return result, nil
@@ -129,13 +131,42 @@ func (r *ClientRepository) Update(ctx context.Context, client *domain.Client) er
 		Valid: true,
 	}
 
-	_, err := r.queries.UpdateClient(ctx, db.UpdateClientParams{
-		ID:            pgUUID,
-		ClientName:    client.ClientName,
-		RedirectUris:  client.RedirectURIs,
-		GrantTypes:    client.GrantTypes,
-		ResponseTypes: client.ResponseTypes,
-		Scopes:        client.Scopes,
+	jwks, err := marshalKeySet(client.JWKS)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.queries.UpdateClient(ctx, db.UpdateClientParams{
+		ID:                                    pgUUID,
+		ClientName:                            client.ClientName,
+		RedirectUris:                          client.RedirectURIs,
+		GrantTypes:                            client.GrantTypes,
+		ResponseTypes:                         client.ResponseTypes,
+		ResponseModes:                         client.ResponseModes,
+		Scopes:                                client.Scopes,
+		SubjectType:                           client.SubjectType,
+		SectorIdentifierUri:                   client.SectorIdentifierURI,
+		FirstParty:                            client.FirstParty,
+		AccessTokenLifetime:                   durationToSeconds(client.TokenPolicy.AccessTokenLifetime),
+		RefreshTokenLifetime:                  durationToSeconds(client.TokenPolicy.RefreshTokenLifetime),
+		RefreshTokenIdleTimeout:               durationToSeconds(client.TokenPolicy.RefreshTokenIdleTimeout),
+		IDTokenLifetime:                       durationToSeconds(client.TokenPolicy.IDTokenLifetime),
+		IssueRefreshTokens:                    client.TokenPolicy.IssueRefreshTokens,
+		AccessTokenFormat:                     cmp.Or(client.TokenPolicy.AccessTokenFormat, domain.AccessTokenFormatJWT),
+		ExchangeAudiences:                     nonNilStrings(client.ExchangeAudiences),
+		TokenEndpointAuthMethod:               cmp.Or(client.TokenEndpointAuthMethod, domain.TokenEndpointAuthMethodClientSecretPost),
+		Jwks:                                  jwks,
+		JwksUri:                               client.JWKSURI,
+		DpopBoundAccessTokens:                 client.DPoPBoundAccessTokens,
+		TlsClientAuthSubjectDn:                client.TLSClientAuthSubjectDN,
+		TlsClientCertificateBoundAccessTokens: client.TLSClientCertificateBoundAccessTokens,
+		BackchannelTokenDeliveryMode:          client.BackchannelTokenDeliveryMode,
+		BackchannelClientNotificationEndpoint: client.BackchannelClientNotificationEndpoint,
+		IDTokenEncryptedResponseAlg:           client.IDTokenEncryption.Alg,
+		IDTokenEncryptedResponseEnc:           client.IDTokenEncryption.Enc,
+		UserinfoEncryptedResponseAlg:          client.UserInfoEncryption.Alg,
+		UserinfoEncryptedResponseEnc:          client.UserInfoEncryption.Enc,
+		DefaultAcrValues:                      nonNilStrings(client.DefaultACRValues),
 	})
 
 	if err != nil {
@@ -158,3 +189,63 @@ func (r *ClientRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+func (r *ClientRepository) toDomain(client db.OauthClient) (*domain.Client, error) {
+	jwks, err := unmarshalKeySet(client.Jwks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Client{
+		ID:                  client.ID.Bytes,
+		ClientID:            client.ClientID,
+		ClientSecret:        client.ClientSecret,
+		ClientName:          client.ClientName,
+		RedirectURIs:        client.RedirectUris,
+		GrantTypes:          client.GrantTypes,
+		ResponseTypes:       client.ResponseTypes,
+		ResponseModes:       client.ResponseModes,
+		Scopes:              client.Scopes,
+		LogoURL:             client.LogoUrl,
+		SubjectType:         client.SubjectType,
+		SectorIdentifierURI: client.SectorIdentifierUri,
+		FirstParty:          client.FirstParty,
+		TokenPolicy: domain.TokenPolicy{
+			AccessTokenLifetime:     secondsToDuration(client.AccessTokenLifetime),
+			RefreshTokenLifetime:    secondsToDuration(client.RefreshTokenLifetime),
+			RefreshTokenIdleTimeout: secondsToDuration(client.RefreshTokenIdleTimeout),
+			IDTokenLifetime:         secondsToDuration(client.IDTokenLifetime),
+			IssueRefreshTokens:      client.IssueRefreshTokens,
+			AccessTokenFormat:       client.AccessTokenFormat,
+		},
+		ExchangeAudiences:                     client.ExchangeAudiences,
+		TokenEndpointAuthMethod:               client.TokenEndpointAuthMethod,
+		JWKS:                                  jwks,
+		JWKSURI:                               client.JwksUri,
+		DPoPBoundAccessTokens:                 client.DpopBoundAccessTokens,
+		TLSClientAuthSubjectDN:                client.TlsClientAuthSubjectDn,
+		TLSClientCertificateBoundAccessTokens: client.TlsClientCertificateBoundAccessTokens,
+		BackchannelTokenDeliveryMode:          client.BackchannelTokenDeliveryMode,
+		BackchannelClientNotificationEndpoint: client.BackchannelClientNotificationEndpoint,
+		IDTokenEncryption: domain.ResponseEncryption{
+			Alg: client.IDTokenEncryptedResponseAlg,
+			Enc: client.IDTokenEncryptedResponseEnc,
+		},
+		UserInfoEncryption: domain.ResponseEncryption{
+			Alg: client.UserinfoEncryptedResponseAlg,
+			Enc: client.UserinfoEncryptedResponseEnc,
+		},
+		DefaultACRValues:   client.DefaultAcrValues,
+		EncryptedSecretKey: client.EncryptedSecretKey,
+		CreatedAt:          client.CreatedAt.Time,
+		UpdatedAt:          client.UpdatedAt.Time,
+	}, nil
+}
+
+func durationToSeconds(duration time.Duration) int32 {
+	return int32(duration / time.Second)
+}
+
+func secondsToDuration(seconds int32) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
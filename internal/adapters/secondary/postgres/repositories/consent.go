@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/db"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ConsentRepository struct {
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+func NewConsentRepository(pool *pgxpool.Pool) ports.ConsentRepository {
+	return &ConsentRepository{
+		queries: db.New(pool),
+		pool:    pool,
+	}
+}
+
+func (r *ConsentRepository) Save(ctx context.Context, consent *domain.Consent) error {
+	err := r.queries.UpsertConsent(ctx, db.UpsertConsentParams{
+		UserID:   pgtype.UUID{Bytes: consent.UserID, Valid: true},
+		ClientID: consent.ClientID,
+		Scopes:   consent.Scopes,
+	})
+	if err != nil {
+		return fmt.Errorf("upsert consent: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ConsentRepository) Get(ctx context.Context, userID uuid.UUID, clientID string) (*domain.Consent, error) {
+	consent, err := r.queries.GetConsent(ctx, db.GetConsentParams{
+		UserID:   pgtype.UUID{Bytes: userID, Valid: true},
+		ClientID: clientID,
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ports.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("get consent: %w", err)
+	}
+
+	return r.toDomain(consent), nil
+}
+
+func (r *ConsentRepository) Delete(ctx context.Context, userID uuid.UUID, clientID string) error {
+	err := r.queries.DeleteConsent(ctx, db.DeleteConsentParams{
+		UserID:   pgtype.UUID{Bytes: userID, Valid: true},
+		ClientID: clientID,
+	})
+	if err != nil {
+		return fmt.Errorf("delete consent: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ConsentRepository) toDomain(consent db.Consent) *domain.Consent {
+	return &domain.Consent{
+		UserID:    consent.UserID.Bytes,
+		ClientID:  consent.ClientID,
+		Scopes:    consent.Scopes,
+		CreatedAt: consent.CreatedAt.Time,
+		UpdatedAt: consent.UpdatedAt.Time,
+	}
+}
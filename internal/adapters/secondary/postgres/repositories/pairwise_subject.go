@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/db"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PairwiseSubjectRepository struct {
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+func NewPairwiseSubjectRepository(pool *pgxpool.Pool) ports.PairwiseSubjectRepository {
+	return &PairwiseSubjectRepository{
+		queries: db.New(pool),
+		pool:    pool,
+	}
+}
+
+func (r *PairwiseSubjectRepository) Create(ctx context.Context, subject *domain.PairwiseSubject) error {
+	err := r.queries.CreatePairwiseSubject(ctx, db.CreatePairwiseSubjectParams{
+		SectorIdentifier: subject.SectorIdentifier,
+		Subject:          subject.Subject,
+		UserID:           pgtype.UUID{Bytes: subject.UserID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("create pairwise subject: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PairwiseSubjectRepository) GetBySubject(ctx context.Context, sectorIdentifier string, subject string) (*domain.PairwiseSubject, error) {
+	pairwiseSubject, err := r.queries.GetPairwiseSubject(ctx, db.GetPairwiseSubjectParams{
+		SectorIdentifier: sectorIdentifier,
+		Subject:          subject,
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ports.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("get pairwise subject: %w", err)
+	}
+
+	return r.toDomain(pairwiseSubject), nil
+}
+
+func (r *PairwiseSubjectRepository) GetByUser(ctx context.Context, sectorIdentifier string, userID uuid.UUID) (*domain.PairwiseSubject, error) {
+	pairwiseSubject, err := r.queries.GetPairwiseSubjectByUser(ctx, db.GetPairwiseSubjectByUserParams{
+		SectorIdentifier: sectorIdentifier,
+		UserID:           pgtype.UUID{Bytes: userID, Valid: true},
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ports.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("get pairwise subject by user: %w", err)
+	}
+
+	return r.toDomain(pairwiseSubject), nil
+}
+
+func (r *PairwiseSubjectRepository) toDomain(pairwiseSubject db.PairwiseSubject) *domain.PairwiseSubject {
+	return &domain.PairwiseSubject{
+		SectorIdentifier: pairwiseSubject.SectorIdentifier,
+		Subject:          pairwiseSubject.Subject,
+		UserID:           pairwiseSubject.UserID.Bytes,
+		CreatedAt:        pairwiseSubject.CreatedAt.Time,
+	}
+}
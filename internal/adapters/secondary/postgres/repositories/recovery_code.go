@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/db"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RecoveryCodeRepository struct {
+	queries *db.Queries
+}
+
+func NewRecoveryCodeRepository(pool *pgxpool.Pool) ports.RecoveryCodeRepository {
+	return &RecoveryCodeRepository{
+		queries: db.New(pool),
+	}
+}
+
+func (r *RecoveryCodeRepository) Replace(ctx context.Context, userID uuid.UUID, codes []*domain.RecoveryCode) error {
+	ids := make([]pgtype.UUID, 0, len(codes))
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		ids = append(ids, pgtype.UUID{Bytes: code.ID, Valid: true})
+		hashes = append(hashes, code.CodeHash)
+	}
+
+	err := r.queries.ReplaceRecoveryCodes(ctx, db.ReplaceRecoveryCodesParams{
+		UserID:     pgtype.UUID{Bytes: userID, Valid: true},
+		Ids:        ids,
+		CodeHashes: hashes,
+	})
+	if err != nil {
+		return fmt.Errorf("replace recovery codes: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RecoveryCodeRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.RecoveryCode, error) {
+	codes, err := r.queries.ListRecoveryCodesByUserID(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("list recovery codes: %w", err)
+	}
+
+	result := make([]*domain.RecoveryCode, 0, len(codes))
+	for _, code := range codes {
+		result = append(result, &domain.RecoveryCode{
+			ID:        code.ID.Bytes,
+			UserID:    code.UserID.Bytes,
+			CodeHash:  code.CodeHash,
+			UsedAt:    timePointer(code.UsedAt),
+			CreatedAt: code.CreatedAt.Time,
+		})
+	}
+
+	return result, nil
+}
+
+func (r *RecoveryCodeRepository) Use(ctx context.Context, id uuid.UUID) (bool, error) {
+	rows, err := r.queries.UseRecoveryCode(ctx, pgtype.UUID{Bytes: id, Valid: true})
+	if err != nil {
+		return false, fmt.Errorf("use recovery code: %w", err)
+	}
+
+	return rows == 1, nil
+}
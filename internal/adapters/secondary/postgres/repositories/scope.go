@@ -0,0 +1,165 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/db"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ScopeRepository struct {
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+func NewScopeRepository(pool *pgxpool.Pool) ports.ScopeRepository {
+	return &ScopeRepository{
+		queries: db.New(pool),
+		pool:    pool,
+	}
+}
+
+func (r *ScopeRepository) Create(ctx context.Context, scope *domain.Scope) error {
+	descriptions, err := marshalDescriptions(scope.Descriptions)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.queries.CreateScope(ctx, db.CreateScopeParams{
+		ID:             pgtype.UUID{Bytes: scope.ID, Valid: true},
+		Name:           scope.Name,
+		Descriptions:   descriptions,
+		Claims:         nonNilStrings(scope.Claims),
+		IsDefault:      scope.Default,
+		FirstPartyOnly: scope.FirstPartyOnly,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrUniqueKeyViolation
+		}
+
+		return fmt.Errorf("create scope: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ScopeRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Scope, error) {
+	scope, err := r.queries.GetScopeByID(ctx, pgtype.UUID{Bytes: id, Valid: true})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ports.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("get scope by ID: %w", err)
+	}
+
+	return r.toDomain(scope)
+}
+
+func (r *ScopeRepository) List(ctx context.Context) ([]*domain.Scope, error) {
+	scopes, err := r.queries.ListScopes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list scopes: %w", err)
+	}
+
+	return r.toDomainList(scopes)
+}
+
+func (r *ScopeRepository) ListByNames(ctx context.Context, names []string) ([]*domain.Scope, error) {
+	scopes, err := r.queries.ListScopesByNames(ctx, nonNilStrings(names))
+	if err != nil {
+		return nil, fmt.Errorf("list scopes by names: %w", err)
+	}
+
+	return r.toDomainList(scopes)
+}
+
+func (r *ScopeRepository) Update(ctx context.Context, scope *domain.Scope) error {
+	descriptions, err := marshalDescriptions(scope.Descriptions)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.queries.UpdateScope(ctx, db.UpdateScopeParams{
+		ID:             pgtype.UUID{Bytes: scope.ID, Valid: true},
+		Descriptions:   descriptions,
+		Claims:         nonNilStrings(scope.Claims),
+		IsDefault:      scope.Default,
+		FirstPartyOnly: scope.FirstPartyOnly,
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return ports.ErrNotFound
+		}
+
+		return fmt.Errorf("update scope: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ScopeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteScope(ctx, pgtype.UUID{Bytes: id, Valid: true}); err != nil {
+		return fmt.Errorf("delete scope: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ScopeRepository) toDomainList(scopes []db.Scope) ([]*domain.Scope, error) {
+	result := make([]*domain.Scope, 0, len(scopes))
+	for _, scope := range scopes {
+		s, err := r.toDomain(scope)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+func (r *ScopeRepository) toDomain(scope db.Scope) (*domain.Scope, error) {
+	descriptions := map[string]string{}
+	if err := json.Unmarshal(scope.Descriptions, &descriptions); err != nil {
+		return nil, fmt.Errorf("unmarshal scope descriptions: %w", err)
+	}
+
+	return &domain.Scope{
+		ID:             scope.ID.Bytes,
+		Name:           scope.Name,
+		Descriptions:   descriptions,
+		Claims:         scope.Claims,
+		Default:        scope.IsDefault,
+		FirstPartyOnly: scope.FirstPartyOnly,
+		CreatedAt:      scope.CreatedAt.Time,
+		UpdatedAt:      scope.UpdatedAt.Time,
+	}, nil
+}
+
+func marshalDescriptions(descriptions map[string]string) ([]byte, error) {
+	if descriptions == nil {
+		descriptions = map[string]string{}
+	}
+
+	data, err := json.Marshal(descriptions)
+	if err != nil {
+		return nil, fmt.Errorf("marshal scope descriptions: %w", err)
+	}
+
+	return data, nil
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
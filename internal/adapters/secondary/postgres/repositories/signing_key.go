@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/db"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SigningKeyRepository struct {
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+func NewSigningKeyRepository(pool *pgxpool.Pool) ports.SigningKeyRepository {
+	return &SigningKeyRepository{
+		queries: db.New(pool),
+		pool:    pool,
+	}
+}
+
+func (r *SigningKeyRepository) Create(ctx context.Context, key *domain.SigningKey) error {
+	publicKey, err := json.Marshal(key.PublicKey)
+	if err != nil {
+		return fmt.Errorf("marshal public key: %w", err)
+	}
+
+	_, err = r.queries.CreateSigningKey(ctx, db.CreateSigningKeyParams{
+		ID:                  pgtype.UUID{Bytes: key.ID, Valid: true},
+		Kid:                 key.KeyID,
+		Algorithm:           key.Algorithm,
+		State:               key.State,
+		PublicKey:           publicKey,
+		EncryptedPrivateKey: key.EncryptedPrivateKey,
+		ActivatedAt:         optionalTimestamp(key.ActivatedAt),
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrUniqueKeyViolation
+		}
+
+		return fmt.Errorf("create signing key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SigningKeyRepository) GetCurrent(ctx context.Context, algorithm string) (*domain.SigningKey, error) {
+	key, err := r.queries.GetCurrentSigningKey(ctx, algorithm)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ports.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("get current signing key: %w", err)
+	}
+
+	return r.toDomain(key)
+}
+
+func (r *SigningKeyRepository) List(ctx context.Context) ([]*domain.SigningKey, error) {
+	keys, err := r.queries.ListSigningKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list signing keys: %w", err)
+	}
+
+	return r.toDomainList(keys)
+}
+
+func (r *SigningKeyRepository) ListByStates(ctx context.Context, algorithm string, states []string) ([]*domain.SigningKey, error) {
+	keys, err := r.queries.ListSigningKeysByStates(ctx, db.ListSigningKeysByStatesParams{
+		Algorithm: algorithm,
+		States:    nonNilStrings(states),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list signing keys by states: %w", err)
+	}
+
+	return r.toDomainList(keys)
+}
+
+func (r *SigningKeyRepository) Update(ctx context.Context, key *domain.SigningKey) error {
+	err := r.queries.UpdateSigningKeyState(ctx, db.UpdateSigningKeyStateParams{
+		ID:            pgtype.UUID{Bytes: key.ID, Valid: true},
+		State:         key.State,
+		ActivatedAt:   optionalTimestamp(key.ActivatedAt),
+		DeactivatedAt: optionalTimestamp(key.DeactivatedAt),
+		RetiredAt:     optionalTimestamp(key.RetiredAt),
+	})
+	if err != nil {
+		return fmt.Errorf("update signing key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SigningKeyRepository) toDomain(key db.SigningKey) (*domain.SigningKey, error) {
+	var publicKey domain.JSONWebKey
+	if err := json.Unmarshal(key.PublicKey, &publicKey); err != nil {
+		return nil, fmt.Errorf("unmarshal public key: %w", err)
+	}
+
+	return &domain.SigningKey{
+		ID:                  key.ID.Bytes,
+		KeyID:               key.Kid,
+		Algorithm:           key.Algorithm,
+		State:               key.State,
+		PublicKey:           publicKey,
+		EncryptedPrivateKey: key.EncryptedPrivateKey,
+		ActivatedAt:         timePointer(key.ActivatedAt),
+		DeactivatedAt:       timePointer(key.DeactivatedAt),
+		RetiredAt:           timePointer(key.RetiredAt),
+		CreatedAt:           key.CreatedAt.Time,
+		UpdatedAt:           key.UpdatedAt.Time,
+	}, nil
+}
+
+func (r *SigningKeyRepository) toDomainList(keys []db.SigningKey) ([]*domain.SigningKey, error) {
+	result := make([]*domain.SigningKey, 0, len(keys))
+	for _, key := range keys {
+		signingKey, err := r.toDomain(key)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, signingKey)
+	}
+	return result, nil
+}
+
+func optionalTimestamp(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+	return nullableTimestamp(*t)
+}
+
+func timePointer(t pgtype.Timestamp) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
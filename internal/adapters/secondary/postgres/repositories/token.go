@@ -32,7 +32,7 @@ func (r *TokenRepository) Create(ctx context.Context, token *domain.Token) error
 
 	userID := pgtype.UUID{
 		Bytes: token.UserID,
-		Valid: true,
+		Valid: !token.IsClientToken(),
 	}
 
 	accessTokenExpiresAt := pgtype.Timestamp{
@@ -53,19 +53,51 @@ func (r *TokenRepository) Create(ctx context.Context, token *domain.Token) error
 		}
 	}
 
+	refreshTokenHash := pgtype.Text{
+		String: token.RefreshTokenHash,
+		Valid:  token.RefreshTokenHash != "",
+	}
+
 	scopes := token.Scopes
 	if scopes == nil {
 		scopes = []string{}
 	}
 
-	_, err := r.queries.CreateToken(ctx, db.CreateTokenParams{
+	claims, err := marshalClaimsRequest(token.Claims)
+	if err != nil {
+		return err
+	}
+
+	actor, err := marshalActor(token.Actor)
+	if err != nil {
+		return err
+	}
+
+	authorizationDetails, err := marshalAuthorizationDetails(token.AuthorizationDetails)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.queries.CreateToken(ctx, db.CreateTokenParams{
 		ID:                   id,
 		AccessTokenHash:      token.AccessTokenHash,
-		RefreshTokenHash:     token.RefreshTokenHash,
+		RefreshTokenHash:     refreshTokenHash,
 		AuthorizationCode:    authCodePtr,
 		ClientID:             token.ClientID,
 		UserID:               userID,
 		Scopes:               scopes,
+		Claims:               claims,
+		SessionID:            nullableUUID(token.SessionID),
+		Offline:              token.Offline,
+		Resources:            nonNilStrings(token.Resources),
+		AuthTime:             nullableTimestamp(token.AuthTime),
+		Acr:                  pgtype.Text{String: token.ACR, Valid: token.ACR != ""},
+		Amr:                  nonNilStrings(token.AMR),
+		Actor:                actor,
+		DpopJkt:              pgtype.Text{String: token.DPoPJKT, Valid: token.IsDPoPBound()},
+		X5tS256:              pgtype.Text{String: token.CertificateThumbprint, Valid: token.IsCertificateBound()},
+		AuthorizationDetails: authorizationDetails,
+		FamilyID:             nullableUUID(token.FamilyID),
 		TokenType:            token.TokenType,
 		AccessTokenExpiresAt: accessTokenExpiresAt,
 		RefreshTokenExpiresAt: refreshTokenExpiresAt,
@@ -83,11 +115,11 @@ func (r *TokenRepository) GetByAccessTokenHash(ctx context.Context, accessTokenH
 		return nil, err
 	}
 
-	return r.mapTokenToDomain(t), nil
+	return r.mapTokenToDomain(t)
 }
 
 func (r *TokenRepository) GetByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*domain.Token, error) {
-	t, err := r.queries.GetTokenByRefreshTokenHash(ctx, refreshTokenHash)
+	t, err := r.queries.GetTokenByRefreshTokenHash(ctx, pgtype.Text{String: refreshTokenHash, Valid: true})
 	if err != nil {
 		if isNotFound(err) {
 			return nil, ports.ErrNotFound
@@ -95,7 +127,7 @@ func (r *TokenRepository) GetByRefreshTokenHash(ctx context.Context, refreshToke
 		return nil, err
 	}
 
-	return r.mapTokenToDomain(t), nil
+	return r.mapTokenToDomain(t)
 }
 
 func (r *TokenRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Token, error) {
@@ -112,7 +144,7 @@ func (r *TokenRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.To
 		return nil, err
 	}
 
-	return r.mapTokenToDomain(t), nil
+	return r.mapTokenToDomain(t)
 }
 
 func (r *TokenRepository) Revoke(ctx context.Context, id uuid.UUID, reason string) error {
@@ -127,6 +159,22 @@ func (r *TokenRepository) Revoke(ctx context.Context, id uuid.UUID, reason strin
 	})
 }
 
+// RevokeActive revokes the token unless it already was, reporting whether this call revoked it.
+func (r *TokenRepository) RevokeActive(ctx context.Context, id uuid.UUID, reason string) (bool, error) {
+	_, err := r.queries.RevokeActiveToken(ctx, db.RevokeActiveTokenParams{
+		ID:            pgtype.UUID{Bytes: id, Valid: true},
+		RevokedReason: pgtype.Text{String: reason, Valid: true},
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (r *TokenRepository) RevokeByAccessTokenHash(ctx context.Context, accessTokenHash string, reason string) error {
 	return r.queries.RevokeTokenByAccessTokenHash(ctx, db.RevokeTokenByAccessTokenHashParams{
 		AccessTokenHash: accessTokenHash,
@@ -141,6 +189,38 @@ func (r *TokenRepository) RevokeByAuthorizationCode(ctx context.Context, authori
 	})
 }
 
+func (r *TokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID, reason string) error {
+	return r.queries.RevokeTokensByFamily(ctx, db.RevokeTokensByFamilyParams{
+		FamilyID:      pgtype.UUID{Bytes: familyID, Valid: true},
+		RevokedReason: pgtype.Text{String: reason, Valid: true},
+	})
+}
+
+func (r *TokenRepository) RevokeBySession(ctx context.Context, sessionID uuid.UUID, reason string) error {
+	return r.queries.RevokeTokensBySession(ctx, db.RevokeTokensBySessionParams{
+		SessionID:     pgtype.UUID{Bytes: sessionID, Valid: true},
+		RevokedReason: pgtype.Text{String: reason, Valid: true},
+	})
+}
+
+func (r *TokenRepository) ListOfflineByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Token, error) {
+	rows, err := r.queries.GetOfflineTokensByUser(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*domain.Token, 0, len(rows))
+	for _, row := range rows {
+		token, err := r.mapTokenToDomain(row)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
 func (r *TokenRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID) error {
 	tokenID := pgtype.UUID{
 		Bytes: id,
@@ -150,7 +230,7 @@ func (r *TokenRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID) erro
 	return r.queries.UpdateLastUsedAt(ctx, tokenID)
 }
 
-func (r *TokenRepository) mapTokenToDomain(t db.Token) *domain.Token {
+func (r *TokenRepository) mapTokenToDomain(t db.Token) (*domain.Token, error) {
 	var authCode *string
 	if t.AuthorizationCode.Valid {
 		authCode = &t.AuthorizationCode.String
@@ -171,14 +251,47 @@ func (r *TokenRepository) mapTokenToDomain(t db.Token) *domain.Token {
 		lastUsedAt = &t.LastUsedAt.Time
 	}
 
+	claims, err := unmarshalClaimsRequest(t.Claims)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := unmarshalActor(t.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationDetails, err := unmarshalAuthorizationDetails(t.AuthorizationDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens issued before families were recorded start their own.
+	familyID := t.ID.Bytes
+	if t.FamilyID.Valid {
+		familyID = t.FamilyID.Bytes
+	}
+
 	return &domain.Token{
 		ID:                    t.ID.Bytes,
 		AccessTokenHash:       t.AccessTokenHash,
-		RefreshTokenHash:      t.RefreshTokenHash,
+		RefreshTokenHash:      t.RefreshTokenHash.String,
 		AuthorizationCode:     authCode,
 		ClientID:              t.ClientID,
 		UserID:                t.UserID.Bytes,
 		Scopes:                t.Scopes,
+		Claims:                claims,
+		SessionID:             t.SessionID.Bytes,
+		Offline:               t.Offline,
+		Resources:             t.Resources,
+		AuthTime:              t.AuthTime.Time,
+		ACR:                   t.Acr.String,
+		AMR:                   t.Amr,
+		Actor:                 actor,
+		DPoPJKT:               t.DpopJkt.String,
+		CertificateThumbprint: t.X5tS256.String,
+		AuthorizationDetails:  authorizationDetails,
+		FamilyID:              familyID,
 		TokenType:             t.TokenType,
 		AccessTokenExpiresAt:  t.AccessTokenExpiresAt.Time,
 		RefreshTokenExpiresAt: t.RefreshTokenExpiresAt.Time,
@@ -187,5 +300,19 @@ func (r *TokenRepository) mapTokenToDomain(t db.Token) *domain.Token {
 		RevokedReason:         revokedReason,
 		CreatedAt:             t.CreatedAt.Time,
 		LastUsedAt:            lastUsedAt,
+	}, nil
+}
+
+func nullableUUID(id uuid.UUID) pgtype.UUID {
+	return pgtype.UUID{
+		Bytes: id,
+		Valid: id != uuid.Nil,
+	}
+}
+
+func nullableTimestamp(t time.Time) pgtype.Timestamp {
+	return pgtype.Timestamp{
+		Time:  t,
+		Valid: !t.IsZero(),
 	}
 }
@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/db"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TOTPCredentialRepository struct {
+	queries *db.Queries
+}
+
+func NewTOTPCredentialRepository(pool *pgxpool.Pool) ports.TOTPCredentialRepository {
+	return &TOTPCredentialRepository{
+		queries: db.New(pool),
+	}
+}
+
+func (r *TOTPCredentialRepository) Create(ctx context.Context, credential *domain.TOTPCredential) error {
+	_, err := r.queries.CreateTOTPCredential(ctx, db.CreateTOTPCredentialParams{
+		ID:              pgtype.UUID{Bytes: credential.ID, Valid: true},
+		UserID:          pgtype.UUID{Bytes: credential.UserID, Valid: true},
+		EncryptedSecret: credential.EncryptedSecret,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrUniqueKeyViolation
+		}
+
+		return fmt.Errorf("create TOTP credential: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TOTPCredentialRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*domain.TOTPCredential, error) {
+	credential, err := r.queries.GetTOTPCredentialByUserID(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ports.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("get TOTP credential: %w", err)
+	}
+
+	return &domain.TOTPCredential{
+		ID:              credential.ID.Bytes,
+		UserID:          credential.UserID.Bytes,
+		EncryptedSecret: credential.EncryptedSecret,
+		LastUsedStep:    credential.LastUsedStep,
+		ConfirmedAt:     timePointer(credential.ConfirmedAt),
+		CreatedAt:       credential.CreatedAt.Time,
+		UpdatedAt:       credential.UpdatedAt.Time,
+	}, nil
+}
+
+func (r *TOTPCredentialRepository) Confirm(ctx context.Context, id uuid.UUID, step int64) error {
+	err := r.queries.ConfirmTOTPCredential(ctx, db.ConfirmTOTPCredentialParams{
+		ID:           pgtype.UUID{Bytes: id, Valid: true},
+		LastUsedStep: step,
+	})
+	if err != nil {
+		return fmt.Errorf("confirm TOTP credential: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TOTPCredentialRepository) UseStep(ctx context.Context, id uuid.UUID, step int64) (bool, error) {
+	rows, err := r.queries.UseTOTPCredentialStep(ctx, db.UseTOTPCredentialStepParams{
+		ID:           pgtype.UUID{Bytes: id, Valid: true},
+		LastUsedStep: step,
+	})
+	if err != nil {
+		return false, fmt.Errorf("use TOTP credential step: %w", err)
+	}
+
+	return rows == 1, nil
+}
+
+func (r *TOTPCredentialRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteTOTPCredential(ctx, pgtype.UUID{Bytes: id, Valid: true}); err != nil {
+		return fmt.Errorf("delete TOTP credential: %w", err)
+	}
+
+	return nil
+}
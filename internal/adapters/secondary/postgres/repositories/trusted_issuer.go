@@ -0,0 +1,173 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/db"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type TrustedIssuerRepository struct {
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+func NewTrustedIssuerRepository(pool *pgxpool.Pool) ports.TrustedIssuerRepository {
+	return &TrustedIssuerRepository{
+		queries: db.New(pool),
+		pool:    pool,
+	}
+}
+
+func (r *TrustedIssuerRepository) Create(ctx context.Context, issuer *domain.TrustedIssuer) error {
+	jwks, err := marshalKeySet(issuer.JWKS)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.queries.CreateTrustedIssuer(ctx, db.CreateTrustedIssuerParams{
+		ID:        pgtype.UUID{Bytes: issuer.ID, Valid: true},
+		Issuer:    issuer.Issuer,
+		Jwks:      jwks,
+		JwksUri:   issuer.JWKSURI,
+		Audiences: nonNilStrings(issuer.Audiences),
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrUniqueKeyViolation
+		}
+
+		return fmt.Errorf("create trusted issuer: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TrustedIssuerRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.TrustedIssuer, error) {
+	issuer, err := r.queries.GetTrustedIssuerByID(ctx, pgtype.UUID{Bytes: id, Valid: true})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ports.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("get trusted issuer by ID: %w", err)
+	}
+
+	return r.toDomain(issuer)
+}
+
+func (r *TrustedIssuerRepository) GetByIssuer(ctx context.Context, issuer string) (*domain.TrustedIssuer, error) {
+	trustedIssuer, err := r.queries.GetTrustedIssuerByIssuer(ctx, issuer)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ports.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("get trusted issuer by issuer: %w", err)
+	}
+
+	return r.toDomain(trustedIssuer)
+}
+
+func (r *TrustedIssuerRepository) List(ctx context.Context) ([]*domain.TrustedIssuer, error) {
+	issuers, err := r.queries.ListTrustedIssuers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list trusted issuers: %w", err)
+	}
+
+	result := make([]*domain.TrustedIssuer, 0, len(issuers))
+	for _, issuer := range issuers {
+		trustedIssuer, err := r.toDomain(issuer)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, trustedIssuer)
+	}
+
+	return result, nil
+}
+
+func (r *TrustedIssuerRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteTrustedIssuer(ctx, pgtype.UUID{Bytes: id, Valid: true}); err != nil {
+		return fmt.Errorf("delete trusted issuer: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TrustedIssuerRepository) CreatePolicy(ctx context.Context, policy *domain.TrustPolicy) error {
+	conditions, err := json.Marshal(policy.Conditions)
+	if err != nil {
+		return fmt.Errorf("marshal trust policy conditions: %w", err)
+	}
+
+	_, err = r.queries.CreateTrustPolicy(ctx, db.CreateTrustPolicyParams{
+		ID:         pgtype.UUID{Bytes: policy.ID, Valid: true},
+		IssuerID:   pgtype.UUID{Bytes: policy.IssuerID, Valid: true},
+		ClientID:   policy.ClientID,
+		Conditions: conditions,
+		Scopes:     nonNilStrings(policy.Scopes),
+	})
+	if err != nil {
+		return fmt.Errorf("create trust policy: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TrustedIssuerRepository) ListPolicies(ctx context.Context, issuerID uuid.UUID) ([]*domain.TrustPolicy, error) {
+	policies, err := r.queries.ListTrustPoliciesByIssuerID(ctx, pgtype.UUID{Bytes: issuerID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("list trust policies: %w", err)
+	}
+
+	result := make([]*domain.TrustPolicy, 0, len(policies))
+	for _, policy := range policies {
+		var conditions map[string]string
+		if err := json.Unmarshal(policy.Conditions, &conditions); err != nil {
+			return nil, fmt.Errorf("unmarshal trust policy conditions: %w", err)
+		}
+
+		result = append(result, &domain.TrustPolicy{
+			ID:         policy.ID.Bytes,
+			IssuerID:   policy.IssuerID.Bytes,
+			ClientID:   policy.ClientID,
+			Conditions: conditions,
+			Scopes:     policy.Scopes,
+			CreatedAt:  policy.CreatedAt.Time,
+		})
+	}
+
+	return result, nil
+}
+
+func (r *TrustedIssuerRepository) DeletePolicy(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteTrustPolicy(ctx, pgtype.UUID{Bytes: id, Valid: true}); err != nil {
+		return fmt.Errorf("delete trust policy: %w", err)
+	}
+
+	return nil
+}
+
+func (r *TrustedIssuerRepository) toDomain(issuer db.TrustedIssuer) (*domain.TrustedIssuer, error) {
+	jwks, err := unmarshalKeySet(issuer.Jwks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TrustedIssuer{
+		ID:        issuer.ID.Bytes,
+		Issuer:    issuer.Issuer,
+		JWKS:      jwks,
+		JWKSURI:   issuer.JwksUri,
+		Audiences: issuer.Audiences,
+		CreatedAt: issuer.CreatedAt.Time,
+		UpdatedAt: issuer.UpdatedAt.Time,
+	}, nil
+}
@@ -103,6 +103,23 @@ func (u *UserRepository) Update(ctx context.Context, user *domain.User) error {
 	return nil
 }
 
+func (u *UserRepository) VerifyEmail(ctx context.Context, id uuid.UUID) error {
+	pgUUID := pgtype.UUID{
+		Bytes: id,
+		Valid: true,
+	}
+
+	if _, err := u.queries.VerifyEmail(ctx, pgUUID); err != nil {
+		if isNotFound(err) {
+			return ports.ErrNotFound
+		}
+
+		return fmt.Errorf("verify user email: %w", err)
+	}
+
+	return nil
+}
+
 func (r *UserRepository) toDomain(user db.User) *domain.User {
 	return &domain.User{
 		ID:            user.ID.Bytes,
@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/db"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WebAuthnCredentialRepository struct {
+	queries *db.Queries
+}
+
+func NewWebAuthnCredentialRepository(pool *pgxpool.Pool) ports.WebAuthnCredentialRepository {
+	return &WebAuthnCredentialRepository{
+		queries: db.New(pool),
+	}
+}
+
+func (r *WebAuthnCredentialRepository) Create(ctx context.Context, credential *domain.WebAuthnCredential) error {
+	_, err := r.queries.CreateWebAuthnCredential(ctx, db.CreateWebAuthnCredentialParams{
+		ID:           pgtype.UUID{Bytes: credential.ID, Valid: true},
+		UserID:       pgtype.UUID{Bytes: credential.UserID, Valid: true},
+		CredentialID: credential.CredentialID,
+		PublicKey:    credential.PublicKey,
+		SignCount:    int64(credential.SignCount),
+		Transports:   nonNilStrings(credential.Transports),
+		Name:         credential.Name,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ports.ErrUniqueKeyViolation
+		}
+
+		return fmt.Errorf("create webauthn credential: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebAuthnCredentialRepository) GetByCredentialID(ctx context.Context, credentialID []byte) (*domain.WebAuthnCredential, error) {
+	credential, err := r.queries.GetWebAuthnCredentialByCredentialID(ctx, credentialID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ports.ErrNotFound
+		}
+
+		return nil, fmt.Errorf("get webauthn credential: %w", err)
+	}
+
+	return r.toDomain(credential), nil
+}
+
+func (r *WebAuthnCredentialRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.WebAuthnCredential, error) {
+	credentials, err := r.queries.ListWebAuthnCredentialsByUserID(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("list webauthn credentials: %w", err)
+	}
+
+	result := make([]*domain.WebAuthnCredential, 0, len(credentials))
+	for _, credential := range credentials {
+		result = append(result, r.toDomain(credential))
+	}
+
+	return result, nil
+}
+
+func (r *WebAuthnCredentialRepository) UpdateSignCount(ctx context.Context, id uuid.UUID, signCount uint32) error {
+	err := r.queries.UpdateWebAuthnCredentialSignCount(ctx, db.UpdateWebAuthnCredentialSignCountParams{
+		ID:        pgtype.UUID{Bytes: id, Valid: true},
+		SignCount: int64(signCount),
+	})
+	if err != nil {
+		return fmt.Errorf("update webauthn credential sign count: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebAuthnCredentialRepository) Rename(ctx context.Context, userID uuid.UUID, id uuid.UUID, name string) error {
+	rows, err := r.queries.RenameWebAuthnCredential(ctx, db.RenameWebAuthnCredentialParams{
+		ID:     pgtype.UUID{Bytes: id, Valid: true},
+		UserID: pgtype.UUID{Bytes: userID, Valid: true},
+		Name:   name,
+	})
+	if err != nil {
+		return fmt.Errorf("rename webauthn credential: %w", err)
+	}
+
+	if rows == 0 {
+		return ports.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *WebAuthnCredentialRepository) Delete(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	rows, err := r.queries.DeleteWebAuthnCredential(ctx, db.DeleteWebAuthnCredentialParams{
+		ID:     pgtype.UUID{Bytes: id, Valid: true},
+		UserID: pgtype.UUID{Bytes: userID, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("delete webauthn credential: %w", err)
+	}
+
+	if rows == 0 {
+		return ports.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *WebAuthnCredentialRepository) toDomain(credential db.WebauthnCredential) *domain.WebAuthnCredential {
+	return &domain.WebAuthnCredential{
+		ID:           credential.ID.Bytes,
+		UserID:       credential.UserID.Bytes,
+		CredentialID: credential.CredentialID,
+		PublicKey:    credential.PublicKey,
+		SignCount:    uint32(credential.SignCount),
+		Transports:   credential.Transports,
+		Name:         credential.Name,
+		LastUsedAt:   timePointer(credential.LastUsedAt),
+		CreatedAt:    credential.CreatedAt.Time,
+		UpdatedAt:    credential.UpdatedAt.Time,
+	}
+}
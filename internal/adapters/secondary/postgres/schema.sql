@@ -7,8 +7,33 @@ CREATE TABLE oauth_clients (
     redirect_uris TEXT[] NOT NULL,
     grant_types TEXT[] NOT NULL,
     response_types TEXT[] NOT NULL,
+    response_modes TEXT[] NOT NULL DEFAULT '{}',
     scopes TEXT[] NOT NULL,
     logo_url TEXT NOT NULL,
+    subject_type VARCHAR(20) NOT NULL DEFAULT 'public',
+    sector_identifier_uri TEXT NOT NULL DEFAULT '',
+    first_party BOOLEAN NOT NULL DEFAULT FALSE,
+    access_token_lifetime INTEGER NOT NULL DEFAULT 0,
+    refresh_token_lifetime INTEGER NOT NULL DEFAULT 0,
+    refresh_token_idle_timeout INTEGER NOT NULL DEFAULT 0,
+    id_token_lifetime INTEGER NOT NULL DEFAULT 0,
+    issue_refresh_tokens BOOLEAN NOT NULL DEFAULT TRUE,
+    access_token_format VARCHAR(16) NOT NULL DEFAULT 'jwt',
+    exchange_audiences TEXT[] NOT NULL DEFAULT '{}',
+    token_endpoint_auth_method VARCHAR(32) NOT NULL DEFAULT 'client_secret_post',
+    jwks JSONB,
+    jwks_uri TEXT NOT NULL DEFAULT '',
+    dpop_bound_access_tokens BOOLEAN NOT NULL DEFAULT FALSE,
+    tls_client_auth_subject_dn VARCHAR(1024) NOT NULL DEFAULT '',
+    tls_client_certificate_bound_access_tokens BOOLEAN NOT NULL DEFAULT FALSE,
+    backchannel_token_delivery_mode VARCHAR(16) NOT NULL DEFAULT '',
+    backchannel_client_notification_endpoint TEXT NOT NULL DEFAULT '',
+    id_token_encrypted_response_alg VARCHAR(32) NOT NULL DEFAULT '',
+    id_token_encrypted_response_enc VARCHAR(32) NOT NULL DEFAULT '',
+    userinfo_encrypted_response_alg VARCHAR(32) NOT NULL DEFAULT '',
+    userinfo_encrypted_response_enc VARCHAR(32) NOT NULL DEFAULT '',
+    default_acr_values TEXT[] NOT NULL DEFAULT '{}',
+    encrypted_secret_key BYTEA,
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW()
 );
@@ -24,6 +49,55 @@ CREATE TABLE users (
     updated_at TIMESTAMP NOT NULL DEFAULT NOW()
 );
 
+-- Tabela de credenciais TOTP (segundo fator)
+CREATE TABLE totp_credentials (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    encrypted_secret BYTEA NOT NULL,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    confirmed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Tabela de credenciais WebAuthn (passkeys e chaves de segurança)
+CREATE TABLE webauthn_credentials (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    credential_id BYTEA NOT NULL UNIQUE,
+    public_key BYTEA NOT NULL,
+    sign_count BIGINT NOT NULL DEFAULT 0,
+    transports TEXT[] NOT NULL DEFAULT '{}',
+    name VARCHAR(255) NOT NULL,
+    last_used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webauthn_credentials_user_id ON webauthn_credentials(user_id);
+
+-- Tabela de códigos de recuperação do MFA (uso único)
+CREATE TABLE recovery_codes (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(255) NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_recovery_codes_user_id ON recovery_codes(user_id);
+
+-- Trilha de auditoria dos eventos de segurança das contas
+CREATE TABLE audit_events (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(100) NOT NULL,
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_audit_events_user_id ON audit_events(user_id);
+
 -- Tabela de authorization codes
 CREATE TABLE authorization_codes (
     code VARCHAR(255) PRIMARY KEY,
@@ -34,6 +108,13 @@ CREATE TABLE authorization_codes (
     nonce VARCHAR(255),
     code_challenge VARCHAR(255),
     code_challenge_method VARCHAR(10),
+    claims JSONB,
+    session_id UUID,
+    resources TEXT[] NOT NULL DEFAULT '{}',
+    auth_time TIMESTAMP,
+    acr VARCHAR(255),
+    amr TEXT[] NOT NULL DEFAULT '{}',
+    authorization_details JSONB,
     used BOOLEAN NOT NULL DEFAULT FALSE,
     expires_at TIMESTAMP NOT NULL,
     created_at TIMESTAMP NOT NULL DEFAULT NOW()
@@ -47,11 +128,23 @@ CREATE INDEX idx_auth_codes_used ON authorization_codes(used) WHERE used = FALSE
 CREATE TABLE tokens (
     id UUID PRIMARY KEY,
     access_token_hash VARCHAR(64) NOT NULL UNIQUE,
-    refresh_token_hash VARCHAR(64) NOT NULL UNIQUE,
+    refresh_token_hash VARCHAR(64) UNIQUE,
     authorization_code VARCHAR(255) REFERENCES authorization_codes(code) ON DELETE SET NULL,
     client_id VARCHAR(255) NOT NULL REFERENCES oauth_clients(client_id) ON DELETE CASCADE,
-    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
     scopes TEXT[] NOT NULL,
+    claims JSONB,
+    session_id UUID,
+    offline BOOLEAN NOT NULL DEFAULT FALSE,
+    resources TEXT[] NOT NULL DEFAULT '{}',
+    auth_time TIMESTAMP,
+    acr VARCHAR(255),
+    amr TEXT[] NOT NULL DEFAULT '{}',
+    actor JSONB,
+    dpop_jkt VARCHAR(64),
+    x5t_s256 VARCHAR(64),
+    authorization_details JSONB,
+    family_id UUID,
     token_type VARCHAR(50) NOT NULL DEFAULT 'Bearer',
     access_token_expires_at TIMESTAMP NOT NULL,
     refresh_token_expires_at TIMESTAMP NOT NULL,
@@ -69,4 +162,108 @@ CREATE INDEX idx_tokens_user_id ON tokens(user_id);
 CREATE INDEX idx_tokens_client_id ON tokens(client_id);
 CREATE INDEX idx_tokens_revoked ON tokens(revoked) WHERE revoked = FALSE;
 CREATE INDEX idx_tokens_auth_code ON tokens(authorization_code) WHERE authorization_code IS NOT NULL;
+CREATE INDEX idx_tokens_family_id ON tokens(family_id) WHERE family_id IS NOT NULL;
+
+
+-- Tabela de subjects pairwise
+CREATE TABLE pairwise_subjects (
+    sector_identifier VARCHAR(255) NOT NULL,
+    subject VARCHAR(64) NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (sector_identifier, subject)
+);
+
+CREATE INDEX idx_pairwise_subjects_user_id ON pairwise_subjects(user_id);
+CREATE UNIQUE INDEX idx_pairwise_subjects_sector_user ON pairwise_subjects(sector_identifier, user_id);
+
+-- Tabela de consentimentos dos usuários por client
+CREATE TABLE consents (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    client_id VARCHAR(255) NOT NULL REFERENCES oauth_clients(client_id) ON DELETE CASCADE,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, client_id)
+);
+
+-- Tabela de scopes
+CREATE TABLE scopes (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    descriptions JSONB NOT NULL DEFAULT '{}',
+    claims TEXT[] NOT NULL DEFAULT '{}',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    first_party_only BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO scopes (id, name, descriptions, claims, is_default) VALUES
+    (gen_random_uuid(), 'openid', '{"en": "Sign you in", "pt-BR": "Fazer seu login"}', '{}', TRUE),
+    (gen_random_uuid(), 'profile', '{"en": "View your basic profile", "pt-BR": "Ver seu perfil básico"}', '{name,updated_at}', TRUE),
+    (gen_random_uuid(), 'email', '{"en": "View your email address", "pt-BR": "Ver seu endereço de e-mail"}', '{email,email_verified}', FALSE),
+    (gen_random_uuid(), 'offline_access', '{"en": "Keep access to your data while you are signed out", "pt-BR": "Manter acesso aos seus dados enquanto você estiver desconectado"}', '{}', FALSE);
+
+-- Tabela de API resources (RFC 8707)
+CREATE TABLE api_resources (
+    id UUID PRIMARY KEY,
+    identifier TEXT NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    access_token_format VARCHAR(16) NOT NULL DEFAULT '',
+    client_id VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_api_resources_client_id ON api_resources(client_id);
+
+-- Tabela de tipos de authorization details (RFC 9396)
+CREATE TABLE authorization_detail_types (
+    id UUID PRIMARY KEY,
+    type VARCHAR(255) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    fields TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Tabela de emissores externos confiáveis (workload identity federation)
+CREATE TABLE trusted_issuers (
+    id UUID PRIMARY KEY,
+    issuer TEXT NOT NULL UNIQUE,
+    jwks JSONB,
+    jwks_uri TEXT NOT NULL DEFAULT '',
+    audiences TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE trust_policies (
+    id UUID PRIMARY KEY,
+    issuer_id UUID NOT NULL REFERENCES trusted_issuers(id) ON DELETE CASCADE,
+    client_id VARCHAR(255) NOT NULL REFERENCES oauth_clients(client_id) ON DELETE CASCADE,
+    conditions JSONB NOT NULL DEFAULT '{}',
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_trust_policies_issuer_id ON trust_policies(issuer_id);
+
+-- Tabela de chaves de assinatura (rotação com validade sobreposta)
+CREATE TABLE signing_keys (
+    id UUID PRIMARY KEY,
+    kid VARCHAR(255) NOT NULL UNIQUE,
+    algorithm VARCHAR(16) NOT NULL,
+    state VARCHAR(16) NOT NULL,
+    public_key JSONB NOT NULL,
+    encrypted_private_key BYTEA NOT NULL,
+    activated_at TIMESTAMP,
+    deactivated_at TIMESTAMP,
+    retired_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
 
+CREATE INDEX idx_signing_keys_state ON signing_keys(state);
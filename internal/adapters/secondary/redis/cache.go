@@ -6,14 +6,22 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
 	"github.com/redis/go-redis/v9"
 )
 
+var deleteIfEqualScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
 type cache struct {
 	client *redis.Client
 }
 
-func NewCache(client *redis.Client) *cache {
+func NewCache(client *redis.Client) ports.Cache {
 	return &cache{
 		client: client,
 	}
@@ -46,6 +54,14 @@ func (c *cache) Delete(ctx context.Context, key string) error {
 	return nil
 }
 
+func (c *cache) DeleteIfEqual(ctx context.Context, key string, value string) (bool, error) {
+	deleted, err := deleteIfEqualScript.Run(ctx, c.client, []string{key}, value).Int64()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete key %s: %w", key, err)
+	}
+	return deleted > 0, nil
+}
+
 func (c *cache) Exists(ctx context.Context, key string) (bool, error) {
 	count, err := c.client.Exists(ctx, key).Result()
 	if err != nil {
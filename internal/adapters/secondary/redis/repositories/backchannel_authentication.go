@@ -0,0 +1,85 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/pkg/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+type BackchannelAuthenticationRepository struct {
+	client *redis.Client
+}
+
+func NewBackchannelAuthenticationRepository(client *redis.Client) ports.BackchannelAuthenticationRepository {
+	return &BackchannelAuthenticationRepository{
+		client: client,
+	}
+}
+
+func (r *BackchannelAuthenticationRepository) Create(ctx context.Context, request *domain.BackchannelAuthenticationRequest) error {
+	key := cache.BackchannelAuthenticationKey(request.AuthReqID)
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("marshal backchannel authentication request: %w", err)
+	}
+
+	if err := r.client.Set(ctx, key, data, request.TTL()).Err(); err != nil {
+		return fmt.Errorf("store backchannel authentication request: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BackchannelAuthenticationRepository) GetByAuthReqID(ctx context.Context, authReqID string) (*domain.BackchannelAuthenticationRequest, error) {
+	key := cache.BackchannelAuthenticationKey(authReqID)
+
+	data, err := r.client.Get(ctx, key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, ports.ErrNotFound
+		}
+		return nil, fmt.Errorf("get backchannel authentication request: %w", err)
+	}
+
+	var request domain.BackchannelAuthenticationRequest
+	if err := json.Unmarshal([]byte(data), &request); err != nil {
+		return nil, fmt.Errorf("unmarshal backchannel authentication request: %w", err)
+	}
+
+	return &request, nil
+}
+
+// Update stores the request's new state, keeping the expiry it was created with.
+func (r *BackchannelAuthenticationRepository) Update(ctx context.Context, request *domain.BackchannelAuthenticationRequest) error {
+	key := cache.BackchannelAuthenticationKey(request.AuthReqID)
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		return fmt.Errorf("marshal backchannel authentication request: %w", err)
+	}
+
+	if err := r.client.SetArgs(ctx, key, data, redis.SetArgs{Mode: "XX", KeepTTL: true}).Err(); err != nil {
+		if err == redis.Nil {
+			return ports.ErrNotFound
+		}
+		return fmt.Errorf("update backchannel authentication request: %w", err)
+	}
+
+	return nil
+}
+
+func (r *BackchannelAuthenticationRepository) Delete(ctx context.Context, authReqID string) error {
+	key := cache.BackchannelAuthenticationKey(authReqID)
+
+	if err := r.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("delete backchannel authentication request: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/pkg/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+type LoginTicketRepository struct {
+	client *redis.Client
+}
+
+func NewLoginTicketRepository(client *redis.Client) ports.LoginTicketRepository {
+	return &LoginTicketRepository{
+		client: client,
+	}
+}
+
+func (r *LoginTicketRepository) Create(ctx context.Context, ticket *domain.LoginTicket) error {
+	key := cache.LoginTicketKey(ticket.ID)
+
+	data, err := json.Marshal(ticket)
+	if err != nil {
+		return fmt.Errorf("marshal login ticket: %w", err)
+	}
+
+	if err := r.client.Set(ctx, key, data, ticket.TTL()).Err(); err != nil {
+		return fmt.Errorf("store login ticket: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LoginTicketRepository) GetByID(ctx context.Context, ticketID string) (*domain.LoginTicket, error) {
+	key := cache.LoginTicketKey(ticketID)
+
+	data, err := r.client.Get(ctx, key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, ports.ErrNotFound
+		}
+		return nil, fmt.Errorf("get login ticket: %w", err)
+	}
+
+	var ticket domain.LoginTicket
+	if err := json.Unmarshal([]byte(data), &ticket); err != nil {
+		return nil, fmt.Errorf("unmarshal login ticket: %w", err)
+	}
+
+	return &ticket, nil
+}
+
+func (r *LoginTicketRepository) Delete(ctx context.Context, ticketID string) error {
+	key := cache.LoginTicketKey(ticketID)
+
+	if err := r.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("delete login ticket: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/pkg/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+type WebAuthnChallengeRepository struct {
+	client *redis.Client
+}
+
+func NewWebAuthnChallengeRepository(client *redis.Client) ports.WebAuthnChallengeRepository {
+	return &WebAuthnChallengeRepository{
+		client: client,
+	}
+}
+
+func (r *WebAuthnChallengeRepository) Create(ctx context.Context, challenge *domain.WebAuthnChallenge) error {
+	key := cache.WebAuthnChallengeKey(challenge.ID)
+
+	data, err := json.Marshal(challenge)
+	if err != nil {
+		return fmt.Errorf("marshal webauthn challenge: %w", err)
+	}
+
+	if err := r.client.Set(ctx, key, data, challenge.TTL()).Err(); err != nil {
+		return fmt.Errorf("store webauthn challenge: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WebAuthnChallengeRepository) GetByID(ctx context.Context, challengeID string) (*domain.WebAuthnChallenge, error) {
+	key := cache.WebAuthnChallengeKey(challengeID)
+
+	data, err := r.client.Get(ctx, key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, ports.ErrNotFound
+		}
+		return nil, fmt.Errorf("get webauthn challenge: %w", err)
+	}
+
+	var challenge domain.WebAuthnChallenge
+	if err := json.Unmarshal([]byte(data), &challenge); err != nil {
+		return nil, fmt.Errorf("unmarshal webauthn challenge: %w", err)
+	}
+
+	return &challenge, nil
+}
+
+func (r *WebAuthnChallengeRepository) Delete(ctx context.Context, challengeID string) error {
+	key := cache.WebAuthnChallengeKey(challengeID)
+
+	if err := r.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("delete webauthn challenge: %w", err)
+	}
+
+	return nil
+}
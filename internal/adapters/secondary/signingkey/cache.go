@@ -0,0 +1,41 @@
+package signingkey
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"sync"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+// Cache keeps the decrypted private keys of the token generators by key ID.
+type Cache struct {
+	keyEncrypter ports.KeyEncrypter
+	keys         sync.Map
+}
+
+func NewCache(keyEncrypter ports.KeyEncrypter) *Cache {
+	return &Cache{keyEncrypter: keyEncrypter}
+}
+
+// PrivateKey returns the private key of the signing key, decrypting it the first time it is used.
+func (c *Cache) PrivateKey(ctx context.Context, signingKey *domain.SigningKey) (crypto.Signer, error) {
+	if privateKey, ok := c.keys.Load(signingKey.KeyID); ok {
+		return privateKey.(crypto.Signer), nil
+	}
+
+	der, err := c.keyEncrypter.Decrypt(ctx, signingKey.EncryptedPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt signing key: %w", err)
+	}
+
+	privateKey, err := domain.ParseSigningPrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+
+	c.keys.Store(signingKey.KeyID, privateKey)
+	return privateKey, nil
+}
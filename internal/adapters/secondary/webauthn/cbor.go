@@ -0,0 +1,154 @@
+package webauthn
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// maxCBORDepth bounds the nesting of decoded items.
+const maxCBORDepth = 8
+
+var errInvalidCBOR = errors.New("invalid CBOR")
+
+// decodeCBOR decodes the first CBOR item (RFC 8949) of data and returns it with the bytes that follow it.
+func decodeCBOR(data []byte) (any, []byte, error) {
+	return decodeCBORItem(data, 0)
+}
+
+func decodeCBORItem(data []byte, depth int) (any, []byte, error) {
+	if depth > maxCBORDepth {
+		return nil, nil, fmt.Errorf("%w: nested too deeply", errInvalidCBOR)
+	}
+
+	if len(data) == 0 {
+		return nil, nil, fmt.Errorf("%w: unexpected end of data", errInvalidCBOR)
+	}
+
+	major := data[0] >> 5
+	arg, rest, err := readCBORArgument(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch major {
+	case 0, 1:
+		if arg > math.MaxInt64 {
+			return nil, nil, fmt.Errorf("%w: integer overflows int64", errInvalidCBOR)
+		}
+
+		if major == 1 {
+			return -1 - int64(arg), rest, nil
+		}
+
+		return int64(arg), rest, nil
+	case 2, 3:
+		if arg > uint64(len(rest)) {
+			return nil, nil, fmt.Errorf("%w: unexpected end of data", errInvalidCBOR)
+		}
+
+		if major == 3 {
+			return string(rest[:arg]), rest[arg:], nil
+		}
+
+		return bytes.Clone(rest[:arg]), rest[arg:], nil
+	case 4:
+		// Every item takes at least a byte, which bounds the allocation.
+		if arg > uint64(len(rest)) {
+			return nil, nil, fmt.Errorf("%w: unexpected end of data", errInvalidCBOR)
+		}
+
+		items := make([]any, 0, arg)
+		for range arg {
+			var item any
+			item, rest, err = decodeCBORItem(rest, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			items = append(items, item)
+		}
+
+		return items, rest, nil
+	case 5:
+		if arg > uint64(len(rest))/2 {
+			return nil, nil, fmt.Errorf("%w: unexpected end of data", errInvalidCBOR)
+		}
+
+		items := make(map[any]any, arg)
+		for range arg {
+			var key, value any
+			key, rest, err = decodeCBORItem(rest, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, nil, fmt.Errorf("%w: unsupported map key %T", errInvalidCBOR, key)
+			}
+
+			if _, duplicate := items[key]; duplicate {
+				return nil, nil, fmt.Errorf("%w: duplicate map key %v", errInvalidCBOR, key)
+			}
+
+			value, rest, err = decodeCBORItem(rest, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			items[key] = value
+		}
+
+		return items, rest, nil
+	case 7:
+		switch data[0] & 0x1f {
+		case 20:
+			return false, rest, nil
+		case 21:
+			return true, rest, nil
+		case 22:
+			return nil, rest, nil
+		}
+
+		return nil, nil, fmt.Errorf("%w: unsupported simple value", errInvalidCBOR)
+	}
+
+	return nil, nil, fmt.Errorf("%w: unsupported major type %d", errInvalidCBOR, major)
+}
+
+// readCBORArgument reads the argument of the item's initial byte.
+func readCBORArgument(data []byte) (uint64, []byte, error) {
+	info := data[0] & 0x1f
+	rest := data[1:]
+
+	if info < 24 {
+		return uint64(info), rest, nil
+	}
+
+	if info > 27 {
+		return 0, nil, fmt.Errorf("%w: indefinite or reserved length", errInvalidCBOR)
+	}
+
+	size := 1 << (info - 24)
+	if len(rest) < size {
+		return 0, nil, fmt.Errorf("%w: unexpected end of data", errInvalidCBOR)
+	}
+
+	var arg uint64
+	switch size {
+	case 1:
+		arg = uint64(rest[0])
+	case 2:
+		arg = uint64(binary.BigEndian.Uint16(rest))
+	case 4:
+		arg = uint64(binary.BigEndian.Uint32(rest))
+	case 8:
+		arg = binary.BigEndian.Uint64(rest)
+	}
+
+	return arg, rest[size:], nil
+}
@@ -0,0 +1,128 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+// COSE key parameters and values (RFC 9052, RFC 9053) of the supported key types.
+const (
+	coseKeyType  = 1
+	coseKeyAlg   = 3
+	coseKeyCurve = -1
+	coseKeyX     = -2
+	coseKeyY     = -3
+	coseKeyN     = -1
+	coseKeyE     = -2
+
+	coseKeyTypeOKP = 1
+	coseKeyTypeEC2 = 2
+	coseKeyTypeRSA = 3
+
+	coseCurveP256    = 1
+	coseCurveEd25519 = 6
+
+	minRSAKeySize = 2048
+)
+
+var errInvalidSignature = errors.New("invalid signature")
+
+type coseKey struct {
+	alg int64
+	key crypto.PublicKey
+}
+
+// parseCOSEKey decodes a credential public key of one of the algorithms in domain.WebAuthnAlgorithms.
+func parseCOSEKey(data []byte) (*coseKey, error) {
+	item, rest, err := decodeCBOR(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rest) != 0 {
+		return nil, errors.New("trailing data after COSE key")
+	}
+
+	params, ok := item.(map[any]any)
+	if !ok {
+		return nil, errors.New("COSE key is not a map")
+	}
+
+	keyType, _ := params[int64(coseKeyType)].(int64)
+	alg, _ := params[int64(coseKeyAlg)].(int64)
+
+	switch alg {
+	case domain.COSEAlgES256:
+		curve, _ := params[int64(coseKeyCurve)].(int64)
+		x, _ := params[int64(coseKeyX)].([]byte)
+		y, _ := params[int64(coseKeyY)].([]byte)
+		if keyType != coseKeyTypeEC2 || curve != coseCurveP256 || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("malformed ES256 COSE key")
+		}
+
+		point := append([]byte{4}, append(x, y...)...)
+		key, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), point)
+		if err != nil {
+			return nil, fmt.Errorf("parse ES256 COSE key: %w", err)
+		}
+
+		return &coseKey{alg: alg, key: key}, nil
+	case domain.COSEAlgEdDSA:
+		curve, _ := params[int64(coseKeyCurve)].(int64)
+		x, _ := params[int64(coseKeyX)].([]byte)
+		if keyType != coseKeyTypeOKP || curve != coseCurveEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("malformed EdDSA COSE key")
+		}
+
+		return &coseKey{alg: alg, key: ed25519.PublicKey(x)}, nil
+	case domain.COSEAlgRS256:
+		n, _ := params[int64(coseKeyN)].([]byte)
+		e, _ := params[int64(coseKeyE)].([]byte)
+		if keyType != coseKeyTypeRSA || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("malformed RS256 COSE key")
+		}
+
+		key := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+		if key.N.BitLen() < minRSAKeySize {
+			return nil, fmt.Errorf("RS256 COSE key shorter than %d bits", minRSAKeySize)
+		}
+
+		return &coseKey{alg: alg, key: key}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported COSE algorithm %d", alg)
+}
+
+// verify checks a signature made by the credential's private key.
+func (k *coseKey) verify(message, signature []byte) error {
+	digest := sha256.Sum256(message)
+
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return errInvalidSignature
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, message, signature) {
+			return errInvalidSignature
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return errInvalidSignature
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package webauthn
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+// Authenticator data flags (WebAuthn §6.1).
+const (
+	flagUserPresent        = 0x01
+	flagUserVerified       = 0x04
+	flagAttestedCredential = 0x40
+	flagExtensionData      = 0x80
+)
+
+const (
+	authenticatorDataMinLength = 37
+	maxCredentialIDLength      = 1023
+)
+
+type WebAuthnVerifier struct {
+	rpIDHash [sha256.Size]byte
+	origins  []string
+}
+
+func NewWebAuthnVerifier(config *config.Config) ports.WebAuthnVerifier {
+	return &WebAuthnVerifier{
+		rpIDHash: sha256.Sum256([]byte(config.WebAuthnRPID())),
+		origins:  config.WebAuthnOrigins(),
+	}
+}
+
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+type authenticatorData struct {
+	flags        byte
+	signCount    uint32
+	credentialID []byte
+	publicKey    []byte
+}
+
+// VerifyAttestation checks a registration response and returns the credential it created.
+func (v *WebAuthnVerifier) VerifyAttestation(ctx context.Context, attestation *domain.WebAuthnAttestation, challenge []byte) (*domain.WebAuthnAttestedCredential, error) {
+	if err := v.verifyClientData(attestation.ClientDataJSON, domain.WebAuthnCeremonyCreate, challenge); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidWebAuthnResponse, err)
+	}
+
+	item, rest, err := decodeCBOR(attestation.AttestationObject)
+	if err != nil || len(rest) != 0 {
+		return nil, fmt.Errorf("%w: malformed attestation object", domain.ErrInvalidWebAuthnResponse)
+	}
+
+	object, ok := item.(map[any]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed attestation object", domain.ErrInvalidWebAuthnResponse)
+	}
+
+	format, _ := object["fmt"].(string)
+	statement, _ := object["attStmt"].(map[any]any)
+	if format != "none" || len(statement) != 0 {
+		return nil, fmt.Errorf("%w: unsupported attestation format %q", domain.ErrInvalidWebAuthnResponse, format)
+	}
+
+	rawAuthData, _ := object["authData"].([]byte)
+	authData, err := v.parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidWebAuthnResponse, err)
+	}
+
+	if authData.credentialID == nil {
+		return nil, fmt.Errorf("%w: missing attested credential data", domain.ErrInvalidWebAuthnResponse)
+	}
+
+	if _, err := parseCOSEKey(authData.publicKey); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidWebAuthnResponse, err)
+	}
+
+	return &domain.WebAuthnAttestedCredential{
+		CredentialID: authData.credentialID,
+		PublicKey:    authData.publicKey,
+		SignCount:    authData.signCount,
+		UserVerified: authData.flags&flagUserVerified != 0,
+	}, nil
+}
+
+// VerifyAssertion checks a login response against the stored public key of the credential it names.
+func (v *WebAuthnVerifier) VerifyAssertion(ctx context.Context, assertion *domain.WebAuthnAssertion, challenge []byte, publicKey []byte) (*domain.WebAuthnVerifiedAssertion, error) {
+	if err := v.verifyClientData(assertion.ClientDataJSON, domain.WebAuthnCeremonyGet, challenge); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidWebAuthnResponse, err)
+	}
+
+	authData, err := v.parseAuthenticatorData(assertion.AuthenticatorData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidWebAuthnResponse, err)
+	}
+
+	key, err := parseCOSEKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("parse credential public key: %w", err)
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	signed := append(slices.Clone(assertion.AuthenticatorData), clientDataHash[:]...)
+	if err := key.verify(signed, assertion.Signature); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidWebAuthnResponse, err)
+	}
+
+	return &domain.WebAuthnVerifiedAssertion{
+		SignCount:    authData.signCount,
+		UserVerified: authData.flags&flagUserVerified != 0,
+	}, nil
+}
+
+// verifyClientData checks that the browser ran the ceremony for our challenge on one of our pages.
+func (v *WebAuthnVerifier) verifyClientData(data []byte, ceremony string, challenge []byte) error {
+	var client clientData
+	if err := json.Unmarshal(data, &client); err != nil {
+		return fmt.Errorf("decode client data: %w", err)
+	}
+
+	if client.Type != ceremony {
+		return fmt.Errorf("unexpected client data type %q", client.Type)
+	}
+
+	received, err := base64.RawURLEncoding.DecodeString(client.Challenge)
+	if err != nil || subtle.ConstantTimeCompare(received, challenge) != 1 {
+		return errors.New("challenge mismatch")
+	}
+
+	if !slices.Contains(v.origins, client.Origin) {
+		return fmt.Errorf("unexpected origin %q", client.Origin)
+	}
+
+	if client.CrossOrigin {
+		return errors.New("ceremony ran in a cross-origin frame")
+	}
+
+	return nil
+}
+
+// parseAuthenticatorData reads the authenticator data (WebAuthn §6.1) and checks its RP ID and flags.
+func (v *WebAuthnVerifier) parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < authenticatorDataMinLength {
+		return nil, errors.New("authenticator data too short")
+	}
+
+	if subtle.ConstantTimeCompare(data[:sha256.Size], v.rpIDHash[:]) != 1 {
+		return nil, errors.New("RP ID hash mismatch")
+	}
+
+	parsed := &authenticatorData{
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+
+	if parsed.flags&flagUserPresent == 0 {
+		return nil, errors.New("user not present")
+	}
+
+	rest := data[authenticatorDataMinLength:]
+
+	if parsed.flags&flagAttestedCredential != 0 {
+		// A 16 byte AAGUID and the 2 byte credential ID length come first.
+		if len(rest) < 18 {
+			return nil, errors.New("attested credential data too short")
+		}
+
+		length := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if length == 0 || length > maxCredentialIDLength || len(rest) < length {
+			return nil, errors.New("invalid credential ID length")
+		}
+
+		parsed.credentialID = slices.Clone(rest[:length])
+		rest = rest[length:]
+
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("decode credential public key: %w", err)
+		}
+
+		parsed.publicKey = slices.Clone(rest[:len(rest)-len(after)])
+		rest = after
+	}
+
+	if parsed.flags&flagExtensionData != 0 {
+		_, after, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("decode extensions: %w", err)
+		}
+
+		rest = after
+	}
+
+	if len(rest) != 0 {
+		return nil, errors.New("trailing data after authenticator data")
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,324 @@
+package webauthn
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRPID   = "auth.example.com"
+	testOrigin = "https://auth.example.com"
+)
+
+// cborMap keeps the order of its entries, as authenticators encode maps
+// canonically.
+type cborMap []cborEntry
+
+type cborEntry struct {
+	key   any
+	value any
+}
+
+// encodeCBOR encodes the values the software authenticator produces.
+func encodeCBOR(value any) []byte {
+	header := func(major byte, arg uint64) []byte {
+		switch {
+		case arg < 24:
+			return []byte{major<<5 | byte(arg)}
+		case arg <= 0xff:
+			return []byte{major<<5 | 24, byte(arg)}
+		case arg <= 0xffff:
+			return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(arg))
+		default:
+			return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(arg))
+		}
+	}
+
+	switch v := value.(type) {
+	case int:
+		if v < 0 {
+			return header(1, uint64(-1-v))
+		}
+		return header(0, uint64(v))
+	case int64:
+		return encodeCBOR(int(v))
+	case []byte:
+		return append(header(2, uint64(len(v))), v...)
+	case string:
+		return append(header(3, uint64(len(v))), v...)
+	case cborMap:
+		out := header(5, uint64(len(v)))
+		for _, entry := range v {
+			out = append(out, encodeCBOR(entry.key)...)
+			out = append(out, encodeCBOR(entry.value)...)
+		}
+		return out
+	}
+
+	panic("unsupported CBOR value")
+}
+
+// softwareAuthenticator holds a credential and answers ceremonies the way a
+// browser hands an authenticator's responses over.
+type softwareAuthenticator struct {
+	credentialID []byte
+	publicKey    []byte
+	sign         func(message []byte) []byte
+	signCount    uint32
+}
+
+func newES256Authenticator(t *testing.T) *softwareAuthenticator {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return &softwareAuthenticator{
+		credentialID: []byte("es256-credential"),
+		publicKey: encodeCBOR(cborMap{
+			{coseKeyType, coseKeyTypeEC2},
+			{coseKeyAlg, domain.COSEAlgES256},
+			{coseKeyCurve, coseCurveP256},
+			{coseKeyX, privateKey.PublicKey.X.FillBytes(make([]byte, 32))},
+			{coseKeyY, privateKey.PublicKey.Y.FillBytes(make([]byte, 32))},
+		}),
+		sign: func(message []byte) []byte {
+			digest := sha256.Sum256(message)
+			signature, err := ecdsa.SignASN1(rand.Reader, privateKey, digest[:])
+			require.NoError(t, err)
+			return signature
+		},
+	}
+}
+
+func newEd25519Authenticator(t *testing.T) *softwareAuthenticator {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return &softwareAuthenticator{
+		credentialID: []byte("ed25519-credential"),
+		publicKey: encodeCBOR(cborMap{
+			{coseKeyType, coseKeyTypeOKP},
+			{coseKeyAlg, domain.COSEAlgEdDSA},
+			{coseKeyCurve, coseCurveEd25519},
+			{coseKeyX, []byte(publicKey)},
+		}),
+		sign: func(message []byte) []byte {
+			return ed25519.Sign(privateKey, message)
+		},
+	}
+}
+
+func clientDataJSON(t *testing.T, ceremony string, challenge []byte, origin string) []byte {
+	data, err := json.Marshal(clientData{
+		Type:      ceremony,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge),
+		Origin:    origin,
+	})
+	require.NoError(t, err)
+	return data
+}
+
+func (a *softwareAuthenticator) authenticatorData(rpID string, flags byte, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append(rpIDHash[:], flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+
+	if attested {
+		data = append(data, make([]byte, 16)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+		data = append(data, a.credentialID...)
+		data = append(data, a.publicKey...)
+	}
+
+	return data
+}
+
+func (a *softwareAuthenticator) register(t *testing.T, challenge []byte, format string) *domain.WebAuthnAttestation {
+	authData := a.authenticatorData(testRPID, flagUserPresent|flagUserVerified|flagAttestedCredential, true)
+
+	return &domain.WebAuthnAttestation{
+		ClientDataJSON: clientDataJSON(t, domain.WebAuthnCeremonyCreate, challenge, testOrigin),
+		AttestationObject: encodeCBOR(cborMap{
+			{"fmt", format},
+			{"attStmt", cborMap{}},
+			{"authData", authData},
+		}),
+		Transports: []string{"internal"},
+	}
+}
+
+func (a *softwareAuthenticator) login(t *testing.T, clientData []byte, rpID string, flags byte) *domain.WebAuthnAssertion {
+	a.signCount++
+	authData := a.authenticatorData(rpID, flags, false)
+	clientDataHash := sha256.Sum256(clientData)
+
+	return &domain.WebAuthnAssertion{
+		CredentialID:      a.credentialID,
+		ClientDataJSON:    clientData,
+		AuthenticatorData: authData,
+		Signature:         a.sign(append(authData, clientDataHash[:]...)),
+	}
+}
+
+func newTestVerifier() *WebAuthnVerifier {
+	return NewWebAuthnVerifier(&config.Config{
+		URL: config.URL{AppBaseURL: testOrigin},
+	}).(*WebAuthnVerifier)
+}
+
+func TestVerifyAttestation(t *testing.T) {
+	verifier := newTestVerifier()
+	challenge := []byte("registration-challenge")
+
+	t.Run("should return the credential created by the authenticator", func(t *testing.T) {
+		// Arrange
+		authenticator := newES256Authenticator(t)
+		attestation := authenticator.register(t, challenge, "none")
+
+		// Act
+		credential, err := verifier.VerifyAttestation(context.Background(), attestation, challenge)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, authenticator.credentialID, credential.CredentialID)
+		assert.Equal(t, authenticator.publicKey, credential.PublicKey)
+		assert.True(t, credential.UserVerified)
+	})
+
+	t.Run("should reject a response to another challenge", func(t *testing.T) {
+		// Arrange
+		attestation := newES256Authenticator(t).register(t, []byte("other-challenge"), "none")
+
+		// Act
+		credential, err := verifier.VerifyAttestation(context.Background(), attestation, challenge)
+
+		// Assert
+		assert.Nil(t, credential)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("should reject a ceremony run on another origin", func(t *testing.T) {
+		// Arrange
+		attestation := newES256Authenticator(t).register(t, challenge, "none")
+		attestation.ClientDataJSON = clientDataJSON(t, domain.WebAuthnCeremonyCreate, challenge, "https://evil.example.com")
+
+		// Act
+		credential, err := verifier.VerifyAttestation(context.Background(), attestation, challenge)
+
+		// Assert
+		assert.Nil(t, credential)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("should reject attestation formats other than none", func(t *testing.T) {
+		// Arrange
+		attestation := newES256Authenticator(t).register(t, challenge, "packed")
+
+		// Act
+		credential, err := verifier.VerifyAttestation(context.Background(), attestation, challenge)
+
+		// Assert
+		assert.Nil(t, credential)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebAuthnResponse)
+	})
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	verifier := newTestVerifier()
+	challenge := []byte("login-challenge")
+	getClientData := func(t *testing.T) []byte {
+		return clientDataJSON(t, domain.WebAuthnCeremonyGet, challenge, testOrigin)
+	}
+
+	t.Run("should verify an ES256 signature and return the sign count", func(t *testing.T) {
+		// Arrange
+		authenticator := newES256Authenticator(t)
+		assertion := authenticator.login(t, getClientData(t), testRPID, flagUserPresent|flagUserVerified)
+
+		// Act
+		verified, err := verifier.VerifyAssertion(context.Background(), assertion, challenge, authenticator.publicKey)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), verified.SignCount)
+		assert.True(t, verified.UserVerified)
+	})
+
+	t.Run("should verify an EdDSA signature without user verification", func(t *testing.T) {
+		// Arrange
+		authenticator := newEd25519Authenticator(t)
+		assertion := authenticator.login(t, getClientData(t), testRPID, flagUserPresent)
+
+		// Act
+		verified, err := verifier.VerifyAssertion(context.Background(), assertion, challenge, authenticator.publicKey)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, verified.UserVerified)
+	})
+
+	t.Run("should reject a signature by another key", func(t *testing.T) {
+		// Arrange
+		authenticator := newES256Authenticator(t)
+		assertion := authenticator.login(t, getClientData(t), testRPID, flagUserPresent)
+
+		// Act
+		verified, err := verifier.VerifyAssertion(context.Background(), assertion, challenge, newES256Authenticator(t).publicKey)
+
+		// Assert
+		assert.Nil(t, verified)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("should reject a response for another RP ID", func(t *testing.T) {
+		// Arrange
+		authenticator := newES256Authenticator(t)
+		assertion := authenticator.login(t, getClientData(t), "evil.example.com", flagUserPresent)
+
+		// Act
+		verified, err := verifier.VerifyAssertion(context.Background(), assertion, challenge, authenticator.publicKey)
+
+		// Assert
+		assert.Nil(t, verified)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("should reject a response without user presence", func(t *testing.T) {
+		// Arrange
+		authenticator := newES256Authenticator(t)
+		assertion := authenticator.login(t, getClientData(t), testRPID, flagUserVerified)
+
+		// Act
+		verified, err := verifier.VerifyAssertion(context.Background(), assertion, challenge, authenticator.publicKey)
+
+		// Assert
+		assert.Nil(t, verified)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("should reject a registration response", func(t *testing.T) {
+		// Arrange
+		authenticator := newES256Authenticator(t)
+		createClientData := clientDataJSON(t, domain.WebAuthnCeremonyCreate, challenge, testOrigin)
+		assertion := authenticator.login(t, createClientData, testRPID, flagUserPresent)
+
+		// Act
+		verified, err := verifier.VerifyAssertion(context.Background(), assertion, challenge, authenticator.publicKey)
+
+		// Assert
+		assert.Nil(t, verified)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebAuthnResponse)
+	})
+}
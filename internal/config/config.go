@@ -1,6 +1,10 @@
 package config
 
-import "time"
+import (
+	"net/url"
+	"strings"
+	"time"
+)
 
 const (
 	development = "development"
@@ -9,22 +13,39 @@ const (
 )
 
 type Config struct {
-	Env       string    `mapstructure:"env"`
-	Postgres  Postgres  `mapstructure:"postgres"`
-	Redis     Redis     `mapstructure:"redis"`
-	Cors      Cors      `mapstructure:"cors"`
-	Key       Key       `mapstructure:"key"`
-	RateLimit RateLimit `mapstructure:"ratelimit"`
-	Session   Session   `mapstructure:"session"`
-	Server    Server    `mapstructure:"server"`
-	URL       URL       `mapstructure:"url"`
-	JWT       JWT       `mapstructure:"jwt"`
+	Env               string            `mapstructure:"env"`
+	Postgres          Postgres          `mapstructure:"postgres"`
+	Redis             Redis             `mapstructure:"redis"`
+	Cors              Cors              `mapstructure:"cors"`
+	Key               Key               `mapstructure:"key"`
+	RateLimit         RateLimit         `mapstructure:"ratelimit"`
+	Session           Session           `mapstructure:"session"`
+	Server            Server            `mapstructure:"server"`
+	URL               URL               `mapstructure:"url"`
+	JWT               JWT               `mapstructure:"jwt"`
+	DPoP              DPoP              `mapstructure:"dpop"`
+	WebAuthn          WebAuthn          `mapstructure:"webauthn"`
+	Mail              Mail              `mapstructure:"mail"`
+	EmailVerification EmailVerification `mapstructure:"emailverification"`
+	Admin             Admin             `mapstructure:"admin"`
 }
 
 type Server struct {
 	Port            int           `mapstructure:"port"`
 	Host            string        `mapstructure:"host"`
 	ShutdownTimeout time.Duration `mapstructure:"shutdowntimeout"`
+	TLS             TLS           `mapstructure:"tls"`
+}
+
+// TLS serves the API over HTTPS when a certificate is configured.
+type TLS struct {
+	CertFile     string `mapstructure:"certfile"`
+	KeyFile      string `mapstructure:"keyfile"`
+	ClientCAFile string `mapstructure:"clientcafile"`
+}
+
+func (t TLS) Enabled() bool {
+	return t.CertFile != ""
 }
 
 type Postgres struct {
@@ -61,9 +82,14 @@ type RateLimit struct {
 	Window      time.Duration `mapstructure:"window"`
 }
 
+// Key configures the signing keys.
 type Key struct {
-	PrivateKey string `mapstructure:"privatekey"`
-	PublicKey  string `mapstructure:"publickey"`
+	PrivateKey            string        `mapstructure:"privatekey"`
+	PublicKey             string        `mapstructure:"publickey"`
+	EncryptionKey         string        `mapstructure:"encryptionkey"`
+	RotationInterval      time.Duration `mapstructure:"rotationinterval"`
+	RetirementPeriod      time.Duration `mapstructure:"retirementperiod"`
+	RotationCheckInterval time.Duration `mapstructure:"rotationcheckinterval"`
 }
 
 type Session struct {
@@ -90,6 +116,83 @@ type JWT struct {
 	AccessTokenDuration  time.Duration `mapstructure:"AccessTokenDuration"`
 	RefreshTokenDuration time.Duration `mapstructure:"RefreshTokenDuration"`
 	IDTokenDuration      time.Duration `mapstructure:"IDTokenDuration"`
+	// RefreshTokenIdleTimeout expires refresh tokens left unused for that long, within RefreshTokenDuration.
+	RefreshTokenIdleTimeout time.Duration `mapstructure:"RefreshTokenIdleTimeout"`
+	// OfflineTokenDuration is the lifetime of refresh tokens granted through the offline_access scope.
+	OfflineTokenDuration time.Duration `mapstructure:"OfflineTokenDuration"`
+	// PairwiseSubjectSalt derives the sub of pairwise clients and needs at least 32 characters once one is registered.
+	PairwiseSubjectSalt string `mapstructure:"PairwiseSubjectSalt"`
+}
+
+type DPoP struct {
+	// RequireNonce makes clients include a server-provided nonce in their DPoP proofs.
+	RequireNonce bool `mapstructure:"requirenonce"`
+}
+
+// WebAuthn configures the relying party passkeys are registered with.
+type WebAuthn struct {
+	RPID    string   `mapstructure:"rpid"`
+	RPName  string   `mapstructure:"rpname"`
+	Origins []string `mapstructure:"origins"`
+}
+
+// Mail configures outgoing email.
+type Mail struct {
+	From string `mapstructure:"from"`
+	Dir  string `mapstructure:"dir"`
+	SMTP SMTP   `mapstructure:"smtp"`
+}
+
+type SMTP struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+}
+
+// Admin configures access to the admin API.
+type Admin struct {
+	APIKey string `mapstructure:"apikey"`
+}
+
+// EmailVerification configures the links that verify email addresses.
+type EmailVerification struct {
+	Secret string `mapstructure:"secret"`
+}
+
+func (e *Config) WebAuthnRPID() string {
+	if e.WebAuthn.RPID != "" {
+		return e.WebAuthn.RPID
+	}
+
+	u, err := url.Parse(e.URL.AppBaseURL)
+	if err != nil {
+		return ""
+	}
+
+	return u.Hostname()
+}
+
+func (e *Config) WebAuthnOrigins() []string {
+	if len(e.WebAuthn.Origins) > 0 {
+		return e.WebAuthn.Origins
+	}
+
+	return []string{strings.TrimSuffix(e.URL.AppBaseURL, "/")}
+}
+
+// MailFrom is the sender of outgoing email, no-reply at the host of URL.AppBaseURL unless configured.
+func (e *Config) MailFrom() string {
+	if e.Mail.From != "" {
+		return e.Mail.From
+	}
+
+	host := "localhost"
+	if u, err := url.Parse(e.URL.AppBaseURL); err == nil && u.Hostname() != "" {
+		host = u.Hostname()
+	}
+
+	return "no-reply@" + host
 }
 
 func (e *Config) IsDevelopment() bool {
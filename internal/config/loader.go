@@ -18,6 +18,9 @@ func NewConfig() (*Config, error) {
 	v.AutomaticEnv()
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 
+	// Unmarshal only reads environment variables for keys viper knows about.
+	v.SetDefault("jwt.pairwisesubjectsalt", "")
+
 	if err := v.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
 			return nil, fmt.Errorf("error reading config file: %w", err)
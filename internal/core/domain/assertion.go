@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const ClientAssertionTypeJWTBearer = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// assertionSigningAlgs are the algorithms assertions may be signed with using the client's registered keys.
+var assertionSigningAlgs = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+}
+
+// assertionMACAlgs are the algorithms client_secret_jwt assertions may be MACed with using the client's secret.
+var assertionMACAlgs = []string{"HS256", "HS384", "HS512"}
+
+func AssertionSigningAlgs() []string {
+	return slices.Clone(assertionSigningAlgs)
+}
+
+func AssertionMACAlgs() []string {
+	return slices.Clone(assertionMACAlgs)
+}
+
+var (
+	ErrInvalidAssertion  = errors.New("invalid assertion")
+	ErrAssertionReplayed = errors.New("assertion already used")
+)
+
+// AssertionClaims are the claims of a verified RFC 7523 JWT.
+type AssertionClaims struct {
+	Issuer    string
+	Subject   string
+	Audience  []string
+	ExpiresAt time.Time
+	JWTID     string
+}
+
+// Validate checks the assertion's issuer, audience and jti.
+func (c *AssertionClaims) Validate(issuer string, audiences []string) error {
+	if c.Issuer == "" || c.Issuer != issuer {
+		return fmt.Errorf("%w: unexpected issuer", ErrInvalidAssertion)
+	}
+
+	if c.Subject == "" {
+		return fmt.Errorf("%w: missing subject", ErrInvalidAssertion)
+	}
+
+	if !slices.ContainsFunc(c.Audience, func(audience string) bool { return slices.Contains(audiences, audience) }) {
+		return fmt.Errorf("%w: unexpected audience", ErrInvalidAssertion)
+	}
+
+	if c.JWTID == "" {
+		return fmt.Errorf("%w: missing jti", ErrInvalidAssertion)
+	}
+
+	if time.Now().UTC().After(c.ExpiresAt) {
+		return fmt.Errorf("%w: expired", ErrInvalidAssertion)
+	}
+
+	return nil
+}
+
+// ClientCredentials are the credentials a client authenticates with at the token endpoint.
+type ClientCredentials struct {
+	ClientID            string
+	ClientSecret        string
+	ClientAssertion     string
+	ClientAssertionType string
+	Certificate         *ClientCertificate
+}
+
+func (c ClientCredentials) HasAssertion() bool {
+	return c.ClientAssertion != "" || c.ClientAssertionType != ""
+}
+
+// IsEmpty reports whether no credentials were presented at all, as public clients do.
+func (c ClientCredentials) IsEmpty() bool {
+	return c.ClientSecret == "" && !c.HasAssertion()
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Types of the security events recorded in a user's audit trail.
+const (
+	AuditEventRecoveryCodesGenerated = "mfa.recovery_codes_generated"
+	AuditEventRecoveryCodeUsed       = "mfa.recovery_code_used"
+)
+
+// AuditEvent is an entry of the audit trail, kept so users and operators can tell later what happened to an account.
+type AuditEvent struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Type      string
+	Details   map[string]any
+	CreatedAt time.Time
+}
+
+func NewAuditEvent(userID uuid.UUID, eventType string, details map[string]any) (*AuditEvent, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditEvent{
+		ID:      id,
+		UserID:  userID,
+		Type:    eventType,
+		Details: details,
+	}, nil
+}
@@ -15,17 +15,24 @@ const (
 )
 
 type AuthorizationCode struct {
-	Code                string
-	ClientID            string
-	UserID              uuid.UUID
-	RedirectURI         string
-	Scopes              []string
-	Nonce               string
-	CodeChallenge       string
-	CodeChallengeMethod string
-	Used                bool
-	ExpiresAt           time.Time
-	CreatedAt           time.Time
+	Code                 string
+	ClientID             string
+	UserID               uuid.UUID
+	RedirectURI          string
+	Scopes               []string
+	Nonce                string
+	CodeChallenge        string
+	CodeChallengeMethod  string
+	Claims               *ClaimsRequest
+	SessionID            uuid.UUID
+	Resources            []string
+	AuthTime             time.Time
+	ACR                  string
+	AMR                  []string
+	AuthorizationDetails AuthorizationDetails
+	Used                 bool
+	ExpiresAt            time.Time
+	CreatedAt            time.Time
 }
 
 func NewAuthorizationCode(clientID string, userID uuid.UUID, redirectURI string, scopes []string, nonce, codeChallenge, codeChallengeMethod string) (*AuthorizationCode, error) {
@@ -90,10 +97,17 @@ func (ac *AuthorizationCode) IsValidPKCE(verifier string) bool {
 
 func (ac *AuthorizationCode) ToCreateTokenParams() CreateTokenParams {
 	return CreateTokenParams{
-		AuthorizationCode: &ac.Code,
-		ClientID:          ac.ClientID,
-		UserID:            ac.UserID,
-		Scopes:            ac.Scopes,
-		Nonce:             ac.Nonce,
+		AuthorizationCode:    &ac.Code,
+		ClientID:             ac.ClientID,
+		UserID:               ac.UserID,
+		Scopes:               ac.Scopes,
+		Nonce:                ac.Nonce,
+		Claims:               ac.Claims,
+		SessionID:            ac.SessionID,
+		Resources:            ac.Resources,
+		AuthTime:             ac.AuthTime,
+		ACR:                  ac.ACR,
+		AMR:                  ac.AMR,
+		AuthorizationDetails: ac.AuthorizationDetails,
 	}
 }
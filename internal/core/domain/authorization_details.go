@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const authorizationDetailTypeMember = "type"
+
+var (
+	ErrInvalidAuthorizationDetails          = errors.New("invalid authorization details")
+	ErrAuthorizationDetailTypeAlreadyExists = errors.New("authorization detail type already exists")
+)
+
+// AuthorizationDetail is one object of an RFC 9396 authorization_details array.
+type AuthorizationDetail map[string]any
+
+func (d AuthorizationDetail) Type() string {
+	detailType, _ := d[authorizationDetailTypeMember].(string)
+	return detailType
+}
+
+type AuthorizationDetails []AuthorizationDetail
+
+// ParseAuthorizationDetails decodes the authorization_details parameter.
+func ParseAuthorizationDetails(raw string) (AuthorizationDetails, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(raw)))
+	decoder.UseNumber()
+
+	var details AuthorizationDetails
+	if err := decoder.Decode(&details); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAuthorizationDetails, err)
+	}
+
+	if len(details) == 0 {
+		return nil, fmt.Errorf("%w: no details", ErrInvalidAuthorizationDetails)
+	}
+
+	for _, detail := range details {
+		if detail == nil || detail.Type() == "" {
+			return nil, fmt.Errorf("%w: detail without a type", ErrInvalidAuthorizationDetails)
+		}
+	}
+
+	return details, nil
+}
+
+// Types returns the distinct types of the details, in order.
+func (d AuthorizationDetails) Types() []string {
+	types := make([]string, 0, len(d))
+	for _, detail := range d {
+		if !slices.Contains(types, detail.Type()) {
+			types = append(types, detail.Type())
+		}
+	}
+	return types
+}
+
+func (d AuthorizationDetails) Contains(detail AuthorizationDetail) bool {
+	return slices.ContainsFunc(d, func(granted AuthorizationDetail) bool {
+		return reflect.DeepEqual(granted, detail)
+	})
+}
+
+// NarrowAuthorizationDetails returns the requested details, each of which must already be granted.
+func NarrowAuthorizationDetails(granted, requested AuthorizationDetails) (AuthorizationDetails, error) {
+	if len(requested) == 0 {
+		return granted, nil
+	}
+
+	for _, detail := range requested {
+		if !granted.Contains(detail) {
+			return nil, fmt.Errorf("%w: %s detail wasn't granted", ErrInvalidAuthorizationDetails, detail.Type())
+		}
+	}
+
+	return requested, nil
+}
+
+// AuthorizationDetailType is a type of authorization details clients may request.
+type AuthorizationDetailType struct {
+	ID          uuid.UUID
+	Type        string
+	Description string
+	Fields      []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type CreateAuthorizationDetailTypeParams struct {
+	Type        string
+	Description string
+	Fields      []string
+}
+
+type UpdateAuthorizationDetailTypeParams struct {
+	Description string
+	Fields      []string
+}
+
+func NewAuthorizationDetailType(params CreateAuthorizationDetailTypeParams) (*AuthorizationDetailType, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	return &AuthorizationDetailType{
+		ID:          id,
+		Type:        params.Type,
+		Description: params.Description,
+		Fields:      params.Fields,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (t *AuthorizationDetailType) Update(params UpdateAuthorizationDetailTypeParams) {
+	t.Description = params.Description
+	t.Fields = params.Fields
+	t.UpdatedAt = time.Now().UTC()
+}
+
+// Validate checks a detail of this type only carries the registered members.
+func (t *AuthorizationDetailType) Validate(detail AuthorizationDetail) error {
+	for member := range detail {
+		if member != authorizationDetailTypeMember && !slices.Contains(t.Fields, member) {
+			return fmt.Errorf("%w: unknown %s member %q", ErrInvalidAuthorizationDetails, t.Type, member)
+		}
+	}
+	return nil
+}
+
+func AuthorizationDetailTypeNames(types []*AuthorizationDetailType) []string {
+	names := make([]string, 0, len(types))
+	for _, detailType := range types {
+		names = append(names, detailType.Type)
+	}
+	return names
+}
@@ -0,0 +1,208 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// GrantTypeCIBA is the grant of Client Initiated Backchannel Authentication.
+const GrantTypeCIBA = "urn:openid:params:grant-type:ciba"
+
+const (
+	BackchannelTokenDeliveryModePoll = "poll"
+	BackchannelTokenDeliveryModePing = "ping"
+)
+
+const (
+	BackchannelAuthenticationStatusPending  = "pending"
+	BackchannelAuthenticationStatusApproved = "approved"
+	BackchannelAuthenticationStatusDenied   = "denied"
+)
+
+const (
+	BackchannelAuthenticationExpiry    = 5 * time.Minute
+	BackchannelAuthenticationMaxExpiry = 10 * time.Minute
+	// BackchannelPollInterval is the minimum wait between token requests of a polling client.
+	BackchannelPollInterval      = 5 * time.Second
+	BackchannelSlowDownIncrement = 5 * time.Second
+	bindingMessageMaxLength      = 64
+)
+
+var (
+	ErrAuthorizationPending            = errors.New("authorization pending")
+	ErrSlowDown                        = errors.New("polling too frequently")
+	ErrAccessDenied                    = errors.New("access denied")
+	ErrExpiredAuthReqID                = errors.New("auth_req_id expired")
+	ErrInvalidAuthReqID                = errors.New("invalid auth_req_id")
+	ErrUnknownUserID                   = errors.New("unknown user ID")
+	ErrInvalidBindingMessage           = errors.New("invalid binding message")
+	ErrInvalidBackchannelRequest       = errors.New("invalid backchannel authentication request")
+	ErrInvalidBackchannelConfiguration = errors.New("invalid backchannel configuration")
+	ErrBackchannelRequestNotFound      = errors.New("backchannel authentication request not found")
+)
+
+// BackchannelAuthenticationRequest is an authentication a client started on the user's behalf.
+type BackchannelAuthenticationRequest struct {
+	AuthReqID               string
+	ClientID                string
+	ClientName              string
+	UserID                  uuid.UUID
+	Scopes                  []string
+	BindingMessage          string
+	DeliveryMode            string
+	ClientNotificationToken string
+	Status                  string
+	Interval                time.Duration
+	AuthTime                time.Time
+	ACR                     string
+	AMR                     []string
+	LastPolledAt            *time.Time
+	ExpiresAt               time.Time
+	CreatedAt               time.Time
+}
+
+type BackchannelAuthenticationParams struct {
+	ClientID            string
+	ClientSecret        string
+	ClientAssertion     string
+	ClientAssertionType string
+	ClientCertificate   *ClientCertificate
+	Scopes              []string
+	LoginHint           string
+	BindingMessage      string
+	// RequestedExpiry is the lifetime, in seconds, the client asks the auth_req_id to have.
+	RequestedExpiry         int64
+	ClientNotificationToken string
+}
+
+func (p BackchannelAuthenticationParams) ClientCredentials() ClientCredentials {
+	return ClientCredentials{
+		ClientID:            p.ClientID,
+		ClientSecret:        p.ClientSecret,
+		ClientAssertion:     p.ClientAssertion,
+		ClientAssertionType: p.ClientAssertionType,
+		Certificate:         p.ClientCertificate,
+	}
+}
+
+// Expiry returns the lifetime of the auth_req_id.
+func (p BackchannelAuthenticationParams) Expiry() time.Duration {
+	if p.RequestedExpiry <= 0 {
+		return BackchannelAuthenticationExpiry
+	}
+	return min(time.Duration(p.RequestedExpiry)*time.Second, BackchannelAuthenticationMaxExpiry)
+}
+
+// ValidateBindingMessage checks the binding message is short enough to be shown on a device.
+func (p BackchannelAuthenticationParams) ValidateBindingMessage() error {
+	if utf8.RuneCountInString(p.BindingMessage) > bindingMessageMaxLength {
+		return ErrInvalidBindingMessage
+	}
+
+	if slices.ContainsFunc([]rune(p.BindingMessage), unicode.IsControl) {
+		return ErrInvalidBindingMessage
+	}
+
+	return nil
+}
+
+type BackchannelAuthenticationResponse struct {
+	AuthReqID string `json:"auth_req_id"`
+	ExpiresIn int64  `json:"expires_in"`
+	Interval  int64  `json:"interval,omitempty"`
+}
+
+func NewBackchannelAuthenticationRequest(client *Client, userID uuid.UUID, params BackchannelAuthenticationParams) (*BackchannelAuthenticationRequest, error) {
+	authReqID, err := generateAuthReqID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	return &BackchannelAuthenticationRequest{
+		AuthReqID:               authReqID,
+		ClientID:                client.ClientID,
+		ClientName:              client.ClientName,
+		UserID:                  userID,
+		Scopes:                  params.Scopes,
+		BindingMessage:          params.BindingMessage,
+		DeliveryMode:            client.BackchannelTokenDeliveryMode,
+		ClientNotificationToken: params.ClientNotificationToken,
+		Status:                  BackchannelAuthenticationStatusPending,
+		Interval:                BackchannelPollInterval,
+		ExpiresAt:               now.Add(params.Expiry()),
+		CreatedAt:               now,
+	}, nil
+}
+
+func (r *BackchannelAuthenticationRequest) IsExpired() bool {
+	return time.Now().After(r.ExpiresAt)
+}
+
+func (r *BackchannelAuthenticationRequest) IsPending() bool {
+	return r.Status == BackchannelAuthenticationStatusPending
+}
+
+func (r *BackchannelAuthenticationRequest) UsesPing() bool {
+	return r.DeliveryMode == BackchannelTokenDeliveryModePing
+}
+
+// Approve records the user's approval along with the session it was given from.
+func (r *BackchannelAuthenticationRequest) Approve(session *Session) {
+	r.Status = BackchannelAuthenticationStatusApproved
+	r.AuthTime = session.CreatedAt
+	r.ACR = session.ACR
+	r.AMR = session.AMR
+}
+
+func (r *BackchannelAuthenticationRequest) Deny() {
+	r.Status = BackchannelAuthenticationStatusDenied
+}
+
+// Poll records a token request for a still pending authentication.
+func (r *BackchannelAuthenticationRequest) Poll(now time.Time) error {
+	lastPolledAt := r.LastPolledAt
+	r.LastPolledAt = &now
+
+	if r.DeliveryMode == BackchannelTokenDeliveryModePoll && lastPolledAt != nil && now.Sub(*lastPolledAt) < r.Interval {
+		r.Interval += BackchannelSlowDownIncrement
+		return ErrSlowDown
+	}
+
+	return ErrAuthorizationPending
+}
+
+func (r *BackchannelAuthenticationRequest) TTL() time.Duration {
+	return time.Until(r.ExpiresAt)
+}
+
+func (r *BackchannelAuthenticationRequest) ToResponse() *BackchannelAuthenticationResponse {
+	response := &BackchannelAuthenticationResponse{
+		AuthReqID: r.AuthReqID,
+		ExpiresIn: int64(r.ExpiresAt.Sub(r.CreatedAt).Seconds()),
+	}
+
+	if r.DeliveryMode == BackchannelTokenDeliveryModePoll {
+		response.Interval = int64(r.Interval.Seconds())
+	}
+
+	return response
+}
+
+func generateAuthReqID() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("generate random bytes: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
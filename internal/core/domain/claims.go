@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+const (
+	ScopeOpenID        = "openid"
+	ScopeOfflineAccess = "offline_access"
+)
+
+const (
+	ClaimName          = "name"
+	ClaimUpdatedAt     = "updated_at"
+	ClaimEmail         = "email"
+	ClaimEmailVerified = "email_verified"
+)
+
+var ErrInvalidClaimsRequest = errors.New("invalid claims request")
+
+// ClaimRequest is an individual claim entry of the OIDC claims request parameter.
+type ClaimRequest struct {
+	Essential bool  `json:"essential,omitempty"`
+	Value     any   `json:"value,omitempty"`
+	Values    []any `json:"values,omitempty"`
+}
+
+// ClaimsRequest is the OIDC claims request parameter.
+type ClaimsRequest struct {
+	UserInfo map[string]*ClaimRequest `json:"userinfo,omitempty"`
+	IDToken  map[string]*ClaimRequest `json:"id_token,omitempty"`
+}
+
+// ParseClaimsRequest decodes the JSON claims request parameter.
+func ParseClaimsRequest(raw string) (*ClaimsRequest, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var request ClaimsRequest
+	if err := json.Unmarshal([]byte(raw), &request); err != nil {
+		return nil, ErrInvalidClaimsRequest
+	}
+
+	return &request, nil
+}
+
+func (r *ClaimsRequest) UserInfoClaims() map[string]*ClaimRequest {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserInfo
+}
+
+func (r *ClaimsRequest) IDTokenClaims() map[string]*ClaimRequest {
+	if r == nil {
+		return nil
+	}
+
+	return r.IDToken
+}
+
+// Matches reports whether value satisfies the value or values constraint of the request.
+func (r *ClaimRequest) Matches(value any) bool {
+	if r == nil {
+		return true
+	}
+
+	if r.Value != nil {
+		return equalClaimValues(r.Value, value)
+	}
+
+	if len(r.Values) > 0 {
+		return slices.ContainsFunc(r.Values, func(expected any) bool {
+			return equalClaimValues(expected, value)
+		})
+	}
+
+	return true
+}
+
+// equalClaimValues compares claim values by their JSON encoding.
+func equalClaimValues(a, b any) bool {
+	encodedA, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+
+	encodedB, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(encodedA, encodedB)
+}
+
+// UserInfo is the response of the userinfo endpoint.
+type UserInfo struct {
+	Claims map[string]any
+	JWT    string
+}
+
+// ResolveClaims returns the user claims to release.
+func ResolveClaims(user *User, scopeClaims []string, requested map[string]*ClaimRequest) map[string]any {
+	available := user.Claims()
+	claims := make(map[string]any)
+
+	for _, name := range scopeClaims {
+		if value, ok := available[name]; ok {
+			claims[name] = value
+		}
+	}
+
+	for name, request := range requested {
+		value, ok := available[name]
+		if !ok {
+			continue
+		}
+
+		if !request.Matches(value) {
+			delete(claims, name)
+			continue
+		}
+
+		claims[name] = value
+	}
+
+	return claims
+}
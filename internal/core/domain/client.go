@@ -1,6 +1,11 @@
 package domain
 
 import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
 	"slices"
 	"strings"
 	"time"
@@ -8,46 +13,305 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	TokenEndpointAuthMethodNone             = "none"
+	TokenEndpointAuthMethodClientSecretPost = "client_secret_post"
+	TokenEndpointAuthMethodPrivateKeyJWT    = "private_key_jwt"
+	TokenEndpointAuthMethodClientSecretJWT  = "client_secret_jwt"
+)
+
+const clientSecretKeySize = 64
+
+var ErrInvalidClientKeys = errors.New("invalid client keys")
+
 type Client struct {
-	ID            uuid.UUID
-	ClientID      string
-	ClientSecret  string
-	ClientName    string
-	RedirectURIs  []string
-	GrantTypes    []string
-	ResponseTypes []string
-	Scopes        []string
-	LogoURL       string
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-}
-
-func NewClient(clientID, clientSecret, clientName string, redirectURIs, grantTypes, responseTypes, scopes []string, logoURL string) (*Client, error) {
+	ID                      uuid.UUID
+	ClientID                string
+	ClientSecret            string
+	ClientName              string
+	RedirectURIs            []string
+	GrantTypes              []string
+	ResponseTypes           []string
+	ResponseModes           []string
+	Scopes                  []string
+	LogoURL                 string
+	SubjectType             string
+	SectorIdentifierURI     string
+	FirstParty              bool
+	TokenPolicy             TokenPolicy
+	ExchangeAudiences       []string
+	TokenEndpointAuthMethod string
+	JWKS                    *JSONWebKeySet
+	JWKSURI                 string
+	DPoPBoundAccessTokens   bool
+	// TLSClientAuthSubjectDN is the subject DN of the certificate a tls_client_auth client authenticates with.
+	TLSClientAuthSubjectDN                string
+	TLSClientCertificateBoundAccessTokens bool
+	BackchannelTokenDeliveryMode          string
+	BackchannelClientNotificationEndpoint string
+	IDTokenEncryption                     ResponseEncryption
+	UserInfoEncryption                    ResponseEncryption
+	// DefaultACRValues is the assurance the client requires when an authorization request carries no acr_values.
+	DefaultACRValues []string
+	// EncryptedSecretKey is the encrypted secret a client_secret_jwt client MACs its assertions with.
+	EncryptedSecretKey []byte
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func NewClient(clientID, clientSecret string, params CreateClientParams) (*Client, error) {
 	id, err := uuid.NewV7()
 	if err != nil {
 		return nil, err
 	}
 
 	return &Client{
-		ID:            id,
-		ClientID:      clientID,
-		ClientSecret:  clientSecret,
-		ClientName:    clientName,
-		RedirectURIs:  redirectURIs,
-		GrantTypes:    grantTypes,
-		ResponseTypes: responseTypes,
-		Scopes:        scopes,
-		LogoURL:       logoURL,
+		ID:                                    id,
+		ClientID:                              clientID,
+		ClientSecret:                          clientSecret,
+		ClientName:                            params.ClientName,
+		RedirectURIs:                          params.RedirectURIs,
+		GrantTypes:                            params.GrantTypes,
+		ResponseTypes:                         params.ResponseTypes,
+		ResponseModes:                         params.ResponseModes,
+		Scopes:                                params.Scopes,
+		LogoURL:                               params.LogoURL,
+		SubjectType:                           subjectTypeOrDefault(params.SubjectType),
+		SectorIdentifierURI:                   params.SectorIdentifierURI,
+		FirstParty:                            params.FirstParty,
+		TokenPolicy:                           params.TokenPolicy,
+		ExchangeAudiences:                     params.ExchangeAudiences,
+		TokenEndpointAuthMethod:               tokenEndpointAuthMethodOrDefault(params.TokenEndpointAuthMethod),
+		JWKS:                                  params.JWKS,
+		JWKSURI:                               params.JWKSURI,
+		DPoPBoundAccessTokens:                 params.DPoPBoundAccessTokens,
+		TLSClientAuthSubjectDN:                params.TLSClientAuthSubjectDN,
+		TLSClientCertificateBoundAccessTokens: params.TLSClientCertificateBoundAccessTokens,
+		BackchannelTokenDeliveryMode:          params.BackchannelTokenDeliveryMode,
+		BackchannelClientNotificationEndpoint: params.BackchannelClientNotificationEndpoint,
+		IDTokenEncryption:                     params.IDTokenEncryption,
+		UserInfoEncryption:                    params.UserInfoEncryption,
+		DefaultACRValues:                      params.DefaultACRValues,
 	}, nil
 }
 
 type CreateClientParams struct {
-	ClientName    string
-	RedirectURIs  []string
-	GrantTypes    []string
-	ResponseTypes []string
-	Scopes        []string
-	LogoURL       string
+	ClientName                            string
+	RedirectURIs                          []string
+	GrantTypes                            []string
+	ResponseTypes                         []string
+	ResponseModes                         []string
+	Scopes                                []string
+	LogoURL                               string
+	SubjectType                           string
+	SectorIdentifierURI                   string
+	FirstParty                            bool
+	TokenPolicy                           TokenPolicy
+	ExchangeAudiences                     []string
+	TokenEndpointAuthMethod               string
+	JWKS                                  *JSONWebKeySet
+	JWKSURI                               string
+	DPoPBoundAccessTokens                 bool
+	TLSClientAuthSubjectDN                string
+	TLSClientCertificateBoundAccessTokens bool
+	BackchannelTokenDeliveryMode          string
+	BackchannelClientNotificationEndpoint string
+	IDTokenEncryption                     ResponseEncryption
+	UserInfoEncryption                    ResponseEncryption
+	DefaultACRValues                      []string
+}
+
+type UpdateClientParams struct {
+	ClientName                            string
+	RedirectURIs                          []string
+	GrantTypes                            []string
+	ResponseTypes                         []string
+	ResponseModes                         []string
+	Scopes                                []string
+	SubjectType                           string
+	SectorIdentifierURI                   string
+	FirstParty                            bool
+	TokenPolicy                           TokenPolicy
+	ExchangeAudiences                     []string
+	TokenEndpointAuthMethod               string
+	JWKS                                  *JSONWebKeySet
+	JWKSURI                               string
+	DPoPBoundAccessTokens                 bool
+	TLSClientAuthSubjectDN                string
+	TLSClientCertificateBoundAccessTokens bool
+	BackchannelTokenDeliveryMode          string
+	BackchannelClientNotificationEndpoint string
+	IDTokenEncryption                     ResponseEncryption
+	UserInfoEncryption                    ResponseEncryption
+	DefaultACRValues                      []string
+}
+
+func (c *Client) Update(params UpdateClientParams) {
+	c.ClientName = params.ClientName
+	c.RedirectURIs = params.RedirectURIs
+	c.GrantTypes = params.GrantTypes
+	c.ResponseTypes = params.ResponseTypes
+	c.ResponseModes = params.ResponseModes
+	c.Scopes = params.Scopes
+	c.SubjectType = subjectTypeOrDefault(params.SubjectType)
+	c.SectorIdentifierURI = params.SectorIdentifierURI
+	c.FirstParty = params.FirstParty
+	c.TokenPolicy = params.TokenPolicy
+	c.ExchangeAudiences = params.ExchangeAudiences
+	c.TokenEndpointAuthMethod = tokenEndpointAuthMethodOrDefault(params.TokenEndpointAuthMethod)
+	c.JWKS = params.JWKS
+	c.JWKSURI = params.JWKSURI
+	c.DPoPBoundAccessTokens = params.DPoPBoundAccessTokens
+	c.TLSClientAuthSubjectDN = params.TLSClientAuthSubjectDN
+	c.TLSClientCertificateBoundAccessTokens = params.TLSClientCertificateBoundAccessTokens
+	c.BackchannelTokenDeliveryMode = params.BackchannelTokenDeliveryMode
+	c.BackchannelClientNotificationEndpoint = params.BackchannelClientNotificationEndpoint
+	c.IDTokenEncryption = params.IDTokenEncryption
+	c.UserInfoEncryption = params.UserInfoEncryption
+	c.DefaultACRValues = params.DefaultACRValues
+}
+
+// IsPublic reports whether the client has no credentials to authenticate with, like a SPA or a native app.
+func (c *Client) IsPublic() bool {
+	return c.TokenEndpointAuthMethod == TokenEndpointAuthMethodNone
+}
+
+// UsesPrivateKeyJWT reports whether the client authenticates with assertions signed by its keys.
+func (c *Client) UsesPrivateKeyJWT() bool {
+	return c.TokenEndpointAuthMethod == TokenEndpointAuthMethodPrivateKeyJWT
+}
+
+// UsesClientSecretJWT reports whether the client authenticates with assertions MACed with its secret.
+func (c *Client) UsesClientSecretJWT() bool {
+	return c.TokenEndpointAuthMethod == TokenEndpointAuthMethodClientSecretJWT
+}
+
+// UsesTLSClientAuth reports whether the client authenticates with a mutual TLS certificate.
+func (c *Client) UsesTLSClientAuth() bool {
+	return c.TokenEndpointAuthMethod == TokenEndpointAuthMethodTLSClientAuth ||
+		c.TokenEndpointAuthMethod == TokenEndpointAuthMethodSelfSignedTLSClientAuth
+}
+
+// HasKeys reports whether the client registered public keys, inline or by reference.
+func (c *Client) HasKeys() bool {
+	return (c.JWKS != nil && len(c.JWKS.Keys) > 0) || c.JWKSURI != ""
+}
+
+// ValidateKeys checks a client registered what it authenticates with.
+func (c *Client) ValidateKeys() error {
+	switch c.TokenEndpointAuthMethod {
+	case TokenEndpointAuthMethodPrivateKeyJWT, TokenEndpointAuthMethodSelfSignedTLSClientAuth:
+		if !c.HasKeys() {
+			return ErrInvalidClientKeys
+		}
+	case TokenEndpointAuthMethodTLSClientAuth:
+		if c.TLSClientAuthSubjectDN == "" {
+			return ErrInvalidClientKeys
+		}
+	case TokenEndpointAuthMethodClientSecretJWT:
+		if len(c.EncryptedSecretKey) == 0 {
+			return ErrInvalidClientKeys
+		}
+	}
+	return nil
+}
+
+// ValidateBackchannel checks the CIBA delivery settings of the client.
+func (c *Client) ValidateBackchannel() error {
+	if !c.SupportsGrantType(GrantTypeCIBA) {
+		return nil
+	}
+
+	if c.IsPublic() {
+		return ErrInvalidBackchannelConfiguration
+	}
+
+	switch c.BackchannelTokenDeliveryMode {
+	case BackchannelTokenDeliveryModePoll:
+		return nil
+	case BackchannelTokenDeliveryModePing:
+		if c.BackchannelClientNotificationEndpoint == "" {
+			return ErrInvalidBackchannelConfiguration
+		}
+		return nil
+	}
+
+	return ErrInvalidBackchannelConfiguration
+}
+
+// ValidateEncryption checks the client's ID token and userinfo encryption algorithms.
+func (c *Client) ValidateEncryption() error {
+	if err := c.IDTokenEncryption.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.UserInfoEncryption.Validate(); err != nil {
+		return err
+	}
+
+	if (c.IDTokenEncryption.Enabled() || c.UserInfoEncryption.Enabled()) && !c.HasKeys() {
+		return ErrInvalidClientEncryption
+	}
+
+	return nil
+}
+
+// GenerateClientSecretKey returns a secret long enough to MAC HS512 assertions with.
+func GenerateClientSecretKey() (string, error) {
+	key := make([]byte, clientSecretKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("generate client secret key: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(key), nil
+}
+
+func tokenEndpointAuthMethodOrDefault(method string) string {
+	if method == "" {
+		return TokenEndpointAuthMethodClientSecretPost
+	}
+	return method
+}
+
+func (c *Client) UsesPairwiseSubject() bool {
+	return c.SubjectType == SubjectTypePairwise
+}
+
+// SectorIdentifier returns the host pairwise subjects are computed for.
+func (c *Client) SectorIdentifier() (string, error) {
+	if c.SectorIdentifierURI != "" {
+		u, err := url.Parse(c.SectorIdentifierURI)
+		if err != nil || u.Hostname() == "" {
+			return "", ErrInvalidSectorIdentifier
+		}
+		return u.Hostname(), nil
+	}
+
+	var host string
+	for _, redirectURI := range c.RedirectURIs {
+		u, err := url.Parse(redirectURI)
+		if err != nil {
+			return "", ErrInvalidRedirectURI
+		}
+
+		if host != "" && u.Hostname() != host {
+			return "", ErrInvalidSectorIdentifier
+		}
+		host = u.Hostname()
+	}
+
+	if host == "" {
+		return "", ErrInvalidSectorIdentifier
+	}
+
+	return host, nil
+}
+
+func subjectTypeOrDefault(subjectType string) string {
+	if subjectType == "" {
+		return SubjectTypePublic
+	}
+	return subjectType
 }
 
 func (c *Client) HasRedirectURI(uri string) bool {
@@ -58,8 +322,27 @@ func (c *Client) SupportsGrantType(grantType string) bool {
 	return slices.Contains(c.GrantTypes, grantType)
 }
 
+// CanExchangeFor reports whether the client may exchange tokens for ones targeting the audience.
+func (c *Client) CanExchangeFor(audience string) bool {
+	return c.SupportsGrantType(GrantTypeTokenExchange) && slices.Contains(c.ExchangeAudiences, audience)
+}
+
 func (c *Client) SupportsResponseType(responseType string) bool {
-	return slices.Contains(c.ResponseTypes, responseType)
+	responseType = NormalizeResponseType(responseType)
+	for _, registered := range c.ResponseTypes {
+		if NormalizeResponseType(registered) == responseType {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportsResponseMode reports whether the client accepts the given response_mode.
+func (c *Client) SupportsResponseMode(responseMode string) bool {
+	if len(c.ResponseModes) == 0 {
+		return true
+	}
+	return slices.Contains(c.ResponseModes, responseMode)
 }
 
 func (c *Client) SupportsScopes(requestedScopes []string) bool {
@@ -76,6 +359,13 @@ func (c *Client) SupportsScopes(requestedScopes []string) bool {
 	return true
 }
 
+// AllowedScopes filters the scopes down to the ones registered for the client.
+func (c *Client) AllowedScopes(scopes []string) []string {
+	return slices.DeleteFunc(slices.Clone(scopes), func(scope string) bool {
+		return !slices.Contains(c.Scopes, scope)
+	})
+}
+
 func (c *Client) HasAnyScope(requestedScopes []string) bool {
 	for _, requested := range requestedScopes {
 		requested = strings.TrimSpace(requested)
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrConsentRequired = errors.New("consent required")
+
+// Consent holds the scopes a user approved for a client on the consent screen.
+type Consent struct {
+	UserID    uuid.UUID
+	ClientID  string
+	Scopes    []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (c *Consent) Includes(scope string) bool {
+	return c != nil && slices.Contains(c.Scopes, scope)
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+var responseTypes = []string{
+	"code",
+	"id_token",
+	"id_token token",
+	"code id_token",
+	"code token",
+	"code id_token token",
+}
+
+// idTokenClaims are the claims about the authentication every ID token can carry, whatever the scopes.
+var idTokenClaims = []string{"sub", "auth_time", "acr", "amr", "azp", "sid"}
+
+// ProviderMetadata is the OpenID Provider configuration published at /.well-known/openid-configuration.
+type ProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	IntrospectionEndpoint string `json:"introspection_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+	// PASETOKeysURI is not registered metadata; it points to the PASETO verification keys.
+	PASETOKeysURI                              string   `json:"paseto_keys_uri"`
+	ScopesSupported                            []string `json:"scopes_supported"`
+	ResponseTypesSupported                     []string `json:"response_types_supported"`
+	ResponseModesSupported                     []string `json:"response_modes_supported"`
+	GrantTypesSupported                        []string `json:"grant_types_supported"`
+	SubjectTypesSupported                      []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported           []string `json:"id_token_signing_alg_values_supported"`
+	IDTokenEncryptionAlgValuesSupported        []string `json:"id_token_encryption_alg_values_supported"`
+	IDTokenEncryptionEncValuesSupported        []string `json:"id_token_encryption_enc_values_supported"`
+	UserInfoSigningAlgValuesSupported          []string `json:"userinfo_signing_alg_values_supported"`
+	UserInfoEncryptionAlgValuesSupported       []string `json:"userinfo_encryption_alg_values_supported"`
+	UserInfoEncryptionEncValuesSupported       []string `json:"userinfo_encryption_enc_values_supported"`
+	ACRValuesSupported                         []string `json:"acr_values_supported"`
+	ClaimsSupported                            []string `json:"claims_supported"`
+	ClaimsParameterSupported                   bool     `json:"claims_parameter_supported"`
+	TokenEndpointAuthMethodsSupported          []string `json:"token_endpoint_auth_methods_supported"`
+	TokenEndpointAuthSigningAlgValuesSupported []string `json:"token_endpoint_auth_signing_alg_values_supported"`
+	DPoPSigningAlgValuesSupported              []string `json:"dpop_signing_alg_values_supported"`
+	TLSClientCertificateBoundAccessTokens      bool     `json:"tls_client_certificate_bound_access_tokens"`
+	AuthorizationDetailsTypesSupported         []string `json:"authorization_details_types_supported"`
+	BackchannelAuthenticationEndpoint          string   `json:"backchannel_authentication_endpoint"`
+	BackchannelTokenDeliveryModesSupported     []string `json:"backchannel_token_delivery_modes_supported"`
+	BackchannelUserCodeParameterSupported      bool     `json:"backchannel_user_code_parameter_supported"`
+}
+
+// NewProviderMetadata describes the provider served under baseURL.
+func NewProviderMetadata(issuer, baseURL string, scopes []*Scope, detailTypes []*AuthorizationDetailType) *ProviderMetadata {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	return &ProviderMetadata{
+		Issuer:                              issuer,
+		AuthorizationEndpoint:               baseURL + "/api/v1/oauth/authorize",
+		TokenEndpoint:                       TokenEndpoint(baseURL),
+		UserInfoEndpoint:                    UserInfoEndpoint(baseURL),
+		IntrospectionEndpoint:               IntrospectionEndpoint(baseURL),
+		JWKSURI:                             baseURL + "/api/.well-known/jwks.json",
+		PASETOKeysURI:                       baseURL + "/api/.well-known/paseto-keys.json",
+		ScopesSupported:                     ScopeNames(scopes),
+		ResponseTypesSupported:              slices.Clone(responseTypes),
+		ResponseModesSupported:              slices.Clone(responseModes),
+		GrantTypesSupported:                 []string{GrantTypeAuthorizationCode, "implicit", GrantTypeRefreshToken, GrantTypeTokenExchange, GrantTypeJWTBearer, GrantTypeCIBA},
+		SubjectTypesSupported:               []string{SubjectTypePublic, SubjectTypePairwise},
+		IDTokenSigningAlgValuesSupported:    []string{SigningAlgorithmRS256},
+		IDTokenEncryptionAlgValuesSupported: KeyManagementAlgs(),
+		IDTokenEncryptionEncValuesSupported: ContentEncryptions(),
+		// Encrypted userinfo responses are signed before being encrypted.
+		UserInfoSigningAlgValuesSupported:    []string{SigningAlgorithmRS256},
+		UserInfoEncryptionAlgValuesSupported: KeyManagementAlgs(),
+		UserInfoEncryptionEncValuesSupported: ContentEncryptions(),
+		ACRValuesSupported:                   slices.Clone(ACRValuesSupported),
+		ClaimsSupported:                      append(slices.Clone(idTokenClaims), ScopeClaims(scopes)...),
+		ClaimsParameterSupported:             true,
+		TokenEndpointAuthMethodsSupported: []string{
+			TokenEndpointAuthMethodNone,
+			TokenEndpointAuthMethodClientSecretPost,
+			TokenEndpointAuthMethodPrivateKeyJWT,
+			TokenEndpointAuthMethodClientSecretJWT,
+			TokenEndpointAuthMethodTLSClientAuth,
+			TokenEndpointAuthMethodSelfSignedTLSClientAuth,
+		},
+		TokenEndpointAuthSigningAlgValuesSupported: slices.Concat(assertionSigningAlgs, assertionMACAlgs),
+		DPoPSigningAlgValuesSupported:              DPoPSigningAlgs(),
+		TLSClientCertificateBoundAccessTokens:      true,
+		AuthorizationDetailsTypesSupported:         AuthorizationDetailTypeNames(detailTypes),
+		BackchannelAuthenticationEndpoint:          baseURL + "/api/v1/oauth/bc-authorize",
+		BackchannelTokenDeliveryModesSupported:     []string{BackchannelTokenDeliveryModePoll, BackchannelTokenDeliveryModePing},
+		BackchannelUserCodeParameterSupported:      false,
+	}
+}
+
+func TokenEndpoint(baseURL string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/api/v1/oauth/token"
+}
+
+func UserInfoEndpoint(baseURL string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/api/v1/oauth/userinfo"
+}
+
+func IntrospectionEndpoint(baseURL string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/api/v1/oauth/introspect"
+}
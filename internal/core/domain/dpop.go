@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	TokenTypeDPoP = "DPoP"
+	DPoPProofType = "dpop+jwt"
+)
+
+var (
+	ErrInvalidDPoPProof = errors.New("invalid DPoP proof")
+	ErrUseDPoPNonce     = errors.New("DPoP nonce required")
+)
+
+// DPoPSigningAlgs are the algorithms DPoP proofs may be signed with.
+func DPoPSigningAlgs() []string {
+	return slices.Clone(assertionSigningAlgs)
+}
+
+// Confirmation is the cnf claim of a sender-constrained token.
+type Confirmation struct {
+	JKT                   string `json:"jkt,omitempty"`
+	CertificateThumbprint string `json:"x5t#S256,omitempty"`
+}
+
+// DPoPProof is a DPoP proof JWT (RFC 9449) whose signature was verified against the public key in its own header.
+type DPoPProof struct {
+	Thumbprint      string
+	Method          string
+	URL             string
+	IssuedAt        time.Time
+	JWTID           string
+	Nonce           string
+	AccessTokenHash string
+}
+
+// DPoPProofParams is the request a DPoP proof was presented with.
+type DPoPProofParams struct {
+	Proof       string
+	Method      string
+	URL         string
+	AccessToken string
+}
+
+// Validate checks the proof was created recently for this request and access token.
+func (p *DPoPProof) Validate(params DPoPProofParams, maxAge time.Duration) error {
+	if p.JWTID == "" {
+		return fmt.Errorf("%w: missing jti", ErrInvalidDPoPProof)
+	}
+
+	if p.Method != params.Method {
+		return fmt.Errorf("%w: htm mismatch", ErrInvalidDPoPProof)
+	}
+
+	if !sameHTU(p.URL, params.URL) {
+		return fmt.Errorf("%w: htu mismatch", ErrInvalidDPoPProof)
+	}
+
+	now := time.Now().UTC()
+	if p.IssuedAt.Before(now.Add(-maxAge)) || p.IssuedAt.After(now.Add(maxAge)) {
+		return fmt.Errorf("%w: iat outside the accepted window", ErrInvalidDPoPProof)
+	}
+
+	if params.AccessToken != "" && p.AccessTokenHash != DPoPAccessTokenHash(params.AccessToken) {
+		return fmt.Errorf("%w: ath mismatch", ErrInvalidDPoPProof)
+	}
+
+	return nil
+}
+
+// DPoPAccessTokenHash computes the ath claim binding a proof to an access token.
+func DPoPAccessTokenHash(accessToken string) string {
+	hash := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// NewDPoPNonce generates a nonce for the DPoP-Nonce header.
+func NewDPoPNonce() (string, error) {
+	bytes := make([]byte, 24)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("generate random bytes: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// sameHTU compares two htu values ignoring query and fragment, with the scheme and host compared case-insensitively.
+func sameHTU(a, b string) bool {
+	first, err := url.Parse(a)
+	if err != nil {
+		return false
+	}
+
+	second, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(first.Scheme, second.Scheme) &&
+		strings.EqualFold(first.Host, second.Host) &&
+		first.Path == second.Path
+}
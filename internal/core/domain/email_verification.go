@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// EmailVerificationTokenExpiry is how long the link of a verification email works.
+	EmailVerificationTokenExpiry = 24 * time.Hour
+	// MaxVerificationEmails caps the verification emails sent to an address within VerificationEmailWindow.
+	MaxVerificationEmails   = 3
+	VerificationEmailWindow = time.Hour
+)
+
+var (
+	ErrInvalidEmailVerificationToken = errors.New("invalid email verification token")
+	ErrEmailAlreadyVerified          = errors.New("email is already verified")
+	ErrTooManyVerificationEmails     = errors.New("too many verification emails")
+	ErrVerificationEmailNotSent      = errors.New("verification email not sent")
+)
+
+// EmailVerificationToken is what the link of a verification email carries.
+type EmailVerificationToken struct {
+	ID        string
+	UserID    uuid.UUID
+	Email     string
+	ExpiresAt time.Time
+}
+
+func NewEmailVerificationToken(user *User, now time.Time) (*EmailVerificationToken, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &EmailVerificationToken{
+		ID:        id.String(),
+		UserID:    user.ID,
+		Email:     user.Email,
+		ExpiresAt: now.Add(EmailVerificationTokenExpiry),
+	}, nil
+}
+
+func (t *EmailVerificationToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// EmailMessage is a plain text email to a single recipient.
+type EmailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+)
+
+// Algorithms ID tokens and userinfo responses can be encrypted with (RFC 7516).
+const (
+	KeyManagementAlgRSAOAEP256 = "RSA-OAEP-256"
+	KeyManagementAlgECDHES     = "ECDH-ES"
+	ContentEncryptionA256GCM   = "A256GCM"
+)
+
+// ContentTypeJWT is the cty of a JWE whose payload is a signed JWT.
+const ContentTypeJWT = "JWT"
+
+var (
+	ErrInvalidClientEncryption = errors.New("invalid client encryption metadata")
+	ErrEncryptionKeyNotFound   = errors.New("client has no key for the encryption algorithm")
+)
+
+var (
+	keyManagementAlgs  = []string{KeyManagementAlgRSAOAEP256, KeyManagementAlgECDHES}
+	contentEncryptions = []string{ContentEncryptionA256GCM}
+)
+
+func KeyManagementAlgs() []string {
+	return slices.Clone(keyManagementAlgs)
+}
+
+func ContentEncryptions() []string {
+	return slices.Clone(contentEncryptions)
+}
+
+// ResponseEncryption is how a client asked for a response to be encrypted.
+type ResponseEncryption struct {
+	Alg string
+	Enc string
+}
+
+func (e ResponseEncryption) Enabled() bool {
+	return e.Alg != ""
+}
+
+// Validate checks both algorithms are supported.
+func (e ResponseEncryption) Validate() error {
+	if e.Alg == "" {
+		if e.Enc != "" {
+			return ErrInvalidClientEncryption
+		}
+		return nil
+	}
+
+	if !slices.Contains(keyManagementAlgs, e.Alg) || !slices.Contains(contentEncryptions, e.Enc) {
+		return ErrInvalidClientEncryption
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenTypeJWT identifies a subject token that is a JWT from a trusted external issuer.
+const TokenTypeJWT = "urn:ietf:params:oauth:token-type:jwt"
+
+var (
+	ErrTrustedIssuerAlreadyExists = errors.New("trusted issuer already exists")
+	ErrInvalidTrustedIssuer       = errors.New("invalid trusted issuer")
+	ErrInvalidTrustPolicy         = errors.New("invalid trust policy")
+	ErrUntrustedToken             = errors.New("untrusted federated token")
+)
+
+// TrustedIssuer is an external identity provider whose tokens workloads can exchange for ours.
+type TrustedIssuer struct {
+	ID        uuid.UUID
+	Issuer    string
+	JWKS      *JSONWebKeySet
+	JWKSURI   string
+	Audiences []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CreateTrustedIssuerParams struct {
+	Issuer    string
+	JWKS      *JSONWebKeySet
+	JWKSURI   string
+	Audiences []string
+}
+
+func NewTrustedIssuer(params CreateTrustedIssuerParams) (*TrustedIssuer, error) {
+	issuer, err := url.Parse(params.Issuer)
+	if err != nil || issuer.Scheme != "https" || issuer.Host == "" {
+		return nil, ErrInvalidTrustedIssuer
+	}
+
+	if (params.JWKS == nil || len(params.JWKS.Keys) == 0) && params.JWKSURI == "" {
+		return nil, ErrInvalidTrustedIssuer
+	}
+
+	if len(params.Audiences) == 0 {
+		return nil, ErrInvalidTrustedIssuer
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	return &TrustedIssuer{
+		ID:        id,
+		Issuer:    params.Issuer,
+		JWKS:      params.JWKS,
+		JWKSURI:   params.JWKSURI,
+		Audiences: params.Audiences,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// AcceptsAudience reports whether a token addressed to any of the audiences is meant for this server.
+func (i *TrustedIssuer) AcceptsAudience(audiences []string) bool {
+	return slices.ContainsFunc(audiences, func(audience string) bool { return slices.Contains(i.Audiences, audience) })
+}
+
+// TrustPolicy maps the tokens of a trusted issuer that meet its conditions to a client.
+type TrustPolicy struct {
+	ID         uuid.UUID
+	IssuerID   uuid.UUID
+	ClientID   string
+	Conditions map[string]string
+	Scopes     []string
+	CreatedAt  time.Time
+}
+
+type CreateTrustPolicyParams struct {
+	ClientID   string
+	Conditions map[string]string
+	Scopes     []string
+}
+
+// NewTrustPolicy creates a policy for the issuer.
+func NewTrustPolicy(issuerID uuid.UUID, params CreateTrustPolicyParams) (*TrustPolicy, error) {
+	if len(params.Conditions) == 0 {
+		return nil, ErrInvalidTrustPolicy
+	}
+
+	for claim, pattern := range params.Conditions {
+		if claim == "" || pattern == "" || pattern == "*" {
+			return nil, ErrInvalidTrustPolicy
+		}
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrustPolicy{
+		ID:         id,
+		IssuerID:   issuerID,
+		ClientID:   params.ClientID,
+		Conditions: params.Conditions,
+		Scopes:     params.Scopes,
+		CreatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+// Matches reports whether the token claims meet every condition.
+func (p *TrustPolicy) Matches(claims map[string]any) bool {
+	for claim, pattern := range p.Conditions {
+		if !slices.ContainsFunc(claimValues(claims[claim]), func(value string) bool { return matchesPattern(pattern, value) }) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesPattern(pattern, value string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(value, prefix)
+	}
+	return value == pattern
+}
+
+func claimValues(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// FederatedClaims are the claims of a verified token from a trusted issuer.
+type FederatedClaims struct {
+	Issuer    string
+	Subject   string
+	Audience  []string
+	ExpiresAt time.Time
+	Claims    map[string]any
+}
+
+// FederatedIdentity is the client a federated token was mapped to by the first matching trust policy.
+type FederatedIdentity struct {
+	Claims *FederatedClaims
+	Policy *TrustPolicy
+}
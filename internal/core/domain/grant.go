@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrGrantNotFound = errors.New("grant not found")
+
+// OfflineGrant is a refresh token issued through the offline_access scope.
+type OfflineGrant struct {
+	ID         uuid.UUID
+	ClientID   string
+	ClientName string
+	Scopes     []string
+	// AuthorizationDetails are the exact details the user approved.
+	AuthorizationDetails AuthorizationDetails
+	CreatedAt            time.Time
+	LastUsedAt           *time.Time
+	ExpiresAt            time.Time
+}
@@ -0,0 +1,50 @@
+package domain
+
+const (
+	TokenTypeHintAccessToken  = "access_token"
+	TokenTypeHintRefreshToken = "refresh_token"
+)
+
+type IntrospectTokenParams struct {
+	Token               string
+	TokenTypeHint       string
+	ClientID            string
+	ClientSecret        string
+	ClientAssertion     string
+	ClientAssertionType string
+	ClientCertificate   *ClientCertificate
+}
+
+func (p IntrospectTokenParams) ClientCredentials() ClientCredentials {
+	return ClientCredentials{
+		ClientID:            p.ClientID,
+		ClientSecret:        p.ClientSecret,
+		ClientAssertion:     p.ClientAssertion,
+		ClientAssertionType: p.ClientAssertionType,
+		Certificate:         p.ClientCertificate,
+	}
+}
+
+// TokenIntrospection is the RFC 7662 introspection response.
+type TokenIntrospection struct {
+	Active    bool     `json:"active"`
+	Scope     string   `json:"scope,omitempty"`
+	ClientID  string   `json:"client_id,omitempty"`
+	TokenType string   `json:"token_type,omitempty"`
+	ExpiresAt int64    `json:"exp,omitempty"`
+	IssuedAt  int64    `json:"iat,omitempty"`
+	Subject   string   `json:"sub,omitempty"`
+	Audience  []string `json:"aud,omitempty"`
+	Issuer    string   `json:"iss,omitempty"`
+	JWTID     string   `json:"jti,omitempty"`
+	AuthTime  int64    `json:"auth_time,omitempty"`
+	ACR       string   `json:"acr,omitempty"`
+	Actor     *Actor   `json:"act,omitempty"`
+	// Confirmation lets resource servers check the proof of possession of a DPoP- or certificate-bound token.
+	Confirmation         *Confirmation        `json:"cnf,omitempty"`
+	AuthorizationDetails AuthorizationDetails `json:"authorization_details,omitempty"`
+}
+
+func InactiveTokenIntrospection() *TokenIntrospection {
+	return &TokenIntrospection{Active: false}
+}
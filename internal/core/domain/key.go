@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+	KeyID     string `json:"kid,omitempty"`
+	Modulus   string `json:"n,omitempty"`
+	Exponent  string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+	// X509CertificateChain holds the certificate of the key first, followed by its chain.
+	X509CertificateChain []string `json:"x5c,omitempty"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// NewRSAPublicJWK describes an RSA public key as a JWK for the given signing algorithm.
+func NewRSAPublicJWK(publicKey *rsa.PublicKey, algorithm, keyID string) JSONWebKey {
+	return JSONWebKey{
+		KeyType:   "RSA",
+		Use:       "sig",
+		Algorithm: algorithm,
+		KeyID:     keyID,
+		Modulus:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		Exponent:  base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+	}
+}
+
+// NewEd25519PublicJWK describes an Ed25519 public key as an OKP JWK (RFC 8037).
+func NewEd25519PublicJWK(publicKey ed25519.PublicKey, keyID string) JSONWebKey {
+	return JSONWebKey{
+		KeyType: "OKP",
+		Use:     "sig",
+		KeyID:   keyID,
+		Curve:   "Ed25519",
+		X:       base64.RawURLEncoding.EncodeToString(publicKey),
+	}
+}
+
+// Thumbprint computes the RFC 7638 JWK SHA-256 thumbprint over the required members of the key.
+func (k JSONWebKey) Thumbprint() (string, error) {
+	var members map[string]string
+	switch k.KeyType {
+	case "RSA":
+		members = map[string]string{"e": k.Exponent, "kty": k.KeyType, "n": k.Modulus}
+	case "EC":
+		members = map[string]string{"crv": k.Curve, "kty": k.KeyType, "x": k.X, "y": k.Y}
+	case "OKP":
+		members = map[string]string{"crv": k.Curve, "kty": k.KeyType, "x": k.X}
+	default:
+		return "", fmt.Errorf("unsupported key type %q", k.KeyType)
+	}
+
+	// Members must be serialized in lexicographic order, which is what encoding/json does for maps.
+	canonical, err := json.Marshal(members)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(canonical)
+	return base64.RawURLEncoding.EncodeToString(hash[:]), nil
+}
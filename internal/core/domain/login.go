@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginTicketExpiry is how long a user has to enter their second factor after their password was accepted.
+const LoginTicketExpiry = 5 * time.Minute
+
+// Second factors a login ticket can be finished with.
+const (
+	SecondFactorTOTP     = "totp"
+	SecondFactorWebAuthn = "webauthn"
+	// SecondFactorRecoveryCode stands in for the others when the user lost their device.
+	SecondFactorRecoveryCode = "recovery_code"
+)
+
+var ErrLoginTicketNotFound = errors.New("login ticket not found")
+
+// LoginTicket is a partially authenticated login.
+type LoginTicket struct {
+	ID     string
+	UserID uuid.UUID
+	// Methods are the second factors the user is enrolled in.
+	Methods   []string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// LoginResult is the outcome of a password login.
+type LoginResult struct {
+	User    *User
+	Session *Session
+	Ticket  *LoginTicket
+}
+
+func NewLoginTicket(userID uuid.UUID, methods []string) (*LoginTicket, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return nil, fmt.Errorf("generate login ticket: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	return &LoginTicket{
+		ID:        base64.RawURLEncoding.EncodeToString(bytes),
+		UserID:    userID,
+		Methods:   methods,
+		ExpiresAt: now.Add(LoginTicketExpiry),
+		CreatedAt: now,
+	}, nil
+}
+
+func (t *LoginTicket) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
+
+func (t *LoginTicket) TTL() time.Duration {
+	return time.Until(t.ExpiresAt)
+}
+
+// Allows reports whether the ticket can be finished with the second factor.
+func (t *LoginTicket) Allows(method string) bool {
+	return slices.Contains(t.Methods, method)
+}
+
+func (r *LoginResult) RequiresSecondFactor() bool {
+	return r.Ticket != nil
+}
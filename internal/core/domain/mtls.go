@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+)
+
+const (
+	TokenEndpointAuthMethodTLSClientAuth           = "tls_client_auth"
+	TokenEndpointAuthMethodSelfSignedTLSClientAuth = "self_signed_tls_client_auth"
+)
+
+var ErrInvalidClientCertificate = errors.New("invalid client certificate")
+
+// ClientCertificate is the certificate a client presented in the mutual TLS handshake.
+type ClientCertificate struct {
+	Leaf          *x509.Certificate
+	Intermediates []*x509.Certificate
+}
+
+// NewClientCertificate returns the client certificate of a peer chain, or nil when there is none.
+func NewClientCertificate(peerCertificates []*x509.Certificate) *ClientCertificate {
+	if len(peerCertificates) == 0 {
+		return nil
+	}
+
+	return &ClientCertificate{
+		Leaf:          peerCertificates[0],
+		Intermediates: peerCertificates[1:],
+	}
+}
+
+// SubjectDN returns the subject distinguished name in its RFC 4514 string form, which tls_client_auth clients register.
+func (c *ClientCertificate) SubjectDN() string {
+	return c.Leaf.Subject.String()
+}
+
+// Thumbprint returns the x5t#S256 value certificate-bound tokens are confirmed with (RFC 8705 section 3.1).
+func (c *ClientCertificate) Thumbprint() string {
+	return CertificateThumbprint(c.Leaf.Raw)
+}
+
+// MatchesKeySet reports whether the certificate is one of the x5c certificates in the key set.
+func (c *ClientCertificate) MatchesKeySet(keySet *JSONWebKeySet) bool {
+	if keySet == nil {
+		return false
+	}
+
+	for _, key := range keySet.Keys {
+		if len(key.X509CertificateChain) == 0 {
+			continue
+		}
+
+		// x5c values are standard base64, not base64url (RFC 7517 section 4.7).
+		registered, err := base64.StdEncoding.DecodeString(key.X509CertificateChain[0])
+		if err == nil && bytes.Equal(registered, c.Leaf.Raw) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CertificateThumbprint computes the base64url SHA-256 hash of a DER certificate.
+func CertificateThumbprint(der []byte) string {
+	hash := sha256.Sum256(der)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
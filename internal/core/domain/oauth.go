@@ -1,36 +1,269 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	ResponseTypeCode    = "code"
+	ResponseTypeToken   = "token"
+	ResponseTypeIDToken = "id_token"
+)
+
+const (
+	ResponseModeQuery       = "query"
+	ResponseModeFragment    = "fragment"
+	ResponseModeFormPost    = "form_post"
+	ResponseModeJWT         = "jwt"
+	ResponseModeQueryJWT    = "query.jwt"
+	ResponseModeFragmentJWT = "fragment.jwt"
+	ResponseModeFormPostJWT = "form_post.jwt"
+)
+
+const jwtResponseModeSuffix = ".jwt"
+
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeTokenExchange     = "urn:ietf:params:oauth:grant-type:token-exchange"
+	GrantTypeJWTBearer         = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+)
+
+const PromptConsent = "consent"
+
+const ErrorUnmetAuthenticationRequirements = "unmet_authentication_requirements"
+
+var responseModes = []string{
+	ResponseModeQuery,
+	ResponseModeFragment,
+	ResponseModeFormPost,
+	ResponseModeQueryJWT,
+	ResponseModeFragmentJWT,
+	ResponseModeFormPostJWT,
+}
 
 var (
 	ErrClientNotFound               = errors.New("client not found")
 	ErrInvalidRedirectURI           = errors.New("invalid redirect URI")
 	ErrUnauthorizedClient           = errors.New("unauthorized client")
+	ErrInvalidClient                = errors.New("invalid client credentials")
 	ErrUnsupportedResponseType      = errors.New("unsupported response type")
 	ErrInvalidScope                 = errors.New("invalid scope")
 	ErrInvalidAuthorizationCode     = errors.New("invalid authorization code")
 	ErrAuthorizationCodeAlreadyUsed = errors.New("authorization code already used")
 	ErrAuthorizationCodeExpired     = errors.New("authorization code expired")
 	ErrInvalidPKCEVerification      = errors.New("invalid PKCE verification")
+	ErrNonceRequired                = errors.New("nonce is required for this response type")
+	ErrUnsupportedResponseMode      = errors.New("unsupported response mode")
 )
 
 type AuthorizeParams struct {
 	ClientID            string
 	RedirectURI         string
 	ResponseType        string
+	ResponseMode        string
 	Scopes              []string
 	State               string
 	Nonce               string
 	CodeChallenge       string
 	CodeChallengeMethod string
+	Claims              string
+	Prompt              string
+	Resources           []string
+	// AuthorizationDetails is the raw authorization_details parameter of a rich authorization request (RFC 9396).
+	AuthorizationDetails string
+	// ACRValues are the requested authentication context classes, in order of preference.
+	ACRValues []string
+}
+
+type AuthorizationResponse struct {
+	Code        string
+	AccessToken string
+	TokenType   string
+	ExpiresIn   int64
+	IDToken     string
+	// Error is the OAuth error code of a request that can't be completed.
+	Error string
+	// Response holds the signed JARM response, when one was requested.
+	Response string
 }
 
 type ExchangeTokenParams struct {
-	GrantType    string
-	Code         string
-	RedirectURI  string
-	ClientID     string
-	ClientSecret string
-	CodeVerifier string
-	RefreshToken string
+	GrantType           string
+	Code                string
+	RedirectURI         string
+	ClientID            string
+	ClientSecret        string
+	ClientAssertion     string
+	ClientAssertionType string
+	CodeVerifier        string
+	RefreshToken        string
+	Resources           []string
+	// AuthorizationDetails is the raw authorization_details parameter, which narrows the details of the issued token.
+	AuthorizationDetails string
+	// Assertion is the signed JWT of the jwt-bearer grant (RFC 7523).
+	Assertion string
+	// AuthReqID identifies the backchannel authentication redeemed with the CIBA grant.
+	AuthReqID string
+	// Token exchange (RFC 8693) parameters.
+	SubjectToken     string
+	SubjectTokenType string
+	ActorToken       string
+	ActorTokenType   string
+	Audiences        []string
+	Scopes           []string
+	// DPoPJKT is the thumbprint of the key of the request's DPoP proof.
+	DPoPJKT string
+	// ClientCertificate is the certificate presented in the mutual TLS handshake, if any.
+	ClientCertificate *ClientCertificate
+}
+
+func (p ExchangeTokenParams) ClientCredentials() ClientCredentials {
+	return ClientCredentials{
+		ClientID:            p.ClientID,
+		ClientSecret:        p.ClientSecret,
+		ClientAssertion:     p.ClientAssertion,
+		ClientAssertionType: p.ClientAssertionType,
+		Certificate:         p.ClientCertificate,
+	}
+}
+
+// CertificateThumbprint returns the thumbprint of the request's client certificate, if any.
+func (p ExchangeTokenParams) CertificateThumbprint() string {
+	if p.ClientCertificate == nil {
+		return ""
+	}
+	return p.ClientCertificate.Thumbprint()
+}
+
+// ExchangeTargets returns the resources a token exchange is requested for.
+func (p ExchangeTokenParams) ExchangeTargets() []string {
+	targets := slices.Clone(p.Resources)
+	for _, audience := range p.Audiences {
+		if !slices.Contains(targets, audience) {
+			targets = append(targets, audience)
+		}
+	}
+	return targets
+}
+
+// NormalizeResponseType sorts the space-delimited values of a response_type.
+func NormalizeResponseType(responseType string) string {
+	values := strings.Fields(responseType)
+	slices.Sort(values)
+	return strings.Join(values, " ")
+}
+
+func (p AuthorizeParams) RequestsCode() bool {
+	return slices.Contains(strings.Fields(p.ResponseType), ResponseTypeCode)
+}
+
+func (p AuthorizeParams) RequestsAccessToken() bool {
+	return slices.Contains(strings.Fields(p.ResponseType), ResponseTypeToken)
+}
+
+func (p AuthorizeParams) RequestsIDToken() bool {
+	return slices.Contains(strings.Fields(p.ResponseType), ResponseTypeIDToken)
+}
+
+// RequestsConsent reports whether prompt asks for the user's consent.
+func (p AuthorizeParams) RequestsConsent() bool {
+	return slices.Contains(strings.Fields(p.Prompt), PromptConsent)
+}
+
+// RequestsOfflineAccess reports whether the request asks for offline_access the way OIDC allows it.
+func (p AuthorizeParams) RequestsOfflineAccess() bool {
+	return p.RequestsConsent() && p.RequestsCode() && slices.Contains(p.Scopes, ScopeOfflineAccess)
+}
+
+// GrantedScopes drops offline_access unless it is requested and the consent includes it.
+func (p AuthorizeParams) GrantedScopes(consent *Consent) []string {
+	if p.RequestsOfflineAccess() && consent.Includes(ScopeOfflineAccess) {
+		return p.Scopes
+	}
+
+	return slices.DeleteFunc(slices.Clone(p.Scopes), func(scope string) bool {
+		return scope == ScopeOfflineAccess
+	})
+}
+
+// RequiresNonce reports whether the flow is implicit or hybrid, which OIDC binds to a nonce.
+func (p AuthorizeParams) RequiresNonce() bool {
+	return p.RequestsIDToken() || (p.RequestsCode() && p.RequestsAccessToken())
+}
+
+// DefaultResponseMode returns query for the code flow and fragment for the others.
+func (p AuthorizeParams) DefaultResponseMode() string {
+	if p.RequestsAccessToken() || p.RequestsIDToken() {
+		return ResponseModeFragment
+	}
+
+	return ResponseModeQuery
+}
+
+// ResolveResponseMode returns the requested response_mode, falling back to the default for the response type.
+func (p AuthorizeParams) ResolveResponseMode() string {
+	switch p.ResponseMode {
+	case "":
+		return p.DefaultResponseMode()
+	case ResponseModeJWT:
+		return p.DefaultResponseMode() + jwtResponseModeSuffix
+	default:
+		return p.ResponseMode
+	}
+}
+
+// ValidateResponseMode rejects unknown modes and query-based delivery of tokens.
+func (p AuthorizeParams) ValidateResponseMode() error {
+	responseMode := p.ResolveResponseMode()
+	if !slices.Contains(responseModes, responseMode) {
+		return ErrUnsupportedResponseMode
+	}
+
+	if BaseResponseMode(responseMode) == ResponseModeQuery && (p.RequestsAccessToken() || p.RequestsIDToken()) {
+		return ErrUnsupportedResponseMode
+	}
+
+	return nil
+}
+
+func IsJWTResponseMode(responseMode string) bool {
+	return strings.HasSuffix(responseMode, jwtResponseModeSuffix)
+}
+
+// BaseResponseMode strips the JARM suffix from the response mode.
+func BaseResponseMode(responseMode string) string {
+	return strings.TrimSuffix(responseMode, jwtResponseModeSuffix)
+}
+
+// Parameters returns the authorization response parameters sent back to the client.
+func (r *AuthorizationResponse) Parameters(state string) map[string]string {
+	params := make(map[string]string)
+
+	if r.Code != "" {
+		params["code"] = r.Code
+	}
+
+	if r.AccessToken != "" {
+		params["access_token"] = r.AccessToken
+		params["token_type"] = r.TokenType
+		params["expires_in"] = strconv.FormatInt(r.ExpiresIn, 10)
+	}
+
+	if r.IDToken != "" {
+		params["id_token"] = r.IDToken
+	}
+
+	if r.Error != "" {
+		params["error"] = r.Error
+	}
+
+	if state != "" {
+		params["state"] = state
+	}
+
+	return params
 }
@@ -0,0 +1,34 @@
+package domain
+
+// PASETO access tokens are v4.public tokens, signed with Ed25519.
+const (
+	PASETOVersion4      = "v4"
+	PASETOPurposePublic = "public"
+	paserkV4PublicType  = "k4.public."
+)
+
+// PASETOKey is a published PASETO verification key.
+type PASETOKey struct {
+	KeyID     string `json:"kid"`
+	Version   string `json:"version"`
+	Purpose   string `json:"purpose"`
+	PublicKey string `json:"paserk"`
+}
+
+type PASETOKeySet struct {
+	Keys []PASETOKey `json:"keys"`
+}
+
+// PASETOSigningKeySet describes the Ed25519 signing keys as PASETO verification keys.
+func PASETOSigningKeySet(keys []*SigningKey) *PASETOKeySet {
+	keySet := &PASETOKeySet{Keys: make([]PASETOKey, 0, len(keys))}
+	for _, key := range keys {
+		keySet.Keys = append(keySet.Keys, PASETOKey{
+			KeyID:     key.KeyID,
+			Version:   PASETOVersion4,
+			Purpose:   PASETOPurposePublic,
+			PublicKey: paserkV4PublicType + key.PublicKey.X,
+		})
+	}
+	return keySet
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// RecoveryCodeCount is the size of the set a user gets when they enroll in MFA or regenerate their codes.
+	RecoveryCodeCount = 10
+	// RecoveryCodesLowThreshold is how many unused codes are left when the user is told to regenerate them.
+	RecoveryCodesLowThreshold = 3
+	// A code is ten base32 characters, shown as two groups of five.
+	recoveryCodeSize      = 10
+	recoveryCodeGroupSize = 5
+)
+
+const (
+	// MaxRecoveryCodeAttempts caps the codes a user can try within RecoveryCodeAttemptWindow.
+	MaxRecoveryCodeAttempts   = 5
+	RecoveryCodeAttemptWindow = 15 * time.Minute
+)
+
+var (
+	ErrRecoveryCodesNotGenerated   = errors.New("recovery codes were not generated")
+	ErrInvalidRecoveryCode         = errors.New("invalid recovery code")
+	ErrTooManyRecoveryCodeAttempts = errors.New("too many recovery code attempts")
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// RecoveryCode stands in for a user's second factor once, when they lost their device.
+type RecoveryCode struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	CodeHash  string
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func NewRecoveryCode(userID uuid.UUID, codeHash string) (*RecoveryCode, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecoveryCode{
+		ID:       id,
+		UserID:   userID,
+		CodeHash: codeHash,
+	}, nil
+}
+
+func (c *RecoveryCode) IsUsed() bool {
+	return c.UsedAt != nil
+}
+
+// UnusedRecoveryCodes filters the codes that can still be used.
+func UnusedRecoveryCodes(codes []*RecoveryCode) []*RecoveryCode {
+	unused := make([]*RecoveryCode, 0, len(codes))
+	for _, code := range codes {
+		if !code.IsUsed() {
+			unused = append(unused, code)
+		}
+	}
+
+	return unused
+}
+
+// GenerateRecoveryCode returns a new code the way it is shown to the user, e.g. "k3tqa-7mzbx".
+func GenerateRecoveryCode() (string, error) {
+	raw := make([]byte, recoveryCodeEncoding.DecodedLen(recoveryCodeSize))
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("generate recovery code: %w", err)
+	}
+
+	code := strings.ToLower(recoveryCodeEncoding.EncodeToString(raw))[:recoveryCodeSize]
+
+	return code[:recoveryCodeGroupSize] + "-" + code[recoveryCodeGroupSize:], nil
+}
+
+// NormalizeRecoveryCode reduces a code typed by the user to the form that is hashed.
+func NormalizeRecoveryCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+
+		return r
+	}, strings.ToLower(strings.TrimSpace(code)))
+}
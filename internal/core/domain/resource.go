@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrResourceNotFound      = errors.New("API resource not found")
+	ErrResourceAlreadyExists = errors.New("API resource already exists")
+	ErrInvalidTarget         = errors.New("invalid target resource")
+)
+
+// APIResource is a resource server access tokens can be issued for (RFC 8707).
+type APIResource struct {
+	ID         uuid.UUID
+	Identifier string
+	Name       string
+	Scopes     []string
+	// AccessTokenFormat is the format the resource server accepts access tokens in, overriding the client's.
+	AccessTokenFormat string
+	// ClientID is the client the resource server introspects tokens with.
+	ClientID  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CreateAPIResourceParams struct {
+	Identifier        string
+	Name              string
+	Scopes            []string
+	AccessTokenFormat string
+	ClientID          string
+}
+
+type UpdateAPIResourceParams struct {
+	Name              string
+	Scopes            []string
+	AccessTokenFormat string
+	ClientID          string
+}
+
+func NewAPIResource(params CreateAPIResourceParams) (*APIResource, error) {
+	if err := ValidateResourceIndicator(params.Identifier); err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	return &APIResource{
+		ID:                id,
+		Identifier:        params.Identifier,
+		Name:              params.Name,
+		Scopes:            params.Scopes,
+		AccessTokenFormat: params.AccessTokenFormat,
+		ClientID:          params.ClientID,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}, nil
+}
+
+func (r *APIResource) Update(params UpdateAPIResourceParams) {
+	r.Name = params.Name
+	r.Scopes = params.Scopes
+	r.AccessTokenFormat = params.AccessTokenFormat
+	r.ClientID = params.ClientID
+	r.UpdatedAt = time.Now().UTC()
+}
+
+func (r *APIResource) AllowsScope(scope string) bool {
+	return slices.Contains(r.Scopes, scope)
+}
+
+// ValidateResourceIndicator checks that a resource indicator is an absolute URI without a fragment.
+func ValidateResourceIndicator(identifier string) error {
+	uri, err := url.Parse(identifier)
+	if err != nil || !uri.IsAbs() || uri.Fragment != "" {
+		return ErrInvalidTarget
+	}
+
+	return nil
+}
+
+// ResourceScopes returns the scopes, in order, that at least one of the resources allows.
+func ResourceScopes(resources []*APIResource, scopes []string) []string {
+	allowed := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if slices.ContainsFunc(resources, func(resource *APIResource) bool { return resource.AllowsScope(scope) }) {
+			allowed = append(allowed, scope)
+		}
+	}
+	return allowed
+}
+
+// ResourceAccessTokenFormat returns the format of an access token issued for the resources.
+func ResourceAccessTokenFormat(resources []*APIResource, clientFormat string) (string, error) {
+	format := ""
+	for _, resource := range resources {
+		if resource.AccessTokenFormat == "" {
+			continue
+		}
+
+		if format != "" && format != resource.AccessTokenFormat {
+			return "", fmt.Errorf("%w: the resources require different access token formats", ErrInvalidTarget)
+		}
+		format = resource.AccessTokenFormat
+	}
+
+	if format == "" {
+		return clientFormat, nil
+	}
+
+	return format, nil
+}
+
+// ResourceAudience returns the token audience for the resources, or the default resource.
+func ResourceAudience(resources []string, defaultResource string) []string {
+	if len(resources) == 0 {
+		return []string{defaultResource}
+	}
+
+	return resources
+}
+
+// NarrowResources returns the resources a token request targets, which must be among those already granted.
+func NarrowResources(granted, requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return granted, nil
+	}
+
+	for _, resource := range requested {
+		if !slices.Contains(granted, resource) {
+			return nil, ErrInvalidTarget
+		}
+	}
+
+	return requested, nil
+}
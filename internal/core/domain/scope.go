@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const DefaultLocale = "en"
+
+var (
+	ErrScopeNotFound      = errors.New("scope not found")
+	ErrScopeAlreadyExists = errors.New("scope already exists")
+	ErrUnknownScope       = errors.New("unknown scope")
+	ErrRestrictedScope    = errors.New("scope restricted to first-party clients")
+)
+
+type Scope struct {
+	ID             uuid.UUID
+	Name           string
+	Descriptions   map[string]string
+	Claims         []string
+	Default        bool
+	FirstPartyOnly bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type CreateScopeParams struct {
+	Name           string
+	Descriptions   map[string]string
+	Claims         []string
+	Default        bool
+	FirstPartyOnly bool
+}
+
+type UpdateScopeParams struct {
+	Descriptions   map[string]string
+	Claims         []string
+	Default        bool
+	FirstPartyOnly bool
+}
+
+func NewScope(params CreateScopeParams) (*Scope, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	return &Scope{
+		ID:             id,
+		Name:           params.Name,
+		Descriptions:   params.Descriptions,
+		Claims:         params.Claims,
+		Default:        params.Default,
+		FirstPartyOnly: params.FirstPartyOnly,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+func (s *Scope) Update(params UpdateScopeParams) {
+	s.Descriptions = params.Descriptions
+	s.Claims = params.Claims
+	s.Default = params.Default
+	s.FirstPartyOnly = params.FirstPartyOnly
+	s.UpdatedAt = time.Now().UTC()
+}
+
+// Description returns the consent text for the locale, falling back to the base language.
+func (s *Scope) Description(locale string) string {
+	if description, ok := s.Descriptions[locale]; ok {
+		return description
+	}
+
+	if language, _, found := strings.Cut(locale, "-"); found {
+		if description, ok := s.Descriptions[language]; ok {
+			return description
+		}
+	}
+
+	return s.Descriptions[DefaultLocale]
+}
+
+func (s *Scope) AllowsClient(client *Client) bool {
+	return !s.FirstPartyOnly || client.FirstParty
+}
+
+// ScopeNames returns the names of the scopes, in order.
+func ScopeNames(scopes []*Scope) []string {
+	names := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		names = append(names, scope.Name)
+	}
+	return names
+}
+
+// ScopeClaims returns the claims unlocked by the scopes, without duplicates.
+func ScopeClaims(scopes []*Scope) []string {
+	var claims []string
+	for _, scope := range scopes {
+		for _, claim := range scope.Claims {
+			if !slices.Contains(claims, claim) {
+				claims = append(claims, claim)
+			}
+		}
+	}
+	return claims
+}
@@ -2,20 +2,40 @@ package domain
 
 import (
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// Authentication context class references of a session.
+const (
+	ACRPassword    = "urn:oidc-server:acr:pwd"
+	ACRMultiFactor = "urn:oidc-server:acr:mfa"
+)
+
+// ACRValuesSupported lists the authentication context classes from the lowest assurance to the highest.
+var ACRValuesSupported = []string{ACRPassword, ACRMultiFactor}
+
+// Authentication method references (RFC 8176) of the ways a user proves who they are.
+const (
+	AMRPassword    = "pwd"
+	AMROTP         = "otp"
+	AMRHardwareKey = "hwk"
+)
+
 var (
 	ErrSessionNotFound         = errors.New("session not found")
 	ErrSessionExpired          = errors.New("session has expired")
 	ErrInvalidSessionSignature = errors.New("invalid session signature")
+	ErrStepUpRequired          = errors.New("session does not meet the required authentication context")
 )
 
 type Session struct {
 	ID        uuid.UUID
 	UserID    uuid.UUID
+	ACR       string
+	AMR       []string
 	ExpiresAt time.Time
 	CreatedAt time.Time
 }
@@ -29,11 +49,39 @@ func NewSession(userID uuid.UUID, ttl time.Duration) (*Session, error) {
 	return &Session{
 		ID:        id,
 		UserID:    userID,
+		ACR:       ACRPassword,
+		AMR:       []string{AMRPassword},
 		ExpiresAt: time.Now().Add(ttl),
 		CreatedAt: time.Now(),
 	}, nil
 }
 
+// NewMultiFactorSession creates the session of a login completed with a second factor.
+func NewMultiFactorSession(userID uuid.UUID, ttl time.Duration, amr string) (*Session, error) {
+	session, err := NewSession(userID, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	session.ACR = ACRMultiFactor
+	session.AMR = append(session.AMR, amr)
+
+	return session, nil
+}
+
+// NewPasskeySession creates the session of a passwordless login with a passkey.
+func NewPasskeySession(userID uuid.UUID, ttl time.Duration) (*Session, error) {
+	session, err := NewSession(userID, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	session.ACR = ACRMultiFactor
+	session.AMR = []string{AMRHardwareKey}
+
+	return session, nil
+}
+
 func (s *Session) IsExpired() bool {
 	return time.Now().After(s.ExpiresAt)
 }
@@ -41,3 +89,47 @@ func (s *Session) IsExpired() bool {
 func (s *Session) TTL() time.Duration {
 	return time.Until(s.ExpiresAt)
 }
+
+// RequiredACR resolves the authentication context a request demands, never below the client's default.
+func RequiredACR(acrValues, defaultACRValues []string) string {
+	requested := lowestACR(acrValues)
+	if floor := lowestACR(defaultACRValues); acrLevel(floor) > acrLevel(requested) {
+		return floor
+	}
+
+	return requested
+}
+
+// RequiresSecondFactor reports whether only a login with a second factor reaches the authentication context.
+func RequiresSecondFactor(acr string) bool {
+	return acrLevel(acr) >= acrLevel(ACRMultiFactor)
+}
+
+// SatisfiesACR reports whether the session was authenticated at the required assurance or above.
+func (s *Session) SatisfiesACR(required string) bool {
+	if required == "" {
+		return true
+	}
+
+	return acrLevel(s.ACR) >= acrLevel(required)
+}
+
+func lowestACR(acrValues []string) string {
+	lowest := ""
+	for _, acr := range acrValues {
+		level := acrLevel(acr)
+		if level < 0 {
+			continue
+		}
+
+		if lowest == "" || level < acrLevel(lowest) {
+			lowest = acr
+		}
+	}
+
+	return lowest
+}
+
+func acrLevel(acr string) int {
+	return slices.Index(ACRValuesSupported, acr)
+}
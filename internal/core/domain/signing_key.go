@@ -0,0 +1,229 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Signing keys are kept and rotated per algorithm.
+const (
+	SigningAlgorithmRS256          = "RS256"
+	SigningAlgorithmPASETOV4Public = "v4.public"
+)
+
+// Keys are published as next before signing and as retiring until their tokens expire.
+const (
+	SigningKeyStateNext     = "next"
+	SigningKeyStateCurrent  = "current"
+	SigningKeyStateRetiring = "retiring"
+	SigningKeyStateRetired  = "retired"
+)
+
+const (
+	SigningKeyRotationInterval      = 30 * 24 * time.Hour
+	SigningKeyRetirementPeriod      = 24 * time.Hour
+	SigningKeyRotationCheckInterval = time.Hour
+	signingKeySize                  = 2048
+)
+
+var (
+	ErrSigningKeyNotFound    = errors.New("signing key not found")
+	ErrKeyRotationInProgress = errors.New("key rotation in progress")
+)
+
+var SigningAlgorithms = []string{
+	SigningAlgorithmRS256,
+	SigningAlgorithmPASETOV4Public,
+}
+
+// PublishedSigningKeyStates are the states of the keys listed in the JWKS.
+var PublishedSigningKeyStates = []string{
+	SigningKeyStateNext,
+	SigningKeyStateCurrent,
+	SigningKeyStateRetiring,
+}
+
+type SigningKey struct {
+	ID        uuid.UUID
+	KeyID     string
+	Algorithm string
+	State     string
+	PublicKey JSONWebKey
+	// EncryptedPrivateKey is the PKCS #8 private key, encrypted with the server's key encryption key.
+	EncryptedPrivateKey []byte
+	ActivatedAt         *time.Time
+	DeactivatedAt       *time.Time
+	RetiredAt           *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+func GenerateSigningPrivateKey(algorithm string) (crypto.Signer, error) {
+	switch algorithm {
+	case SigningAlgorithmRS256:
+		privateKey, err := rsa.GenerateKey(rand.Reader, signingKeySize)
+		if err != nil {
+			return nil, fmt.Errorf("generate RSA key: %w", err)
+		}
+		return privateKey, nil
+	case SigningAlgorithmPASETOV4Public:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("generate Ed25519 key: %w", err)
+		}
+		return privateKey, nil
+	}
+
+	return nil, fmt.Errorf("unsupported signing algorithm %q", algorithm)
+}
+
+func MarshalSigningPrivateKey(privateKey crypto.Signer) ([]byte, error) {
+	return x509.MarshalPKCS8PrivateKey(privateKey)
+}
+
+func ParseSigningPrivateKey(der []byte) (crypto.Signer, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse PKCS #8 private key: %w", err)
+	}
+
+	switch privateKey := key.(type) {
+	case *rsa.PrivateKey:
+		return privateKey, nil
+	case ed25519.PrivateKey:
+		return privateKey, nil
+	}
+
+	return nil, fmt.Errorf("unsupported private key type %T", key)
+}
+
+// ParseSigningPrivateKeyPEM parses an RSA private key in a PKCS #1 or PKCS #8 PEM block, as configured for the server.
+func ParseSigningPrivateKeyPEM(data string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("parse signing key: no PEM block")
+	}
+
+	if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return privateKey, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse PKCS #8 private key: %w", err)
+	}
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	return privateKey, nil
+}
+
+// NewSigningKey registers a private key for the algorithm in the given state.
+func NewSigningKey(privateKey crypto.Signer, encryptedPrivateKey []byte, algorithm, state string) (*SigningKey, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	var publicKey JSONWebKey
+	switch key := privateKey.Public().(type) {
+	case *rsa.PublicKey:
+		publicKey = NewRSAPublicJWK(key, algorithm, "")
+	case ed25519.PublicKey:
+		publicKey = NewEd25519PublicJWK(key, "")
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+
+	keyID, err := publicKey.Thumbprint()
+	if err != nil {
+		return nil, fmt.Errorf("compute signing key ID: %w", err)
+	}
+	publicKey.KeyID = keyID
+
+	now := time.Now().UTC()
+
+	key := &SigningKey{
+		ID:                  id,
+		KeyID:               keyID,
+		Algorithm:           algorithm,
+		State:               state,
+		PublicKey:           publicKey,
+		EncryptedPrivateKey: encryptedPrivateKey,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+
+	if state == SigningKeyStateCurrent {
+		key.ActivatedAt = &now
+	}
+
+	return key, nil
+}
+
+func (k *SigningKey) Activate(now time.Time) {
+	k.State = SigningKeyStateCurrent
+	k.ActivatedAt = &now
+	k.UpdatedAt = now
+}
+
+func (k *SigningKey) Deactivate(now time.Time) {
+	k.State = SigningKeyStateRetiring
+	k.DeactivatedAt = &now
+	k.UpdatedAt = now
+}
+
+// Retire stops publishing the key.
+func (k *SigningKey) Retire(now time.Time) {
+	if k.DeactivatedAt == nil {
+		k.DeactivatedAt = &now
+	}
+	k.State = SigningKeyStateRetired
+	k.RetiredAt = &now
+	k.UpdatedAt = now
+}
+
+// IsDueForRotation reports whether a current key has signed for the whole rotation interval.
+func (k *SigningKey) IsDueForRotation(now time.Time, interval time.Duration) bool {
+	return k.State == SigningKeyStateCurrent && k.ActivatedAt != nil && !now.Before(k.ActivatedAt.Add(interval))
+}
+
+// IsDueForRetirement reports whether the tokens a retiring key signed have all expired.
+func (k *SigningKey) IsDueForRetirement(now time.Time, period time.Duration) bool {
+	return k.State == SigningKeyStateRetiring && k.DeactivatedAt != nil && !now.Before(k.DeactivatedAt.Add(period))
+}
+
+// FindSigningKey returns the key in the given state, the most recently activated one for current keys.
+func FindSigningKey(keys []*SigningKey, state string) *SigningKey {
+	var found *SigningKey
+	for _, key := range keys {
+		if key.State != state {
+			continue
+		}
+
+		if found == nil || (key.ActivatedAt != nil && found.ActivatedAt != nil && key.ActivatedAt.After(*found.ActivatedAt)) {
+			found = key
+		}
+	}
+	return found
+}
+
+func SigningKeySet(keys []*SigningKey) *JSONWebKeySet {
+	keySet := &JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(keys))}
+	for _, key := range keys {
+		keySet.Keys = append(keySet.Keys, key.PublicKey)
+	}
+	return keySet
+}
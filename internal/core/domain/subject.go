@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SubjectTypePublic   = "public"
+	SubjectTypePairwise = "pairwise"
+)
+
+var (
+	ErrInvalidSectorIdentifier = errors.New("invalid sector identifier")
+	ErrSubjectNotFound         = errors.New("subject not found")
+)
+
+type PairwiseSubject struct {
+	SectorIdentifier string
+	Subject          string
+	UserID           uuid.UUID
+	CreatedAt        time.Time
+}
+
+// NewPairwiseSubject derives the subject a sector sees for a user, as OIDC Core suggests.
+func NewPairwiseSubject(sectorIdentifier string, userID uuid.UUID, salt string) *PairwiseSubject {
+	hash := sha256.Sum256([]byte(sectorIdentifier + userID.String() + salt))
+
+	return &PairwiseSubject{
+		SectorIdentifier: sectorIdentifier,
+		Subject:          base64.RawURLEncoding.EncodeToString(hash[:]),
+		UserID:           userID,
+	}
+}
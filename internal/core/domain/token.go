@@ -15,12 +15,13 @@ const (
 )
 
 var (
-	ErrTokenExpired   = errors.New("token expired")
-	ErrTokenRevoked   = errors.New("token revoked")
-	ErrTokenNotFound  = errors.New("token not found")
-	ErrInvalidToken   = errors.New("invalid token")
-	ErrRefreshExpired = errors.New("refresh token expired")
-	ErrNoRefreshToken = errors.New("no refresh token available")
+	ErrTokenExpired      = errors.New("token expired")
+	ErrTokenRevoked      = errors.New("token revoked")
+	ErrTokenNotFound     = errors.New("token not found")
+	ErrInvalidToken      = errors.New("invalid token")
+	ErrRefreshExpired    = errors.New("refresh token expired")
+	ErrNoRefreshToken    = errors.New("no refresh token available")
+	ErrInsufficientScope = errors.New("insufficient scope")
 )
 
 type Token struct {
@@ -31,6 +32,19 @@ type Token struct {
 	ClientID              string
 	UserID                uuid.UUID
 	Scopes                []string
+	Claims                *ClaimsRequest
+	SessionID             uuid.UUID
+	Offline               bool
+	Resources             []string
+	AuthTime              time.Time
+	ACR                   string
+	AMR                   []string
+	Actor                 *Actor
+	DPoPJKT               string
+	CertificateThumbprint string
+	AuthorizationDetails  AuthorizationDetails
+	// FamilyID is the ID of the first token of the refresh token rotation chain the token belongs to.
+	FamilyID              uuid.UUID
 	TokenType             string
 	AccessTokenExpiresAt  time.Time
 	RefreshTokenExpiresAt time.Time
@@ -58,8 +72,12 @@ func NewToken(
 
 	now := time.Now().UTC()
 
-	accessTokenHash := hashToken(accessToken)
-	refreshTokenHash := hashToken(refreshToken)
+	accessTokenHash := HashToken(accessToken)
+
+	var refreshTokenHash string
+	if refreshToken != "" {
+		refreshTokenHash = HashToken(refreshToken)
+	}
 
 	return &Token{
 		ID:                    id,
@@ -69,6 +87,7 @@ func NewToken(
 		ClientID:              clientID,
 		UserID:                userID,
 		Scopes:                scopes,
+		FamilyID:              id,
 		TokenType:             TokenTypeBearer,
 		AccessTokenExpiresAt:  now.Add(accessTokenExpiresIn),
 		RefreshTokenExpiresAt: now.Add(refreshTokenExpiresIn),
@@ -77,15 +96,84 @@ func NewToken(
 	}, nil
 }
 
+type TokenResponse struct {
+	AccessToken     string `json:"access_token"`
+	TokenType       string `json:"token_type"`
+	ExpiresIn       int64  `json:"expires_in"`
+	RefreshToken    string `json:"refresh_token,omitempty"`
+	IDToken         string `json:"id_token,omitempty"`
+	IssuedTokenType string `json:"issued_token_type,omitempty"`
+	// AuthorizationDetails are the RFC 9396 details the access token was granted for.
+	AuthorizationDetails AuthorizationDetails `json:"authorization_details,omitempty"`
+}
+
 type CreateTokenParams struct {
 	UserID            uuid.UUID
 	ClientID          string
 	Scopes            []string
 	AuthorizationCode *string
 	Nonce             string
+	Claims            *ClaimsRequest
+	SessionID         uuid.UUID
+	Resources         []string
+	AuthTime          time.Time
+	ACR               string
+	AMR               []string
+	Actor             *Actor
+	DPoPJKT           string
+	// CertificateThumbprint is the x5t#S256 of the client certificate the tokens are bound to.
+	CertificateThumbprint string
+	AuthorizationDetails  AuthorizationDetails
+	// FamilyID is the rotation chain a refreshed token joins.
+	FamilyID uuid.UUID
+}
+
+type AccessTokenParams struct {
+	Subject  string
+	ClientID string
+	Scopes   []string
+	Audience []string
+	AuthTime time.Time
+	ACR      string
+	Actor    *Actor
+	DPoPJKT  string
+	// CertificateThumbprint is set for certificate-bound tokens.
+	CertificateThumbprint string
+	AuthorizationDetails  AuthorizationDetails
+	ExpiresIn             time.Duration
 }
 
-func hashToken(token string) string {
+type RefreshTokenParams struct {
+	RefreshToken string
+	ClientID     string
+	Resources    []string
+	DPoPJKT      string
+	// CertificateThumbprint is the x5t#S256 of the client certificate of the refresh request.
+	CertificateThumbprint string
+	// AuthorizationDetails narrows the details of the refreshed access token to a subset of the grant.
+	AuthorizationDetails AuthorizationDetails
+}
+
+type IDTokenParams struct {
+	Subject  string
+	ClientID string
+	// Audience lists the audiences of the ID token, the client first.
+	Audience  []string
+	ExpiresIn time.Duration
+	Nonce     string
+	Scopes    []string
+	Claims    map[string]any
+	AuthTime  time.Time
+	ACR       string
+	AMR       []string
+	// SessionID is the session the ID token is issued for, as its sid.
+	SessionID   uuid.UUID
+	AccessToken string
+	Code        string
+}
+
+// HashToken returns the digest under which a token is stored.
+func HashToken(token string) string {
 	hash := sha256.Sum256([]byte(token))
 	return hex.EncodeToString(hash[:])
 }
@@ -106,8 +194,70 @@ func (t *Token) IsValid() bool {
 	return !t.IsRevoked() && !t.IsAccessTokenExpired()
 }
 
+// IsRefreshTokenIdle reports whether the token went unused for longer than the idle timeout.
+func (t *Token) IsRefreshTokenIdle(idleTimeout time.Duration) bool {
+	if idleTimeout == 0 {
+		return false
+	}
+
+	lastActivity := t.CreatedAt
+	if t.LastUsedAt != nil && t.LastUsedAt.After(lastActivity) {
+		lastActivity = *t.LastUsedAt
+	}
+
+	return time.Now().UTC().After(lastActivity.Add(idleTimeout))
+}
+
+// IsClientToken reports whether the token was issued to a client on its own behalf, with no user behind it.
+func (t *Token) IsClientToken() bool {
+	return t.UserID == uuid.Nil
+}
+
+// BindDPoPKey sender-constrains the token to the DPoP key with the given thumbprint.
+func (t *Token) BindDPoPKey(jkt string) {
+	if jkt == "" {
+		return
+	}
+
+	t.DPoPJKT = jkt
+	t.TokenType = TokenTypeDPoP
+}
+
+func (t *Token) IsDPoPBound() bool {
+	return t.DPoPJKT != ""
+}
+
+// BindCertificate binds the token to the client certificate with the given thumbprint (RFC 8705 section 3).
+func (t *Token) BindCertificate(thumbprint string) {
+	t.CertificateThumbprint = thumbprint
+}
+
+func (t *Token) IsCertificateBound() bool {
+	return t.CertificateThumbprint != ""
+}
+
+// Confirmation returns the cnf claim of a sender-constrained token.
+func (t *Token) Confirmation() *Confirmation {
+	if !t.IsDPoPBound() && !t.IsCertificateBound() {
+		return nil
+	}
+	return &Confirmation{JKT: t.DPoPJKT, CertificateThumbprint: t.CertificateThumbprint}
+}
+
+// IsConfirmedBy reports whether a request proved possession of every key the token is bound to.
+func (t *Token) IsConfirmedBy(proof Confirmation) bool {
+	if t.DPoPJKT != proof.JKT {
+		return false
+	}
+	return !t.IsCertificateBound() || t.CertificateThumbprint == proof.CertificateThumbprint
+}
+
+func (t *Token) HasRefreshToken() bool {
+	return t.RefreshTokenHash != ""
+}
+
 func (t *Token) CanRefresh() bool {
-	return !t.IsRefreshTokenExpired() && !t.IsRevoked()
+	return t.HasRefreshToken() && !t.IsRefreshTokenExpired() && !t.IsRevoked()
 }
 
 func (t *Token) Revoke(reason string) {
@@ -135,10 +285,35 @@ func (t *Token) HasAllScopes(scopes []string) bool {
 	return true
 }
 
+// Audience returns the resources the access token is meant for.
+func (t *Token) Audience(defaultResource string) []string {
+	return ResourceAudience(t.Resources, defaultResource)
+}
+
+// AudienceClaim renders a single audience as a string and several as an array, as JWT allows either.
+func AudienceClaim(audience []string) any {
+	if len(audience) == 1 {
+		return audience[0]
+	}
+	return audience
+}
+
+// CanBeIntrospectedBy reports whether the client was issued the token or serves one of its resources.
+func (t *Token) CanBeIntrospectedBy(client *Client, clientResources []*APIResource) bool {
+	return t.ClientID == client.ClientID || servesResource(client, clientResources, t.Resources)
+}
+
+// servesResource reports whether one of the resources belongs to the client.
+func servesResource(client *Client, clientResources []*APIResource, resources []string) bool {
+	return slices.ContainsFunc(clientResources, func(resource *APIResource) bool {
+		return resource.ClientID == client.ClientID && slices.Contains(resources, resource.Identifier)
+	})
+}
+
 func (t *Token) ValidateAccessToken(accessToken string) bool {
-	return t.AccessTokenHash == hashToken(accessToken)
+	return t.AccessTokenHash == HashToken(accessToken)
 }
 
 func (t *Token) ValidateRefreshToken(refreshToken string) bool {
-	return t.RefreshTokenHash == hashToken(refreshToken)
+	return t.HasRefreshToken() && t.RefreshTokenHash == HashToken(refreshToken)
 }
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TokenTypeAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+	TokenTypeIDToken     = "urn:ietf:params:oauth:token-type:id_token"
+)
+
+var ErrInvalidSubjectToken = errors.New("invalid subject or actor token")
+
+// Actor is the act claim of a delegated token, naming the party acting on the subject's behalf.
+type Actor struct {
+	Subject  string `json:"sub"`
+	Issuer   string `json:"iss,omitempty"`
+	ClientID string `json:"client_id,omitempty"`
+	Actor    *Actor `json:"act,omitempty"`
+}
+
+// Delegate returns the act claim for a token issued to the actor on behalf of a subject token.
+func (a *Actor) Delegate(subjectActor *Actor) *Actor {
+	if a == nil {
+		return subjectActor
+	}
+
+	delegated := *a
+	delegated.Actor = subjectActor
+	return &delegated
+}
+
+// ExchangedToken is the identity carried by a subject or actor token presented for token exchange.
+type ExchangedToken struct {
+	UserID    uuid.UUID
+	ClientID  string
+	Scopes    []string
+	Resources []string
+	Actor     *Actor
+	AuthTime  time.Time
+	ACR       string
+	ExpiresAt time.Time
+	// Confirmation is the cnf of a sender-constrained token.
+	Confirmation *Confirmation
+}
+
+// CanBeExchangedBy reports whether the client was issued the token or serves one of its resources.
+func (t *ExchangedToken) CanBeExchangedBy(client *Client, clientResources []*APIResource) bool {
+	return t.ClientID == client.ClientID || servesResource(client, clientResources, t.Resources)
+}
+
+// IsConfirmedBy reports whether the request proved possession of every key the token is bound to.
+func (t *ExchangedToken) IsConfirmedBy(proof Confirmation) bool {
+	if t.Confirmation == nil {
+		return true
+	}
+
+	if t.Confirmation.JKT != "" && t.Confirmation.JKT != proof.JKT {
+		return false
+	}
+
+	return t.Confirmation.CertificateThumbprint == "" || t.Confirmation.CertificateThumbprint == proof.CertificateThumbprint
+}
+
+// IDTokenClaims are the claims of a verified ID token issued by this server.
+type IDTokenClaims struct {
+	Subject   string
+	ClientID  string
+	AuthTime  time.Time
+	ACR       string
+	ExpiresAt time.Time
+}
+
+// DownscopeScopes returns the requested scopes, which can't exceed those of the subject token.
+func DownscopeScopes(granted, requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return granted, nil
+	}
+
+	for _, scope := range requested {
+		if !slices.Contains(granted, scope) {
+			return nil, ErrInvalidScope
+		}
+	}
+
+	return requested, nil
+}
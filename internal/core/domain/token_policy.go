@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+const (
+	// AccessTokenFormatJWT issues self-contained JWT access tokens that resource servers can verify on their own.
+	AccessTokenFormatJWT = "jwt"
+	// AccessTokenFormatOpaque issues random reference tokens validated through introspection.
+	AccessTokenFormatOpaque = "opaque"
+	// AccessTokenFormatPASETO issues PASETO v4.public access tokens, verified against the published PASETO keys.
+	AccessTokenFormatPASETO = "paseto"
+)
+
+// TokenPolicy holds the token lifetimes applied to a client.
+type TokenPolicy struct {
+	AccessTokenLifetime     time.Duration
+	RefreshTokenLifetime    time.Duration
+	RefreshTokenIdleTimeout time.Duration
+	IDTokenLifetime         time.Duration
+	IssueRefreshTokens      bool
+	AccessTokenFormat       string
+}
+
+// Resolve returns the policy with every unset lifetime taken from defaults.
+func (p TokenPolicy) Resolve(defaults TokenPolicy) TokenPolicy {
+	return TokenPolicy{
+		AccessTokenLifetime:     durationOrDefault(p.AccessTokenLifetime, defaults.AccessTokenLifetime),
+		RefreshTokenLifetime:    durationOrDefault(p.RefreshTokenLifetime, defaults.RefreshTokenLifetime),
+		RefreshTokenIdleTimeout: durationOrDefault(p.RefreshTokenIdleTimeout, defaults.RefreshTokenIdleTimeout),
+		IDTokenLifetime:         durationOrDefault(p.IDTokenLifetime, defaults.IDTokenLifetime),
+		IssueRefreshTokens:      p.IssueRefreshTokens,
+		AccessTokenFormat:       accessTokenFormatOrDefault(p.AccessTokenFormat),
+	}
+}
+
+func accessTokenFormatOrDefault(format string) string {
+	if format == "" {
+		return AccessTokenFormatJWT
+	}
+	return format
+}
+
+func durationOrDefault(duration, fallback time.Duration) time.Duration {
+	if duration == 0 {
+		return fallback
+	}
+	return duration
+}
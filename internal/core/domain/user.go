@@ -52,3 +52,13 @@ func (u *User) UpdatePasswordHash(newHash string) {
 	u.PasswordHash = newHash
 	u.UpdatedAt = time.Now().UTC()
 }
+
+// Claims returns the standard OIDC claims the server can release for the user.
+func (u *User) Claims() map[string]any {
+	return map[string]any{
+		ClaimName:          u.Name,
+		ClaimUpdatedAt:     u.UpdatedAt.Unix(),
+		ClaimEmail:         u.Email,
+		ClaimEmailVerified: u.EmailVerified,
+	}
+}
@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebAuthnChallengeExpiry is how long a registration or login ceremony can take.
+const WebAuthnChallengeExpiry = 5 * time.Minute
+
+// Ceremony types, as the browser names them in the client data.
+const (
+	WebAuthnCeremonyCreate = "webauthn.create"
+	WebAuthnCeremonyGet    = "webauthn.get"
+)
+
+// COSE algorithm identifiers of the credential keys accepted.
+const (
+	COSEAlgES256 int64 = -7
+	COSEAlgEdDSA int64 = -8
+	COSEAlgRS256 int64 = -257
+)
+
+// WebAuthnAlgorithms lists the accepted credential key algorithms in order of preference.
+var WebAuthnAlgorithms = []int64{COSEAlgES256, COSEAlgEdDSA, COSEAlgRS256}
+
+// User verification requirements of a ceremony.
+const (
+	WebAuthnUserVerificationRequired  = "required"
+	WebAuthnUserVerificationPreferred = "preferred"
+)
+
+const DefaultWebAuthnCredentialName = "Passkey"
+
+var (
+	ErrWebAuthnChallengeNotFound  = errors.New("webauthn challenge not found")
+	ErrInvalidWebAuthnResponse    = errors.New("invalid webauthn response")
+	ErrWebAuthnCredentialNotFound = errors.New("webauthn credential not found")
+	ErrWebAuthnCredentialExists   = errors.New("webauthn credential already registered")
+)
+
+// WebAuthnCredential is a passkey or security key registered by a user.
+type WebAuthnCredential struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// CredentialID is the authenticator's ID of the credential, which the browser sends back with every login.
+	CredentialID []byte
+	// PublicKey is the credential's public key as a COSE key.
+	PublicKey []byte
+	// SignCount is the authenticator's signature counter as of the last login.
+	SignCount  uint32
+	Transports []string
+	Name       string
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// WebAuthnChallenge is the state of a ceremony between its options and the authenticator's response.
+type WebAuthnChallenge struct {
+	ID        string
+	Challenge []byte
+	Ceremony  string
+	// UserID is the user the ceremony is for.
+	UserID    *uuid.UUID
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// WebAuthnRegistrationOptions are what the browser needs to create a credential.
+type WebAuthnRegistrationOptions struct {
+	ChallengeID string
+	Challenge   []byte
+	RPID        string
+	RPName      string
+	User        *User
+	// ExcludeCredentials keeps an authenticator from registering twice.
+	ExcludeCredentials []*WebAuthnCredential
+	Timeout            time.Duration
+}
+
+// WebAuthnLoginOptions are what the browser needs to sign in with a credential.
+type WebAuthnLoginOptions struct {
+	ChallengeID string
+	Challenge   []byte
+	RPID        string
+	// AllowCredentials are the credentials of the user logging in, empty for passwordless logins.
+	AllowCredentials []*WebAuthnCredential
+	UserVerification string
+	Timeout          time.Duration
+}
+
+// WebAuthnAttestation is an authenticator's response to a registration.
+type WebAuthnAttestation struct {
+	ClientDataJSON    []byte
+	AttestationObject []byte
+	Transports        []string
+}
+
+// WebAuthnAssertion is an authenticator's response to a login.
+type WebAuthnAssertion struct {
+	CredentialID      []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+	// UserHandle is the user ID the credential was registered with, sent by authenticators holding a passkey.
+	UserHandle []byte
+}
+
+// WebAuthnAttestedCredential is the credential of a verified registration.
+type WebAuthnAttestedCredential struct {
+	CredentialID []byte
+	PublicKey    []byte
+	SignCount    uint32
+	UserVerified bool
+}
+
+// WebAuthnVerifiedAssertion is what a verified login tells about the authenticator.
+type WebAuthnVerifiedAssertion struct {
+	SignCount    uint32
+	UserVerified bool
+}
+
+func NewWebAuthnCredential(userID uuid.UUID, name string, attested *WebAuthnAttestedCredential, transports []string) (*WebAuthnCredential, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		name = DefaultWebAuthnCredentialName
+	}
+
+	return &WebAuthnCredential{
+		ID:           id,
+		UserID:       userID,
+		CredentialID: attested.CredentialID,
+		PublicKey:    attested.PublicKey,
+		SignCount:    attested.SignCount,
+		Transports:   transports,
+		Name:         name,
+	}, nil
+}
+
+// AcceptsSignCount reports whether a login's signature counter moved past the stored one.
+func (c *WebAuthnCredential) AcceptsSignCount(signCount uint32) bool {
+	if signCount == 0 && c.SignCount == 0 {
+		return true
+	}
+
+	return signCount > c.SignCount
+}
+
+func NewWebAuthnChallenge(ceremony string, userID *uuid.UUID) (*WebAuthnChallenge, error) {
+	id := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("generate webauthn challenge ID: %w", err)
+	}
+
+	challenge := make([]byte, 32)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, fmt.Errorf("generate webauthn challenge: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	return &WebAuthnChallenge{
+		ID:        base64.RawURLEncoding.EncodeToString(id),
+		Challenge: challenge,
+		Ceremony:  ceremony,
+		UserID:    userID,
+		ExpiresAt: now.Add(WebAuthnChallengeExpiry),
+		CreatedAt: now,
+	}, nil
+}
+
+func (c *WebAuthnChallenge) IsExpired() bool {
+	return time.Now().After(c.ExpiresAt)
+}
+
+func (c *WebAuthnChallenge) TTL() time.Duration {
+	return time.Until(c.ExpiresAt)
+}
+
+// IsFor reports whether the challenge was handed out for the ceremony and user.
+func (c *WebAuthnChallenge) IsFor(ceremony string, userID *uuid.UUID) bool {
+	if c.Ceremony != ceremony {
+		return false
+	}
+
+	if c.UserID == nil || userID == nil {
+		return c.UserID == nil && userID == nil
+	}
+
+	return *c.UserID == *userID
+}
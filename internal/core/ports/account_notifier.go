@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// AccountNotifier tells users about events on their account that need their attention.
+type AccountNotifier interface {
+	NotifyRecoveryCodesLow(ctx context.Context, userID uuid.UUID, remaining int) error
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type AssertionVerifier interface {
+	VerifyAssertion(ctx context.Context, assertion string, keySet *domain.JSONWebKeySet) (*domain.AssertionClaims, error)
+	VerifyMACAssertion(ctx context.Context, assertion string, secret []byte) (*domain.AssertionClaims, error)
+}
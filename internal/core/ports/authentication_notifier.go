@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+// AuthenticationNotifier reaches the user on their own device to approve or deny a backchannel authentication request.
+type AuthenticationNotifier interface {
+	NotifyAuthenticationRequest(ctx context.Context, request *domain.BackchannelAuthenticationRequest) error
+}
@@ -0,0 +1,8 @@
+package ports
+
+import "context"
+
+// BackchannelClientNotifier pings a CIBA client in ping mode once the user acted on its request.
+type BackchannelClientNotifier interface {
+	NotifyClient(ctx context.Context, endpoint, clientNotificationToken, authReqID string) error
+}
@@ -9,6 +9,8 @@ type Cache interface {
 	Get(ctx context.Context, key string) (string, error)
 	Set(ctx context.Context, key string, value string, ttl time.Duration) error
 	Delete(ctx context.Context, key string) error
+	// DeleteIfEqual deletes the key only while it still holds the value.
+	DeleteIfEqual(ctx context.Context, key string, value string) (bool, error)
 	Exists(ctx context.Context, key string) (bool, error)
 	SetNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)
 	GetDel(ctx context.Context, key string) (string, error)
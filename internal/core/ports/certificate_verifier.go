@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type CertificateVerifier interface {
+	VerifyClientCertificate(ctx context.Context, certificate *domain.ClientCertificate) error
+}
@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type DPoPProofVerifier interface {
+	VerifyDPoPProof(ctx context.Context, proof string) (*domain.DPoPProof, error)
+}
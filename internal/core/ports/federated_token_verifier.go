@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type FederatedTokenVerifier interface {
+	// PeekIssuer reads the iss claim of an unverified token, to look up the keys it must then be verified with.
+	PeekIssuer(token string) (string, error)
+	VerifyFederatedToken(ctx context.Context, token string, keySet *domain.JSONWebKeySet) (*domain.FederatedClaims, error)
+}
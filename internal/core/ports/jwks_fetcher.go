@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type JWKSFetcher interface {
+	FetchJWKS(ctx context.Context, jwksURI string) (*domain.JSONWebKeySet, error)
+}
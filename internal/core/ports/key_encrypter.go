@@ -0,0 +1,9 @@
+package ports
+
+import "context"
+
+// KeyEncrypter protects the secrets stored by the server.
+type KeyEncrypter interface {
+	Encrypt(ctx context.Context, plaintext []byte) ([]byte, error)
+	Decrypt(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+// Mailer delivers emails to users.
+type Mailer interface {
+	Send(ctx context.Context, message *domain.EmailMessage) error
+}
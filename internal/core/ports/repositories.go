@@ -27,6 +27,7 @@ type UserRepository interface {
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error)
 	Update(ctx context.Context, user *domain.User) error
+	VerifyEmail(ctx context.Context, id uuid.UUID) error
 }
 
 type SessionRepository interface {
@@ -36,6 +37,57 @@ type SessionRepository interface {
 	Delete(ctx context.Context, sessionID uuid.UUID) error
 }
 
+type LoginTicketRepository interface {
+	Create(ctx context.Context, ticket *domain.LoginTicket) error
+	GetByID(ctx context.Context, ticketID string) (*domain.LoginTicket, error)
+	Delete(ctx context.Context, ticketID string) error
+}
+
+type TOTPCredentialRepository interface {
+	Create(ctx context.Context, credential *domain.TOTPCredential) error
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*domain.TOTPCredential, error)
+	// Confirm marks the credential confirmed by the code of the given step.
+	Confirm(ctx context.Context, id uuid.UUID, step int64) error
+	// UseStep records an accepted code's step unless a code of that or a later step was accepted.
+	UseStep(ctx context.Context, id uuid.UUID, step int64) (bool, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type WebAuthnCredentialRepository interface {
+	Create(ctx context.Context, credential *domain.WebAuthnCredential) error
+	GetByCredentialID(ctx context.Context, credentialID []byte) (*domain.WebAuthnCredential, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.WebAuthnCredential, error)
+	// UpdateSignCount records a login with the credential.
+	UpdateSignCount(ctx context.Context, id uuid.UUID, signCount uint32) error
+	Rename(ctx context.Context, userID uuid.UUID, id uuid.UUID, name string) error
+	Delete(ctx context.Context, userID uuid.UUID, id uuid.UUID) error
+}
+
+type WebAuthnChallengeRepository interface {
+	Create(ctx context.Context, challenge *domain.WebAuthnChallenge) error
+	GetByID(ctx context.Context, challengeID string) (*domain.WebAuthnChallenge, error)
+	Delete(ctx context.Context, challengeID string) error
+}
+
+type RecoveryCodeRepository interface {
+	// Replace swaps the user's recovery codes for a new set at once.
+	Replace(ctx context.Context, userID uuid.UUID, codes []*domain.RecoveryCode) error
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.RecoveryCode, error)
+	// Use marks the code used, reporting false when it already was.
+	Use(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+type AuditEventRepository interface {
+	Create(ctx context.Context, event *domain.AuditEvent) error
+}
+
+type BackchannelAuthenticationRepository interface {
+	Create(ctx context.Context, request *domain.BackchannelAuthenticationRequest) error
+	GetByAuthReqID(ctx context.Context, authReqID string) (*domain.BackchannelAuthenticationRequest, error)
+	Update(ctx context.Context, request *domain.BackchannelAuthenticationRequest) error
+	Delete(ctx context.Context, authReqID string) error
+}
+
 type AuthorizationCodeRepository interface {
 	Create(ctx context.Context, code *domain.AuthorizationCode) error
 	GetByCode(ctx context.Context, code string) (*domain.AuthorizationCode, error)
@@ -49,7 +101,70 @@ type TokenRepository interface {
 	GetByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*domain.Token, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Token, error)
 	Revoke(ctx context.Context, id uuid.UUID, reason string) error
+	RevokeActive(ctx context.Context, id uuid.UUID, reason string) (bool, error)
 	RevokeByAccessTokenHash(ctx context.Context, accessTokenHash string, reason string) error
 	RevokeByAuthorizationCode(ctx context.Context, authorizationCode string, reason string) error
+	RevokeFamily(ctx context.Context, familyID uuid.UUID, reason string) error
+	RevokeBySession(ctx context.Context, sessionID uuid.UUID, reason string) error
+	ListOfflineByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Token, error)
 	UpdateLastUsed(ctx context.Context, id uuid.UUID) error
 }
+
+type PairwiseSubjectRepository interface {
+	Create(ctx context.Context, subject *domain.PairwiseSubject) error
+	GetBySubject(ctx context.Context, sectorIdentifier string, subject string) (*domain.PairwiseSubject, error)
+	GetByUser(ctx context.Context, sectorIdentifier string, userID uuid.UUID) (*domain.PairwiseSubject, error)
+}
+
+type ConsentRepository interface {
+	Save(ctx context.Context, consent *domain.Consent) error
+	Get(ctx context.Context, userID uuid.UUID, clientID string) (*domain.Consent, error)
+	Delete(ctx context.Context, userID uuid.UUID, clientID string) error
+}
+
+type ScopeRepository interface {
+	Create(ctx context.Context, scope *domain.Scope) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Scope, error)
+	List(ctx context.Context) ([]*domain.Scope, error)
+	ListByNames(ctx context.Context, names []string) ([]*domain.Scope, error)
+	Update(ctx context.Context, scope *domain.Scope) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type APIResourceRepository interface {
+	Create(ctx context.Context, resource *domain.APIResource) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.APIResource, error)
+	List(ctx context.Context) ([]*domain.APIResource, error)
+	ListByClientID(ctx context.Context, clientID string) ([]*domain.APIResource, error)
+	ListByIdentifiers(ctx context.Context, identifiers []string) ([]*domain.APIResource, error)
+	Update(ctx context.Context, resource *domain.APIResource) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type AuthorizationDetailTypeRepository interface {
+	Create(ctx context.Context, detailType *domain.AuthorizationDetailType) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.AuthorizationDetailType, error)
+	List(ctx context.Context) ([]*domain.AuthorizationDetailType, error)
+	ListByTypes(ctx context.Context, types []string) ([]*domain.AuthorizationDetailType, error)
+	Update(ctx context.Context, detailType *domain.AuthorizationDetailType) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type TrustedIssuerRepository interface {
+	Create(ctx context.Context, issuer *domain.TrustedIssuer) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.TrustedIssuer, error)
+	GetByIssuer(ctx context.Context, issuer string) (*domain.TrustedIssuer, error)
+	List(ctx context.Context) ([]*domain.TrustedIssuer, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	CreatePolicy(ctx context.Context, policy *domain.TrustPolicy) error
+	ListPolicies(ctx context.Context, issuerID uuid.UUID) ([]*domain.TrustPolicy, error)
+	DeletePolicy(ctx context.Context, id uuid.UUID) error
+}
+
+type SigningKeyRepository interface {
+	Create(ctx context.Context, key *domain.SigningKey) error
+	GetCurrent(ctx context.Context, algorithm string) (*domain.SigningKey, error)
+	List(ctx context.Context) ([]*domain.SigningKey, error)
+	ListByStates(ctx context.Context, algorithm string, states []string) ([]*domain.SigningKey, error)
+	Update(ctx context.Context, key *domain.SigningKey) error
+}
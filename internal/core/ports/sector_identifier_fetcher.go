@@ -0,0 +1,7 @@
+package ports
+
+import "context"
+
+type SectorIdentifierFetcher interface {
+	FetchRedirectURIs(ctx context.Context, sectorIdentifierURI string) ([]string, error)
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+type TokenEncrypter interface {
+	// Encrypt wraps a signed JWT in a compact JWE for a key of the set that fits the encryption's alg.
+	Encrypt(ctx context.Context, token string, keySet *domain.JSONWebKeySet, encryption domain.ResponseEncryption) (string, error)
+}
@@ -4,11 +4,21 @@ import (
 	"context"
 
 	"github.com/g-villarinho/oidc-server/internal/core/domain"
-	"github.com/google/uuid"
 )
 
 type TokenGenerator interface {
-	GenerateAccessToken(ctx context.Context, userID uuid.UUID, clientID string, scopes []string) (string, error)
+	GenerateAccessToken(ctx context.Context, params domain.AccessTokenParams) (string, error)
 	GenerateRefreshToken(ctx context.Context) (string, error)
-	GenerateIDToken(ctx context.Context, user *domain.User, clientID, nonce string, scopes []string) (string, error)
+	GenerateOpaqueToken(ctx context.Context) (string, error)
+	GenerateIDToken(ctx context.Context, user *domain.User, params domain.IDTokenParams) (string, error)
+	ParseIDToken(ctx context.Context, idToken string) (*domain.IDTokenClaims, error)
+	GenerateAuthorizationResponse(ctx context.Context, clientID string, params map[string]string) (string, error)
+	GenerateUserInfoResponse(ctx context.Context, clientID string, claims map[string]any) (string, error)
+	GetJSONWebKeySet(ctx context.Context) (*domain.JSONWebKeySet, error)
+}
+
+// PASETOTokenGenerator issues access tokens as PASETO v4.public tokens.
+type PASETOTokenGenerator interface {
+	TokenGenerator
+	GetPASETOKeySet(ctx context.Context) (*domain.PASETOKeySet, error)
 }
@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+)
+
+// WebAuthnVerifier checks authenticator responses against the relying party.
+type WebAuthnVerifier interface {
+	VerifyAttestation(ctx context.Context, attestation *domain.WebAuthnAttestation, challenge []byte) (*domain.WebAuthnAttestedCredential, error)
+	VerifyAssertion(ctx context.Context, assertion *domain.WebAuthnAssertion, challenge []byte, publicKey []byte) (*domain.WebAuthnVerifiedAssertion, error)
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+const (
+	assertionJTIKeyPrefix = "assertion:jti:"
+	minAssertionReplayTTL = time.Second
+)
+
+type AssertionService interface {
+	VerifyClientAssertion(ctx context.Context, client *domain.Client, assertion string) error
+	VerifyAuthorizationGrant(ctx context.Context, client *domain.Client, assertion string) (*domain.AssertionClaims, error)
+}
+
+type AssertionServiceImpl struct {
+	assertionVerifier ports.AssertionVerifier
+	jwksFetcher       ports.JWKSFetcher
+	cache             ports.Cache
+	keyEncrypter      ports.KeyEncrypter
+	config            *config.Config
+}
+
+func NewAssertionService(
+	assertionVerifier ports.AssertionVerifier,
+	jwksFetcher ports.JWKSFetcher,
+	cache ports.Cache,
+	keyEncrypter ports.KeyEncrypter,
+	config *config.Config,
+) AssertionService {
+	return &AssertionServiceImpl{
+		assertionVerifier: assertionVerifier,
+		jwksFetcher:       jwksFetcher,
+		cache:             cache,
+		keyEncrypter:      keyEncrypter,
+		config:            config,
+	}
+}
+
+// VerifyClientAssertion authenticates a client through a JWT assertion (RFC 7523 section 3).
+func (s *AssertionServiceImpl) VerifyClientAssertion(ctx context.Context, client *domain.Client, assertion string) error {
+	verify := s.verify
+	if client.UsesClientSecretJWT() {
+		verify = s.verifyMAC
+	}
+
+	claims, err := verify(ctx, client, assertion)
+	if err != nil {
+		return err
+	}
+
+	if claims.Subject != client.ClientID {
+		return fmt.Errorf("%w: subject is not the client", domain.ErrInvalidAssertion)
+	}
+
+	return nil
+}
+
+// VerifyAuthorizationGrant verifies the assertion of a jwt-bearer grant (RFC 7523 section 2.1).
+func (s *AssertionServiceImpl) VerifyAuthorizationGrant(ctx context.Context, client *domain.Client, assertion string) (*domain.AssertionClaims, error) {
+	return s.verify(ctx, client, assertion)
+}
+
+func (s *AssertionServiceImpl) verify(ctx context.Context, client *domain.Client, assertion string) (*domain.AssertionClaims, error) {
+	keySet, err := s.clientKeys(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := s.assertionVerifier.VerifyAssertion(ctx, assertion, keySet)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.validate(ctx, client, claims)
+}
+
+func (s *AssertionServiceImpl) verifyMAC(ctx context.Context, client *domain.Client, assertion string) (*domain.AssertionClaims, error) {
+	if len(client.EncryptedSecretKey) == 0 {
+		return nil, fmt.Errorf("%w: client has no secret key", domain.ErrInvalidAssertion)
+	}
+
+	secret, err := s.keyEncrypter.Decrypt(ctx, client.EncryptedSecretKey)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt client secret key: %w", err)
+	}
+
+	claims, err := s.assertionVerifier.VerifyMACAssertion(ctx, assertion, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.validate(ctx, client, claims)
+}
+
+func (s *AssertionServiceImpl) validate(ctx context.Context, client *domain.Client, claims *domain.AssertionClaims) (*domain.AssertionClaims, error) {
+	if err := claims.Validate(client.ClientID, s.audiences()); err != nil {
+		return nil, err
+	}
+
+	if err := s.preventReplay(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// audiences are the values an assertion may be addressed to.
+func (s *AssertionServiceImpl) audiences() []string {
+	return []string{
+		s.config.JWT.Issuer,
+		domain.TokenEndpoint(s.config.URL.APIBaseURL),
+		domain.IntrospectionEndpoint(s.config.URL.APIBaseURL),
+	}
+}
+
+// preventReplay records the jti until the assertion expires, so the same assertion can't be presented twice.
+func (s *AssertionServiceImpl) preventReplay(ctx context.Context, claims *domain.AssertionClaims) error {
+	ttl := max(time.Until(claims.ExpiresAt), minAssertionReplayTTL)
+
+	stored, err := s.cache.SetNX(ctx, assertionJTIKeyPrefix+claims.Issuer+":"+claims.JWTID, "1", ttl)
+	if err != nil {
+		return fmt.Errorf("record assertion jti: %w", err)
+	}
+
+	if !stored {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidAssertion, domain.ErrAssertionReplayed)
+	}
+
+	return nil
+}
+
+func (s *AssertionServiceImpl) clientKeys(ctx context.Context, client *domain.Client) (*domain.JSONWebKeySet, error) {
+	if !client.HasKeys() {
+		return nil, fmt.Errorf("%w: client has no registered keys", domain.ErrInvalidAssertion)
+	}
+
+	return resolveKeySet(ctx, s.jwksFetcher, s.cache, client.JWKS, client.JWKSURI)
+}
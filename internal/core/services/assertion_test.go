@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyClientAssertion(t *testing.T) {
+	cfg := &config.Config{
+		JWT: config.JWT{Issuer: "https://auth.example.com"},
+		URL: config.URL{APIBaseURL: "https://auth.example.com"},
+	}
+	tokenEndpoint := "https://auth.example.com/api/v1/oauth/token"
+	keySet := &domain.JSONWebKeySet{Keys: []domain.JSONWebKey{{KeyType: "EC", KeyID: "key-1", Curve: "P-256"}}}
+
+	t.Run("should accept a fresh assertion signed by the client keys", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                "partner-service",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodPrivateKeyJWT,
+			JWKS:                    keySet,
+		}
+		claims := &domain.AssertionClaims{
+			Issuer:    "partner-service",
+			Subject:   "partner-service",
+			Audience:  []string{tokenEndpoint},
+			ExpiresAt: time.Now().UTC().Add(time.Minute),
+			JWTID:     "jti-1",
+		}
+
+		mockVerifier := mocks.NewAssertionVerifierMock(t)
+		mockVerifier.EXPECT().VerifyAssertion(ctx, "assertion", keySet).Return(claims, nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().
+			SetNX(ctx, "assertion:jti:partner-service:jti-1", "1", mock.AnythingOfType("time.Duration")).
+			Return(true, nil)
+
+		assertionService := &AssertionServiceImpl{assertionVerifier: mockVerifier, cache: mockCache, config: cfg}
+
+		// Act
+		err := assertionService.VerifyClientAssertion(ctx, client, "assertion")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a replayed assertion", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                "partner-service",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodPrivateKeyJWT,
+			JWKS:                    keySet,
+		}
+		claims := &domain.AssertionClaims{
+			Issuer:    "partner-service",
+			Subject:   "partner-service",
+			Audience:  []string{tokenEndpoint},
+			ExpiresAt: time.Now().UTC().Add(time.Minute),
+			JWTID:     "jti-1",
+		}
+
+		mockVerifier := mocks.NewAssertionVerifierMock(t)
+		mockVerifier.EXPECT().VerifyAssertion(ctx, "assertion", keySet).Return(claims, nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().
+			SetNX(ctx, "assertion:jti:partner-service:jti-1", "1", mock.AnythingOfType("time.Duration")).
+			Return(false, nil)
+
+		assertionService := &AssertionServiceImpl{assertionVerifier: mockVerifier, cache: mockCache, config: cfg}
+
+		// Act
+		err := assertionService.VerifyClientAssertion(ctx, client, "assertion")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrAssertionReplayed)
+	})
+
+	t.Run("should reject an assertion addressed to another audience", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		claims := &domain.AssertionClaims{
+			Issuer:    "partner-service",
+			Subject:   "partner-service",
+			Audience:  []string{"https://other.example.com/token"},
+			ExpiresAt: time.Now().UTC().Add(time.Minute),
+			JWTID:     "jti-1",
+		}
+		client := &domain.Client{
+			ClientID:                "partner-service",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodPrivateKeyJWT,
+			JWKS:                    keySet,
+		}
+
+		mockVerifier := mocks.NewAssertionVerifierMock(t)
+		mockVerifier.EXPECT().VerifyAssertion(ctx, "assertion", keySet).Return(claims, nil)
+
+		assertionService := &AssertionServiceImpl{assertionVerifier: mockVerifier, config: cfg}
+
+		// Act
+		err := assertionService.VerifyClientAssertion(ctx, client, "assertion")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidAssertion)
+	})
+
+	t.Run("should reject an assertion issued by another client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		claims := &domain.AssertionClaims{
+			Issuer:    "other-client",
+			Subject:   "partner-service",
+			Audience:  []string{tokenEndpoint},
+			ExpiresAt: time.Now().UTC().Add(time.Minute),
+			JWTID:     "jti-1",
+		}
+		client := &domain.Client{
+			ClientID:                "partner-service",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodPrivateKeyJWT,
+			JWKS:                    keySet,
+		}
+
+		mockVerifier := mocks.NewAssertionVerifierMock(t)
+		mockVerifier.EXPECT().VerifyAssertion(ctx, "assertion", keySet).Return(claims, nil)
+
+		assertionService := &AssertionServiceImpl{assertionVerifier: mockVerifier, config: cfg}
+
+		// Act
+		err := assertionService.VerifyClientAssertion(ctx, client, "assertion")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidAssertion)
+	})
+
+	t.Run("should fetch and cache the keys published at the jwks_uri", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                "partner-service",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodPrivateKeyJWT,
+			JWKSURI:                 "https://partner.example.com/jwks.json",
+		}
+		cached, err := json.Marshal(keySet)
+		require.NoError(t, err)
+		claims := &domain.AssertionClaims{
+			Issuer:    "partner-service",
+			Subject:   "partner-service",
+			Audience:  []string{tokenEndpoint},
+			ExpiresAt: time.Now().UTC().Add(time.Minute),
+			JWTID:     "jti-1",
+		}
+
+		mockFetcher := mocks.NewJWKSFetcherMock(t)
+		mockFetcher.EXPECT().FetchJWKS(ctx, client.JWKSURI).Return(keySet, nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().Get(ctx, "jwks:"+client.JWKSURI).Return("", assert.AnError)
+		mockCache.EXPECT().Set(ctx, "jwks:"+client.JWKSURI, string(cached), jwksCacheTTL).Return(nil)
+		mockCache.EXPECT().
+			SetNX(ctx, "assertion:jti:partner-service:jti-1", "1", mock.AnythingOfType("time.Duration")).
+			Return(true, nil)
+
+		mockVerifier := mocks.NewAssertionVerifierMock(t)
+		mockVerifier.EXPECT().VerifyAssertion(ctx, "assertion", keySet).Return(claims, nil)
+
+		assertionService := &AssertionServiceImpl{
+			assertionVerifier: mockVerifier,
+			jwksFetcher:       mockFetcher,
+			cache:             mockCache,
+			config:            cfg,
+		}
+
+		// Act
+		err = assertionService.VerifyClientAssertion(ctx, client, "assertion")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should verify a client_secret_jwt assertion with the decrypted secret", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                "partner-service",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodClientSecretJWT,
+			EncryptedSecretKey:      []byte("encrypted-secret"),
+		}
+		claims := &domain.AssertionClaims{
+			Issuer:    "partner-service",
+			Subject:   "partner-service",
+			Audience:  []string{tokenEndpoint},
+			ExpiresAt: time.Now().UTC().Add(time.Minute),
+			JWTID:     "jti-1",
+		}
+
+		mockKeyEncrypter := mocks.NewKeyEncrypterMock(t)
+		mockKeyEncrypter.EXPECT().Decrypt(ctx, client.EncryptedSecretKey).Return([]byte("secret"), nil)
+
+		mockVerifier := mocks.NewAssertionVerifierMock(t)
+		mockVerifier.EXPECT().VerifyMACAssertion(ctx, "assertion", []byte("secret")).Return(claims, nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().
+			SetNX(ctx, "assertion:jti:partner-service:jti-1", "1", mock.AnythingOfType("time.Duration")).
+			Return(true, nil)
+
+		assertionService := &AssertionServiceImpl{
+			assertionVerifier: mockVerifier,
+			cache:             mockCache,
+			keyEncrypter:      mockKeyEncrypter,
+			config:            cfg,
+		}
+
+		// Act
+		err := assertionService.VerifyClientAssertion(ctx, client, "assertion")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a client_secret_jwt client without a stored secret key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                "partner-service",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodClientSecretJWT,
+		}
+
+		assertionService := &AssertionServiceImpl{config: cfg}
+
+		// Act
+		err := assertionService.VerifyClientAssertion(ctx, client, "assertion")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidAssertion)
+	})
+}
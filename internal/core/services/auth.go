@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/g-villarinho/oidc-server/internal/config"
@@ -12,48 +13,209 @@ import (
 
 type AuthService interface {
 	RegisterUser(ctx context.Context, name, email, password string) error
-	Login(ctx context.Context, email, password string) (*domain.Session, *domain.User, error)
+	Login(ctx context.Context, email, password string) (*domain.LoginResult, error)
+	LoginWithTOTP(ctx context.Context, ticketID, code string) (*domain.Session, *domain.User, error)
+	LoginWithRecoveryCode(ctx context.Context, ticketID, code string) (*domain.Session, *domain.User, error)
+	BeginWebAuthnLogin(ctx context.Context, ticketID string) (*domain.WebAuthnLoginOptions, error)
+	LoginWithWebAuthn(ctx context.Context, ticketID, challengeID string, assertion *domain.WebAuthnAssertion) (*domain.Session, *domain.User, error)
 	GetSessionUser(ctx context.Context, sessionID uuid.UUID) (*domain.User, error)
+	Logout(ctx context.Context, sessionID uuid.UUID) error
 }
 
 type AuthServiceImpl struct {
-	userService       UserService
-	userRepository    ports.UserRepository
-	sessionRepository ports.SessionRepository
-	sessionConfig     config.Session
+	userService           UserService
+	totpService           TOTPService
+	webAuthnService       WebAuthnService
+	recoveryCodeService   RecoveryCodeService
+	emailVerification     EmailVerificationService
+	userRepository        ports.UserRepository
+	sessionRepository     ports.SessionRepository
+	loginTicketRepository ports.LoginTicketRepository
+	tokenRepository       ports.TokenRepository
+	sessionConfig         config.Session
 }
 
 func NewAuthService(
 	userService UserService,
+	totpService TOTPService,
+	webAuthnService WebAuthnService,
+	recoveryCodeService RecoveryCodeService,
+	emailVerification EmailVerificationService,
 	userRepository ports.UserRepository,
 	sessionRepository ports.SessionRepository,
+	loginTicketRepository ports.LoginTicketRepository,
+	tokenRepository ports.TokenRepository,
 	config *config.Config) AuthService {
 	return &AuthServiceImpl{
-		userService:       userService,
-		userRepository:    userRepository,
-		sessionRepository: sessionRepository,
-		sessionConfig:     config.Session,
+		userService:           userService,
+		totpService:           totpService,
+		webAuthnService:       webAuthnService,
+		recoveryCodeService:   recoveryCodeService,
+		emailVerification:     emailVerification,
+		userRepository:        userRepository,
+		sessionRepository:     sessionRepository,
+		loginTicketRepository: loginTicketRepository,
+		tokenRepository:       tokenRepository,
+		sessionConfig:         config.Session,
 	}
 }
 
 func (s *AuthServiceImpl) RegisterUser(ctx context.Context, name, email, password string) error {
-	_, err := s.userService.CreateUser(ctx, name, email, password)
+	user, err := s.userService.CreateUser(ctx, name, email, password)
 	if err != nil {
 		return fmt.Errorf("register user: %w", err)
 	}
 
-	// TODO: Send welcome email to user confirming registration
+	// The user exists by now; they can ask for another email if this fails.
+	if err := s.emailVerification.SendVerificationEmail(ctx, user); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrVerificationEmailNotSent, err)
+	}
 
 	return nil
 }
 
-func (s *AuthServiceImpl) Login(ctx context.Context, email, password string) (*domain.Session, *domain.User, error) {
+// Login checks the user's password.
+func (s *AuthServiceImpl) Login(ctx context.Context, email, password string) (*domain.LoginResult, error) {
 	user, err := s.userService.Authenticate(ctx, email, password)
 	if err != nil {
-		return nil, nil, fmt.Errorf("login user: %w", err)
+		return nil, fmt.Errorf("login user: %w", err)
+	}
+
+	methods, err := s.secondFactors(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(methods) > 0 {
+		ticket, err := domain.NewLoginTicket(user.ID, methods)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.loginTicketRepository.Create(ctx, ticket); err != nil {
+			return nil, fmt.Errorf("store login ticket: %w", err)
+		}
+
+		return &domain.LoginResult{User: user, Ticket: ticket}, nil
 	}
 
 	session, err := domain.NewSession(user.ID, s.sessionConfig.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("create session: %w", err)
+	}
+
+	if err := s.sessionRepository.Create(ctx, session); err != nil {
+		return nil, fmt.Errorf("store session: %w", err)
+	}
+
+	return &domain.LoginResult{User: user, Session: session}, nil
+}
+
+// LoginWithTOTP finishes a login with the TOTP code of the ticket's user and creates a multi-factor session.
+func (s *AuthServiceImpl) LoginWithTOTP(ctx context.Context, ticketID, code string) (*domain.Session, *domain.User, error) {
+	ticket, err := s.getLoginTicket(ctx, ticketID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !ticket.Allows(domain.SecondFactorTOTP) {
+		return nil, nil, domain.ErrTOTPNotEnrolled
+	}
+
+	if err := s.totpService.Verify(ctx, ticket.UserID, code); err != nil {
+		return nil, nil, fmt.Errorf("verify TOTP code: %w", err)
+	}
+
+	return s.completeTicketLogin(ctx, ticket, domain.AMROTP)
+}
+
+// LoginWithRecoveryCode finishes a login with one of the user's recovery codes in place of their second factor.
+func (s *AuthServiceImpl) LoginWithRecoveryCode(ctx context.Context, ticketID, code string) (*domain.Session, *domain.User, error) {
+	ticket, err := s.getLoginTicket(ctx, ticketID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !ticket.Allows(domain.SecondFactorRecoveryCode) {
+		return nil, nil, domain.ErrRecoveryCodesNotGenerated
+	}
+
+	if err := s.recoveryCodeService.Verify(ctx, ticket.UserID, code); err != nil {
+		return nil, nil, fmt.Errorf("verify recovery code: %w", err)
+	}
+
+	return s.completeTicketLogin(ctx, ticket, domain.AMROTP)
+}
+
+// BeginWebAuthnLogin hands out the options of a passkey login.
+func (s *AuthServiceImpl) BeginWebAuthnLogin(ctx context.Context, ticketID string) (*domain.WebAuthnLoginOptions, error) {
+	if ticketID == "" {
+		options, err := s.webAuthnService.BeginAuthentication(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("begin webauthn login: %w", err)
+		}
+
+		return options, nil
+	}
+
+	ticket, err := s.getLoginTicket(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := s.webAuthnService.BeginAuthentication(ctx, &ticket.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("begin webauthn login: %w", err)
+	}
+
+	return options, nil
+}
+
+// LoginWithWebAuthn finishes a passkey login begun with BeginWebAuthnLogin.
+func (s *AuthServiceImpl) LoginWithWebAuthn(ctx context.Context, ticketID, challengeID string, assertion *domain.WebAuthnAssertion) (*domain.Session, *domain.User, error) {
+	var ticket *domain.LoginTicket
+	var userID *uuid.UUID
+
+	if ticketID != "" {
+		var err error
+		ticket, err = s.getLoginTicket(ctx, ticketID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		userID = &ticket.UserID
+	}
+
+	credential, err := s.webAuthnService.FinishAuthentication(ctx, challengeID, userID, assertion)
+	if err != nil {
+		return nil, nil, fmt.Errorf("verify webauthn login: %w", err)
+	}
+
+	if ticket != nil {
+		if err := s.loginTicketRepository.Delete(ctx, ticket.ID); err != nil {
+			return nil, nil, fmt.Errorf("delete login ticket: %w", err)
+		}
+	}
+
+	user, err := s.userRepository.GetByID(ctx, credential.UserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get user: %w", err)
+	}
+
+	if user == nil {
+		return nil, nil, domain.ErrUserNotFound
+	}
+
+	var session *domain.Session
+	if ticket != nil {
+		session, err = domain.NewMultiFactorSession(user.ID, s.sessionConfig.Duration, domain.AMRHardwareKey)
+	} else {
+		if !user.EmailVerified {
+			return nil, nil, domain.ErrEmailNotVerified
+		}
+
+		session, err = domain.NewPasskeySession(user.ID, s.sessionConfig.Duration)
+	}
 	if err != nil {
 		return nil, nil, fmt.Errorf("create session: %w", err)
 	}
@@ -94,3 +256,98 @@ func (s *AuthServiceImpl) GetSessionUser(ctx context.Context, sessionID uuid.UUI
 
 	return user, nil
 }
+
+// Logout ends the session and revokes the tokens bound to it.
+func (s *AuthServiceImpl) Logout(ctx context.Context, sessionID uuid.UUID) error {
+	if err := s.sessionRepository.Delete(ctx, sessionID); err != nil {
+		return fmt.Errorf("delete session: %w", err)
+	}
+
+	if err := s.tokenRepository.RevokeBySession(ctx, sessionID, "logout"); err != nil {
+		return fmt.Errorf("revoke session tokens: %w", err)
+	}
+
+	return nil
+}
+
+// completeTicketLogin consumes a verified ticket and creates its multi-factor session.
+func (s *AuthServiceImpl) completeTicketLogin(ctx context.Context, ticket *domain.LoginTicket, amr string) (*domain.Session, *domain.User, error) {
+	if err := s.loginTicketRepository.Delete(ctx, ticket.ID); err != nil {
+		return nil, nil, fmt.Errorf("delete login ticket: %w", err)
+	}
+
+	user, err := s.userRepository.GetByID(ctx, ticket.UserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get user: %w", err)
+	}
+
+	if user == nil {
+		return nil, nil, domain.ErrUserNotFound
+	}
+
+	session, err := domain.NewMultiFactorSession(user.ID, s.sessionConfig.Duration, amr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create session: %w", err)
+	}
+
+	if err := s.sessionRepository.Create(ctx, session); err != nil {
+		return nil, nil, fmt.Errorf("store session: %w", err)
+	}
+
+	return session, user, nil
+}
+
+// secondFactors lists the second factors the user is enrolled in.
+func (s *AuthServiceImpl) secondFactors(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	var methods []string
+
+	enrolled, err := s.totpService.IsEnrolled(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("check TOTP enrollment: %w", err)
+	}
+
+	if enrolled {
+		methods = append(methods, domain.SecondFactorTOTP)
+	}
+
+	registered, err := s.webAuthnService.HasCredentials(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("check webauthn credentials: %w", err)
+	}
+
+	if registered {
+		methods = append(methods, domain.SecondFactorWebAuthn)
+	}
+
+	if len(methods) == 0 {
+		return nil, nil
+	}
+
+	remaining, err := s.recoveryCodeService.Remaining(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("count recovery codes: %w", err)
+	}
+
+	if remaining > 0 {
+		methods = append(methods, domain.SecondFactorRecoveryCode)
+	}
+
+	return methods, nil
+}
+
+func (s *AuthServiceImpl) getLoginTicket(ctx context.Context, ticketID string) (*domain.LoginTicket, error) {
+	ticket, err := s.loginTicketRepository.GetByID(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrLoginTicketNotFound
+		}
+
+		return nil, fmt.Errorf("get login ticket: %w", err)
+	}
+
+	if ticket.IsExpired() {
+		return nil, domain.ErrLoginTicketNotFound
+	}
+
+	return ticket, nil
+}
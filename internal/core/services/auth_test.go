@@ -8,6 +8,7 @@ import (
 
 	"github.com/g-villarinho/oidc-server/internal/config"
 	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
 	"github.com/g-villarinho/oidc-server/internal/mocks"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -35,8 +36,14 @@ func TestRegisterUser(t *testing.T) {
 			CreateUser(ctx, name, email, password).
 			Return(expectedUser, nil)
 
+		mockEmailVerification := mocks.NewEmailVerificationServiceMock(t)
+		mockEmailVerification.EXPECT().
+			SendVerificationEmail(ctx, expectedUser).
+			Return(nil)
+
 		authService := &AuthServiceImpl{
-			userService: mockUserService,
+			userService:       mockUserService,
+			emailVerification: mockEmailVerification,
 		}
 
 		// Act
@@ -46,6 +53,37 @@ func TestRegisterUser(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("should return ErrVerificationEmailNotSent when the email fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		name := "John Doe"
+		email := "john.doe@example.com"
+		password := "SecurePassword123!"
+
+		expectedUser := &domain.User{ID: uuid.New(), Name: name, Email: email}
+
+		mockUserService := mocks.NewUserServiceMock(t)
+		mockUserService.EXPECT().
+			CreateUser(ctx, name, email, password).
+			Return(expectedUser, nil)
+
+		mockEmailVerification := mocks.NewEmailVerificationServiceMock(t)
+		mockEmailVerification.EXPECT().
+			SendVerificationEmail(ctx, expectedUser).
+			Return(errors.New("connection refused"))
+
+		authService := &AuthServiceImpl{
+			userService:       mockUserService,
+			emailVerification: mockEmailVerification,
+		}
+
+		// Act
+		err := authService.RegisterUser(ctx, name, email, password)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrVerificationEmailNotSent)
+	})
+
 	t.Run("should return error when user service fails to create user", func(t *testing.T) {
 		// Arrange
 		ctx := context.Background()
@@ -258,22 +296,34 @@ func TestLogin(t *testing.T) {
 			Create(ctx, mock.AnythingOfType("*domain.Session")).
 			Return(nil)
 
+		mockTOTPService := mocks.NewTOTPServiceMock(t)
+		mockTOTPService.EXPECT().
+			IsEnrolled(ctx, userID).
+			Return(false, nil)
+
+		mockWebAuthnService := mocks.NewWebAuthnServiceMock(t)
+		mockWebAuthnService.EXPECT().
+			HasCredentials(ctx, userID).
+			Return(false, nil)
+
 		authService := &AuthServiceImpl{
 			userService:       mockUserService,
+			totpService:       mockTOTPService,
+			webAuthnService:   mockWebAuthnService,
 			sessionRepository: mockSessionRepository,
 			sessionConfig:     sessionConfig,
 		}
 
 		// Act
-		session, user, err := authService.Login(ctx, email, password)
+		result, err := authService.Login(ctx, email, password)
 
 		// Assert
 		require.NoError(t, err)
-		assert.NotNil(t, session)
-		assert.NotNil(t, user)
-		assert.Equal(t, expectedUser.ID, user.ID)
-		assert.Equal(t, expectedUser.Email, user.Email)
-		assert.Equal(t, userID, session.UserID)
+		assert.NotNil(t, result.Session)
+		assert.NotNil(t, result.User)
+		assert.Equal(t, expectedUser.ID, result.User.ID)
+		assert.Equal(t, expectedUser.Email, result.User.Email)
+		assert.Equal(t, userID, result.Session.UserID)
 	})
 
 	t.Run("should return error when authentication fails", func(t *testing.T) {
@@ -292,12 +342,11 @@ func TestLogin(t *testing.T) {
 		}
 
 		// Act
-		session, user, err := authService.Login(ctx, email, password)
+		result, err := authService.Login(ctx, email, password)
 
 		// Assert
 		require.Error(t, err)
-		assert.Nil(t, session)
-		assert.Nil(t, user)
+		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "login user")
 		assert.ErrorIs(t, err, domain.ErrPasswordMismatch)
 	})
@@ -318,12 +367,11 @@ func TestLogin(t *testing.T) {
 		}
 
 		// Act
-		session, user, err := authService.Login(ctx, email, password)
+		result, err := authService.Login(ctx, email, password)
 
 		// Assert
 		require.Error(t, err)
-		assert.Nil(t, session)
-		assert.Nil(t, user)
+		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "login user")
 		assert.ErrorIs(t, err, domain.ErrUserNotFound)
 	})
@@ -355,21 +403,33 @@ func TestLogin(t *testing.T) {
 			Create(ctx, mock.AnythingOfType("*domain.Session")).
 			Return(nil)
 
+		mockTOTPService := mocks.NewTOTPServiceMock(t)
+		mockTOTPService.EXPECT().
+			IsEnrolled(ctx, expectedUser.ID).
+			Return(false, nil)
+
+		mockWebAuthnService := mocks.NewWebAuthnServiceMock(t)
+		mockWebAuthnService.EXPECT().
+			HasCredentials(ctx, expectedUser.ID).
+			Return(false, nil)
+
 		authService := &AuthServiceImpl{
 			userService:       mockUserService,
+			totpService:       mockTOTPService,
+			webAuthnService:   mockWebAuthnService,
 			sessionRepository: mockSessionRepository,
 			sessionConfig:     sessionConfig,
 		}
 
 		// Act
-		session, user, err := authService.Login(ctx, email, password)
+		result, err := authService.Login(ctx, email, password)
 
 		// Assert
 		require.NoError(t, err)
-		assert.NotNil(t, session)
-		assert.NotNil(t, user)
-		assert.True(t, session.TTL() <= 2*time.Hour)
-		assert.True(t, session.TTL() > 0)
+		assert.NotNil(t, result.Session)
+		assert.NotNil(t, result.User)
+		assert.True(t, result.Session.TTL() <= 2*time.Hour)
+		assert.True(t, result.Session.TTL() > 0)
 	})
 
 	t.Run("should return error when session repository fails to store session", func(t *testing.T) {
@@ -401,19 +461,30 @@ func TestLogin(t *testing.T) {
 			Create(ctx, mock.AnythingOfType("*domain.Session")).
 			Return(expectedError)
 
+		mockTOTPService := mocks.NewTOTPServiceMock(t)
+		mockTOTPService.EXPECT().
+			IsEnrolled(ctx, expectedUser.ID).
+			Return(false, nil)
+
+		mockWebAuthnService := mocks.NewWebAuthnServiceMock(t)
+		mockWebAuthnService.EXPECT().
+			HasCredentials(ctx, expectedUser.ID).
+			Return(false, nil)
+
 		authService := &AuthServiceImpl{
 			userService:       mockUserService,
+			totpService:       mockTOTPService,
+			webAuthnService:   mockWebAuthnService,
 			sessionRepository: mockSessionRepository,
 			sessionConfig:     sessionConfig,
 		}
 
 		// Act
-		session, user, err := authService.Login(ctx, email, password)
+		result, err := authService.Login(ctx, email, password)
 
 		// Assert
 		require.Error(t, err)
-		assert.Nil(t, session)
-		assert.Nil(t, user)
+		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "store session")
 		assert.Contains(t, err.Error(), expectedError.Error())
 	})
@@ -434,12 +505,11 @@ func TestLogin(t *testing.T) {
 		}
 
 		// Act
-		session, user, err := authService.Login(ctx, email, password)
+		result, err := authService.Login(ctx, email, password)
 
 		// Assert
 		require.Error(t, err)
-		assert.Nil(t, session)
-		assert.Nil(t, user)
+		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "login user")
 		assert.ErrorIs(t, err, domain.ErrEmailNotVerified)
 	})
@@ -462,12 +532,11 @@ func TestLogin(t *testing.T) {
 		}
 
 		// Act
-		session, user, err := authService.Login(ctx, email, password)
+		result, err := authService.Login(ctx, email, password)
 
 		// Assert
 		require.Error(t, err)
-		assert.Nil(t, session)
-		assert.Nil(t, user)
+		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "login user")
 	})
 
@@ -489,13 +558,403 @@ func TestLogin(t *testing.T) {
 		}
 
 		// Act
-		session, user, err := authService.Login(ctx, email, password)
+		result, err := authService.Login(ctx, email, password)
 
 		// Assert
 		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "login user")
+	})
+
+	t.Run("should return a login ticket instead of a session when TOTP is enrolled", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		email := "john.doe@example.com"
+		password := "SecurePassword123!"
+
+		expectedUser := &domain.User{ID: uuid.New(), Email: email, EmailVerified: true}
+
+		mockUserService := mocks.NewUserServiceMock(t)
+		mockUserService.EXPECT().
+			Authenticate(ctx, email, password).
+			Return(expectedUser, nil)
+
+		mockTOTPService := mocks.NewTOTPServiceMock(t)
+		mockTOTPService.EXPECT().
+			IsEnrolled(ctx, expectedUser.ID).
+			Return(true, nil)
+
+		mockWebAuthnService := mocks.NewWebAuthnServiceMock(t)
+		mockWebAuthnService.EXPECT().
+			HasCredentials(ctx, expectedUser.ID).
+			Return(false, nil)
+
+		mockRecoveryCodeService := mocks.NewRecoveryCodeServiceMock(t)
+		mockRecoveryCodeService.EXPECT().
+			Remaining(ctx, expectedUser.ID).
+			Return(domain.RecoveryCodeCount, nil)
+
+		var storedTicket *domain.LoginTicket
+		mockLoginTicketRepository := mocks.NewLoginTicketRepositoryMock(t)
+		mockLoginTicketRepository.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.LoginTicket")).
+			Run(func(ctx context.Context, ticket *domain.LoginTicket) { storedTicket = ticket }).
+			Return(nil)
+
+		authService := &AuthServiceImpl{
+			userService:           mockUserService,
+			totpService:           mockTOTPService,
+			webAuthnService:       mockWebAuthnService,
+			recoveryCodeService:   mockRecoveryCodeService,
+			loginTicketRepository: mockLoginTicketRepository,
+		}
+
+		// Act
+		result, err := authService.Login(ctx, email, password)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, result.RequiresSecondFactor())
+		assert.Nil(t, result.Session)
+		assert.Equal(t, storedTicket, result.Ticket)
+		assert.Equal(t, expectedUser.ID, result.Ticket.UserID)
+		assert.Equal(t, []string{domain.SecondFactorTOTP, domain.SecondFactorRecoveryCode}, result.Ticket.Methods)
+		assert.WithinDuration(t, time.Now().Add(domain.LoginTicketExpiry), result.Ticket.ExpiresAt, time.Minute)
+	})
+}
+
+func TestLoginWithTOTP(t *testing.T) {
+	t.Run("should create a multi-factor session and consume the ticket", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{ID: uuid.New(), Email: "john.doe@example.com"}
+		ticket := &domain.LoginTicket{
+			ID:        "ticket-1",
+			UserID:    user.ID,
+			Methods:   []string{domain.SecondFactorTOTP},
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		mockLoginTicketRepository := mocks.NewLoginTicketRepositoryMock(t)
+		mockLoginTicketRepository.EXPECT().GetByID(ctx, ticket.ID).Return(ticket, nil)
+		mockLoginTicketRepository.EXPECT().Delete(ctx, ticket.ID).Return(nil)
+
+		mockTOTPService := mocks.NewTOTPServiceMock(t)
+		mockTOTPService.EXPECT().Verify(ctx, user.ID, "123456").Return(nil)
+
+		mockUserRepository := mocks.NewUserRepositoryMock(t)
+		mockUserRepository.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		mockSessionRepository := mocks.NewSessionRepositoryMock(t)
+		mockSessionRepository.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Session")).
+			Return(nil)
+
+		authService := &AuthServiceImpl{
+			totpService:           mockTOTPService,
+			userRepository:        mockUserRepository,
+			sessionRepository:     mockSessionRepository,
+			loginTicketRepository: mockLoginTicketRepository,
+			sessionConfig:         config.Session{Duration: time.Hour},
+		}
+
+		// Act
+		session, sessionUser, err := authService.LoginWithTOTP(ctx, ticket.ID, "123456")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, user, sessionUser)
+		assert.Equal(t, user.ID, session.UserID)
+		assert.Equal(t, domain.ACRMultiFactor, session.ACR)
+		assert.Equal(t, []string{domain.AMRPassword, domain.AMROTP}, session.AMR)
+	})
+
+	t.Run("should keep the ticket when the code is wrong", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		ticket := &domain.LoginTicket{
+			ID:        "ticket-1",
+			UserID:    uuid.New(),
+			Methods:   []string{domain.SecondFactorTOTP},
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		mockLoginTicketRepository := mocks.NewLoginTicketRepositoryMock(t)
+		mockLoginTicketRepository.EXPECT().GetByID(ctx, ticket.ID).Return(ticket, nil)
+
+		mockTOTPService := mocks.NewTOTPServiceMock(t)
+		mockTOTPService.EXPECT().Verify(ctx, ticket.UserID, "000000").Return(domain.ErrInvalidTOTPCode)
+
+		authService := &AuthServiceImpl{
+			totpService:           mockTOTPService,
+			loginTicketRepository: mockLoginTicketRepository,
+		}
+
+		// Act
+		session, user, err := authService.LoginWithTOTP(ctx, ticket.ID, "000000")
+
+		// Assert
 		assert.Nil(t, session)
 		assert.Nil(t, user)
-		assert.Contains(t, err.Error(), "login user")
+		assert.ErrorIs(t, err, domain.ErrInvalidTOTPCode)
+	})
+
+	t.Run("should return ErrLoginTicketNotFound for an unknown ticket", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockLoginTicketRepository := mocks.NewLoginTicketRepositoryMock(t)
+		mockLoginTicketRepository.EXPECT().GetByID(ctx, "unknown").Return(nil, ports.ErrNotFound)
+
+		authService := &AuthServiceImpl{loginTicketRepository: mockLoginTicketRepository}
+
+		// Act
+		session, user, err := authService.LoginWithTOTP(ctx, "unknown", "123456")
+
+		// Assert
+		assert.Nil(t, session)
+		assert.Nil(t, user)
+		assert.ErrorIs(t, err, domain.ErrLoginTicketNotFound)
+	})
+
+	t.Run("should return ErrTOTPNotEnrolled for a ticket without TOTP", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		ticket := &domain.LoginTicket{
+			ID:        "ticket-1",
+			UserID:    uuid.New(),
+			Methods:   []string{domain.SecondFactorWebAuthn},
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		mockLoginTicketRepository := mocks.NewLoginTicketRepositoryMock(t)
+		mockLoginTicketRepository.EXPECT().GetByID(ctx, ticket.ID).Return(ticket, nil)
+
+		authService := &AuthServiceImpl{loginTicketRepository: mockLoginTicketRepository}
+
+		// Act
+		session, user, err := authService.LoginWithTOTP(ctx, ticket.ID, "123456")
+
+		// Assert
+		assert.Nil(t, session)
+		assert.Nil(t, user)
+		assert.ErrorIs(t, err, domain.ErrTOTPNotEnrolled)
+	})
+}
+
+func TestLoginWithRecoveryCode(t *testing.T) {
+	t.Run("should create a multi-factor session and consume the ticket", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{ID: uuid.New(), Email: "john.doe@example.com"}
+		ticket := &domain.LoginTicket{
+			ID:        "ticket-1",
+			UserID:    user.ID,
+			Methods:   []string{domain.SecondFactorTOTP, domain.SecondFactorRecoveryCode},
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		mockLoginTicketRepository := mocks.NewLoginTicketRepositoryMock(t)
+		mockLoginTicketRepository.EXPECT().GetByID(ctx, ticket.ID).Return(ticket, nil)
+		mockLoginTicketRepository.EXPECT().Delete(ctx, ticket.ID).Return(nil)
+
+		mockRecoveryCodeService := mocks.NewRecoveryCodeServiceMock(t)
+		mockRecoveryCodeService.EXPECT().Verify(ctx, user.ID, "k3tqa-7mzbx").Return(nil)
+
+		mockUserRepository := mocks.NewUserRepositoryMock(t)
+		mockUserRepository.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		mockSessionRepository := mocks.NewSessionRepositoryMock(t)
+		mockSessionRepository.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Session")).
+			Return(nil)
+
+		authService := &AuthServiceImpl{
+			recoveryCodeService:   mockRecoveryCodeService,
+			userRepository:        mockUserRepository,
+			sessionRepository:     mockSessionRepository,
+			loginTicketRepository: mockLoginTicketRepository,
+			sessionConfig:         config.Session{Duration: time.Hour},
+		}
+
+		// Act
+		session, sessionUser, err := authService.LoginWithRecoveryCode(ctx, ticket.ID, "k3tqa-7mzbx")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, user, sessionUser)
+		assert.Equal(t, domain.ACRMultiFactor, session.ACR)
+		assert.Equal(t, []string{domain.AMRPassword, domain.AMROTP}, session.AMR)
+	})
+
+	t.Run("should return ErrRecoveryCodesNotGenerated for a ticket without recovery codes", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		ticket := &domain.LoginTicket{
+			ID:        "ticket-1",
+			UserID:    uuid.New(),
+			Methods:   []string{domain.SecondFactorTOTP},
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		mockLoginTicketRepository := mocks.NewLoginTicketRepositoryMock(t)
+		mockLoginTicketRepository.EXPECT().GetByID(ctx, ticket.ID).Return(ticket, nil)
+
+		authService := &AuthServiceImpl{loginTicketRepository: mockLoginTicketRepository}
+
+		// Act
+		session, user, err := authService.LoginWithRecoveryCode(ctx, ticket.ID, "k3tqa-7mzbx")
+
+		// Assert
+		assert.Nil(t, session)
+		assert.Nil(t, user)
+		assert.ErrorIs(t, err, domain.ErrRecoveryCodesNotGenerated)
+	})
+}
+
+func TestLoginWithWebAuthn(t *testing.T) {
+	t.Run("should create a passkey session for a passwordless login", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{ID: uuid.New(), Email: "john.doe@example.com", EmailVerified: true}
+		assertion := &domain.WebAuthnAssertion{CredentialID: []byte("credential-1")}
+
+		mockWebAuthnService := mocks.NewWebAuthnServiceMock(t)
+		mockWebAuthnService.EXPECT().
+			FinishAuthentication(ctx, "challenge-1", (*uuid.UUID)(nil), assertion).
+			Return(&domain.WebAuthnCredential{ID: uuid.New(), UserID: user.ID}, nil)
+
+		mockUserRepository := mocks.NewUserRepositoryMock(t)
+		mockUserRepository.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		mockSessionRepository := mocks.NewSessionRepositoryMock(t)
+		mockSessionRepository.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Session")).
+			Return(nil)
+
+		authService := &AuthServiceImpl{
+			webAuthnService:   mockWebAuthnService,
+			userRepository:    mockUserRepository,
+			sessionRepository: mockSessionRepository,
+			sessionConfig:     config.Session{Duration: time.Hour},
+		}
+
+		// Act
+		session, sessionUser, err := authService.LoginWithWebAuthn(ctx, "", "challenge-1", assertion)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, user, sessionUser)
+		assert.Equal(t, user.ID, session.UserID)
+		assert.Equal(t, domain.ACRMultiFactor, session.ACR)
+		assert.Equal(t, []string{domain.AMRHardwareKey}, session.AMR)
+	})
+
+	t.Run("should add the passkey to the password as a second factor", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{ID: uuid.New(), Email: "john.doe@example.com", EmailVerified: true}
+		ticket := &domain.LoginTicket{
+			ID:        "ticket-1",
+			UserID:    user.ID,
+			Methods:   []string{domain.SecondFactorWebAuthn},
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+		assertion := &domain.WebAuthnAssertion{CredentialID: []byte("credential-1")}
+
+		mockLoginTicketRepository := mocks.NewLoginTicketRepositoryMock(t)
+		mockLoginTicketRepository.EXPECT().GetByID(ctx, ticket.ID).Return(ticket, nil)
+		mockLoginTicketRepository.EXPECT().Delete(ctx, ticket.ID).Return(nil)
+
+		mockWebAuthnService := mocks.NewWebAuthnServiceMock(t)
+		mockWebAuthnService.EXPECT().
+			FinishAuthentication(ctx, "challenge-1", &user.ID, assertion).
+			Return(&domain.WebAuthnCredential{ID: uuid.New(), UserID: user.ID}, nil)
+
+		mockUserRepository := mocks.NewUserRepositoryMock(t)
+		mockUserRepository.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		mockSessionRepository := mocks.NewSessionRepositoryMock(t)
+		mockSessionRepository.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Session")).
+			Return(nil)
+
+		authService := &AuthServiceImpl{
+			webAuthnService:       mockWebAuthnService,
+			userRepository:        mockUserRepository,
+			sessionRepository:     mockSessionRepository,
+			loginTicketRepository: mockLoginTicketRepository,
+			sessionConfig:         config.Session{Duration: time.Hour},
+		}
+
+		// Act
+		session, _, err := authService.LoginWithWebAuthn(ctx, ticket.ID, "challenge-1", assertion)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.ACRMultiFactor, session.ACR)
+		assert.Equal(t, []string{domain.AMRPassword, domain.AMRHardwareKey}, session.AMR)
+	})
+
+	t.Run("should keep the ticket when the passkey is rejected", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		ticket := &domain.LoginTicket{
+			ID:        "ticket-1",
+			UserID:    uuid.New(),
+			Methods:   []string{domain.SecondFactorWebAuthn},
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+		assertion := &domain.WebAuthnAssertion{CredentialID: []byte("credential-1")}
+
+		mockLoginTicketRepository := mocks.NewLoginTicketRepositoryMock(t)
+		mockLoginTicketRepository.EXPECT().GetByID(ctx, ticket.ID).Return(ticket, nil)
+
+		mockWebAuthnService := mocks.NewWebAuthnServiceMock(t)
+		mockWebAuthnService.EXPECT().
+			FinishAuthentication(ctx, "challenge-1", &ticket.UserID, assertion).
+			Return(nil, domain.ErrInvalidWebAuthnResponse)
+
+		authService := &AuthServiceImpl{
+			webAuthnService:       mockWebAuthnService,
+			loginTicketRepository: mockLoginTicketRepository,
+		}
+
+		// Act
+		session, user, err := authService.LoginWithWebAuthn(ctx, ticket.ID, "challenge-1", assertion)
+
+		// Assert
+		assert.Nil(t, session)
+		assert.Nil(t, user)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("should refuse a passwordless login to an unverified email", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{ID: uuid.New(), Email: "john.doe@example.com"}
+		assertion := &domain.WebAuthnAssertion{CredentialID: []byte("credential-1")}
+
+		mockWebAuthnService := mocks.NewWebAuthnServiceMock(t)
+		mockWebAuthnService.EXPECT().
+			FinishAuthentication(ctx, "challenge-1", (*uuid.UUID)(nil), assertion).
+			Return(&domain.WebAuthnCredential{ID: uuid.New(), UserID: user.ID}, nil)
+
+		mockUserRepository := mocks.NewUserRepositoryMock(t)
+		mockUserRepository.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		authService := &AuthServiceImpl{
+			webAuthnService: mockWebAuthnService,
+			userRepository:  mockUserRepository,
+		}
+
+		// Act
+		session, sessionUser, err := authService.LoginWithWebAuthn(ctx, "", "challenge-1", assertion)
+
+		// Assert
+		assert.Nil(t, session)
+		assert.Nil(t, sessionUser)
+		assert.ErrorIs(t, err, domain.ErrEmailNotVerified)
 	})
 }
 
@@ -785,3 +1244,54 @@ func TestGetSessionUser(t *testing.T) {
 		assert.Equal(t, expectedUser.ID, user.ID)
 	})
 }
+
+func TestLogout(t *testing.T) {
+	t.Run("should delete the session and revoke its tokens", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		sessionID := uuid.New()
+
+		mockSessionRepository := mocks.NewSessionRepositoryMock(t)
+		mockSessionRepository.EXPECT().
+			Delete(ctx, sessionID).
+			Return(nil)
+
+		mockTokenRepository := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepository.EXPECT().
+			RevokeBySession(ctx, sessionID, "logout").
+			Return(nil)
+
+		authService := &AuthServiceImpl{
+			sessionRepository: mockSessionRepository,
+			tokenRepository:   mockTokenRepository,
+		}
+
+		// Act
+		err := authService.Logout(ctx, sessionID)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should return error when session deletion fails", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		sessionID := uuid.New()
+
+		mockSessionRepository := mocks.NewSessionRepositoryMock(t)
+		mockSessionRepository.EXPECT().
+			Delete(ctx, sessionID).
+			Return(errors.New("redis unavailable"))
+
+		authService := &AuthServiceImpl{
+			sessionRepository: mockSessionRepository,
+		}
+
+		// Act
+		err := authService.Logout(ctx, sessionID)
+
+		// Assert
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "delete session")
+	})
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type AuthorizationDetailService interface {
+	CreateDetailType(ctx context.Context, params domain.CreateAuthorizationDetailTypeParams) (*domain.AuthorizationDetailType, error)
+	GetDetailTypeByID(ctx context.Context, id uuid.UUID) (*domain.AuthorizationDetailType, error)
+	ListDetailTypes(ctx context.Context) ([]*domain.AuthorizationDetailType, error)
+	UpdateDetailType(ctx context.Context, id uuid.UUID, params domain.UpdateAuthorizationDetailTypeParams) (*domain.AuthorizationDetailType, error)
+	DeleteDetailType(ctx context.Context, id uuid.UUID) error
+	ParseAuthorizationDetails(ctx context.Context, raw string) (domain.AuthorizationDetails, error)
+}
+
+type AuthorizationDetailServiceImpl struct {
+	detailTypeRepository ports.AuthorizationDetailTypeRepository
+}
+
+func NewAuthorizationDetailService(detailTypeRepository ports.AuthorizationDetailTypeRepository) AuthorizationDetailService {
+	return &AuthorizationDetailServiceImpl{
+		detailTypeRepository: detailTypeRepository,
+	}
+}
+
+func (s *AuthorizationDetailServiceImpl) CreateDetailType(ctx context.Context, params domain.CreateAuthorizationDetailTypeParams) (*domain.AuthorizationDetailType, error) {
+	detailType, err := domain.NewAuthorizationDetailType(params)
+	if err != nil {
+		return nil, fmt.Errorf("create authorization detail type domain: %w", err)
+	}
+
+	if err := s.detailTypeRepository.Create(ctx, detailType); err != nil {
+		if errors.Is(err, ports.ErrUniqueKeyViolation) {
+			return nil, domain.ErrAuthorizationDetailTypeAlreadyExists
+		}
+
+		return nil, fmt.Errorf("create authorization detail type: %w", err)
+	}
+
+	return detailType, nil
+}
+
+func (s *AuthorizationDetailServiceImpl) GetDetailTypeByID(ctx context.Context, id uuid.UUID) (*domain.AuthorizationDetailType, error) {
+	detailType, err := s.detailTypeRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get authorization detail type by ID: %w", err)
+	}
+
+	return detailType, nil
+}
+
+func (s *AuthorizationDetailServiceImpl) ListDetailTypes(ctx context.Context) ([]*domain.AuthorizationDetailType, error) {
+	detailTypes, err := s.detailTypeRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list authorization detail types: %w", err)
+	}
+
+	return detailTypes, nil
+}
+
+func (s *AuthorizationDetailServiceImpl) UpdateDetailType(ctx context.Context, id uuid.UUID, params domain.UpdateAuthorizationDetailTypeParams) (*domain.AuthorizationDetailType, error) {
+	detailType, err := s.detailTypeRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get authorization detail type for update: %w", err)
+	}
+
+	detailType.Update(params)
+
+	if err := s.detailTypeRepository.Update(ctx, detailType); err != nil {
+		return nil, fmt.Errorf("update authorization detail type: %w", err)
+	}
+
+	return detailType, nil
+}
+
+func (s *AuthorizationDetailServiceImpl) DeleteDetailType(ctx context.Context, id uuid.UUID) error {
+	if err := s.detailTypeRepository.Delete(ctx, id); err != nil {
+		return fmt.Errorf("delete authorization detail type: %w", err)
+	}
+
+	return nil
+}
+
+// ParseAuthorizationDetails decodes and validates the authorization_details parameter.
+func (s *AuthorizationDetailServiceImpl) ParseAuthorizationDetails(ctx context.Context, raw string) (domain.AuthorizationDetails, error) {
+	details, err := domain.ParseAuthorizationDetails(raw)
+	if err != nil || len(details) == 0 {
+		return nil, err
+	}
+
+	detailTypes, err := s.detailTypeRepository.ListByTypes(ctx, details.Types())
+	if err != nil {
+		return nil, fmt.Errorf("list authorization detail types by types: %w", err)
+	}
+
+	registered := make(map[string]*domain.AuthorizationDetailType, len(detailTypes))
+	for _, detailType := range detailTypes {
+		registered[detailType.Type] = detailType
+	}
+
+	for _, detail := range details {
+		detailType, ok := registered[detail.Type()]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown type %s", domain.ErrInvalidAuthorizationDetails, detail.Type())
+		}
+
+		if err := detailType.Validate(detail); err != nil {
+			return nil, err
+		}
+	}
+
+	return details, nil
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAuthorizationDetails(t *testing.T) {
+	paymentInitiation := &domain.AuthorizationDetailType{
+		Type:   "payment_initiation",
+		Fields: []string{"actions", "locations", "instructedAmount", "creditorName", "creditorAccount"},
+	}
+
+	t.Run("should return registered details exactly as requested", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		raw := `[{"type":"payment_initiation","actions":["initiate"],"instructedAmount":{"currency":"EUR","amount":123.50},"creditorName":"Merchant A"}]`
+
+		mockDetailTypeRepo := mocks.NewAuthorizationDetailTypeRepositoryMock(t)
+		mockDetailTypeRepo.EXPECT().
+			ListByTypes(ctx, []string{"payment_initiation"}).
+			Return([]*domain.AuthorizationDetailType{paymentInitiation}, nil)
+
+		detailService := &AuthorizationDetailServiceImpl{detailTypeRepository: mockDetailTypeRepo}
+
+		// Act
+		details, err := detailService.ParseAuthorizationDetails(ctx, raw)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, details, 1)
+		assert.Equal(t, "payment_initiation", details[0].Type())
+
+		encoded, err := json.Marshal(details)
+		require.NoError(t, err)
+		assert.JSONEq(t, raw, string(encoded))
+		assert.Contains(t, string(encoded), "123.50")
+	})
+
+	t.Run("should reject a detail of an unregistered type", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockDetailTypeRepo := mocks.NewAuthorizationDetailTypeRepositoryMock(t)
+		mockDetailTypeRepo.EXPECT().ListByTypes(ctx, []string{"account_information"}).Return(nil, nil)
+
+		detailService := &AuthorizationDetailServiceImpl{detailTypeRepository: mockDetailTypeRepo}
+
+		// Act
+		details, err := detailService.ParseAuthorizationDetails(ctx, `[{"type":"account_information","actions":["list_accounts"]}]`)
+
+		// Assert
+		assert.Nil(t, details)
+		assert.ErrorIs(t, err, domain.ErrInvalidAuthorizationDetails)
+	})
+
+	t.Run("should reject a member the detail type doesn't register", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockDetailTypeRepo := mocks.NewAuthorizationDetailTypeRepositoryMock(t)
+		mockDetailTypeRepo.EXPECT().
+			ListByTypes(ctx, []string{"payment_initiation"}).
+			Return([]*domain.AuthorizationDetailType{paymentInitiation}, nil)
+
+		detailService := &AuthorizationDetailServiceImpl{detailTypeRepository: mockDetailTypeRepo}
+
+		// Act
+		details, err := detailService.ParseAuthorizationDetails(ctx, `[{"type":"payment_initiation","debtorAccount":{"iban":"DE40100100103307118608"}}]`)
+
+		// Assert
+		assert.Nil(t, details)
+		assert.ErrorIs(t, err, domain.ErrInvalidAuthorizationDetails)
+	})
+
+	t.Run("should reject a detail without a type", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		detailService := &AuthorizationDetailServiceImpl{}
+
+		// Act
+		details, err := detailService.ParseAuthorizationDetails(ctx, `[{"actions":["initiate"]}]`)
+
+		// Assert
+		assert.Nil(t, details)
+		assert.ErrorIs(t, err, domain.ErrInvalidAuthorizationDetails)
+	})
+}
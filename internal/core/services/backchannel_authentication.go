@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type BackchannelAuthenticationService interface {
+	Authenticate(ctx context.Context, params domain.BackchannelAuthenticationParams) (*domain.BackchannelAuthenticationResponse, error)
+	GetRequest(ctx context.Context, userID uuid.UUID, authReqID string) (*domain.BackchannelAuthenticationRequest, error)
+	Approve(ctx context.Context, session *domain.Session, authReqID string) error
+	Deny(ctx context.Context, userID uuid.UUID, authReqID string) error
+	CompleteAuthentication(ctx context.Context, clientID, authReqID string) (*domain.BackchannelAuthenticationRequest, error)
+}
+
+type BackchannelAuthenticationServiceImpl struct {
+	backchannelAuthenticationRepository ports.BackchannelAuthenticationRepository
+	clientRepository                    ports.ClientRepository
+	clientService                       ClientService
+	userRepository                      ports.UserRepository
+	authenticationNotifier              ports.AuthenticationNotifier
+	clientNotifier                      ports.BackchannelClientNotifier
+}
+
+func NewBackchannelAuthenticationService(
+	backchannelAuthenticationRepository ports.BackchannelAuthenticationRepository,
+	clientRepository ports.ClientRepository,
+	clientService ClientService,
+	userRepository ports.UserRepository,
+	authenticationNotifier ports.AuthenticationNotifier,
+	clientNotifier ports.BackchannelClientNotifier,
+) BackchannelAuthenticationService {
+	return &BackchannelAuthenticationServiceImpl{
+		backchannelAuthenticationRepository: backchannelAuthenticationRepository,
+		clientRepository:                    clientRepository,
+		clientService:                       clientService,
+		userRepository:                      userRepository,
+		authenticationNotifier:              authenticationNotifier,
+		clientNotifier:                      clientNotifier,
+	}
+}
+
+// Authenticate starts a backchannel authentication.
+func (s *BackchannelAuthenticationServiceImpl) Authenticate(ctx context.Context, params domain.BackchannelAuthenticationParams) (*domain.BackchannelAuthenticationResponse, error) {
+	client, err := s.clientService.AuthenticateClient(ctx, params.ClientCredentials())
+	if err != nil {
+		return nil, err
+	}
+
+	if client.IsPublic() || !client.SupportsGrantType(domain.GrantTypeCIBA) {
+		return nil, domain.ErrUnauthorizedClient
+	}
+
+	if !slices.Contains(params.Scopes, domain.ScopeOpenID) || !client.SupportsScopes(params.Scopes) {
+		return nil, domain.ErrInvalidScope
+	}
+
+	if client.BackchannelTokenDeliveryMode == domain.BackchannelTokenDeliveryModePing && params.ClientNotificationToken == "" {
+		return nil, fmt.Errorf("%w: client_notification_token is required in ping mode", domain.ErrInvalidBackchannelRequest)
+	}
+
+	if err := params.ValidateBindingMessage(); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepository.GetByEmail(ctx, params.LoginHint)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrUnknownUserID
+		}
+
+		return nil, fmt.Errorf("get user by login hint: %w", err)
+	}
+
+	request, err := domain.NewBackchannelAuthenticationRequest(client, user.ID, params)
+	if err != nil {
+		return nil, fmt.Errorf("create backchannel authentication request domain: %w", err)
+	}
+
+	if err := s.backchannelAuthenticationRepository.Create(ctx, request); err != nil {
+		return nil, fmt.Errorf("create backchannel authentication request: %w", err)
+	}
+
+	if err := s.authenticationNotifier.NotifyAuthenticationRequest(ctx, request); err != nil {
+		return nil, fmt.Errorf("notify user of backchannel authentication request: %w", err)
+	}
+
+	return request.ToResponse(), nil
+}
+
+// GetRequest returns a pending request of the user, as shown on their device before they approve or deny it.
+func (s *BackchannelAuthenticationServiceImpl) GetRequest(ctx context.Context, userID uuid.UUID, authReqID string) (*domain.BackchannelAuthenticationRequest, error) {
+	request, err := s.backchannelAuthenticationRepository.GetByAuthReqID(ctx, authReqID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrBackchannelRequestNotFound
+		}
+
+		return nil, fmt.Errorf("get backchannel authentication request: %w", err)
+	}
+
+	if request.UserID != userID || !request.IsPending() || request.IsExpired() {
+		return nil, domain.ErrBackchannelRequestNotFound
+	}
+
+	return request, nil
+}
+
+func (s *BackchannelAuthenticationServiceImpl) Approve(ctx context.Context, session *domain.Session, authReqID string) error {
+	request, err := s.GetRequest(ctx, session.UserID, authReqID)
+	if err != nil {
+		return err
+	}
+
+	request.Approve(session)
+
+	return s.complete(ctx, request)
+}
+
+func (s *BackchannelAuthenticationServiceImpl) Deny(ctx context.Context, userID uuid.UUID, authReqID string) error {
+	request, err := s.GetRequest(ctx, userID, authReqID)
+	if err != nil {
+		return err
+	}
+
+	request.Deny()
+
+	return s.complete(ctx, request)
+}
+
+// complete stores the user's decision and, in ping mode, tells the client it can come and redeem the auth_req_id.
+func (s *BackchannelAuthenticationServiceImpl) complete(ctx context.Context, request *domain.BackchannelAuthenticationRequest) error {
+	if err := s.backchannelAuthenticationRepository.Update(ctx, request); err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return domain.ErrBackchannelRequestNotFound
+		}
+
+		return fmt.Errorf("update backchannel authentication request: %w", err)
+	}
+
+	if !request.UsesPing() {
+		return nil
+	}
+
+	client, err := s.clientRepository.GetByClientID(ctx, request.ClientID)
+	if err != nil {
+		return fmt.Errorf("get backchannel client: %w", err)
+	}
+
+	if err := s.clientNotifier.NotifyClient(ctx, client.BackchannelClientNotificationEndpoint, request.ClientNotificationToken, request.AuthReqID); err != nil {
+		return fmt.Errorf("notify backchannel client: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteAuthentication redeems an auth_req_id at the token endpoint.
+func (s *BackchannelAuthenticationServiceImpl) CompleteAuthentication(ctx context.Context, clientID, authReqID string) (*domain.BackchannelAuthenticationRequest, error) {
+	request, err := s.backchannelAuthenticationRepository.GetByAuthReqID(ctx, authReqID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrInvalidAuthReqID
+		}
+
+		return nil, fmt.Errorf("get backchannel authentication request: %w", err)
+	}
+
+	if request.ClientID != clientID {
+		return nil, domain.ErrInvalidAuthReqID
+	}
+
+	if request.IsExpired() {
+		return nil, domain.ErrExpiredAuthReqID
+	}
+
+	switch request.Status {
+	case domain.BackchannelAuthenticationStatusDenied:
+		if err := s.backchannelAuthenticationRepository.Delete(ctx, authReqID); err != nil {
+			return nil, fmt.Errorf("delete backchannel authentication request: %w", err)
+		}
+		return nil, domain.ErrAccessDenied
+	case domain.BackchannelAuthenticationStatusApproved:
+		if err := s.backchannelAuthenticationRepository.Delete(ctx, authReqID); err != nil {
+			return nil, fmt.Errorf("delete backchannel authentication request: %w", err)
+		}
+		return request, nil
+	}
+
+	pollErr := request.Poll(time.Now().UTC())
+
+	if err := s.backchannelAuthenticationRepository.Update(ctx, request); err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrExpiredAuthReqID
+		}
+
+		return nil, fmt.Errorf("update backchannel authentication request: %w", err)
+	}
+
+	return nil, pollErr
+}
@@ -0,0 +1,410 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackchannelAuthenticate(t *testing.T) {
+	t.Run("should store the request and notify the user", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                              "call-center",
+			GrantTypes:                            []string{domain.GrantTypeCIBA},
+			Scopes:                                []string{"openid", "profile"},
+			TokenEndpointAuthMethod:               domain.TokenEndpointAuthMethodClientSecretPost,
+			BackchannelTokenDeliveryMode:          domain.BackchannelTokenDeliveryModePoll,
+			BackchannelClientNotificationEndpoint: "https://call-center.example.com/cb",
+		}
+		params := domain.BackchannelAuthenticationParams{
+			ClientID:        "call-center",
+			ClientSecret:    "secret",
+			Scopes:          []string{"openid"},
+			LoginHint:       "customer@example.com",
+			BindingMessage:  "Call 4821",
+			RequestedExpiry: 120,
+		}
+		user := &domain.User{ID: uuid.New(), Email: "customer@example.com"}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, params.ClientCredentials()).Return(client, nil)
+
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		mockUserRepo.EXPECT().GetByEmail(ctx, "customer@example.com").Return(user, nil)
+
+		var stored *domain.BackchannelAuthenticationRequest
+		mockRequestRepo := mocks.NewBackchannelAuthenticationRepositoryMock(t)
+		mockRequestRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.BackchannelAuthenticationRequest")).
+			Run(func(ctx context.Context, request *domain.BackchannelAuthenticationRequest) { stored = request }).
+			Return(nil)
+
+		mockNotifier := mocks.NewAuthenticationNotifierMock(t)
+		mockNotifier.EXPECT().NotifyAuthenticationRequest(ctx, mock.AnythingOfType("*domain.BackchannelAuthenticationRequest")).Return(nil)
+
+		backchannelService := &BackchannelAuthenticationServiceImpl{
+			backchannelAuthenticationRepository: mockRequestRepo,
+			clientService:                       mockClientService,
+			userRepository:                      mockUserRepo,
+			authenticationNotifier:              mockNotifier,
+		}
+
+		// Act
+		response, err := backchannelService.Authenticate(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		assert.Equal(t, stored.AuthReqID, response.AuthReqID)
+		assert.Equal(t, int64(120), response.ExpiresIn)
+		assert.Equal(t, int64(5), response.Interval)
+		assert.Equal(t, user.ID, stored.UserID)
+		assert.Equal(t, "Call 4821", stored.BindingMessage)
+		assert.True(t, stored.IsPending())
+	})
+
+	t.Run("should reject a login hint of an unknown user", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                              "call-center",
+			GrantTypes:                            []string{domain.GrantTypeCIBA},
+			Scopes:                                []string{"openid", "profile"},
+			TokenEndpointAuthMethod:               domain.TokenEndpointAuthMethodClientSecretPost,
+			BackchannelTokenDeliveryMode:          domain.BackchannelTokenDeliveryModePoll,
+			BackchannelClientNotificationEndpoint: "https://call-center.example.com/cb",
+		}
+		params := domain.BackchannelAuthenticationParams{
+			ClientID:       "call-center",
+			ClientSecret:   "secret",
+			Scopes:         []string{"openid"},
+			LoginHint:      "customer@example.com",
+			BindingMessage: "Call 4821",
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, params.ClientCredentials()).Return(client, nil)
+
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		mockUserRepo.EXPECT().GetByEmail(ctx, "customer@example.com").Return(nil, ports.ErrNotFound)
+
+		backchannelService := &BackchannelAuthenticationServiceImpl{
+			clientService:  mockClientService,
+			userRepository: mockUserRepo,
+		}
+
+		// Act
+		response, err := backchannelService.Authenticate(ctx, params)
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrUnknownUserID)
+	})
+
+	t.Run("should require a client notification token in ping mode", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                              "call-center",
+			GrantTypes:                            []string{domain.GrantTypeCIBA},
+			Scopes:                                []string{"openid", "profile"},
+			TokenEndpointAuthMethod:               domain.TokenEndpointAuthMethodClientSecretPost,
+			BackchannelTokenDeliveryMode:          domain.BackchannelTokenDeliveryModePing,
+			BackchannelClientNotificationEndpoint: "https://call-center.example.com/cb",
+		}
+		params := domain.BackchannelAuthenticationParams{
+			ClientID:       "call-center",
+			ClientSecret:   "secret",
+			Scopes:         []string{"openid"},
+			LoginHint:      "customer@example.com",
+			BindingMessage: "Call 4821",
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, params.ClientCredentials()).Return(client, nil)
+
+		backchannelService := &BackchannelAuthenticationServiceImpl{clientService: mockClientService}
+
+		// Act
+		_, err := backchannelService.Authenticate(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidBackchannelRequest)
+	})
+
+	t.Run("should reject a request without the openid scope", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                              "call-center",
+			GrantTypes:                            []string{domain.GrantTypeCIBA},
+			Scopes:                                []string{"openid", "profile"},
+			TokenEndpointAuthMethod:               domain.TokenEndpointAuthMethodClientSecretPost,
+			BackchannelTokenDeliveryMode:          domain.BackchannelTokenDeliveryModePoll,
+			BackchannelClientNotificationEndpoint: "https://call-center.example.com/cb",
+		}
+		params := domain.BackchannelAuthenticationParams{
+			ClientID:       "call-center",
+			ClientSecret:   "secret",
+			Scopes:         []string{"profile"},
+			LoginHint:      "customer@example.com",
+			BindingMessage: "Call 4821",
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, params.ClientCredentials()).Return(client, nil)
+
+		backchannelService := &BackchannelAuthenticationServiceImpl{clientService: mockClientService}
+
+		// Act
+		_, err := backchannelService.Authenticate(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidScope)
+	})
+}
+
+func TestApproveBackchannelRequest(t *testing.T) {
+	t.Run("should record the approval and ping a client in ping mode", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                              "call-center",
+			GrantTypes:                            []string{domain.GrantTypeCIBA},
+			Scopes:                                []string{"openid", "profile"},
+			TokenEndpointAuthMethod:               domain.TokenEndpointAuthMethodClientSecretPost,
+			BackchannelTokenDeliveryMode:          domain.BackchannelTokenDeliveryModePing,
+			BackchannelClientNotificationEndpoint: "https://call-center.example.com/cb",
+		}
+		request := &domain.BackchannelAuthenticationRequest{
+			AuthReqID:               "auth-req-id",
+			ClientID:                "call-center",
+			UserID:                  uuid.New(),
+			Scopes:                  []string{"openid"},
+			DeliveryMode:            domain.BackchannelTokenDeliveryModePing,
+			ClientNotificationToken: "notification-token",
+			Status:                  domain.BackchannelAuthenticationStatusPending,
+			Interval:                domain.BackchannelPollInterval,
+			ExpiresAt:               time.Now().Add(time.Minute),
+			CreatedAt:               time.Now(),
+		}
+		session := &domain.Session{ID: uuid.New(), UserID: request.UserID, ACR: domain.ACRPassword, CreatedAt: time.Now().Add(-time.Hour)}
+
+		mockRequestRepo := mocks.NewBackchannelAuthenticationRepositoryMock(t)
+		mockRequestRepo.EXPECT().GetByAuthReqID(ctx, "auth-req-id").Return(request, nil)
+		mockRequestRepo.EXPECT().Update(ctx, request).Return(nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "call-center").Return(client, nil)
+
+		mockClientNotifier := mocks.NewBackchannelClientNotifierMock(t)
+		mockClientNotifier.EXPECT().NotifyClient(ctx, "https://call-center.example.com/cb", "notification-token", "auth-req-id").Return(nil)
+
+		backchannelService := &BackchannelAuthenticationServiceImpl{
+			backchannelAuthenticationRepository: mockRequestRepo,
+			clientRepository:                    mockClientRepo,
+			clientNotifier:                      mockClientNotifier,
+		}
+
+		// Act
+		err := backchannelService.Approve(ctx, session, "auth-req-id")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.BackchannelAuthenticationStatusApproved, request.Status)
+		assert.Equal(t, session.CreatedAt, request.AuthTime)
+		assert.Equal(t, domain.ACRPassword, request.ACR)
+	})
+
+	t.Run("should not let a user approve another user's request", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		request := &domain.BackchannelAuthenticationRequest{
+			AuthReqID:               "auth-req-id",
+			ClientID:                "call-center",
+			UserID:                  uuid.New(),
+			Scopes:                  []string{"openid"},
+			DeliveryMode:            domain.BackchannelTokenDeliveryModePoll,
+			ClientNotificationToken: "notification-token",
+			Status:                  domain.BackchannelAuthenticationStatusPending,
+			Interval:                domain.BackchannelPollInterval,
+			ExpiresAt:               time.Now().Add(time.Minute),
+			CreatedAt:               time.Now(),
+		}
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+
+		mockRequestRepo := mocks.NewBackchannelAuthenticationRepositoryMock(t)
+		mockRequestRepo.EXPECT().GetByAuthReqID(ctx, "auth-req-id").Return(request, nil)
+
+		backchannelService := &BackchannelAuthenticationServiceImpl{backchannelAuthenticationRepository: mockRequestRepo}
+
+		// Act
+		err := backchannelService.Approve(ctx, session, "auth-req-id")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrBackchannelRequestNotFound)
+		assert.True(t, request.IsPending())
+	})
+}
+
+func TestCompleteBackchannelAuthentication(t *testing.T) {
+	t.Run("should report a pending request", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		request := &domain.BackchannelAuthenticationRequest{
+			AuthReqID:               "auth-req-id",
+			ClientID:                "call-center",
+			UserID:                  uuid.New(),
+			Scopes:                  []string{"openid"},
+			DeliveryMode:            domain.BackchannelTokenDeliveryModePoll,
+			ClientNotificationToken: "notification-token",
+			Status:                  domain.BackchannelAuthenticationStatusPending,
+			Interval:                domain.BackchannelPollInterval,
+			ExpiresAt:               time.Now().Add(time.Minute),
+			CreatedAt:               time.Now(),
+		}
+
+		mockRequestRepo := mocks.NewBackchannelAuthenticationRepositoryMock(t)
+		mockRequestRepo.EXPECT().GetByAuthReqID(ctx, "auth-req-id").Return(request, nil)
+		mockRequestRepo.EXPECT().Update(ctx, request).Return(nil)
+
+		backchannelService := &BackchannelAuthenticationServiceImpl{backchannelAuthenticationRepository: mockRequestRepo}
+
+		// Act
+		completed, err := backchannelService.CompleteAuthentication(ctx, "call-center", "auth-req-id")
+
+		// Assert
+		assert.Nil(t, completed)
+		assert.ErrorIs(t, err, domain.ErrAuthorizationPending)
+		assert.NotNil(t, request.LastPolledAt)
+	})
+
+	t.Run("should slow down a client polling before its interval elapsed", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		request := &domain.BackchannelAuthenticationRequest{
+			AuthReqID:               "auth-req-id",
+			ClientID:                "call-center",
+			UserID:                  uuid.New(),
+			Scopes:                  []string{"openid"},
+			DeliveryMode:            domain.BackchannelTokenDeliveryModePoll,
+			ClientNotificationToken: "notification-token",
+			Status:                  domain.BackchannelAuthenticationStatusPending,
+			Interval:                domain.BackchannelPollInterval,
+			ExpiresAt:               time.Now().Add(time.Minute),
+			CreatedAt:               time.Now(),
+		}
+		lastPolledAt := time.Now().UTC().Add(-time.Second)
+		request.LastPolledAt = &lastPolledAt
+
+		mockRequestRepo := mocks.NewBackchannelAuthenticationRepositoryMock(t)
+		mockRequestRepo.EXPECT().GetByAuthReqID(ctx, "auth-req-id").Return(request, nil)
+		mockRequestRepo.EXPECT().Update(ctx, request).Return(nil)
+
+		backchannelService := &BackchannelAuthenticationServiceImpl{backchannelAuthenticationRepository: mockRequestRepo}
+
+		// Act
+		_, err := backchannelService.CompleteAuthentication(ctx, "call-center", "auth-req-id")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrSlowDown)
+		assert.Equal(t, domain.BackchannelPollInterval+domain.BackchannelSlowDownIncrement, request.Interval)
+	})
+
+	t.Run("should consume an approved request", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		request := &domain.BackchannelAuthenticationRequest{
+			AuthReqID:               "auth-req-id",
+			ClientID:                "call-center",
+			UserID:                  uuid.New(),
+			Scopes:                  []string{"openid"},
+			DeliveryMode:            domain.BackchannelTokenDeliveryModePoll,
+			ClientNotificationToken: "notification-token",
+			Status:                  domain.BackchannelAuthenticationStatusApproved,
+			Interval:                domain.BackchannelPollInterval,
+			ExpiresAt:               time.Now().Add(time.Minute),
+			CreatedAt:               time.Now(),
+		}
+
+		mockRequestRepo := mocks.NewBackchannelAuthenticationRepositoryMock(t)
+		mockRequestRepo.EXPECT().GetByAuthReqID(ctx, "auth-req-id").Return(request, nil)
+		mockRequestRepo.EXPECT().Delete(ctx, "auth-req-id").Return(nil)
+
+		backchannelService := &BackchannelAuthenticationServiceImpl{backchannelAuthenticationRepository: mockRequestRepo}
+
+		// Act
+		completed, err := backchannelService.CompleteAuthentication(ctx, "call-center", "auth-req-id")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, request, completed)
+	})
+
+	t.Run("should report a denied request as access denied", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		request := &domain.BackchannelAuthenticationRequest{
+			AuthReqID:               "auth-req-id",
+			ClientID:                "call-center",
+			UserID:                  uuid.New(),
+			Scopes:                  []string{"openid"},
+			DeliveryMode:            domain.BackchannelTokenDeliveryModePoll,
+			ClientNotificationToken: "notification-token",
+			Status:                  domain.BackchannelAuthenticationStatusDenied,
+			Interval:                domain.BackchannelPollInterval,
+			ExpiresAt:               time.Now().Add(time.Minute),
+			CreatedAt:               time.Now(),
+		}
+
+		mockRequestRepo := mocks.NewBackchannelAuthenticationRepositoryMock(t)
+		mockRequestRepo.EXPECT().GetByAuthReqID(ctx, "auth-req-id").Return(request, nil)
+		mockRequestRepo.EXPECT().Delete(ctx, "auth-req-id").Return(nil)
+
+		backchannelService := &BackchannelAuthenticationServiceImpl{backchannelAuthenticationRepository: mockRequestRepo}
+
+		// Act
+		_, err := backchannelService.CompleteAuthentication(ctx, "call-center", "auth-req-id")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrAccessDenied)
+	})
+
+	t.Run("should reject an auth_req_id issued to another client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		request := &domain.BackchannelAuthenticationRequest{
+			AuthReqID:               "auth-req-id",
+			ClientID:                "call-center",
+			UserID:                  uuid.New(),
+			Scopes:                  []string{"openid"},
+			DeliveryMode:            domain.BackchannelTokenDeliveryModePoll,
+			ClientNotificationToken: "notification-token",
+			Status:                  domain.BackchannelAuthenticationStatusPending,
+			Interval:                domain.BackchannelPollInterval,
+			ExpiresAt:               time.Now().Add(time.Minute),
+			CreatedAt:               time.Now(),
+		}
+
+		mockRequestRepo := mocks.NewBackchannelAuthenticationRepositoryMock(t)
+		mockRequestRepo.EXPECT().GetByAuthReqID(ctx, "auth-req-id").Return(request, nil)
+
+		backchannelService := &BackchannelAuthenticationServiceImpl{backchannelAuthenticationRepository: mockRequestRepo}
+
+		// Act
+		_, err := backchannelService.CompleteAuthentication(ctx, "other-client", "auth-req-id")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidAuthReqID)
+	})
+}
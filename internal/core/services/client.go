@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/g-villarinho/oidc-server/internal/core/domain"
@@ -10,43 +11,106 @@ import (
 )
 
 type ClientService interface {
-	CreateClient(ctx context.Context, params domain.CreateClientParams) (*domain.Client, error)
+	CreateClient(ctx context.Context, params domain.CreateClientParams) (*domain.Client, string, error)
 	GetClientByID(ctx context.Context, id uuid.UUID) (*domain.Client, error)
 	ListClients(ctx context.Context) ([]*domain.Client, error)
-	UpdateClient(ctx context.Context, id uuid.UUID, clientName string, redirectURIs, grantTypes, responseTypes, scopes []string) (*domain.Client, error)
+	UpdateClient(ctx context.Context, id uuid.UUID, params domain.UpdateClientParams) (*domain.Client, error)
 	DeleteClient(ctx context.Context, id uuid.UUID) error
+	AuthenticateClient(ctx context.Context, credentials domain.ClientCredentials) (*domain.Client, error)
 }
 
 type ClientServiceImpl struct {
-	clientRepository ports.ClientRepository
-	hasher           ports.Hasher
+	clientRepository   ports.ClientRepository
+	hasher             ports.Hasher
+	subjectService     SubjectService
+	scopeService       ScopeService
+	assertionService   AssertionService
+	certificateService ClientCertificateService
+	keyEncrypter       ports.KeyEncrypter
 }
 
-func NewClientService(clientRepository ports.ClientRepository, hasher ports.Hasher) ClientService {
+func NewClientService(
+	clientRepository ports.ClientRepository,
+	hasher ports.Hasher,
+	subjectService SubjectService,
+	scopeService ScopeService,
+	assertionService AssertionService,
+	certificateService ClientCertificateService,
+	keyEncrypter ports.KeyEncrypter,
+) ClientService {
 	return &ClientServiceImpl{
-		clientRepository: clientRepository,
-		hasher:           hasher,
+		clientRepository:   clientRepository,
+		hasher:             hasher,
+		subjectService:     subjectService,
+		scopeService:       scopeService,
+		assertionService:   assertionService,
+		certificateService: certificateService,
+		keyEncrypter:       keyEncrypter,
 	}
 }
 
-func (s *ClientServiceImpl) CreateClient(ctx context.Context, params domain.CreateClientParams) (*domain.Client, error) {
+// CreateClient registers a client and returns it along with its secret.
+func (s *ClientServiceImpl) CreateClient(ctx context.Context, params domain.CreateClientParams) (*domain.Client, string, error) {
 	clientID := uuid.New().String()
+	clientSecret := uuid.New().String()
+
+	var encryptedSecretKey []byte
+	if params.TokenEndpointAuthMethod == domain.TokenEndpointAuthMethodClientSecretJWT {
+		var err error
+		clientSecret, err = domain.GenerateClientSecretKey()
+		if err != nil {
+			return nil, "", err
+		}
+
+		encryptedSecretKey, err = s.keyEncrypter.Encrypt(ctx, []byte(clientSecret))
+		if err != nil {
+			return nil, "", fmt.Errorf("encrypt client secret key: %w", err)
+		}
+	}
 
-	clientSecret, err := s.hasher.Hash(ctx, uuid.New().String())
+	clientSecretHash, err := s.hasher.Hash(ctx, clientSecret)
 	if err != nil {
-		return nil, fmt.Errorf("hash client secret: %w", err)
+		return nil, "", fmt.Errorf("hash client secret: %w", err)
+	}
+
+	if len(params.Scopes) == 0 {
+		params.Scopes, err = s.scopeService.GetDefaultScopes(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("get default scopes: %w", err)
+		}
 	}
 
-	client, err := domain.NewClient(clientID, clientSecret, params.ClientName, params.RedirectURIs, params.GrantTypes, params.ResponseTypes, params.Scopes, params.LogoURL)
+	client, err := domain.NewClient(clientID, clientSecretHash, params)
 	if err != nil {
-		return nil, fmt.Errorf("create client domain: %w", err)
+		return nil, "", fmt.Errorf("create client domain: %w", err)
+	}
+	client.EncryptedSecretKey = encryptedSecretKey
+
+	if err := client.ValidateKeys(); err != nil {
+		return nil, "", err
+	}
+
+	if err := client.ValidateBackchannel(); err != nil {
+		return nil, "", err
+	}
+
+	if err := client.ValidateEncryption(); err != nil {
+		return nil, "", err
+	}
+
+	if err := s.scopeService.ValidateClientScopes(ctx, client); err != nil {
+		return nil, "", fmt.Errorf("validate client scopes: %w", err)
+	}
+
+	if err := s.subjectService.ValidateSectorIdentifier(ctx, client); err != nil {
+		return nil, "", fmt.Errorf("validate sector identifier: %w", err)
 	}
 
 	if err := s.clientRepository.Create(ctx, client); err != nil {
-		return nil, fmt.Errorf("create client: %w", err)
+		return nil, "", fmt.Errorf("create client: %w", err)
 	}
 
-	return client, nil
+	return client, clientSecret, nil
 }
 
 func (s *ClientServiceImpl) GetClientByID(ctx context.Context, id uuid.UUID) (*domain.Client, error) {
@@ -67,17 +131,33 @@ func (s *ClientServiceImpl) ListClients(ctx context.Context) ([]*domain.Client,
 	return clients, nil
 }
 
-func (s *ClientServiceImpl) UpdateClient(ctx context.Context, id uuid.UUID, clientName string, redirectURIs, grantTypes, responseTypes, scopes []string) (*domain.Client, error) {
+func (s *ClientServiceImpl) UpdateClient(ctx context.Context, id uuid.UUID, params domain.UpdateClientParams) (*domain.Client, error) {
 	client, err := s.clientRepository.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("get client for update: %w", err)
 	}
 
-	client.ClientName = clientName
-	client.RedirectURIs = redirectURIs
-	client.GrantTypes = grantTypes
-	client.ResponseTypes = responseTypes
-	client.Scopes = scopes
+	client.Update(params)
+
+	if err := client.ValidateKeys(); err != nil {
+		return nil, err
+	}
+
+	if err := client.ValidateBackchannel(); err != nil {
+		return nil, err
+	}
+
+	if err := client.ValidateEncryption(); err != nil {
+		return nil, err
+	}
+
+	if err := s.scopeService.ValidateClientScopes(ctx, client); err != nil {
+		return nil, fmt.Errorf("validate client scopes: %w", err)
+	}
+
+	if err := s.subjectService.ValidateSectorIdentifier(ctx, client); err != nil {
+		return nil, fmt.Errorf("validate sector identifier: %w", err)
+	}
 
 	if err := s.clientRepository.Update(ctx, client); err != nil {
 		return nil, fmt.Errorf("update client: %w", err)
@@ -98,3 +178,45 @@ func (s *ClientServiceImpl) DeleteClient(ctx context.Context, id uuid.UUID) erro
 
 	return nil
 }
+
+// AuthenticateClient verifies the credentials a client presents to the OAuth endpoints.
+func (s *ClientServiceImpl) AuthenticateClient(ctx context.Context, credentials domain.ClientCredentials) (*domain.Client, error) {
+	client, err := s.clientRepository.GetByClientID(ctx, credentials.ClientID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrInvalidClient
+		}
+
+		return nil, fmt.Errorf("get client for authentication: %w", err)
+	}
+
+	if client.UsesTLSClientAuth() {
+		if err := s.certificateService.VerifyClientCertificate(ctx, client, credentials.Certificate); err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidClient, err)
+		}
+
+		return client, nil
+	}
+
+	if credentials.HasAssertion() {
+		if credentials.ClientAssertionType != domain.ClientAssertionTypeJWTBearer {
+			return nil, fmt.Errorf("%w: unsupported client assertion type", domain.ErrInvalidClient)
+		}
+
+		if err := s.assertionService.VerifyClientAssertion(ctx, client, credentials.ClientAssertion); err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidClient, err)
+		}
+
+		return client, nil
+	}
+
+	if client.UsesPrivateKeyJWT() || client.UsesClientSecretJWT() || credentials.ClientSecret == "" {
+		return nil, domain.ErrInvalidClient
+	}
+
+	if err := s.hasher.Compare(ctx, credentials.ClientSecret, client.ClientSecret); err != nil {
+		return nil, domain.ErrInvalidClient
+	}
+
+	return client, nil
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+type ClientCertificateService interface {
+	VerifyClientCertificate(ctx context.Context, client *domain.Client, certificate *domain.ClientCertificate) error
+}
+
+type ClientCertificateServiceImpl struct {
+	certificateVerifier ports.CertificateVerifier
+	jwksFetcher         ports.JWKSFetcher
+	cache               ports.Cache
+}
+
+func NewClientCertificateService(
+	certificateVerifier ports.CertificateVerifier,
+	jwksFetcher ports.JWKSFetcher,
+	cache ports.Cache,
+) ClientCertificateService {
+	return &ClientCertificateServiceImpl{
+		certificateVerifier: certificateVerifier,
+		jwksFetcher:         jwksFetcher,
+		cache:               cache,
+	}
+}
+
+// VerifyClientCertificate authenticates a client through its mutual TLS certificate.
+func (s *ClientCertificateServiceImpl) VerifyClientCertificate(ctx context.Context, client *domain.Client, certificate *domain.ClientCertificate) error {
+	if certificate == nil {
+		return fmt.Errorf("%w: no certificate presented", domain.ErrInvalidClientCertificate)
+	}
+
+	switch client.TokenEndpointAuthMethod {
+	case domain.TokenEndpointAuthMethodTLSClientAuth:
+		if err := s.certificateVerifier.VerifyClientCertificate(ctx, certificate); err != nil {
+			return err
+		}
+
+		if certificate.SubjectDN() != client.TLSClientAuthSubjectDN {
+			return fmt.Errorf("%w: subject DN doesn't match", domain.ErrInvalidClientCertificate)
+		}
+
+		return nil
+	case domain.TokenEndpointAuthMethodSelfSignedTLSClientAuth:
+		keySet, err := resolveKeySet(ctx, s.jwksFetcher, s.cache, client.JWKS, client.JWKSURI)
+		if err != nil {
+			return fmt.Errorf("resolve client certificates: %w", err)
+		}
+
+		if !certificate.MatchesKeySet(keySet) {
+			return fmt.Errorf("%w: certificate isn't registered", domain.ErrInvalidClientCertificate)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: client doesn't use mutual TLS", domain.ErrInvalidClientCertificate)
+	}
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyClientCertificate(t *testing.T) {
+	t.Run("should accept a CA-issued certificate for the registered subject DN", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "partner-client"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		require.NoError(t, err)
+		leaf, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+		certificate := domain.NewClientCertificate([]*x509.Certificate{leaf})
+
+		client := &domain.Client{
+			ClientID:                "partner-client",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodTLSClientAuth,
+			TLSClientAuthSubjectDN:  "CN=partner-client",
+		}
+
+		mockVerifier := mocks.NewCertificateVerifierMock(t)
+		mockVerifier.EXPECT().VerifyClientCertificate(ctx, certificate).Return(nil)
+
+		certificateService := &ClientCertificateServiceImpl{certificateVerifier: mockVerifier}
+
+		// Act
+		err = certificateService.VerifyClientCertificate(ctx, client, certificate)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a CA-issued certificate for another subject DN", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "other-client"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		require.NoError(t, err)
+		leaf, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+		certificate := domain.NewClientCertificate([]*x509.Certificate{leaf})
+
+		client := &domain.Client{
+			ClientID:                "partner-client",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodTLSClientAuth,
+			TLSClientAuthSubjectDN:  "CN=partner-client",
+		}
+
+		mockVerifier := mocks.NewCertificateVerifierMock(t)
+		mockVerifier.EXPECT().VerifyClientCertificate(ctx, certificate).Return(nil)
+
+		certificateService := &ClientCertificateServiceImpl{certificateVerifier: mockVerifier}
+
+		// Act
+		err = certificateService.VerifyClientCertificate(ctx, client, certificate)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidClientCertificate)
+	})
+
+	t.Run("should accept a self-signed certificate registered in the client's keys", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "partner-client"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		require.NoError(t, err)
+		leaf, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+		certificate := domain.NewClientCertificate([]*x509.Certificate{leaf})
+
+		client := &domain.Client{
+			ClientID:                "partner-client",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodSelfSignedTLSClientAuth,
+			JWKS: &domain.JSONWebKeySet{Keys: []domain.JSONWebKey{{
+				KeyType:              "EC",
+				X509CertificateChain: []string{base64.StdEncoding.EncodeToString(certificate.Leaf.Raw)},
+			}}},
+		}
+
+		certificateService := &ClientCertificateServiceImpl{}
+
+		// Act
+		err = certificateService.VerifyClientCertificate(ctx, client, certificate)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a self-signed certificate the client didn't register", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "partner-client"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		require.NoError(t, err)
+		leaf, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+		registered := domain.NewClientCertificate([]*x509.Certificate{leaf})
+
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		otherDER, err := x509.CreateCertificate(rand.Reader, template, template, &otherKey.PublicKey, otherKey)
+		require.NoError(t, err)
+		otherLeaf, err := x509.ParseCertificate(otherDER)
+		require.NoError(t, err)
+		certificate := domain.NewClientCertificate([]*x509.Certificate{otherLeaf})
+
+		client := &domain.Client{
+			ClientID:                "partner-client",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodSelfSignedTLSClientAuth,
+			JWKS: &domain.JSONWebKeySet{Keys: []domain.JSONWebKey{{
+				KeyType:              "EC",
+				X509CertificateChain: []string{base64.StdEncoding.EncodeToString(registered.Leaf.Raw)},
+			}}},
+		}
+
+		certificateService := &ClientCertificateServiceImpl{}
+
+		// Act
+		err = certificateService.VerifyClientCertificate(ctx, client, certificate)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidClientCertificate)
+	})
+
+	t.Run("should reject a connection without a certificate", func(t *testing.T) {
+		// Arrange
+		client := &domain.Client{
+			ClientID:                "partner-client",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodTLSClientAuth,
+			TLSClientAuthSubjectDN:  "CN=partner-client",
+		}
+
+		certificateService := &ClientCertificateServiceImpl{}
+
+		// Act
+		err := certificateService.VerifyClientCertificate(context.Background(), client, nil)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidClientCertificate)
+	})
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+type DiscoveryService interface {
+	GetJSONWebKeySet(ctx context.Context) (*domain.JSONWebKeySet, error)
+	GetPASETOKeySet(ctx context.Context) (*domain.PASETOKeySet, error)
+	GetProviderMetadata(ctx context.Context) (*domain.ProviderMetadata, error)
+}
+
+type DiscoveryServiceImpl struct {
+	tokenGenerator                    ports.TokenGenerator
+	pasetoTokenGenerator              ports.PASETOTokenGenerator
+	scopeRepository                   ports.ScopeRepository
+	authorizationDetailTypeRepository ports.AuthorizationDetailTypeRepository
+	config                            *config.Config
+}
+
+func NewDiscoveryService(tokenGenerator ports.TokenGenerator, pasetoTokenGenerator ports.PASETOTokenGenerator, scopeRepository ports.ScopeRepository, authorizationDetailTypeRepository ports.AuthorizationDetailTypeRepository, config *config.Config) DiscoveryService {
+	return &DiscoveryServiceImpl{
+		tokenGenerator:                    tokenGenerator,
+		pasetoTokenGenerator:              pasetoTokenGenerator,
+		scopeRepository:                   scopeRepository,
+		authorizationDetailTypeRepository: authorizationDetailTypeRepository,
+		config:                            config,
+	}
+}
+
+func (s *DiscoveryServiceImpl) GetJSONWebKeySet(ctx context.Context) (*domain.JSONWebKeySet, error) {
+	keySet, err := s.tokenGenerator.GetJSONWebKeySet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get JSON web key set: %w", err)
+	}
+
+	return keySet, nil
+}
+
+func (s *DiscoveryServiceImpl) GetPASETOKeySet(ctx context.Context) (*domain.PASETOKeySet, error) {
+	keySet, err := s.pasetoTokenGenerator.GetPASETOKeySet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get PASETO key set: %w", err)
+	}
+
+	return keySet, nil
+}
+
+func (s *DiscoveryServiceImpl) GetProviderMetadata(ctx context.Context) (*domain.ProviderMetadata, error) {
+	scopes, err := s.scopeRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list scopes: %w", err)
+	}
+
+	detailTypes, err := s.authorizationDetailTypeRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list authorization detail types: %w", err)
+	}
+
+	return domain.NewProviderMetadata(s.config.JWT.Issuer, s.config.URL.APIBaseURL, scopes, detailTypes), nil
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+const (
+	dpopProofMaxAge         = time.Minute
+	dpopNonceLifetime       = 5 * time.Minute
+	dpopJTICacheKeyPrefix   = "dpop:jti:"
+	dpopNonceCacheKeyPrefix = "dpop:nonce:"
+)
+
+type DPoPService interface {
+	VerifyProof(ctx context.Context, params domain.DPoPProofParams) (*domain.DPoPProof, error)
+	IssueNonce(ctx context.Context) (string, error)
+}
+
+type DPoPServiceImpl struct {
+	proofVerifier ports.DPoPProofVerifier
+	cache         ports.Cache
+	config        *config.Config
+}
+
+func NewDPoPService(proofVerifier ports.DPoPProofVerifier, cache ports.Cache, config *config.Config) DPoPService {
+	return &DPoPServiceImpl{
+		proofVerifier: proofVerifier,
+		cache:         cache,
+		config:        config,
+	}
+}
+
+// VerifyProof checks a DPoP proof was made for the request it came with and wasn't seen before.
+func (s *DPoPServiceImpl) VerifyProof(ctx context.Context, params domain.DPoPProofParams) (*domain.DPoPProof, error) {
+	proof, err := s.proofVerifier.VerifyDPoPProof(ctx, params.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := proof.Validate(params, dpopProofMaxAge); err != nil {
+		return nil, err
+	}
+
+	if s.config.DPoP.RequireNonce {
+		if proof.Nonce == "" {
+			return nil, domain.ErrUseDPoPNonce
+		}
+
+		valid, err := s.cache.Exists(ctx, dpopNonceCacheKeyPrefix+proof.Nonce)
+		if err != nil {
+			return nil, fmt.Errorf("check DPoP nonce: %w", err)
+		}
+
+		if !valid {
+			return nil, domain.ErrUseDPoPNonce
+		}
+	}
+
+	// A proof is accepted once, remembered for as long as it passes the freshness check.
+	stored, err := s.cache.SetNX(ctx, dpopJTICacheKeyPrefix+proof.Thumbprint+":"+proof.JWTID, "1", 2*dpopProofMaxAge)
+	if err != nil {
+		return nil, fmt.Errorf("record DPoP proof: %w", err)
+	}
+
+	if !stored {
+		return nil, fmt.Errorf("%w: proof already used", domain.ErrInvalidDPoPProof)
+	}
+
+	return proof, nil
+}
+
+// IssueNonce hands out a nonce clients have to include in their next proofs, valid for a few minutes.
+func (s *DPoPServiceImpl) IssueNonce(ctx context.Context) (string, error) {
+	nonce, err := domain.NewDPoPNonce()
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.cache.Set(ctx, dpopNonceCacheKeyPrefix+nonce, "1", dpopNonceLifetime); err != nil {
+		return "", fmt.Errorf("store DPoP nonce: %w", err)
+	}
+
+	return nonce, nil
+}
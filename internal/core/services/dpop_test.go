@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyDPoPProof(t *testing.T) {
+	const tokenEndpoint = "https://auth.example.com/api/v1/oauth/token"
+
+	t.Run("should accept a fresh proof made for the request", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.DPoPProofParams{Proof: "dpop-proof", Method: "POST", URL: tokenEndpoint}
+		proof := &domain.DPoPProof{
+			Thumbprint: "key-thumbprint",
+			Method:     "POST",
+			URL:        tokenEndpoint,
+			IssuedAt:   time.Now().UTC(),
+			JWTID:      "jti-1",
+		}
+
+		mockVerifier := mocks.NewDPoPProofVerifierMock(t)
+		mockVerifier.EXPECT().VerifyDPoPProof(ctx, "dpop-proof").Return(proof, nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().SetNX(ctx, "dpop:jti:key-thumbprint:jti-1", "1", 2*dpopProofMaxAge).Return(true, nil)
+
+		dpopService := &DPoPServiceImpl{proofVerifier: mockVerifier, cache: mockCache, config: &config.Config{}}
+
+		// Act
+		verified, err := dpopService.VerifyProof(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, proof, verified)
+	})
+
+	t.Run("should reject a replayed proof", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		proof := &domain.DPoPProof{
+			Thumbprint: "key-thumbprint",
+			Method:     "POST",
+			URL:        tokenEndpoint,
+			IssuedAt:   time.Now().UTC(),
+			JWTID:      "jti-1",
+		}
+		params := domain.DPoPProofParams{Proof: "dpop-proof", Method: "POST", URL: tokenEndpoint}
+
+		mockVerifier := mocks.NewDPoPProofVerifierMock(t)
+		mockVerifier.EXPECT().VerifyDPoPProof(ctx, "dpop-proof").Return(proof, nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().SetNX(ctx, "dpop:jti:key-thumbprint:jti-1", "1", 2*dpopProofMaxAge).Return(false, nil)
+
+		dpopService := &DPoPServiceImpl{proofVerifier: mockVerifier, cache: mockCache, config: &config.Config{}}
+
+		// Act
+		verified, err := dpopService.VerifyProof(ctx, params)
+
+		// Assert
+		assert.Nil(t, verified)
+		assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+	})
+
+	t.Run("should reject a proof made for another endpoint", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.DPoPProofParams{Proof: "dpop-proof", Method: "POST", URL: tokenEndpoint}
+		proof := &domain.DPoPProof{
+			Thumbprint: "key-thumbprint",
+			Method:     "POST",
+			URL:        "https://auth.example.com/api/v1/oauth/userinfo",
+			IssuedAt:   time.Now().UTC(),
+			JWTID:      "jti-1",
+		}
+
+		mockVerifier := mocks.NewDPoPProofVerifierMock(t)
+		mockVerifier.EXPECT().VerifyDPoPProof(ctx, "dpop-proof").Return(proof, nil)
+
+		dpopService := &DPoPServiceImpl{proofVerifier: mockVerifier, config: &config.Config{}}
+
+		// Act
+		_, err := dpopService.VerifyProof(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+	})
+
+	t.Run("should reject a proof for another access token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		proof := &domain.DPoPProof{
+			Thumbprint:      "key-thumbprint",
+			Method:          "POST",
+			URL:             tokenEndpoint,
+			IssuedAt:        time.Now().UTC(),
+			JWTID:           "jti-1",
+			AccessTokenHash: domain.DPoPAccessTokenHash("other-token"),
+		}
+		params := domain.DPoPProofParams{Proof: "dpop-proof", Method: "POST", URL: tokenEndpoint, AccessToken: "access-token"}
+
+		mockVerifier := mocks.NewDPoPProofVerifierMock(t)
+		mockVerifier.EXPECT().VerifyDPoPProof(ctx, "dpop-proof").Return(proof, nil)
+
+		dpopService := &DPoPServiceImpl{proofVerifier: mockVerifier, config: &config.Config{}}
+
+		// Act
+		_, err := dpopService.VerifyProof(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+	})
+
+	t.Run("should ask for a nonce when required and missing", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		proof := &domain.DPoPProof{
+			Thumbprint: "key-thumbprint",
+			Method:     "POST",
+			URL:        tokenEndpoint,
+			IssuedAt:   time.Now().UTC(),
+			JWTID:      "jti-1",
+		}
+		params := domain.DPoPProofParams{Proof: "dpop-proof", Method: "POST", URL: tokenEndpoint}
+
+		mockVerifier := mocks.NewDPoPProofVerifierMock(t)
+		mockVerifier.EXPECT().VerifyDPoPProof(ctx, "dpop-proof").Return(proof, nil)
+
+		dpopService := &DPoPServiceImpl{
+			proofVerifier: mockVerifier,
+			config:        &config.Config{DPoP: config.DPoP{RequireNonce: true}},
+		}
+
+		// Act
+		_, err := dpopService.VerifyProof(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrUseDPoPNonce)
+	})
+
+	t.Run("should ask for a new nonce when the proof's one expired", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.DPoPProofParams{Proof: "dpop-proof", Method: "POST", URL: tokenEndpoint}
+		proof := &domain.DPoPProof{
+			Thumbprint: "key-thumbprint",
+			Method:     "POST",
+			URL:        tokenEndpoint,
+			IssuedAt:   time.Now().UTC(),
+			JWTID:      "jti-1",
+			Nonce:      "stale-nonce",
+		}
+
+		mockVerifier := mocks.NewDPoPProofVerifierMock(t)
+		mockVerifier.EXPECT().VerifyDPoPProof(ctx, "dpop-proof").Return(proof, nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().Exists(ctx, "dpop:nonce:stale-nonce").Return(false, nil)
+
+		dpopService := &DPoPServiceImpl{
+			proofVerifier: mockVerifier,
+			cache:         mockCache,
+			config:        &config.Config{DPoP: config.DPoP{RequireNonce: true}},
+		}
+
+		// Act
+		_, err := dpopService.VerifyProof(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrUseDPoPNonce)
+	})
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+const (
+	emailVerificationUsedCacheKeyPrefix = "email_verification:used:"
+	emailVerificationSentCacheKeyPrefix = "email_verification:sent:"
+)
+
+type EmailVerificationService interface {
+	SendVerificationEmail(ctx context.Context, user *domain.User) error
+	ResendVerificationEmail(ctx context.Context, email string) error
+	VerifyEmail(ctx context.Context, token string) error
+}
+
+type EmailVerificationServiceImpl struct {
+	userRepository ports.UserRepository
+	mailer         ports.Mailer
+	cache          ports.Cache
+	secret         []byte
+	appBaseURL     string
+	// now is the clock tokens are issued and checked against.
+	now func() time.Time
+}
+
+func NewEmailVerificationService(
+	userRepository ports.UserRepository,
+	mailer ports.Mailer,
+	cache ports.Cache,
+	config *config.Config,
+) (EmailVerificationService, error) {
+	if config.EmailVerification.Secret == "" {
+		return nil, errors.New("email verification secret is not configured")
+	}
+
+	return &EmailVerificationServiceImpl{
+		userRepository: userRepository,
+		mailer:         mailer,
+		cache:          cache,
+		secret:         []byte(config.EmailVerification.Secret),
+		appBaseURL:     strings.TrimSuffix(config.URL.AppBaseURL, "/"),
+		now:            time.Now,
+	}, nil
+}
+
+// emailVerificationClaims is the signed payload of a verification token.
+type emailVerificationClaims struct {
+	ID        string    `json:"jti"`
+	UserID    uuid.UUID `json:"sub"`
+	Email     string    `json:"email"`
+	ExpiresAt int64     `json:"exp"`
+}
+
+// SendVerificationEmail mails the user a link that verifies their address.
+func (s *EmailVerificationServiceImpl) SendVerificationEmail(ctx context.Context, user *domain.User) error {
+	if user.EmailVerified {
+		return domain.ErrEmailAlreadyVerified
+	}
+
+	if err := s.countEmail(ctx, user.Email); err != nil {
+		return err
+	}
+
+	return s.send(ctx, user)
+}
+
+// ResendVerificationEmail sends a new link to an unverified address.
+func (s *EmailVerificationServiceImpl) ResendVerificationEmail(ctx context.Context, email string) error {
+	if err := s.countEmail(ctx, email); err != nil {
+		return err
+	}
+
+	user, err := s.userRepository.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("get user by email: %w", err)
+	}
+
+	if user.EmailVerified {
+		return nil
+	}
+
+	return s.send(ctx, user)
+}
+
+func (s *EmailVerificationServiceImpl) send(ctx context.Context, user *domain.User) error {
+	token, err := domain.NewEmailVerificationToken(user, s.now())
+	if err != nil {
+		return fmt.Errorf("create email verification token: %w", err)
+	}
+
+	link := s.appBaseURL + "/verify-email?token=" + url.QueryEscape(s.sign(token))
+
+	message := &domain.EmailMessage{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nConfirm your email address by opening the link below:\n\n%s\n\n"+
+				"The link expires in %s. If you didn't create an account, you can ignore this email.\n",
+			user.Name, link, domain.EmailVerificationTokenExpiry,
+		),
+	}
+
+	if err := s.mailer.Send(ctx, message); err != nil {
+		return fmt.Errorf("send verification email: %w", err)
+	}
+
+	return nil
+}
+
+// VerifyEmail marks the address of a verification token as verified.
+func (s *EmailVerificationServiceImpl) VerifyEmail(ctx context.Context, token string) error {
+	verificationToken, err := s.parse(token)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	if verificationToken.IsExpired(now) {
+		return domain.ErrInvalidEmailVerificationToken
+	}
+
+	user, err := s.userRepository.GetByID(ctx, verificationToken.UserID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return domain.ErrInvalidEmailVerificationToken
+		}
+		return fmt.Errorf("get user: %w", err)
+	}
+
+	if user.Email != verificationToken.Email {
+		return domain.ErrInvalidEmailVerificationToken
+	}
+
+	if user.EmailVerified {
+		return domain.ErrEmailAlreadyVerified
+	}
+
+	// The mark outlives the token, so the token can't be used again.
+	unused, err := s.cache.SetNX(ctx,
+		emailVerificationUsedCacheKeyPrefix+verificationToken.ID, "1", verificationToken.ExpiresAt.Sub(now))
+	if err != nil {
+		return fmt.Errorf("mark email verification token used: %w", err)
+	}
+
+	if !unused {
+		return domain.ErrInvalidEmailVerificationToken
+	}
+
+	if err := s.userRepository.VerifyEmail(ctx, user.ID); err != nil {
+		return fmt.Errorf("verify email: %w", err)
+	}
+
+	return nil
+}
+
+// sign encodes the token as its JSON payload and an HMAC-SHA256 over it, both base64url encoded and joined by a dot.
+func (s *EmailVerificationServiceImpl) sign(token *domain.EmailVerificationToken) string {
+	payload, _ := json.Marshal(emailVerificationClaims{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		Email:     token.Email,
+		ExpiresAt: token.ExpiresAt.Unix(),
+	})
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.mac(encoded))
+}
+
+func (s *EmailVerificationServiceImpl) parse(token string) (*domain.EmailVerificationToken, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, domain.ErrInvalidEmailVerificationToken
+	}
+
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.mac(encoded)) {
+		return nil, domain.ErrInvalidEmailVerificationToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, domain.ErrInvalidEmailVerificationToken
+	}
+
+	var claims emailVerificationClaims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.ID == "" {
+		return nil, domain.ErrInvalidEmailVerificationToken
+	}
+
+	return &domain.EmailVerificationToken{
+		ID:        claims.ID,
+		UserID:    claims.UserID,
+		Email:     claims.Email,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+	}, nil
+}
+
+func (s *EmailVerificationServiceImpl) mac(payload string) []byte {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
+
+// countEmail counts a verification email against the address's limit.
+func (s *EmailVerificationServiceImpl) countEmail(ctx context.Context, email string) error {
+	key := emailVerificationSentCacheKeyPrefix + strings.ToLower(email)
+
+	sent, err := s.cache.Increment(ctx, key)
+	if err != nil {
+		return fmt.Errorf("count verification email: %w", err)
+	}
+
+	if sent == 1 {
+		if err := s.cache.Expire(ctx, key, domain.VerificationEmailWindow); err != nil {
+			return fmt.Errorf("expire verification email count: %w", err)
+		}
+	}
+
+	if sent > domain.MaxVerificationEmails {
+		return domain.ErrTooManyVerificationEmails
+	}
+
+	return nil
+}
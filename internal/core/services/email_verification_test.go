@@ -0,0 +1,338 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendVerificationEmail(t *testing.T) {
+	t.Run("should mail a link with a token that verifies the email", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Now()
+		user := &domain.User{ID: uuid.New(), Name: "Ana", Email: "ana@example.com"}
+
+		var sent *domain.EmailMessage
+		mailer := mocks.NewMailerMock(t)
+		mailer.EXPECT().
+			Send(ctx, mock.AnythingOfType("*domain.EmailMessage")).
+			Run(func(ctx context.Context, message *domain.EmailMessage) { sent = message }).
+			Return(nil)
+
+		userRepository := mocks.NewUserRepositoryMock(t)
+		userRepository.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+		userRepository.EXPECT().VerifyEmail(ctx, user.ID).Return(nil)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, emailVerificationSentCacheKeyPrefix+user.Email).Return(1, nil)
+		cache.EXPECT().Expire(ctx, emailVerificationSentCacheKeyPrefix+user.Email, domain.VerificationEmailWindow).Return(nil)
+		cache.EXPECT().
+			SetNX(ctx, mock.MatchedBy(func(key string) bool {
+				return strings.HasPrefix(key, emailVerificationUsedCacheKeyPrefix)
+			}), "1", mock.AnythingOfType("time.Duration")).
+			Return(true, nil)
+
+		emailVerificationService := &EmailVerificationServiceImpl{
+			userRepository: userRepository,
+			mailer:         mailer,
+			cache:          cache,
+			secret:         []byte("test-email-verification-secret"),
+			appBaseURL:     "https://app.example.com",
+			now:            func() time.Time { return now },
+		}
+
+		// Act
+		err := emailVerificationService.SendVerificationEmail(ctx, user)
+		require.NoError(t, err)
+
+		_, link, ok := strings.Cut(sent.Body, "https://app.example.com/verify-email?")
+		require.True(t, ok, "email has no verification link")
+		query, err := url.ParseQuery(strings.Fields(link)[0])
+		require.NoError(t, err)
+
+		err = emailVerificationService.VerifyEmail(ctx, query.Get("token"))
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, user.Email, sent.To)
+	})
+
+	t.Run("should return ErrTooManyVerificationEmails past the limit", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{ID: uuid.New(), Email: "ana@example.com"}
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, emailVerificationSentCacheKeyPrefix+user.Email).Return(domain.MaxVerificationEmails+1, nil)
+
+		emailVerificationService := &EmailVerificationServiceImpl{cache: cache}
+
+		// Act
+		err := emailVerificationService.SendVerificationEmail(ctx, user)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrTooManyVerificationEmails)
+	})
+}
+
+func TestResendVerificationEmail(t *testing.T) {
+	t.Run("should succeed without an email for an unknown address", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		email := "nobody@example.com"
+
+		userRepository := mocks.NewUserRepositoryMock(t)
+		userRepository.EXPECT().GetByEmail(ctx, email).Return(nil, ports.ErrNotFound)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, emailVerificationSentCacheKeyPrefix+email).Return(1, nil)
+		cache.EXPECT().Expire(ctx, emailVerificationSentCacheKeyPrefix+email, domain.VerificationEmailWindow).Return(nil)
+
+		emailVerificationService := &EmailVerificationServiceImpl{
+			userRepository: userRepository,
+			mailer:         mocks.NewMailerMock(t),
+			cache:          cache,
+		}
+
+		// Act
+		err := emailVerificationService.ResendVerificationEmail(ctx, email)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should succeed without an email for a verified address", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{ID: uuid.New(), Email: "ana@example.com", EmailVerified: true}
+
+		userRepository := mocks.NewUserRepositoryMock(t)
+		userRepository.EXPECT().GetByEmail(ctx, user.Email).Return(user, nil)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, emailVerificationSentCacheKeyPrefix+user.Email).Return(2, nil)
+
+		emailVerificationService := &EmailVerificationServiceImpl{
+			userRepository: userRepository,
+			mailer:         mocks.NewMailerMock(t),
+			cache:          cache,
+		}
+
+		// Act
+		err := emailVerificationService.ResendVerificationEmail(ctx, user.Email)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should limit unknown addresses like known ones", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		email := "nobody@example.com"
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, emailVerificationSentCacheKeyPrefix+email).Return(domain.MaxVerificationEmails+1, nil)
+
+		emailVerificationService := &EmailVerificationServiceImpl{cache: cache}
+
+		// Act
+		err := emailVerificationService.ResendVerificationEmail(ctx, email)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrTooManyVerificationEmails)
+	})
+}
+
+func TestVerifyEmail(t *testing.T) {
+	t.Run("should reject a token with a tampered payload", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Now()
+		user := &domain.User{ID: uuid.New(), Email: "ana@example.com"}
+		other := &domain.User{ID: uuid.New(), Email: "eve@example.com"}
+
+		emailVerificationService := &EmailVerificationServiceImpl{
+			secret: []byte("test-email-verification-secret"),
+			now:    func() time.Time { return now },
+		}
+
+		token, err := domain.NewEmailVerificationToken(user, now)
+		require.NoError(t, err)
+		_, signature, _ := strings.Cut(emailVerificationService.sign(token), ".")
+
+		otherToken, err := domain.NewEmailVerificationToken(other, now)
+		require.NoError(t, err)
+		payload, _, _ := strings.Cut(emailVerificationService.sign(otherToken), ".")
+
+		// Act
+		err = emailVerificationService.VerifyEmail(ctx, payload+"."+signature)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidEmailVerificationToken)
+	})
+
+	t.Run("should reject a token signed with another secret", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Now()
+		user := &domain.User{ID: uuid.New(), Email: "ana@example.com"}
+
+		emailVerificationService := &EmailVerificationServiceImpl{
+			secret: []byte("test-email-verification-secret"),
+			now:    func() time.Time { return now },
+		}
+		forger := &EmailVerificationServiceImpl{secret: []byte("another-secret")}
+
+		token, err := domain.NewEmailVerificationToken(user, now)
+		require.NoError(t, err)
+
+		// Act
+		err = emailVerificationService.VerifyEmail(ctx, forger.sign(token))
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidEmailVerificationToken)
+	})
+
+	t.Run("should reject an expired token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Now()
+		user := &domain.User{ID: uuid.New(), Email: "ana@example.com"}
+
+		emailVerificationService := &EmailVerificationServiceImpl{
+			secret: []byte("test-email-verification-secret"),
+			now:    func() time.Time { return now },
+		}
+
+		token, err := domain.NewEmailVerificationToken(user, now.Add(-domain.EmailVerificationTokenExpiry-time.Minute))
+		require.NoError(t, err)
+
+		// Act
+		err = emailVerificationService.VerifyEmail(ctx, emailVerificationService.sign(token))
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidEmailVerificationToken)
+	})
+
+	t.Run("should reject a token sent to the user's previous address", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Now()
+		user := &domain.User{ID: uuid.New(), Email: "ana@example.com"}
+
+		userRepository := mocks.NewUserRepositoryMock(t)
+		userRepository.EXPECT().GetByID(ctx, user.ID).Return(&domain.User{ID: user.ID, Email: "ana@new.example.com"}, nil)
+
+		emailVerificationService := &EmailVerificationServiceImpl{
+			userRepository: userRepository,
+			secret:         []byte("test-email-verification-secret"),
+			now:            func() time.Time { return now },
+		}
+
+		token, err := domain.NewEmailVerificationToken(user, now)
+		require.NoError(t, err)
+
+		// Act
+		err = emailVerificationService.VerifyEmail(ctx, emailVerificationService.sign(token))
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidEmailVerificationToken)
+	})
+
+	t.Run("should reject a token that was already used", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Now()
+		user := &domain.User{ID: uuid.New(), Email: "ana@example.com"}
+
+		userRepository := mocks.NewUserRepositoryMock(t)
+		userRepository.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().SetNX(ctx, mock.AnythingOfType("string"), "1", mock.AnythingOfType("time.Duration")).Return(false, nil)
+
+		emailVerificationService := &EmailVerificationServiceImpl{
+			userRepository: userRepository,
+			cache:          cache,
+			secret:         []byte("test-email-verification-secret"),
+			now:            func() time.Time { return now },
+		}
+
+		token, err := domain.NewEmailVerificationToken(user, now)
+		require.NoError(t, err)
+
+		// Act
+		err = emailVerificationService.VerifyEmail(ctx, emailVerificationService.sign(token))
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidEmailVerificationToken)
+	})
+
+	t.Run("should return ErrEmailAlreadyVerified for a verified user", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Now()
+		user := &domain.User{ID: uuid.New(), Email: "ana@example.com", EmailVerified: true}
+
+		userRepository := mocks.NewUserRepositoryMock(t)
+		userRepository.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		emailVerificationService := &EmailVerificationServiceImpl{
+			userRepository: userRepository,
+			secret:         []byte("test-email-verification-secret"),
+			now:            func() time.Time { return now },
+		}
+
+		token, err := domain.NewEmailVerificationToken(user, now)
+		require.NoError(t, err)
+
+		// Act
+		err = emailVerificationService.VerifyEmail(ctx, emailVerificationService.sign(token))
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrEmailAlreadyVerified)
+	})
+
+	t.Run("should return the error of a failed update", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Now()
+		user := &domain.User{ID: uuid.New(), Email: "ana@example.com"}
+		dbErr := errors.New("database unavailable")
+
+		userRepository := mocks.NewUserRepositoryMock(t)
+		userRepository.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+		userRepository.EXPECT().VerifyEmail(ctx, user.ID).Return(dbErr)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().SetNX(ctx, mock.AnythingOfType("string"), "1", mock.AnythingOfType("time.Duration")).Return(true, nil)
+
+		emailVerificationService := &EmailVerificationServiceImpl{
+			userRepository: userRepository,
+			cache:          cache,
+			secret:         []byte("test-email-verification-secret"),
+			now:            func() time.Time { return now },
+		}
+
+		token, err := domain.NewEmailVerificationToken(user, now)
+		require.NoError(t, err)
+
+		// Act
+		err = emailVerificationService.VerifyEmail(ctx, emailVerificationService.sign(token))
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type FederationService interface {
+	CreateTrustedIssuer(ctx context.Context, params domain.CreateTrustedIssuerParams) (*domain.TrustedIssuer, error)
+	GetTrustedIssuerByID(ctx context.Context, id uuid.UUID) (*domain.TrustedIssuer, error)
+	ListTrustedIssuers(ctx context.Context) ([]*domain.TrustedIssuer, error)
+	DeleteTrustedIssuer(ctx context.Context, id uuid.UUID) error
+	CreateTrustPolicy(ctx context.Context, issuerID uuid.UUID, params domain.CreateTrustPolicyParams) (*domain.TrustPolicy, error)
+	ListTrustPolicies(ctx context.Context, issuerID uuid.UUID) ([]*domain.TrustPolicy, error)
+	DeleteTrustPolicy(ctx context.Context, id uuid.UUID) error
+	ResolveFederatedIdentity(ctx context.Context, token, clientID string) (*domain.FederatedIdentity, error)
+}
+
+type FederationServiceImpl struct {
+	trustedIssuerRepository ports.TrustedIssuerRepository
+	clientRepository        ports.ClientRepository
+	federatedTokenVerifier  ports.FederatedTokenVerifier
+	jwksFetcher             ports.JWKSFetcher
+	cache                   ports.Cache
+}
+
+func NewFederationService(
+	trustedIssuerRepository ports.TrustedIssuerRepository,
+	clientRepository ports.ClientRepository,
+	federatedTokenVerifier ports.FederatedTokenVerifier,
+	jwksFetcher ports.JWKSFetcher,
+	cache ports.Cache,
+) FederationService {
+	return &FederationServiceImpl{
+		trustedIssuerRepository: trustedIssuerRepository,
+		clientRepository:        clientRepository,
+		federatedTokenVerifier:  federatedTokenVerifier,
+		jwksFetcher:             jwksFetcher,
+		cache:                   cache,
+	}
+}
+
+func (s *FederationServiceImpl) CreateTrustedIssuer(ctx context.Context, params domain.CreateTrustedIssuerParams) (*domain.TrustedIssuer, error) {
+	issuer, err := domain.NewTrustedIssuer(params)
+	if err != nil {
+		return nil, fmt.Errorf("create trusted issuer domain: %w", err)
+	}
+
+	if err := s.trustedIssuerRepository.Create(ctx, issuer); err != nil {
+		if errors.Is(err, ports.ErrUniqueKeyViolation) {
+			return nil, domain.ErrTrustedIssuerAlreadyExists
+		}
+
+		return nil, fmt.Errorf("create trusted issuer: %w", err)
+	}
+
+	return issuer, nil
+}
+
+func (s *FederationServiceImpl) GetTrustedIssuerByID(ctx context.Context, id uuid.UUID) (*domain.TrustedIssuer, error) {
+	issuer, err := s.trustedIssuerRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get trusted issuer by ID: %w", err)
+	}
+
+	return issuer, nil
+}
+
+func (s *FederationServiceImpl) ListTrustedIssuers(ctx context.Context) ([]*domain.TrustedIssuer, error) {
+	issuers, err := s.trustedIssuerRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list trusted issuers: %w", err)
+	}
+
+	return issuers, nil
+}
+
+func (s *FederationServiceImpl) DeleteTrustedIssuer(ctx context.Context, id uuid.UUID) error {
+	if err := s.trustedIssuerRepository.Delete(ctx, id); err != nil {
+		return fmt.Errorf("delete trusted issuer: %w", err)
+	}
+
+	return nil
+}
+
+// CreateTrustPolicy maps tokens of the issuer to a client.
+func (s *FederationServiceImpl) CreateTrustPolicy(ctx context.Context, issuerID uuid.UUID, params domain.CreateTrustPolicyParams) (*domain.TrustPolicy, error) {
+	if _, err := s.trustedIssuerRepository.GetByID(ctx, issuerID); err != nil {
+		return nil, fmt.Errorf("get trusted issuer for policy: %w", err)
+	}
+
+	client, err := s.clientRepository.GetByClientID(ctx, params.ClientID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrClientNotFound
+		}
+
+		return nil, fmt.Errorf("get client for trust policy: %w", err)
+	}
+
+	if !client.SupportsGrantType(domain.GrantTypeTokenExchange) {
+		return nil, fmt.Errorf("%w: client can't exchange tokens", domain.ErrInvalidTrustPolicy)
+	}
+
+	if !client.SupportsScopes(params.Scopes) {
+		return nil, domain.ErrInvalidScope
+	}
+
+	policy, err := domain.NewTrustPolicy(issuerID, params)
+	if err != nil {
+		return nil, fmt.Errorf("create trust policy domain: %w", err)
+	}
+
+	if err := s.trustedIssuerRepository.CreatePolicy(ctx, policy); err != nil {
+		return nil, fmt.Errorf("create trust policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+func (s *FederationServiceImpl) ListTrustPolicies(ctx context.Context, issuerID uuid.UUID) ([]*domain.TrustPolicy, error) {
+	policies, err := s.trustedIssuerRepository.ListPolicies(ctx, issuerID)
+	if err != nil {
+		return nil, fmt.Errorf("list trust policies: %w", err)
+	}
+
+	return policies, nil
+}
+
+func (s *FederationServiceImpl) DeleteTrustPolicy(ctx context.Context, id uuid.UUID) error {
+	if err := s.trustedIssuerRepository.DeletePolicy(ctx, id); err != nil {
+		return fmt.Errorf("delete trust policy: %w", err)
+	}
+
+	return nil
+}
+
+// ResolveFederatedIdentity maps a trusted external token to a client through its trust policies.
+func (s *FederationServiceImpl) ResolveFederatedIdentity(ctx context.Context, token, clientID string) (*domain.FederatedIdentity, error) {
+	issuer, err := s.federatedTokenVerifier.PeekIssuer(token)
+	if err != nil {
+		return nil, err
+	}
+
+	trustedIssuer, err := s.trustedIssuerRepository.GetByIssuer(ctx, issuer)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, fmt.Errorf("%w: unknown issuer %s", domain.ErrUntrustedToken, issuer)
+		}
+
+		return nil, fmt.Errorf("get trusted issuer: %w", err)
+	}
+
+	keySet, err := resolveKeySet(ctx, s.jwksFetcher, s.cache, trustedIssuer.JWKS, trustedIssuer.JWKSURI)
+	if err != nil {
+		return nil, fmt.Errorf("get trusted issuer keys: %w", err)
+	}
+
+	claims, err := s.federatedTokenVerifier.VerifyFederatedToken(ctx, token, keySet)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Issuer != trustedIssuer.Issuer {
+		return nil, fmt.Errorf("%w: unexpected issuer", domain.ErrUntrustedToken)
+	}
+
+	if !trustedIssuer.AcceptsAudience(claims.Audience) {
+		return nil, fmt.Errorf("%w: unexpected audience", domain.ErrUntrustedToken)
+	}
+
+	policies, err := s.trustedIssuerRepository.ListPolicies(ctx, trustedIssuer.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list trust policies: %w", err)
+	}
+
+	for _, policy := range policies {
+		if clientID != "" && policy.ClientID != clientID {
+			continue
+		}
+
+		if policy.Matches(claims.Claims) {
+			return &domain.FederatedIdentity{Claims: claims, Policy: policy}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no matching trust policy", domain.ErrUntrustedToken)
+}
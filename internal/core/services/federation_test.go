@@ -0,0 +1,328 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveFederatedIdentity(t *testing.T) {
+	const issuer = "https://token.actions.githubusercontent.com"
+
+	keySet := &domain.JSONWebKeySet{Keys: []domain.JSONWebKey{{KeyType: "RSA", KeyID: "ci-key"}}}
+
+	t.Run("should map the token to the client of the first matching policy", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		trustedIssuer := &domain.TrustedIssuer{
+			ID:        uuid.New(),
+			Issuer:    issuer,
+			JWKSURI:   issuer + "/.well-known/jwks",
+			Audiences: []string{"https://auth.example.com"},
+		}
+		policies := []*domain.TrustPolicy{
+			{ID: uuid.New(), IssuerID: trustedIssuer.ID, ClientID: "billing-ci", Conditions: map[string]string{"repository": "acme/billing"}},
+			{ID: uuid.New(), IssuerID: trustedIssuer.ID, ClientID: "deployer", Conditions: map[string]string{"repository": "acme/deployer", "sub": "repo:acme/deployer:*"}},
+		}
+		claims := &domain.FederatedClaims{
+			Issuer:    issuer,
+			Subject:   "repo:acme/deployer:ref:refs/heads/main",
+			Audience:  []string{"https://auth.example.com"},
+			ExpiresAt: time.Now().UTC().Add(5 * time.Minute),
+			Claims: map[string]any{
+				"sub":        "repo:acme/deployer:ref:refs/heads/main",
+				"repository": "acme/deployer",
+			},
+		}
+
+		mockVerifier := mocks.NewFederatedTokenVerifierMock(t)
+		mockVerifier.EXPECT().PeekIssuer("external-token").Return(issuer, nil)
+		mockVerifier.EXPECT().VerifyFederatedToken(ctx, "external-token", keySet).Return(claims, nil)
+
+		mockIssuerRepo := mocks.NewTrustedIssuerRepositoryMock(t)
+		mockIssuerRepo.EXPECT().GetByIssuer(ctx, issuer).Return(trustedIssuer, nil)
+		mockIssuerRepo.EXPECT().ListPolicies(ctx, trustedIssuer.ID).Return(policies, nil)
+
+		mockFetcher := mocks.NewJWKSFetcherMock(t)
+		mockFetcher.EXPECT().FetchJWKS(ctx, trustedIssuer.JWKSURI).Return(keySet, nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().Get(ctx, "jwks:"+trustedIssuer.JWKSURI).Return("", assert.AnError)
+		mockCache.EXPECT().Set(ctx, "jwks:"+trustedIssuer.JWKSURI, mock.AnythingOfType("string"), jwksCacheTTL).Return(nil)
+
+		federationService := &FederationServiceImpl{
+			trustedIssuerRepository: mockIssuerRepo,
+			federatedTokenVerifier:  mockVerifier,
+			jwksFetcher:             mockFetcher,
+			cache:                   mockCache,
+		}
+
+		// Act
+		identity, err := federationService.ResolveFederatedIdentity(ctx, "external-token", "")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, policies[1], identity.Policy)
+		assert.Equal(t, "repo:acme/deployer:ref:refs/heads/main", identity.Claims.Subject)
+	})
+
+	t.Run("should reject a token no policy matches", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		trustedIssuer := &domain.TrustedIssuer{
+			ID:        uuid.New(),
+			Issuer:    issuer,
+			JWKSURI:   issuer + "/.well-known/jwks",
+			Audiences: []string{"https://auth.example.com"},
+		}
+		claims := &domain.FederatedClaims{
+			Issuer:    issuer,
+			Subject:   "repo:acme/deployer:ref:refs/heads/main",
+			Audience:  []string{"https://auth.example.com"},
+			ExpiresAt: time.Now().UTC().Add(5 * time.Minute),
+			Claims: map[string]any{
+				"sub":        "repo:acme/deployer:ref:refs/heads/main",
+				"repository": "acme/website",
+			},
+		}
+		policies := []*domain.TrustPolicy{
+			{ID: uuid.New(), IssuerID: trustedIssuer.ID, ClientID: "billing-ci", Conditions: map[string]string{"repository": "acme/billing"}},
+			{ID: uuid.New(), IssuerID: trustedIssuer.ID, ClientID: "deployer", Conditions: map[string]string{"repository": "acme/deployer", "sub": "repo:acme/deployer:*"}},
+		}
+
+		mockVerifier := mocks.NewFederatedTokenVerifierMock(t)
+		mockVerifier.EXPECT().PeekIssuer("external-token").Return(issuer, nil)
+		mockVerifier.EXPECT().VerifyFederatedToken(ctx, "external-token", keySet).Return(claims, nil)
+
+		mockIssuerRepo := mocks.NewTrustedIssuerRepositoryMock(t)
+		mockIssuerRepo.EXPECT().GetByIssuer(ctx, issuer).Return(trustedIssuer, nil)
+		mockIssuerRepo.EXPECT().ListPolicies(ctx, trustedIssuer.ID).Return(policies, nil)
+
+		mockFetcher := mocks.NewJWKSFetcherMock(t)
+		mockFetcher.EXPECT().FetchJWKS(ctx, trustedIssuer.JWKSURI).Return(keySet, nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().Get(ctx, "jwks:"+trustedIssuer.JWKSURI).Return("", assert.AnError)
+		mockCache.EXPECT().Set(ctx, "jwks:"+trustedIssuer.JWKSURI, mock.AnythingOfType("string"), jwksCacheTTL).Return(nil)
+
+		federationService := &FederationServiceImpl{
+			trustedIssuerRepository: mockIssuerRepo,
+			federatedTokenVerifier:  mockVerifier,
+			jwksFetcher:             mockFetcher,
+			cache:                   mockCache,
+		}
+
+		// Act
+		identity, err := federationService.ResolveFederatedIdentity(ctx, "external-token", "")
+
+		// Assert
+		assert.Nil(t, identity)
+		assert.ErrorIs(t, err, domain.ErrUntrustedToken)
+	})
+
+	t.Run("should only consider the policies of the requested client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		trustedIssuer := &domain.TrustedIssuer{
+			ID:        uuid.New(),
+			Issuer:    issuer,
+			JWKSURI:   issuer + "/.well-known/jwks",
+			Audiences: []string{"https://auth.example.com"},
+		}
+		claims := &domain.FederatedClaims{
+			Issuer:    issuer,
+			Subject:   "repo:acme/deployer:ref:refs/heads/main",
+			Audience:  []string{"https://auth.example.com"},
+			ExpiresAt: time.Now().UTC().Add(5 * time.Minute),
+			Claims: map[string]any{
+				"sub":        "repo:acme/deployer:ref:refs/heads/main",
+				"repository": "acme/deployer",
+			},
+		}
+		policies := []*domain.TrustPolicy{
+			{ID: uuid.New(), IssuerID: trustedIssuer.ID, ClientID: "billing-ci", Conditions: map[string]string{"repository": "acme/billing"}},
+			{ID: uuid.New(), IssuerID: trustedIssuer.ID, ClientID: "deployer", Conditions: map[string]string{"repository": "acme/deployer", "sub": "repo:acme/deployer:*"}},
+		}
+
+		mockVerifier := mocks.NewFederatedTokenVerifierMock(t)
+		mockVerifier.EXPECT().PeekIssuer("external-token").Return(issuer, nil)
+		mockVerifier.EXPECT().VerifyFederatedToken(ctx, "external-token", keySet).Return(claims, nil)
+
+		mockIssuerRepo := mocks.NewTrustedIssuerRepositoryMock(t)
+		mockIssuerRepo.EXPECT().GetByIssuer(ctx, issuer).Return(trustedIssuer, nil)
+		mockIssuerRepo.EXPECT().ListPolicies(ctx, trustedIssuer.ID).Return(policies, nil)
+
+		mockFetcher := mocks.NewJWKSFetcherMock(t)
+		mockFetcher.EXPECT().FetchJWKS(ctx, trustedIssuer.JWKSURI).Return(keySet, nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().Get(ctx, "jwks:"+trustedIssuer.JWKSURI).Return("", assert.AnError)
+		mockCache.EXPECT().Set(ctx, "jwks:"+trustedIssuer.JWKSURI, mock.AnythingOfType("string"), jwksCacheTTL).Return(nil)
+
+		federationService := &FederationServiceImpl{
+			trustedIssuerRepository: mockIssuerRepo,
+			federatedTokenVerifier:  mockVerifier,
+			jwksFetcher:             mockFetcher,
+			cache:                   mockCache,
+		}
+
+		// Act
+		identity, err := federationService.ResolveFederatedIdentity(ctx, "external-token", "billing-ci")
+
+		// Assert
+		assert.Nil(t, identity)
+		assert.ErrorIs(t, err, domain.ErrUntrustedToken)
+	})
+
+	t.Run("should reject a token addressed to another audience", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		trustedIssuer := &domain.TrustedIssuer{
+			ID:        uuid.New(),
+			Issuer:    issuer,
+			JWKSURI:   issuer + "/.well-known/jwks",
+			Audiences: []string{"https://auth.example.com"},
+		}
+		claims := &domain.FederatedClaims{
+			Issuer:    issuer,
+			Subject:   "repo:acme/deployer:ref:refs/heads/main",
+			Audience:  []string{"https://sts.amazonaws.com"},
+			ExpiresAt: time.Now().UTC().Add(5 * time.Minute),
+			Claims: map[string]any{
+				"sub":        "repo:acme/deployer:ref:refs/heads/main",
+				"repository": "acme/deployer",
+			},
+		}
+
+		mockVerifier := mocks.NewFederatedTokenVerifierMock(t)
+		mockVerifier.EXPECT().PeekIssuer("external-token").Return(issuer, nil)
+		mockVerifier.EXPECT().VerifyFederatedToken(ctx, "external-token", keySet).Return(claims, nil)
+
+		mockIssuerRepo := mocks.NewTrustedIssuerRepositoryMock(t)
+		mockIssuerRepo.EXPECT().GetByIssuer(ctx, issuer).Return(trustedIssuer, nil)
+
+		mockFetcher := mocks.NewJWKSFetcherMock(t)
+		mockFetcher.EXPECT().FetchJWKS(ctx, trustedIssuer.JWKSURI).Return(keySet, nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().Get(ctx, "jwks:"+trustedIssuer.JWKSURI).Return("", assert.AnError)
+		mockCache.EXPECT().Set(ctx, "jwks:"+trustedIssuer.JWKSURI, mock.AnythingOfType("string"), jwksCacheTTL).Return(nil)
+
+		federationService := &FederationServiceImpl{
+			trustedIssuerRepository: mockIssuerRepo,
+			federatedTokenVerifier:  mockVerifier,
+			jwksFetcher:             mockFetcher,
+			cache:                   mockCache,
+		}
+
+		// Act
+		identity, err := federationService.ResolveFederatedIdentity(ctx, "external-token", "")
+
+		// Assert
+		assert.Nil(t, identity)
+		assert.ErrorIs(t, err, domain.ErrUntrustedToken)
+	})
+
+	t.Run("should reject a token from an unknown issuer", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockVerifier := mocks.NewFederatedTokenVerifierMock(t)
+		mockVerifier.EXPECT().PeekIssuer("external-token").Return("https://evil.example.com", nil)
+
+		mockIssuerRepo := mocks.NewTrustedIssuerRepositoryMock(t)
+		mockIssuerRepo.EXPECT().GetByIssuer(ctx, "https://evil.example.com").Return(nil, ports.ErrNotFound)
+
+		federationService := &FederationServiceImpl{
+			trustedIssuerRepository: mockIssuerRepo,
+			federatedTokenVerifier:  mockVerifier,
+		}
+
+		// Act
+		identity, err := federationService.ResolveFederatedIdentity(ctx, "external-token", "")
+
+		// Assert
+		assert.Nil(t, identity)
+		assert.ErrorIs(t, err, domain.ErrUntrustedToken)
+	})
+}
+
+func TestCreateTrustPolicy(t *testing.T) {
+	t.Run("should create a policy for a client allowed to exchange tokens", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		issuerID := uuid.New()
+		client := &domain.Client{
+			ClientID:   "deployer",
+			GrantTypes: []string{domain.GrantTypeTokenExchange},
+			Scopes:     []string{"deployments:read", "deployments:write"},
+		}
+		params := domain.CreateTrustPolicyParams{
+			ClientID:   "deployer",
+			Conditions: map[string]string{"repository": "acme/deployer"},
+			Scopes:     []string{"deployments:write"},
+		}
+
+		mockIssuerRepo := mocks.NewTrustedIssuerRepositoryMock(t)
+		mockIssuerRepo.EXPECT().GetByID(ctx, issuerID).Return(&domain.TrustedIssuer{ID: issuerID}, nil)
+		mockIssuerRepo.EXPECT().CreatePolicy(ctx, mock.AnythingOfType("*domain.TrustPolicy")).Return(nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "deployer").Return(client, nil)
+
+		federationService := &FederationServiceImpl{
+			trustedIssuerRepository: mockIssuerRepo,
+			clientRepository:        mockClientRepo,
+		}
+
+		// Act
+		policy, err := federationService.CreateTrustPolicy(ctx, issuerID, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, issuerID, policy.IssuerID)
+		assert.Equal(t, "deployer", policy.ClientID)
+	})
+
+	t.Run("should reject a client not allowed to exchange tokens", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		issuerID := uuid.New()
+		client := &domain.Client{
+			ClientID:   "deployer",
+			GrantTypes: []string{domain.GrantTypeAuthorizationCode},
+			Scopes:     []string{"deployments:write"},
+		}
+		params := domain.CreateTrustPolicyParams{
+			ClientID:   "deployer",
+			Conditions: map[string]string{"repository": "acme/deployer"},
+			Scopes:     []string{"deployments:write"},
+		}
+
+		mockIssuerRepo := mocks.NewTrustedIssuerRepositoryMock(t)
+		mockIssuerRepo.EXPECT().GetByID(ctx, issuerID).Return(&domain.TrustedIssuer{ID: issuerID}, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "deployer").Return(client, nil)
+
+		federationService := &FederationServiceImpl{
+			trustedIssuerRepository: mockIssuerRepo,
+			clientRepository:        mockClientRepo,
+		}
+
+		// Act
+		policy, err := federationService.CreateTrustPolicy(ctx, issuerID, params)
+
+		// Assert
+		assert.Nil(t, policy)
+		assert.ErrorIs(t, err, domain.ErrInvalidTrustPolicy)
+	})
+}
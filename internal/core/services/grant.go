@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+const (
+	consentPromptCacheKeyPrefix = "consent:prompted:"
+	consentPromptLifetime       = 10 * time.Minute
+)
+
+type GrantService interface {
+	ListOfflineGrants(ctx context.Context, userID uuid.UUID) ([]*domain.OfflineGrant, error)
+	RevokeOfflineGrant(ctx context.Context, userID uuid.UUID, grantID uuid.UUID) error
+	GrantConsent(ctx context.Context, userID uuid.UUID, clientID string, scopes []string) error
+}
+
+type GrantServiceImpl struct {
+	tokenRepository   ports.TokenRepository
+	clientRepository  ports.ClientRepository
+	consentRepository ports.ConsentRepository
+	cache             ports.Cache
+}
+
+func NewGrantService(tokenRepository ports.TokenRepository, clientRepository ports.ClientRepository, consentRepository ports.ConsentRepository, cache ports.Cache) GrantService {
+	return &GrantServiceImpl{
+		tokenRepository:   tokenRepository,
+		clientRepository:  clientRepository,
+		consentRepository: consentRepository,
+		cache:             cache,
+	}
+}
+
+func (s *GrantServiceImpl) ListOfflineGrants(ctx context.Context, userID uuid.UUID) ([]*domain.OfflineGrant, error) {
+	tokens, err := s.tokenRepository.ListOfflineByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list offline tokens: %w", err)
+	}
+
+	clientNames := make(map[string]string)
+	grants := make([]*domain.OfflineGrant, 0, len(tokens))
+	for _, token := range tokens {
+		clientName, ok := clientNames[token.ClientID]
+		if !ok {
+			client, err := s.clientRepository.GetByClientID(ctx, token.ClientID)
+			if err != nil {
+				return nil, fmt.Errorf("get grant client: %w", err)
+			}
+
+			clientName = client.ClientName
+			clientNames[token.ClientID] = clientName
+		}
+
+		grants = append(grants, &domain.OfflineGrant{
+			ID:                   token.ID,
+			ClientID:             token.ClientID,
+			ClientName:           clientName,
+			Scopes:               token.Scopes,
+			AuthorizationDetails: token.AuthorizationDetails,
+			CreatedAt:            token.CreatedAt,
+			LastUsedAt:           token.LastUsedAt,
+			ExpiresAt:            token.RefreshTokenExpiresAt,
+		})
+	}
+
+	return grants, nil
+}
+
+func (s *GrantServiceImpl) RevokeOfflineGrant(ctx context.Context, userID uuid.UUID, grantID uuid.UUID) error {
+	token, err := s.tokenRepository.GetByID(ctx, grantID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return domain.ErrGrantNotFound
+		}
+
+		return fmt.Errorf("get offline token: %w", err)
+	}
+
+	if token.UserID != userID || !token.Offline || token.IsRevoked() {
+		return domain.ErrGrantNotFound
+	}
+
+	// Refreshing the grant rotated its refresh token, so every token of the family has to go.
+	if err := s.tokenRepository.RevokeFamily(ctx, token.FamilyID, "offline grant revoked by user"); err != nil {
+		return fmt.Errorf("revoke offline token family: %w", err)
+	}
+
+	// A new offline grant for the client has to go through consent again.
+	if err := s.consentRepository.Delete(ctx, userID, token.ClientID); err != nil {
+		return fmt.Errorf("delete consent: %w", err)
+	}
+
+	return nil
+}
+
+// GrantConsent records the scopes the user approved for the client.
+func (s *GrantServiceImpl) GrantConsent(ctx context.Context, userID uuid.UUID, clientID string, scopes []string) error {
+	client, err := s.clientRepository.GetByClientID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return domain.ErrClientNotFound
+		}
+
+		return fmt.Errorf("get consent client: %w", err)
+	}
+
+	if !client.SupportsScopes(scopes) {
+		return domain.ErrInvalidScope
+	}
+
+	consent := &domain.Consent{
+		UserID:   userID,
+		ClientID: clientID,
+		Scopes:   scopes,
+	}
+
+	if err := s.consentRepository.Save(ctx, consent); err != nil {
+		return fmt.Errorf("save consent: %w", err)
+	}
+
+	// The authorization request that prompted the user can now go through, once.
+	if err := s.cache.Set(ctx, consentPromptCacheKey(userID, clientID), "1", consentPromptLifetime); err != nil {
+		return fmt.Errorf("record consent prompt: %w", err)
+	}
+
+	return nil
+}
+
+func consentPromptCacheKey(userID uuid.UUID, clientID string) string {
+	return consentPromptCacheKeyPrefix + userID.String() + ":" + clientID
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOfflineGrants(t *testing.T) {
+	t.Run("should list the user's offline grants with their client names", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		tokens := []*domain.Token{
+			{ID: uuid.New(), ClientID: "mail-app", UserID: userID, Scopes: []string{"openid", "offline_access"}, Offline: true, RefreshTokenExpiresAt: time.Now().Add(time.Hour)},
+			{ID: uuid.New(), ClientID: "mail-app", UserID: userID, Scopes: []string{"offline_access"}, Offline: true, RefreshTokenExpiresAt: time.Now().Add(time.Hour)},
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().ListOfflineByUser(ctx, userID).Return(tokens, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "mail-app").Return(&domain.Client{ClientID: "mail-app", ClientName: "Mail"}, nil).Once()
+
+		grantService := &GrantServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			clientRepository: mockClientRepo,
+		}
+
+		// Act
+		grants, err := grantService.ListOfflineGrants(ctx, userID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, grants, 2)
+		assert.Equal(t, tokens[0].ID, grants[0].ID)
+		assert.Equal(t, "Mail", grants[0].ClientName)
+		assert.Equal(t, tokens[1].RefreshTokenExpiresAt, grants[1].ExpiresAt)
+	})
+}
+
+func TestRevokeOfflineGrant(t *testing.T) {
+	t.Run("should revoke every token of an offline grant owned by the user and forget the consent", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		token := &domain.Token{ID: uuid.New(), FamilyID: uuid.New(), UserID: userID, ClientID: "mail-app", Offline: true}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByID(ctx, token.ID).Return(token, nil)
+		mockTokenRepo.EXPECT().RevokeFamily(ctx, token.FamilyID, "offline grant revoked by user").Return(nil)
+
+		mockConsentRepo := mocks.NewConsentRepositoryMock(t)
+		mockConsentRepo.EXPECT().Delete(ctx, userID, "mail-app").Return(nil)
+
+		grantService := &GrantServiceImpl{
+			tokenRepository:   mockTokenRepo,
+			consentRepository: mockConsentRepo,
+		}
+
+		// Act
+		err := grantService.RevokeOfflineGrant(ctx, userID, token.ID)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should not revoke a grant owned by another user", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{ID: uuid.New(), UserID: uuid.New(), Offline: true}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByID(ctx, token.ID).Return(token, nil)
+
+		grantService := &GrantServiceImpl{tokenRepository: mockTokenRepo}
+
+		// Act
+		err := grantService.RevokeOfflineGrant(ctx, uuid.New(), token.ID)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrGrantNotFound)
+	})
+
+	t.Run("should return grant not found when the token doesn't exist", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		grantID := uuid.New()
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByID(ctx, grantID).Return(nil, ports.ErrNotFound)
+
+		grantService := &GrantServiceImpl{tokenRepository: mockTokenRepo}
+
+		// Act
+		err := grantService.RevokeOfflineGrant(ctx, uuid.New(), grantID)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrGrantNotFound)
+	})
+}
+
+func TestGrantConsent(t *testing.T) {
+	t.Run("should save the scopes the user consented to and let the prompting request through", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{ClientID: "mail-app", Scopes: []string{"openid", "offline_access"}}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "mail-app").Return(client, nil)
+
+		var savedConsent *domain.Consent
+		mockConsentRepo := mocks.NewConsentRepositoryMock(t)
+		mockConsentRepo.EXPECT().
+			Save(ctx, mock.AnythingOfType("*domain.Consent")).
+			Run(func(ctx context.Context, consent *domain.Consent) { savedConsent = consent }).
+			Return(nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().Set(ctx, "consent:prompted:"+userID.String()+":mail-app", "1", consentPromptLifetime).Return(nil)
+
+		grantService := &GrantServiceImpl{
+			clientRepository:  mockClientRepo,
+			consentRepository: mockConsentRepo,
+			cache:             mockCache,
+		}
+
+		// Act
+		err := grantService.GrantConsent(ctx, userID, "mail-app", []string{"openid", "offline_access"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, userID, savedConsent.UserID)
+		assert.Equal(t, []string{"openid", "offline_access"}, savedConsent.Scopes)
+	})
+
+	t.Run("should reject scopes the client isn't allowed", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{ClientID: "mail-app", Scopes: []string{"openid"}}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "mail-app").Return(client, nil)
+
+		grantService := &GrantServiceImpl{clientRepository: mockClientRepo}
+
+		// Act
+		err := grantService.GrantConsent(ctx, uuid.New(), "mail-app", []string{"openid", "offline_access"})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidScope)
+	})
+
+	t.Run("should return client not found when the client doesn't exist", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "unknown").Return(nil, ports.ErrNotFound)
+
+		grantService := &GrantServiceImpl{clientRepository: mockClientRepo}
+
+		// Act
+		err := grantService.GrantConsent(ctx, uuid.New(), "unknown", []string{"openid"})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrClientNotFound)
+	})
+}
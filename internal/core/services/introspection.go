@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+type IntrospectionService interface {
+	IntrospectToken(ctx context.Context, params domain.IntrospectTokenParams) (*domain.TokenIntrospection, error)
+}
+
+type IntrospectionServiceImpl struct {
+	clientService    ClientService
+	tokenRepository  ports.TokenRepository
+	clientRepository ports.ClientRepository
+	subjectService   SubjectService
+	resourceService  ResourceService
+	config           *config.Config
+}
+
+func NewIntrospectionService(
+	clientService ClientService,
+	tokenRepository ports.TokenRepository,
+	clientRepository ports.ClientRepository,
+	subjectService SubjectService,
+	resourceService ResourceService,
+	config *config.Config,
+) IntrospectionService {
+	return &IntrospectionServiceImpl{
+		clientService:    clientService,
+		tokenRepository:  tokenRepository,
+		clientRepository: clientRepository,
+		subjectService:   subjectService,
+		resourceService:  resourceService,
+		config:           config,
+	}
+}
+
+// IntrospectToken reports the state of a token to its client or to the client of a resource it targets.
+func (s *IntrospectionServiceImpl) IntrospectToken(ctx context.Context, params domain.IntrospectTokenParams) (*domain.TokenIntrospection, error) {
+	introspectingClient, err := s.clientService.AuthenticateClient(ctx, params.ClientCredentials())
+	if err != nil {
+		return nil, fmt.Errorf("authenticate introspecting client: %w", err)
+	}
+
+	token, err := s.findToken(ctx, params.Token, params.TokenTypeHint)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return domain.InactiveTokenIntrospection(), nil
+		}
+
+		return nil, err
+	}
+
+	var clientResources []*domain.APIResource
+	if token.ClientID != introspectingClient.ClientID {
+		clientResources, err = s.resourceService.ListResourcesByClientID(ctx, introspectingClient.ClientID)
+		if err != nil {
+			return nil, fmt.Errorf("list resources of introspecting client: %w", err)
+		}
+	}
+
+	if !token.CanBeIntrospectedBy(introspectingClient, clientResources) {
+		return domain.InactiveTokenIntrospection(), nil
+	}
+
+	isRefreshToken := token.ValidateRefreshToken(params.Token)
+
+	active := token.IsValid()
+	if isRefreshToken {
+		active = token.CanRefresh()
+	}
+
+	if !active {
+		return domain.InactiveTokenIntrospection(), nil
+	}
+
+	client, err := s.clientRepository.GetByClientID(ctx, token.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get token client: %w", err)
+	}
+
+	subject, err := s.subjectService.GetSubject(ctx, client, token.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get token subject: %w", err)
+	}
+
+	introspection := &domain.TokenIntrospection{
+		Active:               true,
+		Scope:                strings.Join(token.Scopes, " "),
+		ClientID:             token.ClientID,
+		IssuedAt:             token.CreatedAt.Unix(),
+		Subject:              subject,
+		Issuer:               s.config.JWT.Issuer,
+		JWTID:                token.ID.String(),
+		ACR:                  token.ACR,
+		Actor:                token.Actor,
+		ExpiresAt:            token.RefreshTokenExpiresAt.Unix(),
+		AuthorizationDetails: token.AuthorizationDetails,
+	}
+
+	if !token.AuthTime.IsZero() {
+		introspection.AuthTime = token.AuthTime.Unix()
+	}
+
+	if isRefreshToken {
+		return introspection, nil
+	}
+
+	// Access tokens only carry the scopes their resources allow, as in the JWT format.
+	if len(token.Resources) > 0 {
+		resources, err := s.resourceService.GetResources(ctx, token.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("get token resources: %w", err)
+		}
+
+		introspection.Scope = strings.Join(domain.ResourceScopes(resources, token.Scopes), " ")
+	}
+
+	introspection.TokenType = token.TokenType
+	introspection.Confirmation = token.Confirmation()
+	introspection.ExpiresAt = token.AccessTokenExpiresAt.Unix()
+	introspection.Audience = token.Audience(s.config.JWT.Issuer)
+
+	return introspection, nil
+}
+
+// findToken looks the token up as the hinted type first, falling back to the other type as RFC 7662 requires.
+func (s *IntrospectionServiceImpl) findToken(ctx context.Context, token, hint string) (*domain.Token, error) {
+	tokenHash := domain.HashToken(token)
+
+	lookups := []func(context.Context, string) (*domain.Token, error){
+		s.tokenRepository.GetByAccessTokenHash,
+		s.tokenRepository.GetByRefreshTokenHash,
+	}
+	if hint == domain.TokenTypeHintRefreshToken {
+		lookups[0], lookups[1] = lookups[1], lookups[0]
+	}
+
+	for _, lookup := range lookups {
+		found, err := lookup(ctx, tokenHash)
+		if err == nil {
+			return found, nil
+		}
+
+		if !errors.Is(err, ports.ErrNotFound) {
+			return nil, fmt.Errorf("get token for introspection: %w", err)
+		}
+	}
+
+	return nil, ports.ErrNotFound
+}
@@ -0,0 +1,406 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntrospectToken(t *testing.T) {
+	cfg := &config.Config{JWT: config.JWT{Issuer: "https://auth.example.com"}}
+
+	t.Run("should describe an active access token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			AccessTokenHash:       domain.HashToken("opaque-token"),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              "client-123",
+			UserID:                uuid.New(),
+			Scopes:                []string{"openid", "email"},
+			TokenType:             domain.TokenTypeBearer,
+			ACR:                   domain.ACRPassword,
+			AccessTokenExpiresAt:  time.Now().UTC().Add(time.Hour),
+			RefreshTokenExpiresAt: time.Now().UTC().Add(24 * time.Hour),
+			CreatedAt:             time.Now().UTC(),
+		}
+		client := &domain.Client{ClientID: token.ClientID}
+		params := domain.IntrospectTokenParams{
+			Token:        "opaque-token",
+			ClientID:     "client-123",
+			ClientSecret: "secret",
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("opaque-token")).Return(token, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, token.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, token.UserID).Return("pairwise-subject", nil)
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().
+			AuthenticateClient(ctx, domain.ClientCredentials{ClientID: "client-123", ClientSecret: "secret"}).
+			Return(&domain.Client{ClientID: "client-123"}, nil)
+
+		introspectionService := &IntrospectionServiceImpl{
+			clientService:    mockClientService,
+			tokenRepository:  mockTokenRepo,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		introspection, err := introspectionService.IntrospectToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, introspection.Active)
+		assert.Equal(t, "openid email", introspection.Scope)
+		assert.Equal(t, token.ClientID, introspection.ClientID)
+		assert.Equal(t, "pairwise-subject", introspection.Subject)
+		assert.Equal(t, domain.TokenTypeBearer, introspection.TokenType)
+		assert.Equal(t, []string{cfg.JWT.Issuer}, introspection.Audience)
+		assert.Equal(t, token.AccessTokenExpiresAt.Unix(), introspection.ExpiresAt)
+		assert.Equal(t, domain.ACRPassword, introspection.ACR)
+	})
+
+	t.Run("should report the key confirmation of a DPoP-bound token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			AccessTokenHash:       domain.HashToken("opaque-token"),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              "client-123",
+			UserID:                uuid.New(),
+			Scopes:                []string{"openid", "email"},
+			TokenType:             domain.TokenTypeBearer,
+			ACR:                   domain.ACRPassword,
+			AccessTokenExpiresAt:  time.Now().UTC().Add(time.Hour),
+			RefreshTokenExpiresAt: time.Now().UTC().Add(24 * time.Hour),
+			CreatedAt:             time.Now().UTC(),
+		}
+		token.BindDPoPKey("key-thumbprint")
+		client := &domain.Client{ClientID: token.ClientID}
+		params := domain.IntrospectTokenParams{
+			Token:        "opaque-token",
+			ClientID:     "client-123",
+			ClientSecret: "secret",
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("opaque-token")).Return(token, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, token.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, token.UserID).Return(token.UserID.String(), nil)
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().
+			AuthenticateClient(ctx, domain.ClientCredentials{ClientID: "client-123", ClientSecret: "secret"}).
+			Return(&domain.Client{ClientID: "client-123"}, nil)
+
+		introspectionService := &IntrospectionServiceImpl{
+			clientService:    mockClientService,
+			tokenRepository:  mockTokenRepo,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		introspection, err := introspectionService.IntrospectToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.TokenTypeDPoP, introspection.TokenType)
+		assert.Equal(t, &domain.Confirmation{JKT: "key-thumbprint"}, introspection.Confirmation)
+	})
+
+	t.Run("should look up a refresh token first when hinted", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			AccessTokenHash:       domain.HashToken("opaque-token"),
+			RefreshTokenHash:      domain.HashToken("opaque-token"),
+			ClientID:              "client-123",
+			UserID:                uuid.New(),
+			Scopes:                []string{"openid", "email"},
+			TokenType:             domain.TokenTypeBearer,
+			ACR:                   domain.ACRPassword,
+			AccessTokenExpiresAt:  time.Now().UTC().Add(time.Hour),
+			RefreshTokenExpiresAt: time.Now().UTC().Add(24 * time.Hour),
+			CreatedAt:             time.Now().UTC(),
+		}
+		client := &domain.Client{ClientID: token.ClientID}
+		params := domain.IntrospectTokenParams{
+			Token:         "opaque-token",
+			TokenTypeHint: domain.TokenTypeHintRefreshToken,
+			ClientID:      "client-123",
+			ClientSecret:  "secret",
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByRefreshTokenHash(ctx, domain.HashToken("opaque-token")).Return(token, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, token.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, token.UserID).Return(token.UserID.String(), nil)
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().
+			AuthenticateClient(ctx, domain.ClientCredentials{ClientID: "client-123", ClientSecret: "secret"}).
+			Return(&domain.Client{ClientID: "client-123"}, nil)
+
+		introspectionService := &IntrospectionServiceImpl{
+			clientService:    mockClientService,
+			tokenRepository:  mockTokenRepo,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		introspection, err := introspectionService.IntrospectToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, introspection.Active)
+		assert.Empty(t, introspection.TokenType)
+		assert.Equal(t, token.RefreshTokenExpiresAt.Unix(), introspection.ExpiresAt)
+	})
+
+	t.Run("should report an unknown token as inactive", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.IntrospectTokenParams{
+			Token:        "opaque-token",
+			ClientID:     "client-123",
+			ClientSecret: "secret",
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("opaque-token")).Return(nil, ports.ErrNotFound)
+		mockTokenRepo.EXPECT().GetByRefreshTokenHash(ctx, domain.HashToken("opaque-token")).Return(nil, ports.ErrNotFound)
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().
+			AuthenticateClient(ctx, domain.ClientCredentials{ClientID: "client-123", ClientSecret: "secret"}).
+			Return(&domain.Client{ClientID: "client-123"}, nil)
+
+		introspectionService := &IntrospectionServiceImpl{
+			clientService:   mockClientService,
+			tokenRepository: mockTokenRepo,
+			config:          cfg,
+		}
+
+		// Act
+		introspection, err := introspectionService.IntrospectToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.InactiveTokenIntrospection(), introspection)
+	})
+
+	t.Run("should report a revoked token as inactive", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			AccessTokenHash:       domain.HashToken("opaque-token"),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              "client-123",
+			UserID:                uuid.New(),
+			Scopes:                []string{"openid", "email"},
+			TokenType:             domain.TokenTypeBearer,
+			ACR:                   domain.ACRPassword,
+			AccessTokenExpiresAt:  time.Now().UTC().Add(time.Hour),
+			RefreshTokenExpiresAt: time.Now().UTC().Add(24 * time.Hour),
+			CreatedAt:             time.Now().UTC(),
+		}
+		token.Revoke("logout")
+		params := domain.IntrospectTokenParams{
+			Token:        "opaque-token",
+			ClientID:     "client-123",
+			ClientSecret: "secret",
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("opaque-token")).Return(token, nil)
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().
+			AuthenticateClient(ctx, domain.ClientCredentials{ClientID: "client-123", ClientSecret: "secret"}).
+			Return(&domain.Client{ClientID: "client-123"}, nil)
+
+		introspectionService := &IntrospectionServiceImpl{
+			clientService:   mockClientService,
+			tokenRepository: mockTokenRepo,
+			config:          cfg,
+		}
+
+		// Act
+		introspection, err := introspectionService.IntrospectToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.False(t, introspection.Active)
+		assert.Empty(t, introspection.Subject)
+	})
+
+	t.Run("should reject a client with invalid credentials", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.IntrospectTokenParams{
+			Token:        "opaque-token",
+			ClientID:     "client-123",
+			ClientSecret: "secret",
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().
+			AuthenticateClient(ctx, domain.ClientCredentials{ClientID: "client-123", ClientSecret: "secret"}).
+			Return(nil, domain.ErrInvalidClient)
+
+		introspectionService := &IntrospectionServiceImpl{clientService: mockClientService}
+
+		// Act
+		introspection, err := introspectionService.IntrospectToken(ctx, params)
+
+		// Assert
+		assert.Nil(t, introspection)
+		assert.ErrorIs(t, err, domain.ErrInvalidClient)
+	})
+
+	t.Run("should report a token issued to another client as inactive", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.IntrospectTokenParams{
+			Token:        "opaque-token",
+			ClientID:     "other-client",
+			ClientSecret: "secret",
+		}
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			AccessTokenHash:       domain.HashToken("opaque-token"),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              "client-123",
+			UserID:                uuid.New(),
+			Scopes:                []string{"openid", "email"},
+			TokenType:             domain.TokenTypeBearer,
+			ACR:                   domain.ACRPassword,
+			AccessTokenExpiresAt:  time.Now().UTC().Add(time.Hour),
+			RefreshTokenExpiresAt: time.Now().UTC().Add(24 * time.Hour),
+			CreatedAt:             time.Now().UTC(),
+			Resources:             []string{"https://payments.example.com"},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().
+			AuthenticateClient(ctx, domain.ClientCredentials{ClientID: "other-client", ClientSecret: "secret"}).
+			Return(&domain.Client{ClientID: "other-client"}, nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("opaque-token")).Return(token, nil)
+
+		mockResourceService := mocks.NewResourceServiceMock(t)
+		mockResourceService.EXPECT().
+			ListResourcesByClientID(ctx, "other-client").
+			Return([]*domain.APIResource{{Identifier: "https://reports.example.com", ClientID: "other-client"}}, nil)
+
+		introspectionService := &IntrospectionServiceImpl{
+			clientService:   mockClientService,
+			tokenRepository: mockTokenRepo,
+			resourceService: mockResourceService,
+			config:          cfg,
+		}
+
+		// Act
+		introspection, err := introspectionService.IntrospectToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.InactiveTokenIntrospection(), introspection)
+	})
+
+	t.Run("should describe a token to the client of a resource it targets", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.IntrospectTokenParams{
+			Token:        "opaque-token",
+			ClientID:     "payments-api",
+			ClientSecret: "secret",
+		}
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			AccessTokenHash:       domain.HashToken("opaque-token"),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              "client-123",
+			UserID:                uuid.New(),
+			Scopes:                []string{"openid", "email"},
+			TokenType:             domain.TokenTypeBearer,
+			ACR:                   domain.ACRPassword,
+			AccessTokenExpiresAt:  time.Now().UTC().Add(time.Hour),
+			RefreshTokenExpiresAt: time.Now().UTC().Add(24 * time.Hour),
+			CreatedAt:             time.Now().UTC(),
+			Resources:             []string{"https://payments.example.com"},
+		}
+		client := &domain.Client{ClientID: token.ClientID}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().
+			AuthenticateClient(ctx, domain.ClientCredentials{ClientID: "payments-api", ClientSecret: "secret"}).
+			Return(&domain.Client{ClientID: "payments-api"}, nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("opaque-token")).Return(token, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, token.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, token.UserID).Return(token.UserID.String(), nil)
+
+		resource := &domain.APIResource{Identifier: "https://payments.example.com", Scopes: []string{"email"}, ClientID: "payments-api"}
+
+		mockResourceService := mocks.NewResourceServiceMock(t)
+		mockResourceService.EXPECT().ListResourcesByClientID(ctx, "payments-api").Return([]*domain.APIResource{resource}, nil)
+		mockResourceService.EXPECT().GetResources(ctx, token.Resources).Return([]*domain.APIResource{resource}, nil)
+
+		introspectionService := &IntrospectionServiceImpl{
+			clientService:    mockClientService,
+			tokenRepository:  mockTokenRepo,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			resourceService:  mockResourceService,
+			config:           cfg,
+		}
+
+		// Act
+		introspection, err := introspectionService.IntrospectToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.True(t, introspection.Active)
+		assert.Equal(t, "email", introspection.Scope)
+		assert.Equal(t, []string{"https://payments.example.com"}, introspection.Audience)
+	})
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+const (
+	keyRotationLockKey = "signing_keys:rotation_lock"
+	keyRotationLockTTL = time.Minute
+)
+
+type KeyService interface {
+	ListKeys(ctx context.Context) ([]*domain.SigningKey, error)
+	RotateKeys(ctx context.Context) error
+	ForceRotation(ctx context.Context) ([]*domain.SigningKey, error)
+}
+
+type KeyServiceImpl struct {
+	signingKeyRepository ports.SigningKeyRepository
+	keyEncrypter         ports.KeyEncrypter
+	cache                ports.Cache
+	config               *config.Config
+}
+
+func NewKeyService(
+	signingKeyRepository ports.SigningKeyRepository,
+	keyEncrypter ports.KeyEncrypter,
+	cache ports.Cache,
+	config *config.Config,
+) KeyService {
+	return &KeyServiceImpl{
+		signingKeyRepository: signingKeyRepository,
+		keyEncrypter:         keyEncrypter,
+		cache:                cache,
+		config:               config,
+	}
+}
+
+func (s *KeyServiceImpl) ListKeys(ctx context.Context) ([]*domain.SigningKey, error) {
+	keys, err := s.signingKeyRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list signing keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// RotateKeys is the scheduled rotation.
+func (s *KeyServiceImpl) RotateKeys(ctx context.Context) error {
+	lockToken, err := s.lock(ctx)
+	if err != nil {
+		return err
+	}
+
+	if lockToken == "" {
+		return nil
+	}
+	defer s.unlock(ctx, lockToken)
+
+	for _, algorithm := range domain.SigningAlgorithms {
+		if err := s.rotateKeys(ctx, algorithm); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ForceRotation replaces compromised current keys right away.
+func (s *KeyServiceImpl) ForceRotation(ctx context.Context) ([]*domain.SigningKey, error) {
+	lockToken, err := s.lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if lockToken == "" {
+		return nil, domain.ErrKeyRotationInProgress
+	}
+	defer s.unlock(ctx, lockToken)
+
+	for _, algorithm := range domain.SigningAlgorithms {
+		keys, err := s.ensureKeys(ctx, algorithm)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.promoteNextKey(ctx, keys, algorithm, time.Now().UTC(), true); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.ListKeys(ctx)
+}
+
+func (s *KeyServiceImpl) rotateKeys(ctx context.Context, algorithm string) error {
+	keys, err := s.ensureKeys(ctx, algorithm)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	for _, key := range keys {
+		if !key.IsDueForRetirement(now, s.retirementPeriod()) {
+			continue
+		}
+
+		key.Retire(now)
+		if err := s.signingKeyRepository.Update(ctx, key); err != nil {
+			return fmt.Errorf("retire signing key: %w", err)
+		}
+	}
+
+	current := domain.FindSigningKey(keys, domain.SigningKeyStateCurrent)
+	if !current.IsDueForRotation(now, s.rotationInterval()) {
+		return nil
+	}
+
+	return s.promoteNextKey(ctx, keys, algorithm, now, false)
+}
+
+// ensureKeys returns the published keys of the algorithm, creating the current and next keys when missing.
+func (s *KeyServiceImpl) ensureKeys(ctx context.Context, algorithm string) ([]*domain.SigningKey, error) {
+	keys, err := s.signingKeyRepository.ListByStates(ctx, algorithm, domain.PublishedSigningKeyStates)
+	if err != nil {
+		return nil, fmt.Errorf("list published signing keys: %w", err)
+	}
+
+	if domain.FindSigningKey(keys, domain.SigningKeyStateCurrent) == nil {
+		var privateKeyPEM string
+		if len(keys) == 0 && algorithm == domain.SigningAlgorithmRS256 {
+			privateKeyPEM = s.config.Key.PrivateKey
+		}
+
+		current, err := s.createKey(ctx, algorithm, domain.SigningKeyStateCurrent, privateKeyPEM)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, current)
+	}
+
+	if domain.FindSigningKey(keys, domain.SigningKeyStateNext) == nil {
+		next, err := s.createKey(ctx, algorithm, domain.SigningKeyStateNext, "")
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, next)
+	}
+
+	return keys, nil
+}
+
+// promoteNextKey makes the next key current and prepares a new next key.
+func (s *KeyServiceImpl) promoteNextKey(ctx context.Context, keys []*domain.SigningKey, algorithm string, now time.Time, emergency bool) error {
+	current := domain.FindSigningKey(keys, domain.SigningKeyStateCurrent)
+	next := domain.FindSigningKey(keys, domain.SigningKeyStateNext)
+
+	next.Activate(now)
+	if err := s.signingKeyRepository.Update(ctx, next); err != nil {
+		return fmt.Errorf("activate next signing key: %w", err)
+	}
+
+	if emergency {
+		current.Retire(now)
+	} else {
+		current.Deactivate(now)
+	}
+
+	if err := s.signingKeyRepository.Update(ctx, current); err != nil {
+		return fmt.Errorf("deactivate current signing key: %w", err)
+	}
+
+	if _, err := s.createKey(ctx, algorithm, domain.SigningKeyStateNext, ""); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// createKey stores a new key of the algorithm in the given state, generated unless a PEM private key is provided.
+func (s *KeyServiceImpl) createKey(ctx context.Context, algorithm, state, privateKeyPEM string) (*domain.SigningKey, error) {
+	var (
+		privateKey crypto.Signer
+		err        error
+	)
+	if privateKeyPEM != "" {
+		privateKey, err = domain.ParseSigningPrivateKeyPEM(privateKeyPEM)
+	} else {
+		privateKey, err = domain.GenerateSigningPrivateKey(algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := domain.MarshalSigningPrivateKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("marshal signing key: %w", err)
+	}
+
+	encryptedPrivateKey, err := s.keyEncrypter.Encrypt(ctx, der)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt signing key: %w", err)
+	}
+
+	key, err := domain.NewSigningKey(privateKey, encryptedPrivateKey, algorithm, state)
+	if err != nil {
+		return nil, fmt.Errorf("create signing key domain: %w", err)
+	}
+
+	if err := s.signingKeyRepository.Create(ctx, key); err != nil {
+		return nil, fmt.Errorf("create signing key: %w", err)
+	}
+
+	return key, nil
+}
+
+// lock takes the rotation lock and returns the token that owns it, or nothing when another replica holds it.
+func (s *KeyServiceImpl) lock(ctx context.Context) (string, error) {
+	token := uuid.NewString()
+
+	acquired, err := s.cache.SetNX(ctx, keyRotationLockKey, token, keyRotationLockTTL)
+	if err != nil {
+		return "", fmt.Errorf("acquire key rotation lock: %w", err)
+	}
+
+	if !acquired {
+		return "", nil
+	}
+	return token, nil
+}
+
+// unlock releases the lock unless it expired and another replica took it.
+func (s *KeyServiceImpl) unlock(ctx context.Context, token string) {
+	_, _ = s.cache.DeleteIfEqual(ctx, keyRotationLockKey, token)
+}
+
+func (s *KeyServiceImpl) rotationInterval() time.Duration {
+	if s.config.Key.RotationInterval > 0 {
+		return s.config.Key.RotationInterval
+	}
+	return domain.SigningKeyRotationInterval
+}
+
+func (s *KeyServiceImpl) retirementPeriod() time.Duration {
+	if s.config.Key.RetirementPeriod > 0 {
+		return s.config.Key.RetirementPeriod
+	}
+	return domain.SigningKeyRetirementPeriod
+}
@@ -0,0 +1,402 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateKeys(t *testing.T) {
+	t.Run("should do nothing when another replica holds the lock", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		cache := mocks.NewCacheMock(t)
+		service := &KeyServiceImpl{
+			cache:  cache,
+			config: &config.Config{},
+		}
+
+		cache.EXPECT().SetNX(ctx, keyRotationLockKey, mock.AnythingOfType("string"), keyRotationLockTTL).Return(false, nil)
+
+		// Act
+		err := service.RotateKeys(ctx)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should release the lock with the token it was taken with", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		activatedAt := time.Now().UTC().Add(-time.Hour)
+		current := &domain.SigningKey{
+			ID:          uuid.New(),
+			KeyID:       uuid.NewString(),
+			Algorithm:   domain.SigningAlgorithmRS256,
+			State:       domain.SigningKeyStateCurrent,
+			ActivatedAt: &activatedAt,
+		}
+		next := &domain.SigningKey{
+			ID:        uuid.New(),
+			KeyID:     uuid.NewString(),
+			Algorithm: domain.SigningAlgorithmRS256,
+			State:     domain.SigningKeyStateNext,
+		}
+		pasetoCurrent := &domain.SigningKey{
+			ID:          uuid.New(),
+			KeyID:       uuid.NewString(),
+			Algorithm:   domain.SigningAlgorithmPASETOV4Public,
+			State:       domain.SigningKeyStateCurrent,
+			ActivatedAt: &activatedAt,
+		}
+		pasetoNext := &domain.SigningKey{
+			ID:        uuid.New(),
+			KeyID:     uuid.NewString(),
+			Algorithm: domain.SigningAlgorithmPASETOV4Public,
+			State:     domain.SigningKeyStateNext,
+		}
+
+		signingKeyRepository := mocks.NewSigningKeyRepositoryMock(t)
+		cache := mocks.NewCacheMock(t)
+		service := &KeyServiceImpl{
+			signingKeyRepository: signingKeyRepository,
+			cache:                cache,
+			config:               &config.Config{},
+		}
+
+		var lockToken string
+		cache.EXPECT().
+			SetNX(ctx, keyRotationLockKey, mock.AnythingOfType("string"), keyRotationLockTTL).
+			Run(func(ctx context.Context, key string, value string, ttl time.Duration) { lockToken = value }).
+			Return(true, nil)
+		cache.EXPECT().
+			DeleteIfEqual(ctx, keyRotationLockKey, mock.AnythingOfType("string")).
+			RunAndReturn(func(ctx context.Context, key string, value string) (bool, error) {
+				assert.Equal(t, lockToken, value)
+				return true, nil
+			})
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmRS256, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{current, next}, nil)
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmPASETOV4Public, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{pasetoCurrent, pasetoNext}, nil)
+
+		// Act
+		err := service.RotateKeys(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotEmpty(t, lockToken)
+	})
+
+	t.Run("should leave keys untouched before the rotation interval", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		activatedAt := time.Now().UTC().Add(-time.Hour)
+		current := &domain.SigningKey{
+			ID:          uuid.New(),
+			KeyID:       uuid.NewString(),
+			Algorithm:   domain.SigningAlgorithmRS256,
+			State:       domain.SigningKeyStateCurrent,
+			ActivatedAt: &activatedAt,
+		}
+		next := &domain.SigningKey{
+			ID:        uuid.New(),
+			KeyID:     uuid.NewString(),
+			Algorithm: domain.SigningAlgorithmRS256,
+			State:     domain.SigningKeyStateNext,
+		}
+		pasetoCurrent := &domain.SigningKey{
+			ID:          uuid.New(),
+			KeyID:       uuid.NewString(),
+			Algorithm:   domain.SigningAlgorithmPASETOV4Public,
+			State:       domain.SigningKeyStateCurrent,
+			ActivatedAt: &activatedAt,
+		}
+		pasetoNext := &domain.SigningKey{
+			ID:        uuid.New(),
+			KeyID:     uuid.NewString(),
+			Algorithm: domain.SigningAlgorithmPASETOV4Public,
+			State:     domain.SigningKeyStateNext,
+		}
+
+		signingKeyRepository := mocks.NewSigningKeyRepositoryMock(t)
+		cache := mocks.NewCacheMock(t)
+		service := &KeyServiceImpl{
+			signingKeyRepository: signingKeyRepository,
+			cache:                cache,
+			config:               &config.Config{},
+		}
+
+		cache.EXPECT().SetNX(ctx, keyRotationLockKey, mock.AnythingOfType("string"), keyRotationLockTTL).Return(true, nil)
+		cache.EXPECT().DeleteIfEqual(ctx, keyRotationLockKey, mock.AnythingOfType("string")).Return(true, nil)
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmRS256, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{current, next}, nil)
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmPASETOV4Public, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{pasetoCurrent, pasetoNext}, nil)
+
+		// Act
+		err := service.RotateKeys(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.SigningKeyStateCurrent, current.State)
+		assert.Equal(t, domain.SigningKeyStateNext, next.State)
+	})
+
+	t.Run("should promote the next key when the current key is due", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		activatedAt := time.Now().UTC().Add(-time.Hour)
+		dueAt := time.Now().UTC().Add(-domain.SigningKeyRotationInterval - time.Hour)
+		current := &domain.SigningKey{
+			ID:          uuid.New(),
+			KeyID:       uuid.NewString(),
+			Algorithm:   domain.SigningAlgorithmRS256,
+			State:       domain.SigningKeyStateCurrent,
+			ActivatedAt: &dueAt,
+		}
+		next := &domain.SigningKey{
+			ID:        uuid.New(),
+			KeyID:     uuid.NewString(),
+			Algorithm: domain.SigningAlgorithmRS256,
+			State:     domain.SigningKeyStateNext,
+		}
+		pasetoCurrent := &domain.SigningKey{
+			ID:          uuid.New(),
+			KeyID:       uuid.NewString(),
+			Algorithm:   domain.SigningAlgorithmPASETOV4Public,
+			State:       domain.SigningKeyStateCurrent,
+			ActivatedAt: &activatedAt,
+		}
+		pasetoNext := &domain.SigningKey{
+			ID:        uuid.New(),
+			KeyID:     uuid.NewString(),
+			Algorithm: domain.SigningAlgorithmPASETOV4Public,
+			State:     domain.SigningKeyStateNext,
+		}
+
+		signingKeyRepository := mocks.NewSigningKeyRepositoryMock(t)
+		keyEncrypter := mocks.NewKeyEncrypterMock(t)
+		cache := mocks.NewCacheMock(t)
+		service := &KeyServiceImpl{
+			signingKeyRepository: signingKeyRepository,
+			keyEncrypter:         keyEncrypter,
+			cache:                cache,
+			config:               &config.Config{},
+		}
+
+		cache.EXPECT().SetNX(ctx, keyRotationLockKey, mock.AnythingOfType("string"), keyRotationLockTTL).Return(true, nil)
+		cache.EXPECT().DeleteIfEqual(ctx, keyRotationLockKey, mock.AnythingOfType("string")).Return(true, nil)
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmRS256, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{current, next}, nil)
+		signingKeyRepository.EXPECT().Update(ctx, next).Return(nil)
+		signingKeyRepository.EXPECT().Update(ctx, current).Return(nil)
+		keyEncrypter.EXPECT().Encrypt(ctx, mock.Anything).Return([]byte("encrypted"), nil)
+		signingKeyRepository.EXPECT().Create(ctx, mock.MatchedBy(func(key *domain.SigningKey) bool {
+			return key.State == domain.SigningKeyStateNext && string(key.EncryptedPrivateKey) == "encrypted"
+		})).Return(nil)
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmPASETOV4Public, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{pasetoCurrent, pasetoNext}, nil)
+
+		// Act
+		err := service.RotateKeys(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.SigningKeyStateCurrent, next.State)
+		assert.NotNil(t, next.ActivatedAt)
+		assert.Equal(t, domain.SigningKeyStateRetiring, current.State)
+		assert.NotNil(t, current.DeactivatedAt)
+		assert.Nil(t, current.RetiredAt)
+	})
+
+	t.Run("should retire retiring keys after the retirement period", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		activatedAt := time.Now().UTC().Add(-time.Hour)
+		retiringActivatedAt := time.Now().UTC().Add(-40 * 24 * time.Hour)
+		deactivatedAt := time.Now().UTC().Add(-domain.SigningKeyRetirementPeriod - time.Minute)
+		current := &domain.SigningKey{
+			ID:          uuid.New(),
+			KeyID:       uuid.NewString(),
+			Algorithm:   domain.SigningAlgorithmRS256,
+			State:       domain.SigningKeyStateCurrent,
+			ActivatedAt: &activatedAt,
+		}
+		next := &domain.SigningKey{
+			ID:        uuid.New(),
+			KeyID:     uuid.NewString(),
+			Algorithm: domain.SigningAlgorithmRS256,
+			State:     domain.SigningKeyStateNext,
+		}
+		retiring := &domain.SigningKey{
+			ID:            uuid.New(),
+			KeyID:         uuid.NewString(),
+			Algorithm:     domain.SigningAlgorithmRS256,
+			State:         domain.SigningKeyStateRetiring,
+			ActivatedAt:   &retiringActivatedAt,
+			DeactivatedAt: &deactivatedAt,
+		}
+		pasetoCurrent := &domain.SigningKey{
+			ID:          uuid.New(),
+			KeyID:       uuid.NewString(),
+			Algorithm:   domain.SigningAlgorithmPASETOV4Public,
+			State:       domain.SigningKeyStateCurrent,
+			ActivatedAt: &activatedAt,
+		}
+		pasetoNext := &domain.SigningKey{
+			ID:        uuid.New(),
+			KeyID:     uuid.NewString(),
+			Algorithm: domain.SigningAlgorithmPASETOV4Public,
+			State:     domain.SigningKeyStateNext,
+		}
+
+		signingKeyRepository := mocks.NewSigningKeyRepositoryMock(t)
+		cache := mocks.NewCacheMock(t)
+		service := &KeyServiceImpl{
+			signingKeyRepository: signingKeyRepository,
+			cache:                cache,
+			config:               &config.Config{},
+		}
+
+		cache.EXPECT().SetNX(ctx, keyRotationLockKey, mock.AnythingOfType("string"), keyRotationLockTTL).Return(true, nil)
+		cache.EXPECT().DeleteIfEqual(ctx, keyRotationLockKey, mock.AnythingOfType("string")).Return(true, nil)
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmRS256, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{current, next, retiring}, nil)
+		signingKeyRepository.EXPECT().Update(ctx, retiring).Return(nil)
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmPASETOV4Public, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{pasetoCurrent, pasetoNext}, nil)
+
+		// Act
+		err := service.RotateKeys(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.SigningKeyStateRetired, retiring.State)
+		assert.NotNil(t, retiring.RetiredAt)
+	})
+
+	t.Run("should provision current and next keys of each algorithm in an empty store", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKeyRepository := mocks.NewSigningKeyRepositoryMock(t)
+		keyEncrypter := mocks.NewKeyEncrypterMock(t)
+		cache := mocks.NewCacheMock(t)
+		service := &KeyServiceImpl{
+			signingKeyRepository: signingKeyRepository,
+			keyEncrypter:         keyEncrypter,
+			cache:                cache,
+			config:               &config.Config{},
+		}
+
+		cache.EXPECT().SetNX(ctx, keyRotationLockKey, mock.AnythingOfType("string"), keyRotationLockTTL).Return(true, nil)
+		cache.EXPECT().DeleteIfEqual(ctx, keyRotationLockKey, mock.AnythingOfType("string")).Return(true, nil)
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmRS256, domain.PublishedSigningKeyStates).Return(nil, nil)
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmPASETOV4Public, domain.PublishedSigningKeyStates).Return(nil, nil)
+		keyEncrypter.EXPECT().Encrypt(ctx, mock.Anything).Return([]byte("encrypted"), nil).Times(4)
+		signingKeyRepository.EXPECT().Create(ctx, mock.MatchedBy(func(key *domain.SigningKey) bool {
+			return key.Algorithm == domain.SigningAlgorithmRS256 && key.PublicKey.KeyType == "RSA" &&
+				key.State == domain.SigningKeyStateCurrent && key.ActivatedAt != nil
+		})).Return(nil).Once()
+		signingKeyRepository.EXPECT().Create(ctx, mock.MatchedBy(func(key *domain.SigningKey) bool {
+			return key.Algorithm == domain.SigningAlgorithmRS256 && key.State == domain.SigningKeyStateNext
+		})).Return(nil).Once()
+		signingKeyRepository.EXPECT().Create(ctx, mock.MatchedBy(func(key *domain.SigningKey) bool {
+			return key.Algorithm == domain.SigningAlgorithmPASETOV4Public && key.PublicKey.KeyType == "OKP" &&
+				key.State == domain.SigningKeyStateCurrent && key.ActivatedAt != nil
+		})).Return(nil).Once()
+		signingKeyRepository.EXPECT().Create(ctx, mock.MatchedBy(func(key *domain.SigningKey) bool {
+			return key.Algorithm == domain.SigningAlgorithmPASETOV4Public && key.State == domain.SigningKeyStateNext
+		})).Return(nil).Once()
+
+		// Act
+		err := service.RotateKeys(ctx)
+
+		// Assert
+		require.NoError(t, err)
+	})
+}
+
+func TestForceRotation(t *testing.T) {
+	t.Run("should retire the current keys immediately", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		signingKeyRepository := mocks.NewSigningKeyRepositoryMock(t)
+		keyEncrypter := mocks.NewKeyEncrypterMock(t)
+		cache := mocks.NewCacheMock(t)
+		service := &KeyServiceImpl{
+			signingKeyRepository: signingKeyRepository,
+			keyEncrypter:         keyEncrypter,
+			cache:                cache,
+			config:               &config.Config{},
+		}
+
+		activatedAt := time.Now().UTC().Add(-time.Hour)
+		current := &domain.SigningKey{
+			ID:          uuid.New(),
+			KeyID:       uuid.NewString(),
+			Algorithm:   domain.SigningAlgorithmRS256,
+			State:       domain.SigningKeyStateCurrent,
+			ActivatedAt: &activatedAt,
+		}
+		next := &domain.SigningKey{
+			ID:        uuid.New(),
+			KeyID:     uuid.NewString(),
+			Algorithm: domain.SigningAlgorithmRS256,
+			State:     domain.SigningKeyStateNext,
+		}
+		pasetoCurrent := &domain.SigningKey{
+			ID:          uuid.New(),
+			KeyID:       uuid.NewString(),
+			Algorithm:   domain.SigningAlgorithmPASETOV4Public,
+			State:       domain.SigningKeyStateCurrent,
+			ActivatedAt: &activatedAt,
+		}
+		pasetoNext := &domain.SigningKey{
+			ID:        uuid.New(),
+			KeyID:     uuid.NewString(),
+			Algorithm: domain.SigningAlgorithmPASETOV4Public,
+			State:     domain.SigningKeyStateNext,
+		}
+
+		cache.EXPECT().SetNX(ctx, keyRotationLockKey, mock.AnythingOfType("string"), keyRotationLockTTL).Return(true, nil)
+		cache.EXPECT().DeleteIfEqual(ctx, keyRotationLockKey, mock.AnythingOfType("string")).Return(true, nil)
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmRS256, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{current, next}, nil)
+		signingKeyRepository.EXPECT().Update(ctx, next).Return(nil)
+		signingKeyRepository.EXPECT().Update(ctx, current).Return(nil)
+		signingKeyRepository.EXPECT().ListByStates(ctx, domain.SigningAlgorithmPASETOV4Public, domain.PublishedSigningKeyStates).Return([]*domain.SigningKey{pasetoCurrent, pasetoNext}, nil)
+		signingKeyRepository.EXPECT().Update(ctx, pasetoNext).Return(nil)
+		signingKeyRepository.EXPECT().Update(ctx, pasetoCurrent).Return(nil)
+		keyEncrypter.EXPECT().Encrypt(ctx, mock.Anything).Return([]byte("encrypted"), nil).Times(2)
+		signingKeyRepository.EXPECT().Create(ctx, mock.Anything).Return(nil).Times(2)
+		signingKeyRepository.EXPECT().List(ctx).Return([]*domain.SigningKey{current, next, pasetoCurrent, pasetoNext}, nil)
+
+		// Act
+		keys, err := service.ForceRotation(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Len(t, keys, 4)
+		assert.Equal(t, domain.SigningKeyStateCurrent, next.State)
+		assert.Equal(t, domain.SigningKeyStateRetired, current.State)
+		assert.NotNil(t, current.RetiredAt)
+		assert.Equal(t, domain.SigningKeyStateCurrent, pasetoNext.State)
+		assert.Equal(t, domain.SigningKeyStateRetired, pasetoCurrent.State)
+	})
+
+	t.Run("should return ErrKeyRotationInProgress when the lock is held", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		cache := mocks.NewCacheMock(t)
+		service := &KeyServiceImpl{cache: cache, config: &config.Config{}}
+
+		cache.EXPECT().SetNX(ctx, keyRotationLockKey, mock.AnythingOfType("string"), keyRotationLockTTL).Return(false, nil)
+
+		// Act
+		keys, err := service.ForceRotation(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrKeyRotationInProgress)
+		assert.Nil(t, keys)
+	})
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+const (
+	jwksCacheKeyPrefix = "jwks:"
+	jwksCacheTTL       = 5 * time.Minute
+)
+
+// resolveKeySet returns the keys registered inline or published at jwksURI.
+func resolveKeySet(ctx context.Context, fetcher ports.JWKSFetcher, cache ports.Cache, keySet *domain.JSONWebKeySet, jwksURI string) (*domain.JSONWebKeySet, error) {
+	if keySet != nil && len(keySet.Keys) > 0 {
+		return keySet, nil
+	}
+
+	cacheKey := jwksCacheKeyPrefix + jwksURI
+	if cached, err := cache.Get(ctx, cacheKey); err == nil {
+		var cachedKeySet domain.JSONWebKeySet
+		if err := json.Unmarshal([]byte(cached), &cachedKeySet); err == nil {
+			return &cachedKeySet, nil
+		}
+	}
+
+	fetched, err := fetcher.FetchJWKS(ctx, jwksURI)
+	if err != nil {
+		return nil, fmt.Errorf("fetch JWKS: %w", err)
+	}
+
+	data, err := json.Marshal(fetched)
+	if err != nil {
+		return nil, fmt.Errorf("marshal JWKS: %w", err)
+	}
+
+	if err := cache.Set(ctx, cacheKey, string(data), jwksCacheTTL); err != nil {
+		return nil, fmt.Errorf("cache JWKS: %w", err)
+	}
+
+	return fetched, nil
+}
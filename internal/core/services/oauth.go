@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/g-villarinho/oidc-server/internal/config"
 	"github.com/g-villarinho/oidc-server/internal/core/domain"
@@ -12,30 +14,67 @@ import (
 
 type OAuthService interface {
 	VerifyAuthorization(ctx context.Context, params domain.AuthorizeParams) error
-	CreateAuthorizationCode(ctx context.Context, userID uuid.UUID, params domain.AuthorizeParams) (*domain.AuthorizationCode, error)
-	ExchangeToken(ctx context.Context, params domain.ExchangeTokenParams) (*TokenResponse, error)
+	RequiredACR(ctx context.Context, params domain.AuthorizeParams) (string, error)
+	Authorize(ctx context.Context, session *domain.Session, params domain.AuthorizeParams) (*domain.AuthorizationResponse, error)
+	ExchangeToken(ctx context.Context, params domain.ExchangeTokenParams) (*domain.TokenResponse, error)
 }
 
 type OAuthServiceImpl struct {
 	clientRepository            ports.ClientRepository
+	clientService               ClientService
 	authorizationCodeRepository ports.AuthorizationCodeRepository
 	tokenService                TokenService
+	tokenExchangeService        TokenExchangeService
+	resourceService             ResourceService
+	authorizationDetailService  AuthorizationDetailService
+	subjectService              SubjectService
+	assertionService            AssertionService
+	backchannelService          BackchannelAuthenticationService
+	tokenGenerator              ports.TokenGenerator
 	userRepository              ports.UserRepository
+	consentRepository           ports.ConsentRepository
+	totpService                 TOTPService
+	webAuthnService             WebAuthnService
+	cache                       ports.Cache
 	config                      *config.Config
 }
 
 func NewOAuthService(
 	clientRepository ports.ClientRepository,
+	clientService ClientService,
 	authorizationCodeRepository ports.AuthorizationCodeRepository,
 	tokenService TokenService,
+	tokenExchangeService TokenExchangeService,
+	resourceService ResourceService,
+	authorizationDetailService AuthorizationDetailService,
+	subjectService SubjectService,
+	assertionService AssertionService,
+	backchannelService BackchannelAuthenticationService,
+	tokenGenerator ports.TokenGenerator,
 	userRepository ports.UserRepository,
+	consentRepository ports.ConsentRepository,
+	totpService TOTPService,
+	webAuthnService WebAuthnService,
+	cache ports.Cache,
 	config *config.Config,
 ) OAuthService {
 	return &OAuthServiceImpl{
 		clientRepository:            clientRepository,
+		clientService:               clientService,
 		authorizationCodeRepository: authorizationCodeRepository,
 		tokenService:                tokenService,
+		tokenExchangeService:        tokenExchangeService,
+		resourceService:             resourceService,
+		authorizationDetailService:  authorizationDetailService,
+		subjectService:              subjectService,
+		assertionService:            assertionService,
+		backchannelService:          backchannelService,
+		tokenGenerator:              tokenGenerator,
 		userRepository:              userRepository,
+		consentRepository:           consentRepository,
+		totpService:                 totpService,
+		webAuthnService:             webAuthnService,
+		cache:                       cache,
 		config:                      config,
 	}
 }
@@ -58,19 +97,193 @@ func (s *OAuthServiceImpl) VerifyAuthorization(ctx context.Context, params domai
 		return domain.ErrUnsupportedResponseType
 	}
 
+	if err := params.ValidateResponseMode(); err != nil {
+		return err
+	}
+
+	if !client.SupportsResponseMode(params.ResolveResponseMode()) {
+		return domain.ErrUnsupportedResponseMode
+	}
+
 	if !client.SupportsScopes(params.Scopes) {
 		return domain.ErrInvalidScope
 	}
 
+	if params.RequiresNonce() && params.Nonce == "" {
+		return domain.ErrNonceRequired
+	}
+
+	if _, err := domain.ParseClaimsRequest(params.Claims); err != nil {
+		return err
+	}
+
+	if len(params.Resources) > 0 {
+		if _, err := s.resourceService.GetResources(ctx, params.Resources); err != nil {
+			return err
+		}
+	}
+
+	if params.AuthorizationDetails != "" {
+		if _, err := s.authorizationDetailService.ParseAuthorizationDetails(ctx, params.AuthorizationDetails); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (s *OAuthServiceImpl) CreateAuthorizationCode(ctx context.Context, userID uuid.UUID, params domain.AuthorizeParams) (*domain.AuthorizationCode, error) {
+// RequiredACR resolves the authentication context the session has to meet for the request.
+func (s *OAuthServiceImpl) RequiredACR(ctx context.Context, params domain.AuthorizeParams) (string, error) {
+	client, err := s.clientRepository.GetByClientID(ctx, params.ClientID)
+	if err != nil {
+		return "", fmt.Errorf("get OAuth client: %w", err)
+	}
+
+	if client == nil {
+		return "", domain.ErrClientNotFound
+	}
+
+	return domain.RequiredACR(params.ACRValues, client.DefaultACRValues), nil
+}
+
+func (s *OAuthServiceImpl) Authorize(ctx context.Context, session *domain.Session, params domain.AuthorizeParams) (*domain.AuthorizationResponse, error) {
+	requiredACR, err := s.RequiredACR(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.SatisfiesACR(requiredACR) {
+		reachable, err := s.canReachACR(ctx, session.UserID, requiredACR)
+		if err != nil {
+			return nil, err
+		}
+
+		if !reachable {
+			return s.respond(ctx, params, &domain.AuthorizationResponse{Error: domain.ErrorUnmetAuthenticationRequirements})
+		}
+
+		return nil, domain.ErrStepUpRequired
+	}
+
+	claims, err := domain.ParseClaimsRequest(params.Claims)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationDetails, err := domain.ParseAuthorizationDetails(params.AuthorizationDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	var consent *domain.Consent
+	if params.RequestsOfflineAccess() {
+		// prompt=consent always shows the consent screen, whatever the user approved before.
+		prompted, err := s.cache.DeleteIfEqual(ctx, consentPromptCacheKey(session.UserID, params.ClientID), "1")
+		if err != nil {
+			return nil, fmt.Errorf("check consent prompt: %w", err)
+		}
+
+		if !prompted {
+			return nil, domain.ErrConsentRequired
+		}
+
+		consent, err = s.consentRepository.Get(ctx, session.UserID, params.ClientID)
+		if err != nil && !errors.Is(err, ports.ErrNotFound) {
+			return nil, fmt.Errorf("get consent: %w", err)
+		}
+	}
+
+	response := &domain.AuthorizationResponse{}
+
+	tokenParams := domain.CreateTokenParams{
+		UserID:               session.UserID,
+		ClientID:             params.ClientID,
+		Scopes:               params.GrantedScopes(consent),
+		Nonce:                params.Nonce,
+		Claims:               claims,
+		SessionID:            session.ID,
+		Resources:            params.Resources,
+		AuthTime:             session.CreatedAt,
+		ACR:                  session.ACR,
+		AMR:                  session.AMR,
+		AuthorizationDetails: authorizationDetails,
+	}
+
+	if params.RequestsCode() {
+		authorizationCode, err := s.createAuthorizationCode(ctx, tokenParams, params)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Code = authorizationCode.Code
+		tokenParams.AuthorizationCode = &authorizationCode.Code
+	}
+
+	if params.RequestsAccessToken() {
+		tokenResponse, err := s.tokenService.CreateAccessToken(ctx, tokenParams)
+		if err != nil {
+			return nil, fmt.Errorf("create access token: %w", err)
+		}
+
+		response.AccessToken = tokenResponse.AccessToken
+		response.TokenType = tokenResponse.TokenType
+		response.ExpiresIn = tokenResponse.ExpiresIn
+	}
+
+	if params.RequestsIDToken() {
+		idToken, err := s.tokenService.CreateIDToken(ctx, tokenParams, response.AccessToken, response.Code)
+		if err != nil {
+			return nil, fmt.Errorf("create ID token: %w", err)
+		}
+
+		response.IDToken = idToken
+	}
+
+	return s.respond(ctx, params, response)
+}
+
+func (s *OAuthServiceImpl) respond(ctx context.Context, params domain.AuthorizeParams, response *domain.AuthorizationResponse) (*domain.AuthorizationResponse, error) {
+	if domain.IsJWTResponseMode(params.ResolveResponseMode()) {
+		jwtResponse, err := s.tokenGenerator.GenerateAuthorizationResponse(ctx, params.ClientID, response.Parameters(params.State))
+		if err != nil {
+			return nil, fmt.Errorf("generate JWT authorization response: %w", err)
+		}
+
+		response.Response = jwtResponse
+	}
+
+	return response, nil
+}
+
+// canReachACR reports whether a new login of the user can meet the authentication context.
+func (s *OAuthServiceImpl) canReachACR(ctx context.Context, userID uuid.UUID, acr string) (bool, error) {
+	if !domain.RequiresSecondFactor(acr) {
+		return true, nil
+	}
+
+	enrolled, err := s.totpService.IsEnrolled(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("check TOTP enrollment: %w", err)
+	}
+
+	if enrolled {
+		return true, nil
+	}
+
+	registered, err := s.webAuthnService.HasCredentials(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("check webauthn credentials: %w", err)
+	}
+
+	return registered, nil
+}
+
+func (s *OAuthServiceImpl) createAuthorizationCode(ctx context.Context, tokenParams domain.CreateTokenParams, params domain.AuthorizeParams) (*domain.AuthorizationCode, error) {
 	authorizationCode, err := domain.NewAuthorizationCode(
 		params.ClientID,
-		userID,
+		tokenParams.UserID,
 		params.RedirectURI,
-		params.Scopes,
+		tokenParams.Scopes,
 		params.Nonce,
 		params.CodeChallenge,
 		params.CodeChallengeMethod,
@@ -80,6 +293,14 @@ func (s *OAuthServiceImpl) CreateAuthorizationCode(ctx context.Context, userID u
 		return nil, fmt.Errorf("create authorization code: %w", err)
 	}
 
+	authorizationCode.Claims = tokenParams.Claims
+	authorizationCode.SessionID = tokenParams.SessionID
+	authorizationCode.Resources = tokenParams.Resources
+	authorizationCode.AuthTime = tokenParams.AuthTime
+	authorizationCode.ACR = tokenParams.ACR
+	authorizationCode.AMR = tokenParams.AMR
+	authorizationCode.AuthorizationDetails = tokenParams.AuthorizationDetails
+
 	if err := s.authorizationCodeRepository.Create(ctx, authorizationCode); err != nil {
 		return nil, fmt.Errorf("save authorization code: %w", err)
 	}
@@ -87,18 +308,28 @@ func (s *OAuthServiceImpl) CreateAuthorizationCode(ctx context.Context, userID u
 	return authorizationCode, nil
 }
 
-func (s *OAuthServiceImpl) ExchangeToken(ctx context.Context, params domain.ExchangeTokenParams) (*TokenResponse, error) {
+func (s *OAuthServiceImpl) ExchangeToken(ctx context.Context, params domain.ExchangeTokenParams) (*domain.TokenResponse, error) {
 	switch params.GrantType {
-	case "authorization_code":
+	case domain.GrantTypeAuthorizationCode:
 		return s.exchangeAuthorizationCode(ctx, params)
-	// case "refresh_token":
-	// 	return s.exchangeRefreshToken(ctx, params)
+	case domain.GrantTypeRefreshToken:
+		return s.exchangeRefreshToken(ctx, params)
+	case domain.GrantTypeTokenExchange:
+		return s.exchangeSubjectToken(ctx, params)
+	case domain.GrantTypeJWTBearer:
+		return s.exchangeJWTBearer(ctx, params)
+	case domain.GrantTypeCIBA:
+		return s.exchangeCIBA(ctx, params)
 	default:
 		return nil, domain.ErrUnsupportedResponseType
 	}
 }
 
-func (s *OAuthServiceImpl) exchangeAuthorizationCode(ctx context.Context, params domain.ExchangeTokenParams) (*TokenResponse, error) {
+func (s *OAuthServiceImpl) exchangeAuthorizationCode(ctx context.Context, params domain.ExchangeTokenParams) (*domain.TokenResponse, error) {
+	if _, err := s.authenticateClient(ctx, params); err != nil {
+		return nil, err
+	}
+
 	authorizationCode, err := s.authorizationCodeRepository.GetByCode(ctx, params.Code)
 	if err != nil {
 		if err == ports.ErrNotFound {
@@ -129,11 +360,32 @@ func (s *OAuthServiceImpl) exchangeAuthorizationCode(ctx context.Context, params
 		return nil, domain.ErrInvalidPKCEVerification
 	}
 
+	tokenParams := authorizationCode.ToCreateTokenParams()
+
+	resources, err := domain.NarrowResources(tokenParams.Resources, params.Resources)
+	if err != nil {
+		return nil, err
+	}
+	tokenParams.Resources = resources
+
+	requestedDetails, err := domain.ParseAuthorizationDetails(params.AuthorizationDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationDetails, err := domain.NarrowAuthorizationDetails(tokenParams.AuthorizationDetails, requestedDetails)
+	if err != nil {
+		return nil, err
+	}
+	tokenParams.AuthorizationDetails = authorizationDetails
+	tokenParams.DPoPJKT = params.DPoPJKT
+	tokenParams.CertificateThumbprint = params.CertificateThumbprint()
+
 	if err := s.authorizationCodeRepository.MarkAsUsed(ctx, authorizationCode.Code); err != nil {
 		return nil, fmt.Errorf("mark authorization code as used: %w", err)
 	}
 
-	tokenResponse, err := s.tokenService.CreateTokens(ctx, authorizationCode.ToCreateTokenParams())
+	tokenResponse, err := s.tokenService.CreateTokens(ctx, tokenParams)
 	if err != nil {
 		return nil, fmt.Errorf("create tokens: %w", err)
 	}
@@ -141,15 +393,155 @@ func (s *OAuthServiceImpl) exchangeAuthorizationCode(ctx context.Context, params
 	return tokenResponse, nil
 }
 
-// func (s *OAuthServiceImpl) exchangeRefreshToken(ctx context.Context, params domain.ExchangeTokenParams) (*TokenResponse, error) {
-// 	tokenResponse, err := s.tokenService.RefreshTokens(
-// 		ctx,
-// 		params.RefreshToken,
-// 		s.config.JWT.AccessTokenDuration,
-// 	)
-// 	if err != nil {
-// 		return nil, fmt.Errorf("refresh tokens: %w", err)
-// 	}
+func (s *OAuthServiceImpl) exchangeRefreshToken(ctx context.Context, params domain.ExchangeTokenParams) (*domain.TokenResponse, error) {
+	if _, err := s.authenticateClient(ctx, params); err != nil {
+		return nil, err
+	}
+
+	authorizationDetails, err := domain.ParseAuthorizationDetails(params.AuthorizationDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenResponse, err := s.tokenService.RefreshTokens(ctx, domain.RefreshTokenParams{
+		RefreshToken:          params.RefreshToken,
+		ClientID:              params.ClientID,
+		Resources:             params.Resources,
+		DPoPJKT:               params.DPoPJKT,
+		CertificateThumbprint: params.CertificateThumbprint(),
+		AuthorizationDetails:  authorizationDetails,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("refresh tokens: %w", err)
+	}
+
+	return tokenResponse, nil
+}
+
+func (s *OAuthServiceImpl) exchangeSubjectToken(ctx context.Context, params domain.ExchangeTokenParams) (*domain.TokenResponse, error) {
+	tokenResponse, err := s.tokenExchangeService.ExchangeToken(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("exchange subject token: %w", err)
+	}
+
+	return tokenResponse, nil
+}
+
+// exchangeJWTBearer implements the RFC 7523 authorization grant.
+func (s *OAuthServiceImpl) exchangeJWTBearer(ctx context.Context, params domain.ExchangeTokenParams) (*domain.TokenResponse, error) {
+	client, err := s.authenticateClient(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if !client.SupportsGrantType(domain.GrantTypeJWTBearer) {
+		return nil, domain.ErrUnauthorizedClient
+	}
+
+	claims, err := s.assertionService.VerifyAuthorizationGrant(ctx, client, params.Assertion)
+	if err != nil {
+		return nil, fmt.Errorf("verify authorization grant: %w", err)
+	}
+
+	userID, err := s.subjectService.ResolveUserID(ctx, client, claims.Subject)
+	if err != nil {
+		if errors.Is(err, domain.ErrSubjectNotFound) {
+			return nil, fmt.Errorf("%w: unknown subject", domain.ErrInvalidAssertion)
+		}
+
+		return nil, fmt.Errorf("resolve assertion subject: %w", err)
+	}
 
-// 	return tokenResponse, nil
-// }
+	scopes, err := domain.DownscopeScopes(client.Scopes, params.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params.Resources) > 0 {
+		if _, err := s.resourceService.GetResources(ctx, params.Resources); err != nil {
+			return nil, err
+		}
+	}
+
+	var authorizationDetails domain.AuthorizationDetails
+	if params.AuthorizationDetails != "" {
+		authorizationDetails, err = s.authorizationDetailService.ParseAuthorizationDetails(ctx, params.AuthorizationDetails)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tokenResponse, err := s.tokenService.CreateAccessToken(ctx, domain.CreateTokenParams{
+		UserID:                userID,
+		ClientID:              client.ClientID,
+		Scopes:                scopes,
+		Resources:             params.Resources,
+		AuthTime:              time.Now().UTC(),
+		DPoPJKT:               params.DPoPJKT,
+		CertificateThumbprint: params.CertificateThumbprint(),
+		AuthorizationDetails:  authorizationDetails,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create access token: %w", err)
+	}
+
+	return tokenResponse, nil
+}
+
+// exchangeCIBA redeems the auth_req_id of an approved backchannel authentication for tokens.
+func (s *OAuthServiceImpl) exchangeCIBA(ctx context.Context, params domain.ExchangeTokenParams) (*domain.TokenResponse, error) {
+	client, err := s.clientService.AuthenticateClient(ctx, params.ClientCredentials())
+	if err != nil {
+		return nil, err
+	}
+
+	if client.IsPublic() || !client.SupportsGrantType(domain.GrantTypeCIBA) {
+		return nil, domain.ErrUnauthorizedClient
+	}
+
+	request, err := s.backchannelService.CompleteAuthentication(ctx, client.ClientID, params.AuthReqID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenResponse, err := s.tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+		UserID:                request.UserID,
+		ClientID:              client.ClientID,
+		Scopes:                request.Scopes,
+		AuthTime:              request.AuthTime,
+		ACR:                   request.ACR,
+		AMR:                   request.AMR,
+		DPoPJKT:               params.DPoPJKT,
+		CertificateThumbprint: params.CertificateThumbprint(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create tokens: %w", err)
+	}
+
+	return tokenResponse, nil
+}
+
+// authenticateClient authenticates the client at the token endpoint.
+func (s *OAuthServiceImpl) authenticateClient(ctx context.Context, params domain.ExchangeTokenParams) (*domain.Client, error) {
+	credentials := params.ClientCredentials()
+	if credentials.IsEmpty() {
+		client, err := s.clientRepository.GetByClientID(ctx, params.ClientID)
+		if err != nil {
+			if errors.Is(err, ports.ErrNotFound) {
+				return nil, domain.ErrInvalidClient
+			}
+
+			return nil, fmt.Errorf("get client: %w", err)
+		}
+
+		if client.IsPublic() {
+			return client, nil
+		}
+
+		if !client.UsesTLSClientAuth() {
+			return nil, domain.ErrInvalidClient
+		}
+	}
+
+	return s.clientService.AuthenticateClient(ctx, credentials)
+}
@@ -1 +1,1326 @@
 package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyAuthorization(t *testing.T) {
+	t.Run("should accept a registered response type regardless of value order", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ID:            uuid.New(),
+			ClientID:      "client-123",
+			ClientName:    "Test Client",
+			RedirectURIs:  []string{"https://app.example.com/callback"},
+			GrantTypes:    []string{"authorization_code", "implicit"},
+			ResponseTypes: []string{"code id_token"},
+			Scopes:        []string{"openid", "email"},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{clientRepository: mockClientRepo}
+
+		params := domain.AuthorizeParams{
+			ClientID:     client.ClientID,
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "id_token code",
+			Scopes:       []string{"openid"},
+			Nonce:        "n-0S6_WzA2Mj",
+		}
+
+		// Act
+		err := oauthService.VerifyAuthorization(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a response type the client has not registered", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ID:            uuid.New(),
+			ClientID:      "client-123",
+			ClientName:    "Test Client",
+			RedirectURIs:  []string{"https://app.example.com/callback"},
+			GrantTypes:    []string{"authorization_code", "implicit"},
+			ResponseTypes: []string{"code"},
+			Scopes:        []string{"openid", "email"},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{clientRepository: mockClientRepo}
+
+		params := domain.AuthorizeParams{
+			ClientID:     client.ClientID,
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "id_token token",
+			Scopes:       []string{"openid"},
+			Nonce:        "n-0S6_WzA2Mj",
+		}
+
+		// Act
+		err := oauthService.VerifyAuthorization(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrUnsupportedResponseType)
+	})
+
+	t.Run("should require a nonce for implicit and hybrid response types", func(t *testing.T) {
+		for _, responseType := range []string{"id_token", "id_token token", "code id_token", "code token"} {
+			// Arrange
+			ctx := context.Background()
+			client := &domain.Client{
+				ID:            uuid.New(),
+				ClientID:      "client-123",
+				ClientName:    "Test Client",
+				RedirectURIs:  []string{"https://app.example.com/callback"},
+				GrantTypes:    []string{"authorization_code", "implicit"},
+				ResponseTypes: []string{responseType},
+				Scopes:        []string{"openid", "email"},
+			}
+
+			mockClientRepo := mocks.NewClientRepositoryMock(t)
+			mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+			oauthService := &OAuthServiceImpl{clientRepository: mockClientRepo}
+
+			params := domain.AuthorizeParams{
+				ClientID:     client.ClientID,
+				RedirectURI:  "https://app.example.com/callback",
+				ResponseType: responseType,
+				Scopes:       []string{"openid"},
+			}
+
+			// Act
+			err := oauthService.VerifyAuthorization(ctx, params)
+
+			// Assert
+			assert.ErrorIs(t, err, domain.ErrNonceRequired, responseType)
+		}
+	})
+
+	t.Run("should reject query delivery for response types that return tokens", func(t *testing.T) {
+		for _, responseMode := range []string{"query", "query.jwt"} {
+			// Arrange
+			ctx := context.Background()
+			client := &domain.Client{
+				ID:            uuid.New(),
+				ClientID:      "client-123",
+				ClientName:    "Test Client",
+				RedirectURIs:  []string{"https://app.example.com/callback"},
+				GrantTypes:    []string{"authorization_code", "implicit"},
+				ResponseTypes: []string{"id_token token"},
+				Scopes:        []string{"openid", "email"},
+			}
+
+			mockClientRepo := mocks.NewClientRepositoryMock(t)
+			mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+			oauthService := &OAuthServiceImpl{clientRepository: mockClientRepo}
+
+			params := domain.AuthorizeParams{
+				ClientID:     client.ClientID,
+				RedirectURI:  "https://app.example.com/callback",
+				ResponseType: "id_token token",
+				ResponseMode: responseMode,
+				Scopes:       []string{"openid"},
+				Nonce:        "n-0S6_WzA2Mj",
+			}
+
+			// Act
+			err := oauthService.VerifyAuthorization(ctx, params)
+
+			// Assert
+			assert.ErrorIs(t, err, domain.ErrUnsupportedResponseMode, responseMode)
+		}
+	})
+
+	t.Run("should reject a response mode the client has not registered", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ID:            uuid.New(),
+			ClientID:      "client-123",
+			ClientName:    "Test Client",
+			RedirectURIs:  []string{"https://app.example.com/callback"},
+			GrantTypes:    []string{"authorization_code", "implicit"},
+			ResponseTypes: []string{"code"},
+			Scopes:        []string{"openid", "email"},
+			ResponseModes: []string{"form_post", "form_post.jwt"},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{clientRepository: mockClientRepo}
+
+		params := domain.AuthorizeParams{
+			ClientID:     client.ClientID,
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid"},
+		}
+
+		// Act
+		err := oauthService.VerifyAuthorization(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrUnsupportedResponseMode)
+	})
+
+	t.Run("should reject a malformed claims request", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ID:            uuid.New(),
+			ClientID:      "client-123",
+			ClientName:    "Test Client",
+			RedirectURIs:  []string{"https://app.example.com/callback"},
+			GrantTypes:    []string{"authorization_code", "implicit"},
+			ResponseTypes: []string{"code"},
+			Scopes:        []string{"openid", "email"},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{clientRepository: mockClientRepo}
+
+		params := domain.AuthorizeParams{
+			ClientID:     client.ClientID,
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid"},
+			Claims:       `{"userinfo":["email"]}`,
+		}
+
+		// Act
+		err := oauthService.VerifyAuthorization(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidClaimsRequest)
+	})
+
+	t.Run("should not require a nonce for the code flow", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ID:            uuid.New(),
+			ClientID:      "client-123",
+			ClientName:    "Test Client",
+			RedirectURIs:  []string{"https://app.example.com/callback"},
+			GrantTypes:    []string{"authorization_code", "implicit"},
+			ResponseTypes: []string{"code"},
+			Scopes:        []string{"openid", "email"},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{clientRepository: mockClientRepo}
+
+		params := domain.AuthorizeParams{
+			ClientID:     client.ClientID,
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid"},
+		}
+
+		// Act
+		err := oauthService.VerifyAuthorization(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+	})
+}
+
+func TestAuthorize(t *testing.T) {
+	t.Run("should only issue an authorization code for the code flow", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+
+		mockCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockCodeRepo.EXPECT().Create(ctx, mock.AnythingOfType("*domain.AuthorizationCode")).Return(nil)
+
+		mockTokenService := mocks.NewTokenServiceMock(t)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository:            mockClientRepo,
+			authorizationCodeRepository: mockCodeRepo,
+			tokenService:                mockTokenService,
+		}
+
+		params := domain.AuthorizeParams{
+			ClientID:     "client-123",
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid"},
+		}
+
+		// Act
+		response, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotEmpty(t, response.Code)
+		assert.Empty(t, response.AccessToken)
+		assert.Empty(t, response.IDToken)
+	})
+
+	t.Run("should store the requested claims with the authorization code", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+
+		var storedCode *domain.AuthorizationCode
+		mockCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockCodeRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.AuthorizationCode")).
+			Run(func(ctx context.Context, code *domain.AuthorizationCode) { storedCode = code }).
+			Return(nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository:            mockClientRepo,
+			authorizationCodeRepository: mockCodeRepo,
+		}
+
+		params := domain.AuthorizeParams{
+			ClientID:     "client-123",
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid"},
+			Claims:       `{"userinfo":{"email":{"essential":true}},"id_token":{"name":null}}`,
+		}
+
+		// Act
+		_, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, storedCode.Claims)
+		assert.Equal(t, &domain.ClaimRequest{Essential: true}, storedCode.Claims.UserInfo["email"])
+		assert.Contains(t, storedCode.Claims.IDToken, "name")
+		assert.Equal(t, storedCode.Claims, storedCode.ToCreateTokenParams().Claims)
+	})
+
+	t.Run("should store the approved authorization details with the authorization code", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+
+		var storedCode *domain.AuthorizationCode
+		mockCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockCodeRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.AuthorizationCode")).
+			Run(func(ctx context.Context, code *domain.AuthorizationCode) { storedCode = code }).
+			Return(nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository:            mockClientRepo,
+			authorizationCodeRepository: mockCodeRepo,
+		}
+
+		params := domain.AuthorizeParams{
+			ClientID:             "client-123",
+			RedirectURI:          "https://app.example.com/callback",
+			ResponseType:         "code",
+			Scopes:               []string{"openid"},
+			AuthorizationDetails: `[{"type":"payment_initiation","instructedAmount":{"currency":"EUR","amount":"123.50"}}]`,
+		}
+
+		// Act
+		_, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, storedCode.AuthorizationDetails, 1)
+		assert.Equal(t, "payment_initiation", storedCode.AuthorizationDetails[0].Type())
+		assert.Equal(t, storedCode.AuthorizationDetails, storedCode.ToCreateTokenParams().AuthorizationDetails)
+	})
+
+	t.Run("should issue an access token and an ID token bound to it for id_token token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+
+		params := domain.AuthorizeParams{
+			ClientID:     "client-123",
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "id_token token",
+			Scopes:       []string{"openid", "email"},
+			Nonce:        "n-0S6_WzA2Mj",
+		}
+
+		expectedTokenParams := domain.CreateTokenParams{
+			UserID:    session.UserID,
+			ClientID:  params.ClientID,
+			Scopes:    params.Scopes,
+			Nonce:     params.Nonce,
+			SessionID: session.ID,
+		}
+
+		mockTokenService := mocks.NewTokenServiceMock(t)
+		mockTokenService.EXPECT().
+			CreateAccessToken(ctx, expectedTokenParams).
+			Return(&domain.TokenResponse{AccessToken: "access-token", TokenType: domain.TokenTypeBearer, ExpiresIn: 3600}, nil)
+		mockTokenService.EXPECT().
+			CreateIDToken(ctx, expectedTokenParams, "access-token", "").
+			Return("id-token", nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository: mockClientRepo,
+			tokenService:     mockTokenService,
+		}
+
+		// Act
+		response, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, response.Code)
+		assert.Equal(t, "access-token", response.AccessToken)
+		assert.Equal(t, domain.TokenTypeBearer, response.TokenType)
+		assert.Equal(t, int64(3600), response.ExpiresIn)
+		assert.Equal(t, "id-token", response.IDToken)
+	})
+
+	t.Run("should issue a code and an ID token bound to it for code id_token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+
+		params := domain.AuthorizeParams{
+			ClientID:     "client-123",
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code id_token",
+			Scopes:       []string{"openid"},
+			Nonce:        "n-0S6_WzA2Mj",
+		}
+
+		var storedCode *domain.AuthorizationCode
+		mockCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockCodeRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.AuthorizationCode")).
+			Run(func(ctx context.Context, code *domain.AuthorizationCode) { storedCode = code }).
+			Return(nil)
+
+		mockTokenService := mocks.NewTokenServiceMock(t)
+		mockTokenService.EXPECT().
+			CreateIDToken(ctx, mock.AnythingOfType("domain.CreateTokenParams"), "", mock.AnythingOfType("string")).
+			RunAndReturn(func(ctx context.Context, tokenParams domain.CreateTokenParams, accessToken, code string) (string, error) {
+				assert.Equal(t, storedCode.Code, code)
+				require.NotNil(t, tokenParams.AuthorizationCode)
+				assert.Equal(t, storedCode.Code, *tokenParams.AuthorizationCode)
+				return "id-token", nil
+			})
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository:            mockClientRepo,
+			authorizationCodeRepository: mockCodeRepo,
+			tokenService:                mockTokenService,
+		}
+
+		// Act
+		response, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, storedCode.Code, response.Code)
+		assert.Empty(t, response.AccessToken)
+		assert.Equal(t, "id-token", response.IDToken)
+	})
+
+	t.Run("should sign the response parameters when a JWT response mode is requested", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+
+		params := domain.AuthorizeParams{
+			ClientID:     "client-123",
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			ResponseMode: "jwt",
+			Scopes:       []string{"openid"},
+			State:        "af0ifjsldkj",
+		}
+
+		var storedCode *domain.AuthorizationCode
+		mockCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockCodeRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.AuthorizationCode")).
+			Run(func(ctx context.Context, code *domain.AuthorizationCode) { storedCode = code }).
+			Return(nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			GenerateAuthorizationResponse(ctx, params.ClientID, mock.AnythingOfType("map[string]string")).
+			RunAndReturn(func(ctx context.Context, clientID string, responseParams map[string]string) (string, error) {
+				assert.Equal(t, map[string]string{"code": storedCode.Code, "state": params.State}, responseParams)
+				return "signed-response", nil
+			})
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository:            mockClientRepo,
+			authorizationCodeRepository: mockCodeRepo,
+			tokenGenerator:              mockTokenGenerator,
+		}
+
+		// Act
+		response, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "signed-response", response.Response)
+	})
+
+	t.Run("should drop offline_access when consent was not prompted", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+
+		var storedCode *domain.AuthorizationCode
+		mockCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockCodeRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.AuthorizationCode")).
+			Run(func(ctx context.Context, code *domain.AuthorizationCode) { storedCode = code }).
+			Return(nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository:            mockClientRepo,
+			authorizationCodeRepository: mockCodeRepo,
+		}
+
+		params := domain.AuthorizeParams{
+			ClientID:     "client-123",
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid", "offline_access"},
+		}
+
+		// Act
+		_, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"openid"}, storedCode.Scopes)
+		assert.Equal(t, session.ID, storedCode.SessionID)
+	})
+
+	t.Run("should keep offline_access when the user consented to it", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+
+		var storedCode *domain.AuthorizationCode
+		mockCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockCodeRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.AuthorizationCode")).
+			Run(func(ctx context.Context, code *domain.AuthorizationCode) { storedCode = code }).
+			Return(nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().DeleteIfEqual(ctx, "consent:prompted:"+session.UserID.String()+":client-123", "1").Return(true, nil)
+
+		mockConsentRepo := mocks.NewConsentRepositoryMock(t)
+		mockConsentRepo.EXPECT().
+			Get(ctx, session.UserID, "client-123").
+			Return(&domain.Consent{UserID: session.UserID, ClientID: "client-123", Scopes: []string{"openid", "offline_access"}}, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository:            mockClientRepo,
+			authorizationCodeRepository: mockCodeRepo,
+			consentRepository:           mockConsentRepo,
+			cache:                       mockCache,
+		}
+
+		params := domain.AuthorizeParams{
+			ClientID:     "client-123",
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid", "offline_access"},
+			Prompt:       "login consent",
+		}
+
+		// Act
+		_, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"openid", "offline_access"}, storedCode.Scopes)
+	})
+
+	t.Run("should require consent on prompt=consent even when the user consented before", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().DeleteIfEqual(ctx, "consent:prompted:"+session.UserID.String()+":client-123", "1").Return(false, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository: mockClientRepo,
+			cache:            mockCache,
+		}
+
+		params := domain.AuthorizeParams{
+			ClientID:     "client-123",
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid", "offline_access"},
+			Prompt:       "consent",
+		}
+
+		// Act
+		response, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrConsentRequired)
+	})
+
+	t.Run("should drop offline_access when the prompted user didn't approve it", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New()}
+
+		var storedCode *domain.AuthorizationCode
+		mockCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockCodeRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.AuthorizationCode")).
+			Run(func(ctx context.Context, code *domain.AuthorizationCode) { storedCode = code }).
+			Return(nil)
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().DeleteIfEqual(ctx, "consent:prompted:"+session.UserID.String()+":client-123", "1").Return(true, nil)
+
+		mockConsentRepo := mocks.NewConsentRepositoryMock(t)
+		mockConsentRepo.EXPECT().
+			Get(ctx, session.UserID, "client-123").
+			Return(&domain.Consent{UserID: session.UserID, ClientID: "client-123", Scopes: []string{"openid"}}, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository:            mockClientRepo,
+			authorizationCodeRepository: mockCodeRepo,
+			consentRepository:           mockConsentRepo,
+			cache:                       mockCache,
+		}
+
+		params := domain.AuthorizeParams{
+			ClientID:     "client-123",
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid", "offline_access"},
+			Prompt:       "consent",
+		}
+
+		// Act
+		_, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"openid"}, storedCode.Scopes)
+	})
+
+	t.Run("should return ErrStepUpRequired when the session is below the requested acr", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New(), ACR: domain.ACRPassword}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		mockTOTPService := mocks.NewTOTPServiceMock(t)
+		mockTOTPService.EXPECT().IsEnrolled(ctx, session.UserID).Return(true, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository: mockClientRepo,
+			totpService:      mockTOTPService,
+		}
+
+		params := domain.AuthorizeParams{
+			ClientID:     "client-123",
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid"},
+			ACRValues:    []string{domain.ACRMultiFactor},
+		}
+
+		// Act
+		response, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrStepUpRequired)
+	})
+
+	t.Run("should not let the requested acr_values lower the client default", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New(), ACR: domain.ACRPassword}
+		client := &domain.Client{
+			ID:               uuid.New(),
+			ClientID:         "client-123",
+			DefaultACRValues: []string{domain.ACRMultiFactor},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockTOTPService := mocks.NewTOTPServiceMock(t)
+		mockTOTPService.EXPECT().IsEnrolled(ctx, session.UserID).Return(false, nil)
+
+		mockWebAuthnService := mocks.NewWebAuthnServiceMock(t)
+		mockWebAuthnService.EXPECT().HasCredentials(ctx, session.UserID).Return(true, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository: mockClientRepo,
+			totpService:      mockTOTPService,
+			webAuthnService:  mockWebAuthnService,
+		}
+
+		params := domain.AuthorizeParams{
+			ClientID:     client.ClientID,
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid"},
+			ACRValues:    []string{domain.ACRPassword},
+		}
+
+		// Act
+		response, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrStepUpRequired)
+	})
+
+	t.Run("should answer unmet_authentication_requirements when the user has no second factor", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{ID: uuid.New(), UserID: uuid.New(), ACR: domain.ACRPassword}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		mockTOTPService := mocks.NewTOTPServiceMock(t)
+		mockTOTPService.EXPECT().IsEnrolled(ctx, session.UserID).Return(false, nil)
+
+		mockWebAuthnService := mocks.NewWebAuthnServiceMock(t)
+		mockWebAuthnService.EXPECT().HasCredentials(ctx, session.UserID).Return(false, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository: mockClientRepo,
+			totpService:      mockTOTPService,
+			webAuthnService:  mockWebAuthnService,
+		}
+
+		params := domain.AuthorizeParams{
+			ClientID:     "client-123",
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid"},
+			ACRValues:    []string{domain.ACRMultiFactor},
+		}
+
+		// Act
+		response, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.ErrorUnmetAuthenticationRequirements, response.Error)
+		assert.Empty(t, response.Code)
+	})
+
+	t.Run("should record the session acr on the authorization code", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		session := &domain.Session{
+			ID:     uuid.New(),
+			UserID: uuid.New(),
+			ACR:    domain.ACRMultiFactor,
+			AMR:    []string{domain.AMRPassword, domain.AMROTP},
+		}
+
+		var storedCode *domain.AuthorizationCode
+		mockCodeRepo := mocks.NewAuthorizationCodeRepositoryMock(t)
+		mockCodeRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.AuthorizationCode")).
+			Run(func(ctx context.Context, code *domain.AuthorizationCode) { storedCode = code }).
+			Return(nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(&domain.Client{ID: uuid.New(), ClientID: "client-123"}, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository:            mockClientRepo,
+			authorizationCodeRepository: mockCodeRepo,
+		}
+
+		params := domain.AuthorizeParams{
+			ClientID:     "client-123",
+			RedirectURI:  "https://app.example.com/callback",
+			ResponseType: "code",
+			Scopes:       []string{"openid"},
+			ACRValues:    []string{domain.ACRPassword},
+		}
+
+		// Act
+		_, err := oauthService.Authorize(ctx, session, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.ACRMultiFactor, storedCode.ACR)
+		assert.Equal(t, []string{domain.AMRPassword, domain.AMROTP}, storedCode.AMR)
+	})
+}
+
+func TestRequiredACR(t *testing.T) {
+	t.Run("should fall back to the client default_acr_values", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ID:               uuid.New(),
+			ClientID:         "client-123",
+			ClientName:       "Test Client",
+			RedirectURIs:     []string{"https://app.example.com/callback"},
+			GrantTypes:       []string{"authorization_code", "implicit"},
+			ResponseTypes:    []string{"code"},
+			Scopes:           []string{"openid", "email"},
+			DefaultACRValues: []string{domain.ACRMultiFactor},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{clientRepository: mockClientRepo}
+
+		// Act
+		acr, err := oauthService.RequiredACR(ctx, domain.AuthorizeParams{ClientID: client.ClientID})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.ACRMultiFactor, acr)
+	})
+
+	t.Run("should keep the client default as a floor under the requested acr_values", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ID:               uuid.New(),
+			ClientID:         "client-123",
+			ClientName:       "Test Client",
+			RedirectURIs:     []string{"https://app.example.com/callback"},
+			GrantTypes:       []string{"authorization_code", "implicit"},
+			ResponseTypes:    []string{"code"},
+			Scopes:           []string{"openid", "email"},
+			DefaultACRValues: []string{domain.ACRMultiFactor},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{clientRepository: mockClientRepo}
+
+		params := domain.AuthorizeParams{
+			ClientID:  client.ClientID,
+			ACRValues: []string{"urn:example:unknown", domain.ACRMultiFactor, domain.ACRPassword},
+		}
+
+		// Act
+		acr, err := oauthService.RequiredACR(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.ACRMultiFactor, acr)
+	})
+
+	t.Run("should require nothing when no known acr is requested", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ID:            uuid.New(),
+			ClientID:      "client-123",
+			ClientName:    "Test Client",
+			RedirectURIs:  []string{"https://app.example.com/callback"},
+			GrantTypes:    []string{"authorization_code", "implicit"},
+			ResponseTypes: []string{"code"},
+			Scopes:        []string{"openid", "email"},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{clientRepository: mockClientRepo}
+
+		params := domain.AuthorizeParams{
+			ClientID:  client.ClientID,
+			ACRValues: []string{"urn:example:unknown"},
+		}
+
+		// Act
+		acr, err := oauthService.RequiredACR(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, acr)
+	})
+}
+
+func TestExchangeTokenClientAuthentication(t *testing.T) {
+	t.Run("should reject a confidential client without credentials on every grant", func(t *testing.T) {
+		for _, grantType := range []string{
+			domain.GrantTypeAuthorizationCode,
+			domain.GrantTypeRefreshToken,
+			domain.GrantTypeJWTBearer,
+		} {
+			// Arrange
+			ctx := context.Background()
+			client := &domain.Client{
+				ClientID:                "client-123",
+				GrantTypes:              []string{grantType},
+				TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodClientSecretPost,
+			}
+
+			mockClientRepo := mocks.NewClientRepositoryMock(t)
+			mockClientRepo.EXPECT().GetByClientID(ctx, "client-123").Return(client, nil)
+
+			oauthService := &OAuthServiceImpl{clientRepository: mockClientRepo}
+
+			// Act
+			response, err := oauthService.ExchangeToken(ctx, domain.ExchangeTokenParams{
+				GrantType:    grantType,
+				ClientID:     "client-123",
+				Code:         "authorization-code",
+				RefreshToken: "refresh-token",
+				Assertion:    "grant-assertion",
+			})
+
+			// Assert
+			assert.Nil(t, response, grantType)
+			assert.ErrorIs(t, err, domain.ErrInvalidClient, grantType)
+		}
+	})
+
+	t.Run("should let a public client redeem a refresh token without credentials", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                "spa-client",
+			GrantTypes:              []string{domain.GrantTypeRefreshToken},
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodNone,
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "spa-client").Return(client, nil)
+
+		expected := &domain.TokenResponse{AccessToken: "access-token"}
+		mockTokenService := mocks.NewTokenServiceMock(t)
+		mockTokenService.EXPECT().
+			RefreshTokens(ctx, mock.AnythingOfType("domain.RefreshTokenParams")).
+			Return(expected, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientRepository: mockClientRepo,
+			tokenService:     mockTokenService,
+		}
+
+		// Act
+		response, err := oauthService.ExchangeToken(ctx, domain.ExchangeTokenParams{
+			GrantType:    domain.GrantTypeRefreshToken,
+			ClientID:     "spa-client",
+			RefreshToken: "refresh-token",
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, expected, response)
+	})
+
+	t.Run("should reject a client_secret_jwt client presenting its secret", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                "partner-service",
+			GrantTypes:              []string{domain.GrantTypeRefreshToken},
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodClientSecretJWT,
+			EncryptedSecretKey:      []byte("encrypted-secret"),
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "partner-service").Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientService: &ClientServiceImpl{clientRepository: mockClientRepo},
+		}
+
+		// Act
+		response, err := oauthService.ExchangeToken(ctx, domain.ExchangeTokenParams{
+			GrantType:    domain.GrantTypeRefreshToken,
+			ClientID:     "partner-service",
+			ClientSecret: "secret",
+			RefreshToken: "refresh-token",
+		})
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrInvalidClient)
+	})
+}
+
+func TestExchangeJWTBearer(t *testing.T) {
+	t.Run("should issue an access token for the asserted user", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                "partner-service",
+			GrantTypes:              []string{domain.GrantTypeJWTBearer},
+			Scopes:                  []string{"openid", "payments:read"},
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodPrivateKeyJWT,
+			JWKSURI:                 "https://partner.example.com/jwks.json",
+		}
+		params := domain.ExchangeTokenParams{
+			GrantType:           domain.GrantTypeJWTBearer,
+			ClientID:            "partner-service",
+			ClientAssertion:     "client-assertion",
+			ClientAssertionType: domain.ClientAssertionTypeJWTBearer,
+			Assertion:           "grant-assertion",
+			Scopes:              []string{"payments:read"},
+		}
+		userID := uuid.New()
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, params.ClientCredentials()).Return(client, nil)
+
+		mockAssertionService := mocks.NewAssertionServiceMock(t)
+		mockAssertionService.EXPECT().
+			VerifyAuthorizationGrant(ctx, client, "grant-assertion").
+			Return(&domain.AssertionClaims{Issuer: client.ClientID, Subject: "user-subject"}, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().ResolveUserID(ctx, client, "user-subject").Return(userID, nil)
+
+		var issued domain.CreateTokenParams
+		expected := &domain.TokenResponse{AccessToken: "access-token"}
+		mockTokenService := mocks.NewTokenServiceMock(t)
+		mockTokenService.EXPECT().
+			CreateAccessToken(ctx, mock.AnythingOfType("domain.CreateTokenParams")).
+			Run(func(ctx context.Context, params domain.CreateTokenParams) { issued = params }).
+			Return(expected, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientService:    mockClientService,
+			assertionService: mockAssertionService,
+			subjectService:   mockSubjectService,
+			tokenService:     mockTokenService,
+		}
+
+		// Act
+		response, err := oauthService.ExchangeToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, expected, response)
+		assert.Equal(t, userID, issued.UserID)
+		assert.Equal(t, client.ClientID, issued.ClientID)
+		assert.Equal(t, []string{"payments:read"}, issued.Scopes)
+	})
+
+	t.Run("should reject a client not allowed to use the grant", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                "partner-service",
+			GrantTypes:              []string{domain.GrantTypeAuthorizationCode},
+			Scopes:                  []string{"openid", "payments:read"},
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodPrivateKeyJWT,
+			JWKSURI:                 "https://partner.example.com/jwks.json",
+		}
+		params := domain.ExchangeTokenParams{
+			GrantType:           domain.GrantTypeJWTBearer,
+			ClientID:            "partner-service",
+			ClientAssertion:     "client-assertion",
+			ClientAssertionType: domain.ClientAssertionTypeJWTBearer,
+			Assertion:           "grant-assertion",
+			Scopes:              []string{"payments:read"},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, params.ClientCredentials()).Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{clientService: mockClientService}
+
+		// Act
+		response, err := oauthService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedClient)
+	})
+
+	t.Run("should reject a replayed grant assertion", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                "partner-service",
+			GrantTypes:              []string{domain.GrantTypeJWTBearer},
+			Scopes:                  []string{"openid", "payments:read"},
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodPrivateKeyJWT,
+			JWKSURI:                 "https://partner.example.com/jwks.json",
+		}
+		params := domain.ExchangeTokenParams{
+			GrantType:           domain.GrantTypeJWTBearer,
+			ClientID:            "partner-service",
+			ClientAssertion:     "client-assertion",
+			ClientAssertionType: domain.ClientAssertionTypeJWTBearer,
+			Assertion:           "grant-assertion",
+			Scopes:              []string{"payments:read"},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, params.ClientCredentials()).Return(client, nil)
+
+		mockAssertionService := mocks.NewAssertionServiceMock(t)
+		mockAssertionService.EXPECT().
+			VerifyAuthorizationGrant(ctx, client, "grant-assertion").
+			Return(nil, domain.ErrAssertionReplayed)
+
+		oauthService := &OAuthServiceImpl{
+			clientService:    mockClientService,
+			assertionService: mockAssertionService,
+		}
+
+		// Act
+		_, err := oauthService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrAssertionReplayed)
+	})
+
+	t.Run("should require a private_key_jwt client to authenticate", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.ExchangeTokenParams{
+			GrantType: domain.GrantTypeJWTBearer,
+			ClientID:  "partner-service",
+			Assertion: "grant-assertion",
+			Scopes:    []string{"payments:read"},
+		}
+		client := &domain.Client{
+			ClientID:                "partner-service",
+			GrantTypes:              []string{domain.GrantTypeJWTBearer},
+			Scopes:                  []string{"openid", "payments:read"},
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodPrivateKeyJWT,
+			JWKSURI:                 "https://partner.example.com/jwks.json",
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "partner-service").Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{clientRepository: mockClientRepo}
+
+		// Act
+		_, err := oauthService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidClient)
+	})
+}
+
+func TestExchangeCIBA(t *testing.T) {
+	t.Run("should issue tokens once the user approved the request", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.ExchangeTokenParams{
+			GrantType:    domain.GrantTypeCIBA,
+			ClientID:     "call-center",
+			ClientSecret: "secret",
+			AuthReqID:    "auth-req-id",
+		}
+		authTime := time.Now().Add(-time.Minute).UTC()
+		request := &domain.BackchannelAuthenticationRequest{
+			AuthReqID: "auth-req-id",
+			ClientID:  "call-center",
+			UserID:    uuid.New(),
+			Scopes:    []string{"openid", "profile"},
+			Status:    domain.BackchannelAuthenticationStatusApproved,
+			AuthTime:  authTime,
+			ACR:       domain.ACRPassword,
+		}
+		client := &domain.Client{
+			ClientID:                     "call-center",
+			GrantTypes:                   []string{domain.GrantTypeCIBA},
+			TokenEndpointAuthMethod:      domain.TokenEndpointAuthMethodClientSecretPost,
+			BackchannelTokenDeliveryMode: domain.BackchannelTokenDeliveryModePoll,
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, params.ClientCredentials()).Return(client, nil)
+
+		mockBackchannelService := mocks.NewBackchannelAuthenticationServiceMock(t)
+		mockBackchannelService.EXPECT().CompleteAuthentication(ctx, "call-center", "auth-req-id").Return(request, nil)
+
+		var issued domain.CreateTokenParams
+		expected := &domain.TokenResponse{AccessToken: "access-token", IDToken: "id-token"}
+		mockTokenService := mocks.NewTokenServiceMock(t)
+		mockTokenService.EXPECT().
+			CreateTokens(ctx, mock.AnythingOfType("domain.CreateTokenParams")).
+			Run(func(ctx context.Context, params domain.CreateTokenParams) { issued = params }).
+			Return(expected, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientService:      mockClientService,
+			backchannelService: mockBackchannelService,
+			tokenService:       mockTokenService,
+		}
+
+		// Act
+		response, err := oauthService.ExchangeToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, expected, response)
+		assert.Equal(t, request.UserID, issued.UserID)
+		assert.Equal(t, request.Scopes, issued.Scopes)
+		assert.Equal(t, authTime, issued.AuthTime)
+		assert.Equal(t, domain.ACRPassword, issued.ACR)
+	})
+
+	t.Run("should tell the client to keep polling while the request is pending", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.ExchangeTokenParams{
+			GrantType:    domain.GrantTypeCIBA,
+			ClientID:     "call-center",
+			ClientSecret: "secret",
+			AuthReqID:    "auth-req-id",
+		}
+		client := &domain.Client{
+			ClientID:                     "call-center",
+			GrantTypes:                   []string{domain.GrantTypeCIBA},
+			TokenEndpointAuthMethod:      domain.TokenEndpointAuthMethodClientSecretPost,
+			BackchannelTokenDeliveryMode: domain.BackchannelTokenDeliveryModePoll,
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, params.ClientCredentials()).Return(client, nil)
+
+		mockBackchannelService := mocks.NewBackchannelAuthenticationServiceMock(t)
+		mockBackchannelService.EXPECT().CompleteAuthentication(ctx, "call-center", "auth-req-id").Return(nil, domain.ErrAuthorizationPending)
+
+		oauthService := &OAuthServiceImpl{
+			clientService:      mockClientService,
+			backchannelService: mockBackchannelService,
+		}
+
+		// Act
+		response, err := oauthService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrAuthorizationPending)
+	})
+
+	t.Run("should reject a redemption without client credentials", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.ExchangeTokenParams{
+			GrantType: domain.GrantTypeCIBA,
+			ClientID:  "call-center",
+			AuthReqID: "auth-req-id",
+		}
+		client := &domain.Client{
+			ClientID:                     "call-center",
+			GrantTypes:                   []string{domain.GrantTypeCIBA},
+			TokenEndpointAuthMethod:      domain.TokenEndpointAuthMethodClientSecretPost,
+			BackchannelTokenDeliveryMode: domain.BackchannelTokenDeliveryModePoll,
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "call-center").Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{
+			clientService: &ClientServiceImpl{clientRepository: mockClientRepo},
+		}
+
+		// Act
+		response, err := oauthService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrInvalidClient)
+	})
+
+	t.Run("should reject a client not allowed to use the grant", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.ExchangeTokenParams{
+			GrantType:    domain.GrantTypeCIBA,
+			ClientID:     "call-center",
+			ClientSecret: "secret",
+			AuthReqID:    "auth-req-id",
+		}
+		client := &domain.Client{
+			ClientID:                     "call-center",
+			GrantTypes:                   []string{domain.GrantTypeAuthorizationCode},
+			TokenEndpointAuthMethod:      domain.TokenEndpointAuthMethodClientSecretPost,
+			BackchannelTokenDeliveryMode: domain.BackchannelTokenDeliveryModePoll,
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, params.ClientCredentials()).Return(client, nil)
+
+		oauthService := &OAuthServiceImpl{clientService: mockClientService}
+
+		// Act
+		_, err := oauthService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedClient)
+	})
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+const recoveryCodeAttemptsCacheKeyPrefix = "recovery_code:attempts:"
+
+type RecoveryCodeService interface {
+	Provision(ctx context.Context, userID uuid.UUID) ([]string, error)
+	Regenerate(ctx context.Context, userID uuid.UUID) ([]string, error)
+	Remaining(ctx context.Context, userID uuid.UUID) (int, error)
+	Verify(ctx context.Context, userID uuid.UUID, code string) error
+}
+
+type RecoveryCodeServiceImpl struct {
+	recoveryCodeRepository ports.RecoveryCodeRepository
+	auditEventRepository   ports.AuditEventRepository
+	hasher                 ports.Hasher
+	cache                  ports.Cache
+	notifier               ports.AccountNotifier
+	logger                 *slog.Logger
+}
+
+func NewRecoveryCodeService(
+	recoveryCodeRepository ports.RecoveryCodeRepository,
+	auditEventRepository ports.AuditEventRepository,
+	hasher ports.Hasher,
+	cache ports.Cache,
+	notifier ports.AccountNotifier,
+	logger *slog.Logger,
+) RecoveryCodeService {
+	return &RecoveryCodeServiceImpl{
+		recoveryCodeRepository: recoveryCodeRepository,
+		auditEventRepository:   auditEventRepository,
+		hasher:                 hasher,
+		cache:                  cache,
+		notifier:               notifier,
+		logger:                 logger.With("service", "recovery_code"),
+	}
+}
+
+// Provision hands a user enrolling in MFA their first set of recovery codes.
+func (s *RecoveryCodeServiceImpl) Provision(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	codes, err := s.recoveryCodeRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list recovery codes: %w", err)
+	}
+
+	if len(codes) > 0 {
+		return nil, nil
+	}
+
+	return s.generate(ctx, userID)
+}
+
+// Regenerate replaces the user's recovery codes with a new set, which invalidates every code of the old one.
+func (s *RecoveryCodeServiceImpl) Regenerate(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	codes, err := s.recoveryCodeRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list recovery codes: %w", err)
+	}
+
+	if len(codes) == 0 {
+		return nil, domain.ErrRecoveryCodesNotGenerated
+	}
+
+	return s.generate(ctx, userID)
+}
+
+func (s *RecoveryCodeServiceImpl) Remaining(ctx context.Context, userID uuid.UUID) (int, error) {
+	codes, err := s.recoveryCodeRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("list recovery codes: %w", err)
+	}
+
+	return len(domain.UnusedRecoveryCodes(codes)), nil
+}
+
+// Verify accepts an unused recovery code of the user and uses it up.
+func (s *RecoveryCodeServiceImpl) Verify(ctx context.Context, userID uuid.UUID, code string) error {
+	if err := s.countAttempt(ctx, userID); err != nil {
+		return err
+	}
+
+	codes, err := s.recoveryCodeRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("list recovery codes: %w", err)
+	}
+
+	unused := domain.UnusedRecoveryCodes(codes)
+	normalized := domain.NormalizeRecoveryCode(code)
+
+	var match *domain.RecoveryCode
+	for _, candidate := range unused {
+		if err := s.hasher.Compare(ctx, normalized, candidate.CodeHash); err == nil {
+			match = candidate
+			break
+		}
+	}
+
+	if match == nil {
+		return domain.ErrInvalidRecoveryCode
+	}
+
+	used, err := s.recoveryCodeRepository.Use(ctx, match.ID)
+	if err != nil {
+		return fmt.Errorf("use recovery code: %w", err)
+	}
+
+	if !used {
+		return domain.ErrInvalidRecoveryCode
+	}
+
+	if err := s.cache.Delete(ctx, recoveryCodeAttemptsCacheKeyPrefix+userID.String()); err != nil {
+		return fmt.Errorf("reset recovery code attempts: %w", err)
+	}
+
+	remaining := len(unused) - 1
+
+	if err := s.audit(ctx, userID, domain.AuditEventRecoveryCodeUsed, map[string]any{
+		"recovery_code_id": match.ID.String(),
+		"remaining":        remaining,
+	}); err != nil {
+		return err
+	}
+
+	// The code is spent by now, so a failed notice must not fail the login.
+	if remaining <= domain.RecoveryCodesLowThreshold {
+		if err := s.notifier.NotifyRecoveryCodesLow(ctx, userID, remaining); err != nil {
+			s.logger.Warn("failed to notify user of low recovery codes", "user_id", userID, "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *RecoveryCodeServiceImpl) generate(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	plaintexts := make([]string, 0, domain.RecoveryCodeCount)
+	codes := make([]*domain.RecoveryCode, 0, domain.RecoveryCodeCount)
+
+	for range domain.RecoveryCodeCount {
+		plaintext, err := domain.GenerateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+
+		hash, err := s.hasher.Hash(ctx, domain.NormalizeRecoveryCode(plaintext))
+		if err != nil {
+			return nil, fmt.Errorf("hash recovery code: %w", err)
+		}
+
+		code, err := domain.NewRecoveryCode(userID, hash)
+		if err != nil {
+			return nil, fmt.Errorf("create recovery code: %w", err)
+		}
+
+		plaintexts = append(plaintexts, plaintext)
+		codes = append(codes, code)
+	}
+
+	if err := s.recoveryCodeRepository.Replace(ctx, userID, codes); err != nil {
+		return nil, fmt.Errorf("store recovery codes: %w", err)
+	}
+
+	if err := s.audit(ctx, userID, domain.AuditEventRecoveryCodesGenerated, map[string]any{
+		"count": len(codes),
+	}); err != nil {
+		return nil, err
+	}
+
+	return plaintexts, nil
+}
+
+// countAttempt counts a recovery code attempt against the user's limit.
+func (s *RecoveryCodeServiceImpl) countAttempt(ctx context.Context, userID uuid.UUID) error {
+	key := recoveryCodeAttemptsCacheKeyPrefix + userID.String()
+
+	attempts, err := s.cache.Increment(ctx, key)
+	if err != nil {
+		return fmt.Errorf("count recovery code attempt: %w", err)
+	}
+
+	if attempts == 1 {
+		if err := s.cache.Expire(ctx, key, domain.RecoveryCodeAttemptWindow); err != nil {
+			return fmt.Errorf("expire recovery code attempts: %w", err)
+		}
+	}
+
+	if attempts > domain.MaxRecoveryCodeAttempts {
+		return domain.ErrTooManyRecoveryCodeAttempts
+	}
+
+	return nil
+}
+
+func (s *RecoveryCodeServiceImpl) audit(ctx context.Context, userID uuid.UUID, eventType string, details map[string]any) error {
+	event, err := domain.NewAuditEvent(userID, eventType, details)
+	if err != nil {
+		return fmt.Errorf("create audit event: %w", err)
+	}
+
+	if err := s.auditEventRepository.Create(ctx, event); err != nil {
+		return fmt.Errorf("record audit event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegenerateRecoveryCodes(t *testing.T) {
+	t.Run("should replace the set with new hashed codes and record it", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		usedAt := time.Now()
+		existing := make([]*domain.RecoveryCode, 0, 10)
+		for range 10 {
+			existing = append(existing, &domain.RecoveryCode{ID: uuid.New(), UserID: userID, CodeHash: "hash-" + uuid.NewString(), UsedAt: &usedAt})
+		}
+
+		var stored []*domain.RecoveryCode
+		recoveryCodeRepository := mocks.NewRecoveryCodeRepositoryMock(t)
+		recoveryCodeRepository.EXPECT().ListByUserID(ctx, userID).Return(existing, nil)
+		recoveryCodeRepository.EXPECT().
+			Replace(ctx, userID, mock.AnythingOfType("[]*domain.RecoveryCode")).
+			Run(func(ctx context.Context, userID uuid.UUID, codes []*domain.RecoveryCode) { stored = codes }).
+			Return(nil)
+
+		hasher := mocks.NewHasherMock(t)
+		hasher.EXPECT().
+			Hash(ctx, mock.AnythingOfType("string")).
+			RunAndReturn(func(ctx context.Context, plaintext string) (string, error) { return "hashed:" + plaintext, nil })
+
+		auditEventRepository := mocks.NewAuditEventRepositoryMock(t)
+		auditEventRepository.EXPECT().
+			Create(ctx, mock.MatchedBy(func(event *domain.AuditEvent) bool {
+				return event.UserID == userID && event.Type == domain.AuditEventRecoveryCodesGenerated
+			})).
+			Return(nil)
+
+		recoveryCodeService := &RecoveryCodeServiceImpl{
+			recoveryCodeRepository: recoveryCodeRepository,
+			auditEventRepository:   auditEventRepository,
+			hasher:                 hasher,
+		}
+
+		// Act
+		codes, err := recoveryCodeService.Regenerate(ctx, userID)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, codes, domain.RecoveryCodeCount)
+		require.Len(t, stored, domain.RecoveryCodeCount)
+		for i, code := range codes {
+			assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, code)
+			assert.Equal(t, "hashed:"+domain.NormalizeRecoveryCode(code), stored[i].CodeHash)
+			assert.Equal(t, userID, stored[i].UserID)
+		}
+	})
+
+	t.Run("should return ErrRecoveryCodesNotGenerated for a user without a set", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+
+		recoveryCodeRepository := mocks.NewRecoveryCodeRepositoryMock(t)
+		recoveryCodeRepository.EXPECT().ListByUserID(ctx, userID).Return([]*domain.RecoveryCode{}, nil)
+
+		recoveryCodeService := &RecoveryCodeServiceImpl{recoveryCodeRepository: recoveryCodeRepository}
+
+		// Act
+		codes, err := recoveryCodeService.Regenerate(ctx, userID)
+
+		// Assert
+		assert.Nil(t, codes)
+		assert.ErrorIs(t, err, domain.ErrRecoveryCodesNotGenerated)
+	})
+}
+
+func TestProvisionRecoveryCodes(t *testing.T) {
+	t.Run("should keep the set of a user who already has one", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		usedAt := time.Now()
+		existing := make([]*domain.RecoveryCode, 0, 4+6)
+		for i := range 4 + 6 {
+			code := &domain.RecoveryCode{ID: uuid.New(), UserID: userID, CodeHash: "hash-" + uuid.NewString()}
+			if i >= 4 {
+				code.UsedAt = &usedAt
+			}
+			existing = append(existing, code)
+		}
+
+		recoveryCodeRepository := mocks.NewRecoveryCodeRepositoryMock(t)
+		recoveryCodeRepository.EXPECT().ListByUserID(ctx, userID).Return(existing, nil)
+
+		recoveryCodeService := &RecoveryCodeServiceImpl{recoveryCodeRepository: recoveryCodeRepository}
+
+		// Act
+		codes, err := recoveryCodeService.Provision(ctx, userID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Nil(t, codes)
+	})
+}
+
+func TestVerifyRecoveryCode(t *testing.T) {
+	t.Run("should use up the matching code and record it in the audit trail", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		usedAt := time.Now()
+		codes := make([]*domain.RecoveryCode, 0, 8+2)
+		for i := range 8 + 2 {
+			code := &domain.RecoveryCode{ID: uuid.New(), UserID: userID, CodeHash: "hash-" + uuid.NewString()}
+			if i >= 8 {
+				code.UsedAt = &usedAt
+			}
+			codes = append(codes, code)
+		}
+		match := codes[1]
+
+		recoveryCodeRepository := mocks.NewRecoveryCodeRepositoryMock(t)
+		recoveryCodeRepository.EXPECT().ListByUserID(ctx, userID).Return(codes, nil)
+		recoveryCodeRepository.EXPECT().Use(ctx, match.ID).Return(true, nil)
+
+		hasher := mocks.NewHasherMock(t)
+		hasher.EXPECT().Compare(ctx, "k3tqa7mzbx", codes[0].CodeHash).Return(errors.New("invalid password"))
+		hasher.EXPECT().Compare(ctx, "k3tqa7mzbx", match.CodeHash).Return(nil)
+
+		auditEventRepository := mocks.NewAuditEventRepositoryMock(t)
+		auditEventRepository.EXPECT().
+			Create(ctx, mock.MatchedBy(func(event *domain.AuditEvent) bool {
+				return event.UserID == userID &&
+					event.Type == domain.AuditEventRecoveryCodeUsed &&
+					event.Details["recovery_code_id"] == match.ID.String() &&
+					event.Details["remaining"] == 7
+			})).
+			Return(nil)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, recoveryCodeAttemptsCacheKeyPrefix+userID.String()).Return(1, nil)
+		cache.EXPECT().Expire(ctx, recoveryCodeAttemptsCacheKeyPrefix+userID.String(), domain.RecoveryCodeAttemptWindow).Return(nil)
+		cache.EXPECT().Delete(ctx, recoveryCodeAttemptsCacheKeyPrefix+userID.String()).Return(nil)
+
+		recoveryCodeService := &RecoveryCodeServiceImpl{
+			recoveryCodeRepository: recoveryCodeRepository,
+			auditEventRepository:   auditEventRepository,
+			hasher:                 hasher,
+			cache:                  cache,
+		}
+
+		// Act
+		err := recoveryCodeService.Verify(ctx, userID, " K3TQA-7MZBX ")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should notify the user once few codes remain", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		usedAt := time.Now()
+		codes := make([]*domain.RecoveryCode, 0, domain.RecoveryCodesLowThreshold+1+6)
+		for i := range domain.RecoveryCodesLowThreshold + 1 + 6 {
+			code := &domain.RecoveryCode{ID: uuid.New(), UserID: userID, CodeHash: "hash-" + uuid.NewString()}
+			if i >= domain.RecoveryCodesLowThreshold+1 {
+				code.UsedAt = &usedAt
+			}
+			codes = append(codes, code)
+		}
+
+		recoveryCodeRepository := mocks.NewRecoveryCodeRepositoryMock(t)
+		recoveryCodeRepository.EXPECT().ListByUserID(ctx, userID).Return(codes, nil)
+		recoveryCodeRepository.EXPECT().Use(ctx, codes[0].ID).Return(true, nil)
+
+		hasher := mocks.NewHasherMock(t)
+		hasher.EXPECT().Compare(ctx, "k3tqa7mzbx", codes[0].CodeHash).Return(nil)
+
+		auditEventRepository := mocks.NewAuditEventRepositoryMock(t)
+		auditEventRepository.EXPECT().Create(ctx, mock.AnythingOfType("*domain.AuditEvent")).Return(nil)
+
+		notifier := mocks.NewAccountNotifierMock(t)
+		notifier.EXPECT().
+			NotifyRecoveryCodesLow(ctx, userID, domain.RecoveryCodesLowThreshold).
+			Return(errors.New("mail server unavailable"))
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, recoveryCodeAttemptsCacheKeyPrefix+userID.String()).Return(2, nil)
+		cache.EXPECT().Delete(ctx, recoveryCodeAttemptsCacheKeyPrefix+userID.String()).Return(nil)
+
+		recoveryCodeService := &RecoveryCodeServiceImpl{
+			recoveryCodeRepository: recoveryCodeRepository,
+			auditEventRepository:   auditEventRepository,
+			hasher:                 hasher,
+			cache:                  cache,
+			notifier:               notifier,
+			logger:                 slog.New(slog.DiscardHandler),
+		}
+
+		// Act
+		err := recoveryCodeService.Verify(ctx, userID, "k3tqa-7mzbx")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a code that was already used", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		usedAt := time.Now()
+		codes := []*domain.RecoveryCode{
+			{ID: uuid.New(), UserID: userID, CodeHash: "hash-1"},
+			{ID: uuid.New(), UserID: userID, CodeHash: "hash-2", UsedAt: &usedAt},
+		}
+
+		recoveryCodeRepository := mocks.NewRecoveryCodeRepositoryMock(t)
+		recoveryCodeRepository.EXPECT().ListByUserID(ctx, userID).Return(codes, nil)
+
+		hasher := mocks.NewHasherMock(t)
+		hasher.EXPECT().Compare(ctx, "k3tqa7mzbx", codes[0].CodeHash).Return(errors.New("invalid password"))
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, recoveryCodeAttemptsCacheKeyPrefix+userID.String()).Return(1, nil)
+		cache.EXPECT().Expire(ctx, recoveryCodeAttemptsCacheKeyPrefix+userID.String(), domain.RecoveryCodeAttemptWindow).Return(nil)
+
+		recoveryCodeService := &RecoveryCodeServiceImpl{
+			recoveryCodeRepository: recoveryCodeRepository,
+			hasher:                 hasher,
+			cache:                  cache,
+		}
+
+		// Act
+		err := recoveryCodeService.Verify(ctx, userID, "k3tqa-7mzbx")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidRecoveryCode)
+	})
+
+	t.Run("should reject a code a concurrent request used first", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		codes := []*domain.RecoveryCode{
+			{ID: uuid.New(), UserID: userID, CodeHash: "hash-1"},
+		}
+
+		recoveryCodeRepository := mocks.NewRecoveryCodeRepositoryMock(t)
+		recoveryCodeRepository.EXPECT().ListByUserID(ctx, userID).Return(codes, nil)
+		recoveryCodeRepository.EXPECT().Use(ctx, codes[0].ID).Return(false, nil)
+
+		hasher := mocks.NewHasherMock(t)
+		hasher.EXPECT().Compare(ctx, "k3tqa7mzbx", codes[0].CodeHash).Return(nil)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, recoveryCodeAttemptsCacheKeyPrefix+userID.String()).Return(1, nil)
+		cache.EXPECT().Expire(ctx, recoveryCodeAttemptsCacheKeyPrefix+userID.String(), domain.RecoveryCodeAttemptWindow).Return(nil)
+
+		recoveryCodeService := &RecoveryCodeServiceImpl{
+			recoveryCodeRepository: recoveryCodeRepository,
+			hasher:                 hasher,
+			cache:                  cache,
+		}
+
+		// Act
+		err := recoveryCodeService.Verify(ctx, userID, "k3tqa-7mzbx")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidRecoveryCode)
+	})
+
+	t.Run("should return ErrTooManyRecoveryCodeAttempts past the attempt limit", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, recoveryCodeAttemptsCacheKeyPrefix+userID.String()).Return(domain.MaxRecoveryCodeAttempts+1, nil)
+
+		recoveryCodeService := &RecoveryCodeServiceImpl{
+			cache: cache,
+		}
+
+		// Act
+		err := recoveryCodeService.Verify(ctx, userID, "k3tqa-7mzbx")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrTooManyRecoveryCodeAttempts)
+	})
+}
@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type ResourceService interface {
+	CreateResource(ctx context.Context, params domain.CreateAPIResourceParams) (*domain.APIResource, error)
+	GetResourceByID(ctx context.Context, id uuid.UUID) (*domain.APIResource, error)
+	ListResources(ctx context.Context) ([]*domain.APIResource, error)
+	UpdateResource(ctx context.Context, id uuid.UUID, params domain.UpdateAPIResourceParams) (*domain.APIResource, error)
+	DeleteResource(ctx context.Context, id uuid.UUID) error
+	GetResources(ctx context.Context, identifiers []string) ([]*domain.APIResource, error)
+	ListResourcesByClientID(ctx context.Context, clientID string) ([]*domain.APIResource, error)
+}
+
+type ResourceServiceImpl struct {
+	resourceRepository ports.APIResourceRepository
+	scopeRepository    ports.ScopeRepository
+	clientRepository   ports.ClientRepository
+}
+
+func NewResourceService(
+	resourceRepository ports.APIResourceRepository,
+	scopeRepository ports.ScopeRepository,
+	clientRepository ports.ClientRepository,
+) ResourceService {
+	return &ResourceServiceImpl{
+		resourceRepository: resourceRepository,
+		scopeRepository:    scopeRepository,
+		clientRepository:   clientRepository,
+	}
+}
+
+func (s *ResourceServiceImpl) CreateResource(ctx context.Context, params domain.CreateAPIResourceParams) (*domain.APIResource, error) {
+	resource, err := domain.NewAPIResource(params)
+	if err != nil {
+		return nil, fmt.Errorf("create API resource domain: %w", err)
+	}
+
+	if err := s.validateScopes(ctx, resource.Scopes); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateClient(ctx, resource.ClientID); err != nil {
+		return nil, err
+	}
+
+	if err := s.resourceRepository.Create(ctx, resource); err != nil {
+		if errors.Is(err, ports.ErrUniqueKeyViolation) {
+			return nil, domain.ErrResourceAlreadyExists
+		}
+
+		return nil, fmt.Errorf("create API resource: %w", err)
+	}
+
+	return resource, nil
+}
+
+func (s *ResourceServiceImpl) GetResourceByID(ctx context.Context, id uuid.UUID) (*domain.APIResource, error) {
+	resource, err := s.resourceRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get API resource by ID: %w", err)
+	}
+
+	return resource, nil
+}
+
+func (s *ResourceServiceImpl) ListResources(ctx context.Context) ([]*domain.APIResource, error) {
+	resources, err := s.resourceRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list API resources: %w", err)
+	}
+
+	return resources, nil
+}
+
+func (s *ResourceServiceImpl) UpdateResource(ctx context.Context, id uuid.UUID, params domain.UpdateAPIResourceParams) (*domain.APIResource, error) {
+	resource, err := s.resourceRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get API resource for update: %w", err)
+	}
+
+	if err := s.validateScopes(ctx, params.Scopes); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateClient(ctx, params.ClientID); err != nil {
+		return nil, err
+	}
+
+	resource.Update(params)
+
+	if err := s.resourceRepository.Update(ctx, resource); err != nil {
+		return nil, fmt.Errorf("update API resource: %w", err)
+	}
+
+	return resource, nil
+}
+
+func (s *ResourceServiceImpl) DeleteResource(ctx context.Context, id uuid.UUID) error {
+	if err := s.resourceRepository.Delete(ctx, id); err != nil {
+		return fmt.Errorf("delete API resource: %w", err)
+	}
+
+	return nil
+}
+
+// GetResources looks up the resources named by the resource parameters of a request.
+func (s *ResourceServiceImpl) GetResources(ctx context.Context, identifiers []string) ([]*domain.APIResource, error) {
+	if len(identifiers) == 0 {
+		return nil, nil
+	}
+
+	for _, identifier := range identifiers {
+		if err := domain.ValidateResourceIndicator(identifier); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, identifier)
+		}
+	}
+
+	resources, err := s.resourceRepository.ListByIdentifiers(ctx, identifiers)
+	if err != nil {
+		return nil, fmt.Errorf("list API resources by identifiers: %w", err)
+	}
+
+	registered := make(map[string]bool, len(resources))
+	for _, resource := range resources {
+		registered[resource.Identifier] = true
+	}
+
+	for _, identifier := range identifiers {
+		if !registered[identifier] {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInvalidTarget, identifier)
+		}
+	}
+
+	return resources, nil
+}
+
+func (s *ResourceServiceImpl) ListResourcesByClientID(ctx context.Context, clientID string) ([]*domain.APIResource, error) {
+	resources, err := s.resourceRepository.ListByClientID(ctx, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("list API resources by client ID: %w", err)
+	}
+
+	return resources, nil
+}
+
+// validateClient rejects a resource server client that isn't registered.
+func (s *ResourceServiceImpl) validateClient(ctx context.Context, clientID string) error {
+	if clientID == "" {
+		return nil
+	}
+
+	if _, err := s.clientRepository.GetByClientID(ctx, clientID); err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return domain.ErrClientNotFound
+		}
+
+		return fmt.Errorf("get resource client: %w", err)
+	}
+
+	return nil
+}
+
+// validateScopes rejects resource scopes missing from the scope registry.
+func (s *ResourceServiceImpl) validateScopes(ctx context.Context, names []string) error {
+	scopes, err := s.scopeRepository.ListByNames(ctx, names)
+	if err != nil {
+		return fmt.Errorf("list scopes by names: %w", err)
+	}
+
+	registered := domain.ScopeNames(scopes)
+	for _, name := range names {
+		if !slices.Contains(registered, name) {
+			return fmt.Errorf("%w: %s", domain.ErrUnknownScope, name)
+		}
+	}
+
+	return nil
+}
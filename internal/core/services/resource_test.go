@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateResource(t *testing.T) {
+	t.Run("should create a resource whose scopes are registered", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.CreateAPIResourceParams{
+			Identifier: "https://api.example.com",
+			Name:       "Payments API",
+			Scopes:     []string{"payments"},
+		}
+
+		mockScopeRepo := mocks.NewScopeRepositoryMock(t)
+		mockScopeRepo.EXPECT().ListByNames(ctx, params.Scopes).Return([]*domain.Scope{{Name: "payments"}}, nil)
+
+		mockResourceRepo := mocks.NewAPIResourceRepositoryMock(t)
+		mockResourceRepo.EXPECT().Create(ctx, mock.AnythingOfType("*domain.APIResource")).Return(nil)
+
+		resourceService := &ResourceServiceImpl{
+			resourceRepository: mockResourceRepo,
+			scopeRepository:    mockScopeRepo,
+		}
+
+		// Act
+		resource, err := resourceService.CreateResource(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, params.Identifier, resource.Identifier)
+		assert.Equal(t, params.Scopes, resource.Scopes)
+	})
+
+	t.Run("should reject a scope missing from the registry", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.CreateAPIResourceParams{
+			Identifier: "https://api.example.com",
+			Name:       "Payments API",
+			Scopes:     []string{"payments"},
+		}
+
+		mockScopeRepo := mocks.NewScopeRepositoryMock(t)
+		mockScopeRepo.EXPECT().ListByNames(ctx, params.Scopes).Return(nil, nil)
+
+		resourceService := &ResourceServiceImpl{
+			resourceRepository: mocks.NewAPIResourceRepositoryMock(t),
+			scopeRepository:    mockScopeRepo,
+		}
+
+		// Act
+		resource, err := resourceService.CreateResource(ctx, params)
+
+		// Assert
+		assert.Nil(t, resource)
+		assert.ErrorIs(t, err, domain.ErrUnknownScope)
+	})
+
+	t.Run("should reject a resource server client that isn't registered", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.CreateAPIResourceParams{
+			Identifier: "https://api.example.com",
+			Name:       "Payments API",
+			Scopes:     []string{"payments"},
+			ClientID:   "payments-api",
+		}
+
+		mockScopeRepo := mocks.NewScopeRepositoryMock(t)
+		mockScopeRepo.EXPECT().ListByNames(ctx, params.Scopes).Return([]*domain.Scope{{Name: "payments"}}, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "payments-api").Return(nil, ports.ErrNotFound)
+
+		resourceService := &ResourceServiceImpl{
+			resourceRepository: mocks.NewAPIResourceRepositoryMock(t),
+			scopeRepository:    mockScopeRepo,
+			clientRepository:   mockClientRepo,
+		}
+
+		// Act
+		resource, err := resourceService.CreateResource(ctx, params)
+
+		// Assert
+		assert.Nil(t, resource)
+		assert.ErrorIs(t, err, domain.ErrClientNotFound)
+	})
+
+	t.Run("should reject an identifier that isn't an absolute URI", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		resourceService := &ResourceServiceImpl{}
+
+		// Act
+		resource, err := resourceService.CreateResource(ctx, domain.CreateAPIResourceParams{
+			Identifier: "payments",
+			Name:       "Payments API",
+		})
+
+		// Assert
+		assert.Nil(t, resource)
+		assert.ErrorIs(t, err, domain.ErrInvalidTarget)
+	})
+}
+
+func TestGetResources(t *testing.T) {
+	t.Run("should return the registered resources", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		identifiers := []string{"https://api.example.com"}
+		registered := []*domain.APIResource{{Identifier: "https://api.example.com"}}
+
+		mockResourceRepo := mocks.NewAPIResourceRepositoryMock(t)
+		mockResourceRepo.EXPECT().ListByIdentifiers(ctx, identifiers).Return(registered, nil)
+
+		resourceService := &ResourceServiceImpl{resourceRepository: mockResourceRepo}
+
+		// Act
+		resources, err := resourceService.GetResources(ctx, identifiers)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, registered, resources)
+	})
+
+	t.Run("should reject an unregistered resource as an invalid target", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		identifiers := []string{"https://api.example.com", "https://unknown.example.com"}
+
+		mockResourceRepo := mocks.NewAPIResourceRepositoryMock(t)
+		mockResourceRepo.EXPECT().
+			ListByIdentifiers(ctx, identifiers).
+			Return([]*domain.APIResource{{Identifier: "https://api.example.com"}}, nil)
+
+		resourceService := &ResourceServiceImpl{resourceRepository: mockResourceRepo}
+
+		// Act
+		resources, err := resourceService.GetResources(ctx, identifiers)
+
+		// Assert
+		assert.Nil(t, resources)
+		assert.ErrorIs(t, err, domain.ErrInvalidTarget)
+	})
+
+	t.Run("should reject a resource indicator with a fragment", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		resourceService := &ResourceServiceImpl{}
+
+		// Act
+		resources, err := resourceService.GetResources(ctx, []string{"https://api.example.com#payments"})
+
+		// Assert
+		assert.Nil(t, resources)
+		assert.ErrorIs(t, err, domain.ErrInvalidTarget)
+	})
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+type ResponseEncryptionService interface {
+	EncryptIDToken(ctx context.Context, client *domain.Client, idToken string) (string, error)
+	EncryptUserInfo(ctx context.Context, client *domain.Client, userInfo string) (string, error)
+}
+
+type ResponseEncryptionServiceImpl struct {
+	tokenEncrypter ports.TokenEncrypter
+	jwksFetcher    ports.JWKSFetcher
+	cache          ports.Cache
+}
+
+func NewResponseEncryptionService(
+	tokenEncrypter ports.TokenEncrypter,
+	jwksFetcher ports.JWKSFetcher,
+	cache ports.Cache,
+) ResponseEncryptionService {
+	return &ResponseEncryptionServiceImpl{
+		tokenEncrypter: tokenEncrypter,
+		jwksFetcher:    jwksFetcher,
+		cache:          cache,
+	}
+}
+
+// EncryptIDToken nests the signed ID token in a JWE for the client's key.
+func (s *ResponseEncryptionServiceImpl) EncryptIDToken(ctx context.Context, client *domain.Client, idToken string) (string, error) {
+	return s.encrypt(ctx, client, client.IDTokenEncryption, idToken)
+}
+
+// EncryptUserInfo nests a signed userinfo response in a JWE for the client's key.
+func (s *ResponseEncryptionServiceImpl) EncryptUserInfo(ctx context.Context, client *domain.Client, userInfo string) (string, error) {
+	return s.encrypt(ctx, client, client.UserInfoEncryption, userInfo)
+}
+
+func (s *ResponseEncryptionServiceImpl) encrypt(ctx context.Context, client *domain.Client, encryption domain.ResponseEncryption, token string) (string, error) {
+	keySet, err := resolveKeySet(ctx, s.jwksFetcher, s.cache, client.JWKS, client.JWKSURI)
+	if err != nil {
+		return "", fmt.Errorf("resolve client encryption keys: %w", err)
+	}
+
+	encrypted, err := s.tokenEncrypter.Encrypt(ctx, token, keySet, encryption)
+	if err != nil {
+		return "", fmt.Errorf("encrypt response: %w", err)
+	}
+
+	return encrypted, nil
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptIDToken(t *testing.T) {
+	encryption := domain.ResponseEncryption{
+		Alg: domain.KeyManagementAlgRSAOAEP256,
+		Enc: domain.ContentEncryptionA256GCM,
+	}
+
+	t.Run("should encrypt to the keys registered inline", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		keySet := &domain.JSONWebKeySet{Keys: []domain.JSONWebKey{{KeyType: "RSA", Use: "enc"}}}
+		client := &domain.Client{ClientID: "health-client", JWKS: keySet, IDTokenEncryption: encryption}
+
+		mockTokenEncrypter := mocks.NewTokenEncrypterMock(t)
+		mockTokenEncrypter.EXPECT().Encrypt(ctx, "signed-id-token", keySet, encryption).Return("encrypted-id-token", nil)
+
+		service := &ResponseEncryptionServiceImpl{tokenEncrypter: mockTokenEncrypter}
+
+		// Act
+		idToken, err := service.EncryptIDToken(ctx, client, "signed-id-token")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "encrypted-id-token", idToken)
+	})
+
+	t.Run("should encrypt to the keys published at the client's jwks_uri", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		keySet := &domain.JSONWebKeySet{Keys: []domain.JSONWebKey{{KeyType: "RSA", Use: "enc"}}}
+		client := &domain.Client{ClientID: "health-client", JWKSURI: "https://health.example.com/jwks", IDTokenEncryption: encryption}
+
+		mockCache := mocks.NewCacheMock(t)
+		mockCache.EXPECT().Get(ctx, "jwks:"+client.JWKSURI).Return("", errors.New("cache miss"))
+		mockCache.EXPECT().Set(ctx, "jwks:"+client.JWKSURI, mock.Anything, jwksCacheTTL).Return(nil)
+
+		mockJWKSFetcher := mocks.NewJWKSFetcherMock(t)
+		mockJWKSFetcher.EXPECT().FetchJWKS(ctx, client.JWKSURI).Return(keySet, nil)
+
+		mockTokenEncrypter := mocks.NewTokenEncrypterMock(t)
+		mockTokenEncrypter.EXPECT().Encrypt(ctx, "signed-id-token", keySet, encryption).Return("encrypted-id-token", nil)
+
+		service := &ResponseEncryptionServiceImpl{
+			tokenEncrypter: mockTokenEncrypter,
+			jwksFetcher:    mockJWKSFetcher,
+			cache:          mockCache,
+		}
+
+		// Act
+		idToken, err := service.EncryptIDToken(ctx, client, "signed-id-token")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "encrypted-id-token", idToken)
+	})
+
+	t.Run("should fail when the client has no key for the algorithm", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		keySet := &domain.JSONWebKeySet{Keys: []domain.JSONWebKey{{KeyType: "EC", Use: "enc"}}}
+		client := &domain.Client{ClientID: "health-client", JWKS: keySet, IDTokenEncryption: encryption}
+
+		mockTokenEncrypter := mocks.NewTokenEncrypterMock(t)
+		mockTokenEncrypter.EXPECT().Encrypt(ctx, "signed-id-token", keySet, encryption).Return("", domain.ErrEncryptionKeyNotFound)
+
+		service := &ResponseEncryptionServiceImpl{tokenEncrypter: mockTokenEncrypter}
+
+		// Act
+		_, err := service.EncryptIDToken(ctx, client, "signed-id-token")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrEncryptionKeyNotFound)
+	})
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type ScopeService interface {
+	CreateScope(ctx context.Context, params domain.CreateScopeParams) (*domain.Scope, error)
+	GetScopeByID(ctx context.Context, id uuid.UUID) (*domain.Scope, error)
+	ListScopes(ctx context.Context) ([]*domain.Scope, error)
+	UpdateScope(ctx context.Context, id uuid.UUID, params domain.UpdateScopeParams) (*domain.Scope, error)
+	DeleteScope(ctx context.Context, id uuid.UUID) error
+	GetDefaultScopes(ctx context.Context) ([]string, error)
+	GetScopeClaims(ctx context.Context, names []string) ([]string, error)
+	ValidateClientScopes(ctx context.Context, client *domain.Client) error
+}
+
+type ScopeServiceImpl struct {
+	scopeRepository ports.ScopeRepository
+}
+
+func NewScopeService(scopeRepository ports.ScopeRepository) ScopeService {
+	return &ScopeServiceImpl{
+		scopeRepository: scopeRepository,
+	}
+}
+
+func (s *ScopeServiceImpl) CreateScope(ctx context.Context, params domain.CreateScopeParams) (*domain.Scope, error) {
+	scope, err := domain.NewScope(params)
+	if err != nil {
+		return nil, fmt.Errorf("create scope domain: %w", err)
+	}
+
+	if err := s.scopeRepository.Create(ctx, scope); err != nil {
+		if errors.Is(err, ports.ErrUniqueKeyViolation) {
+			return nil, domain.ErrScopeAlreadyExists
+		}
+
+		return nil, fmt.Errorf("create scope: %w", err)
+	}
+
+	return scope, nil
+}
+
+func (s *ScopeServiceImpl) GetScopeByID(ctx context.Context, id uuid.UUID) (*domain.Scope, error) {
+	scope, err := s.scopeRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get scope by ID: %w", err)
+	}
+
+	return scope, nil
+}
+
+func (s *ScopeServiceImpl) ListScopes(ctx context.Context) ([]*domain.Scope, error) {
+	scopes, err := s.scopeRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list scopes: %w", err)
+	}
+
+	return scopes, nil
+}
+
+func (s *ScopeServiceImpl) UpdateScope(ctx context.Context, id uuid.UUID, params domain.UpdateScopeParams) (*domain.Scope, error) {
+	scope, err := s.scopeRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get scope for update: %w", err)
+	}
+
+	scope.Update(params)
+
+	if err := s.scopeRepository.Update(ctx, scope); err != nil {
+		return nil, fmt.Errorf("update scope: %w", err)
+	}
+
+	return scope, nil
+}
+
+func (s *ScopeServiceImpl) DeleteScope(ctx context.Context, id uuid.UUID) error {
+	if err := s.scopeRepository.Delete(ctx, id); err != nil {
+		return fmt.Errorf("delete scope: %w", err)
+	}
+
+	return nil
+}
+
+// GetDefaultScopes returns the names of the scopes granted to clients that register without listing any.
+func (s *ScopeServiceImpl) GetDefaultScopes(ctx context.Context) ([]string, error) {
+	scopes, err := s.scopeRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list scopes: %w", err)
+	}
+
+	var defaults []string
+	for _, scope := range scopes {
+		if scope.Default {
+			defaults = append(defaults, scope.Name)
+		}
+	}
+
+	return defaults, nil
+}
+
+func (s *ScopeServiceImpl) GetScopeClaims(ctx context.Context, names []string) ([]string, error) {
+	scopes, err := s.scopeRepository.ListByNames(ctx, names)
+	if err != nil {
+		return nil, fmt.Errorf("list scopes by names: %w", err)
+	}
+
+	return domain.ScopeClaims(scopes), nil
+}
+
+// ValidateClientScopes rejects unknown scopes and first-party scopes for other clients.
+func (s *ScopeServiceImpl) ValidateClientScopes(ctx context.Context, client *domain.Client) error {
+	scopes, err := s.scopeRepository.ListByNames(ctx, client.Scopes)
+	if err != nil {
+		return fmt.Errorf("list scopes by names: %w", err)
+	}
+
+	registered := make(map[string]*domain.Scope, len(scopes))
+	for _, scope := range scopes {
+		registered[scope.Name] = scope
+	}
+
+	for _, name := range client.Scopes {
+		scope, ok := registered[name]
+		if !ok {
+			return fmt.Errorf("%w: %s", domain.ErrUnknownScope, name)
+		}
+
+		if !scope.AllowsClient(client) {
+			return fmt.Errorf("%w: %s", domain.ErrRestrictedScope, name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateClientScopes(t *testing.T) {
+	registry := []*domain.Scope{
+		{Name: "openid"},
+		{Name: "email", Claims: []string{"email", "email_verified"}},
+		{Name: "admin", FirstPartyOnly: true},
+	}
+
+	t.Run("should accept scopes present in the registry", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{Scopes: []string{"openid", "email"}}
+
+		mockScopeRepo := mocks.NewScopeRepositoryMock(t)
+		mockScopeRepo.EXPECT().ListByNames(ctx, client.Scopes).Return(registry[:2], nil)
+
+		scopeService := &ScopeServiceImpl{scopeRepository: mockScopeRepo}
+
+		// Act
+		err := scopeService.ValidateClientScopes(ctx, client)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a scope missing from the registry", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{Scopes: []string{"openid", "payments"}}
+
+		mockScopeRepo := mocks.NewScopeRepositoryMock(t)
+		mockScopeRepo.EXPECT().ListByNames(ctx, client.Scopes).Return(registry[:1], nil)
+
+		scopeService := &ScopeServiceImpl{scopeRepository: mockScopeRepo}
+
+		// Act
+		err := scopeService.ValidateClientScopes(ctx, client)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrUnknownScope)
+	})
+
+	t.Run("should reject a first-party scope for a third-party client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{Scopes: []string{"admin"}}
+
+		mockScopeRepo := mocks.NewScopeRepositoryMock(t)
+		mockScopeRepo.EXPECT().ListByNames(ctx, client.Scopes).Return(registry[2:], nil)
+
+		scopeService := &ScopeServiceImpl{scopeRepository: mockScopeRepo}
+
+		// Act
+		err := scopeService.ValidateClientScopes(ctx, client)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrRestrictedScope)
+	})
+
+	t.Run("should accept a first-party scope for a first-party client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{Scopes: []string{"admin"}, FirstParty: true}
+
+		mockScopeRepo := mocks.NewScopeRepositoryMock(t)
+		mockScopeRepo.EXPECT().ListByNames(ctx, client.Scopes).Return(registry[2:], nil)
+
+		scopeService := &ScopeServiceImpl{scopeRepository: mockScopeRepo}
+
+		// Act
+		err := scopeService.ValidateClientScopes(ctx, client)
+
+		// Assert
+		require.NoError(t, err)
+	})
+}
+
+func TestGetDefaultScopes(t *testing.T) {
+	t.Run("should return only the scopes marked as default", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockScopeRepo := mocks.NewScopeRepositoryMock(t)
+		mockScopeRepo.EXPECT().List(ctx).Return([]*domain.Scope{
+			{Name: "email"},
+			{Name: "openid", Default: true},
+			{Name: "profile", Default: true},
+		}, nil)
+
+		scopeService := &ScopeServiceImpl{scopeRepository: mockScopeRepo}
+
+		// Act
+		scopes, err := scopeService.GetDefaultScopes(ctx)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"openid", "profile"}, scopes)
+	})
+}
+
+func TestCreateScope(t *testing.T) {
+	t.Run("should report a duplicate scope name", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockScopeRepo := mocks.NewScopeRepositoryMock(t)
+		mockScopeRepo.EXPECT().Create(ctx, mock.AnythingOfType("*domain.Scope")).Return(ports.ErrUniqueKeyViolation)
+
+		scopeService := &ScopeServiceImpl{scopeRepository: mockScopeRepo}
+
+		// Act
+		scope, err := scopeService.CreateScope(ctx, domain.CreateScopeParams{
+			Name:         "email",
+			Descriptions: map[string]string{"en": "View your email address"},
+		})
+
+		// Assert
+		assert.Nil(t, scope)
+		assert.ErrorIs(t, err, domain.ErrScopeAlreadyExists)
+	})
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+// minPairwiseSubjectSaltLength keeps the pairwise subject salt from being guessed.
+const minPairwiseSubjectSaltLength = 32
+
+type SubjectService interface {
+	GetSubject(ctx context.Context, client *domain.Client, userID uuid.UUID) (string, error)
+	ResolveUserID(ctx context.Context, client *domain.Client, subject string) (uuid.UUID, error)
+	ValidateSectorIdentifier(ctx context.Context, client *domain.Client) error
+}
+
+type SubjectServiceImpl struct {
+	pairwiseSubjectRepository ports.PairwiseSubjectRepository
+	sectorIdentifierFetcher   ports.SectorIdentifierFetcher
+	config                    *config.Config
+}
+
+func NewSubjectService(
+	pairwiseSubjectRepository ports.PairwiseSubjectRepository,
+	sectorIdentifierFetcher ports.SectorIdentifierFetcher,
+	config *config.Config,
+) SubjectService {
+	return &SubjectServiceImpl{
+		pairwiseSubjectRepository: pairwiseSubjectRepository,
+		sectorIdentifierFetcher:   sectorIdentifierFetcher,
+		config:                    config,
+	}
+}
+
+// GetSubject returns the sub value the client sees for the user.
+func (s *SubjectServiceImpl) GetSubject(ctx context.Context, client *domain.Client, userID uuid.UUID) (string, error) {
+	// Tokens a client holds on its own behalf have the client as subject.
+	if userID == uuid.Nil {
+		return client.ClientID, nil
+	}
+
+	if !client.UsesPairwiseSubject() {
+		return userID.String(), nil
+	}
+
+	sectorIdentifier, err := client.SectorIdentifier()
+	if err != nil {
+		return "", fmt.Errorf("get client sector identifier: %w", err)
+	}
+
+	pairwiseSubject, err := s.pairwiseSubjectRepository.GetByUser(ctx, sectorIdentifier, userID)
+	if err == nil {
+		return pairwiseSubject.Subject, nil
+	}
+
+	if !errors.Is(err, ports.ErrNotFound) {
+		return "", fmt.Errorf("get pairwise subject: %w", err)
+	}
+
+	if err := s.validatePairwiseSubjectSalt(); err != nil {
+		return "", err
+	}
+
+	pairwiseSubject = domain.NewPairwiseSubject(sectorIdentifier, userID, s.config.JWT.PairwiseSubjectSalt)
+	if err := s.pairwiseSubjectRepository.Create(ctx, pairwiseSubject); err != nil {
+		return "", fmt.Errorf("save pairwise subject: %w", err)
+	}
+
+	return pairwiseSubject.Subject, nil
+}
+
+// ResolveUserID maps a sub issued to the client back to the local user ID.
+func (s *SubjectServiceImpl) ResolveUserID(ctx context.Context, client *domain.Client, subject string) (uuid.UUID, error) {
+	if !client.UsesPairwiseSubject() {
+		userID, err := uuid.Parse(subject)
+		if err != nil {
+			return uuid.Nil, domain.ErrSubjectNotFound
+		}
+		return userID, nil
+	}
+
+	sectorIdentifier, err := client.SectorIdentifier()
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("get client sector identifier: %w", err)
+	}
+
+	pairwiseSubject, err := s.pairwiseSubjectRepository.GetBySubject(ctx, sectorIdentifier, subject)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return uuid.Nil, domain.ErrSubjectNotFound
+		}
+		return uuid.Nil, fmt.Errorf("get pairwise subject: %w", err)
+	}
+
+	return pairwiseSubject.UserID, nil
+}
+
+// ValidateSectorIdentifier checks that a pairwise client has a usable sector.
+func (s *SubjectServiceImpl) ValidateSectorIdentifier(ctx context.Context, client *domain.Client) error {
+	if client.SectorIdentifierURI != "" {
+		u, err := url.Parse(client.SectorIdentifierURI)
+		if err != nil || u.Scheme != "https" {
+			return domain.ErrInvalidSectorIdentifier
+		}
+
+		redirectURIs, err := s.sectorIdentifierFetcher.FetchRedirectURIs(ctx, client.SectorIdentifierURI)
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidSectorIdentifier, err)
+		}
+
+		for _, redirectURI := range client.RedirectURIs {
+			if !slices.Contains(redirectURIs, redirectURI) {
+				return domain.ErrInvalidSectorIdentifier
+			}
+		}
+	}
+
+	if !client.UsesPairwiseSubject() {
+		return nil
+	}
+
+	if _, err := client.SectorIdentifier(); err != nil {
+		return err
+	}
+
+	return s.validatePairwiseSubjectSalt()
+}
+
+// validatePairwiseSubjectSalt is only enforced once pairwise clients exist, so deployments without them need no salt.
+func (s *SubjectServiceImpl) validatePairwiseSubjectSalt() error {
+	if len(s.config.JWT.PairwiseSubjectSalt) < minPairwiseSubjectSaltLength {
+		return fmt.Errorf("pairwise subject salt must be at least %d characters", minPairwiseSubjectSaltLength)
+	}
+
+	return nil
+}
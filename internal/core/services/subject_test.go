@@ -0,0 +1,319 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSubject(t *testing.T) {
+	t.Run("should return the user ID for public clients", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{SubjectType: domain.SubjectTypePublic}
+
+		subjectService := &SubjectServiceImpl{config: &config.Config{}}
+
+		// Act
+		subject, err := subjectService.GetSubject(ctx, client, userID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, userID.String(), subject)
+	})
+
+	t.Run("should return the same pairwise subject to clients of the same sector", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		first := &domain.Client{
+			ClientID:     uuid.New().String(),
+			RedirectURIs: []string{"https://app.example.com/callback"},
+			SubjectType:  domain.SubjectTypePairwise,
+		}
+		second := &domain.Client{
+			ClientID:     uuid.New().String(),
+			RedirectURIs: []string{"https://app.example.com/other-callback"},
+			SubjectType:  domain.SubjectTypePairwise,
+		}
+
+		mockSubjectRepo := mocks.NewPairwiseSubjectRepositoryMock(t)
+		mockSubjectRepo.EXPECT().GetByUser(ctx, mock.AnythingOfType("string"), userID).Return(nil, ports.ErrNotFound).Times(2)
+		mockSubjectRepo.EXPECT().Create(ctx, mock.AnythingOfType("*domain.PairwiseSubject")).Return(nil).Times(2)
+
+		subjectService := &SubjectServiceImpl{
+			pairwiseSubjectRepository: mockSubjectRepo,
+			config:                    &config.Config{JWT: config.JWT{PairwiseSubjectSalt: "test-pairwise-subject-salt-of-32-chars"}},
+		}
+
+		// Act
+		firstSubject, err := subjectService.GetSubject(ctx, first, userID)
+		require.NoError(t, err)
+		secondSubject, err := subjectService.GetSubject(ctx, second, userID)
+		require.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, firstSubject, secondSubject)
+		assert.NotEqual(t, userID.String(), firstSubject)
+	})
+
+	t.Run("should return different pairwise subjects to different sectors", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		first := &domain.Client{
+			ClientID:     uuid.New().String(),
+			RedirectURIs: []string{"https://app.example.com/callback"},
+			SubjectType:  domain.SubjectTypePairwise,
+		}
+		second := &domain.Client{
+			ClientID:     uuid.New().String(),
+			RedirectURIs: []string{"https://other.example.org/callback"},
+			SubjectType:  domain.SubjectTypePairwise,
+		}
+
+		mockSubjectRepo := mocks.NewPairwiseSubjectRepositoryMock(t)
+		mockSubjectRepo.EXPECT().GetByUser(ctx, mock.AnythingOfType("string"), userID).Return(nil, ports.ErrNotFound).Times(2)
+		mockSubjectRepo.EXPECT().Create(ctx, mock.AnythingOfType("*domain.PairwiseSubject")).Return(nil).Times(2)
+
+		subjectService := &SubjectServiceImpl{
+			pairwiseSubjectRepository: mockSubjectRepo,
+			config:                    &config.Config{JWT: config.JWT{PairwiseSubjectSalt: "test-pairwise-subject-salt-of-32-chars"}},
+		}
+
+		// Act
+		firstSubject, err := subjectService.GetSubject(ctx, first, userID)
+		require.NoError(t, err)
+		secondSubject, err := subjectService.GetSubject(ctx, second, userID)
+		require.NoError(t, err)
+
+		// Assert
+		assert.NotEqual(t, firstSubject, secondSubject)
+	})
+
+	t.Run("should return the recorded pairwise subject without saving it again", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{
+			ClientID:     "client-123",
+			RedirectURIs: []string{"https://app.example.com/callback"},
+			SubjectType:  domain.SubjectTypePairwise,
+		}
+
+		mockSubjectRepo := mocks.NewPairwiseSubjectRepositoryMock(t)
+		mockSubjectRepo.EXPECT().
+			GetByUser(ctx, "app.example.com", userID).
+			Return(&domain.PairwiseSubject{SectorIdentifier: "app.example.com", Subject: "pairwise-subject", UserID: userID}, nil)
+
+		subjectService := &SubjectServiceImpl{pairwiseSubjectRepository: mockSubjectRepo}
+
+		// Act
+		subject, err := subjectService.GetSubject(ctx, client, userID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "pairwise-subject", subject)
+	})
+
+	t.Run("should fail to compute a pairwise subject without a long enough salt", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:     uuid.New().String(),
+			RedirectURIs: []string{"https://app.example.com/callback"},
+			SubjectType:  domain.SubjectTypePairwise,
+		}
+
+		mockSubjectRepo := mocks.NewPairwiseSubjectRepositoryMock(t)
+		mockSubjectRepo.EXPECT().GetByUser(ctx, "app.example.com", mock.AnythingOfType("uuid.UUID")).Return(nil, ports.ErrNotFound)
+
+		subjectService := &SubjectServiceImpl{
+			pairwiseSubjectRepository: mockSubjectRepo,
+			config:                    &config.Config{JWT: config.JWT{PairwiseSubjectSalt: "short-salt"}},
+		}
+
+		// Act
+		subject, err := subjectService.GetSubject(ctx, client, uuid.New())
+
+		// Assert
+		assert.Empty(t, subject)
+		assert.Error(t, err)
+	})
+}
+
+func TestResolveUserID(t *testing.T) {
+	t.Run("should map a pairwise subject back to the user", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{
+			ClientID:     uuid.New().String(),
+			RedirectURIs: []string{"https://app.example.com/callback"},
+			SubjectType:  domain.SubjectTypePairwise,
+		}
+
+		mockSubjectRepo := mocks.NewPairwiseSubjectRepositoryMock(t)
+		mockSubjectRepo.EXPECT().
+			GetBySubject(ctx, "app.example.com", "pairwise-subject").
+			Return(&domain.PairwiseSubject{SectorIdentifier: "app.example.com", Subject: "pairwise-subject", UserID: userID}, nil)
+
+		subjectService := &SubjectServiceImpl{
+			pairwiseSubjectRepository: mockSubjectRepo,
+			config:                    &config.Config{JWT: config.JWT{PairwiseSubjectSalt: "test-pairwise-subject-salt-of-32-chars"}},
+		}
+
+		// Act
+		resolvedUserID, err := subjectService.ResolveUserID(ctx, client, "pairwise-subject")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, userID, resolvedUserID)
+	})
+
+	t.Run("should return ErrSubjectNotFound for an unknown pairwise subject", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:     uuid.New().String(),
+			RedirectURIs: []string{"https://app.example.com/callback"},
+			SubjectType:  domain.SubjectTypePairwise,
+		}
+
+		mockSubjectRepo := mocks.NewPairwiseSubjectRepositoryMock(t)
+		mockSubjectRepo.EXPECT().
+			GetBySubject(ctx, "app.example.com", "unknown").
+			Return(nil, ports.ErrNotFound)
+
+		subjectService := &SubjectServiceImpl{
+			pairwiseSubjectRepository: mockSubjectRepo,
+			config:                    &config.Config{JWT: config.JWT{PairwiseSubjectSalt: "test-pairwise-subject-salt-of-32-chars"}},
+		}
+
+		// Act
+		_, err := subjectService.ResolveUserID(ctx, client, "unknown")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrSubjectNotFound)
+	})
+}
+
+func TestValidateSectorIdentifier(t *testing.T) {
+	t.Run("should require a sector identifier URI when redirect URIs span several hosts", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:     uuid.New().String(),
+			RedirectURIs: []string{"https://app.example.com/callback", "https://admin.example.com/callback"},
+			SubjectType:  domain.SubjectTypePairwise,
+		}
+
+		subjectService := &SubjectServiceImpl{config: &config.Config{JWT: config.JWT{PairwiseSubjectSalt: "test-pairwise-subject-salt-of-32-chars"}}}
+
+		// Act
+		err := subjectService.ValidateSectorIdentifier(ctx, client)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidSectorIdentifier)
+	})
+
+	t.Run("should accept a sector identifier URI listing every redirect URI", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:            uuid.New().String(),
+			RedirectURIs:        []string{"https://app.example.com/callback", "https://admin.example.com/callback"},
+			SubjectType:         domain.SubjectTypePairwise,
+			SectorIdentifierURI: "https://example.com/sector.json",
+		}
+
+		mockFetcher := mocks.NewSectorIdentifierFetcherMock(t)
+		mockFetcher.EXPECT().
+			FetchRedirectURIs(ctx, client.SectorIdentifierURI).
+			Return([]string{"https://app.example.com/callback", "https://admin.example.com/callback"}, nil)
+
+		subjectService := &SubjectServiceImpl{
+			sectorIdentifierFetcher: mockFetcher,
+			config:                  &config.Config{JWT: config.JWT{PairwiseSubjectSalt: "test-pairwise-subject-salt-of-32-chars"}},
+		}
+
+		// Act
+		err := subjectService.ValidateSectorIdentifier(ctx, client)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a sector identifier URI missing a redirect URI", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:            uuid.New().String(),
+			RedirectURIs:        []string{"https://app.example.com/callback", "https://admin.example.com/callback"},
+			SubjectType:         domain.SubjectTypePairwise,
+			SectorIdentifierURI: "https://example.com/sector.json",
+		}
+
+		mockFetcher := mocks.NewSectorIdentifierFetcherMock(t)
+		mockFetcher.EXPECT().
+			FetchRedirectURIs(ctx, client.SectorIdentifierURI).
+			Return([]string{"https://app.example.com/callback"}, nil)
+
+		subjectService := &SubjectServiceImpl{
+			sectorIdentifierFetcher: mockFetcher,
+			config:                  &config.Config{JWT: config.JWT{PairwiseSubjectSalt: "test-pairwise-subject-salt-of-32-chars"}},
+		}
+
+		// Act
+		err := subjectService.ValidateSectorIdentifier(ctx, client)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidSectorIdentifier)
+	})
+
+	t.Run("should reject a sector identifier URI not served over https", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:            uuid.New().String(),
+			RedirectURIs:        []string{"https://app.example.com/callback"},
+			SubjectType:         domain.SubjectTypePairwise,
+			SectorIdentifierURI: "http://example.com/sector.json",
+		}
+
+		subjectService := &SubjectServiceImpl{config: &config.Config{JWT: config.JWT{PairwiseSubjectSalt: "test-pairwise-subject-salt-of-32-chars"}}}
+
+		// Act
+		err := subjectService.ValidateSectorIdentifier(ctx, client)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidSectorIdentifier)
+	})
+
+	t.Run("should reject a pairwise client without a long enough salt", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:     uuid.New().String(),
+			RedirectURIs: []string{"https://app.example.com/callback"},
+			SubjectType:  domain.SubjectTypePairwise,
+		}
+
+		subjectService := &SubjectServiceImpl{config: &config.Config{}}
+
+		// Act
+		err := subjectService.ValidateSectorIdentifier(ctx, client)
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
@@ -2,68 +2,234 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/g-villarinho/oidc-server/internal/config"
 	"github.com/g-villarinho/oidc-server/internal/core/domain"
 	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
 )
 
-type TokenResponse struct {
-	AccessToken  string `json:"access_token"`
-	TokenType    string `json:"token_type"`
-	ExpiresIn    int64  `json:"expires_in"`
-	RefreshToken string `json:"refresh_token,omitempty"`
-	IDToken      string `json:"id_token,omitempty"`
-}
-
 type TokenService interface {
-	CreateTokens(ctx context.Context, params domain.CreateTokenParams) (*TokenResponse, error)
+	CreateTokens(ctx context.Context, params domain.CreateTokenParams) (*domain.TokenResponse, error)
+	RefreshTokens(ctx context.Context, params domain.RefreshTokenParams) (*domain.TokenResponse, error)
+	CreateAccessToken(ctx context.Context, params domain.CreateTokenParams) (*domain.TokenResponse, error)
+	CreateExchangedToken(ctx context.Context, params domain.CreateTokenParams, notAfter time.Time) (*domain.TokenResponse, error)
+	CreateIDToken(ctx context.Context, params domain.CreateTokenParams, accessToken, code string) (string, error)
 }
 
 type TokenServiceImpl struct {
-	tokenRepository ports.TokenRepository
-	tokenGenerator  ports.TokenGenerator
-	userRepository  ports.UserRepository
-	config          *config.Config
+	tokenRepository      ports.TokenRepository
+	tokenGenerator       ports.TokenGenerator
+	pasetoTokenGenerator ports.PASETOTokenGenerator
+	userRepository       ports.UserRepository
+	clientRepository     ports.ClientRepository
+	sessionRepository    ports.SessionRepository
+	subjectService       SubjectService
+	scopeService         ScopeService
+	resourceService      ResourceService
+	encryptionService    ResponseEncryptionService
+	config               *config.Config
 }
 
 func NewTokenService(
 	tokenRepository ports.TokenRepository,
 	tokenGenerator ports.TokenGenerator,
+	pasetoTokenGenerator ports.PASETOTokenGenerator,
 	userRepository ports.UserRepository,
+	clientRepository ports.ClientRepository,
+	sessionRepository ports.SessionRepository,
+	subjectService SubjectService,
+	scopeService ScopeService,
+	resourceService ResourceService,
+	encryptionService ResponseEncryptionService,
 	cfg *config.Config,
 ) TokenService {
 	return &TokenServiceImpl{
-		tokenRepository: tokenRepository,
-		tokenGenerator:  tokenGenerator,
-		userRepository:  userRepository,
-		config:          cfg,
+		tokenRepository:      tokenRepository,
+		tokenGenerator:       tokenGenerator,
+		pasetoTokenGenerator: pasetoTokenGenerator,
+		userRepository:       userRepository,
+		clientRepository:     clientRepository,
+		sessionRepository:    sessionRepository,
+		subjectService:       subjectService,
+		scopeService:         scopeService,
+		resourceService:      resourceService,
+		encryptionService:    encryptionService,
+		config:               cfg,
 	}
 }
 
-func (s *TokenServiceImpl) CreateTokens(ctx context.Context, params domain.CreateTokenParams) (*TokenResponse, error) {
-	accessToken, err := s.tokenGenerator.GenerateAccessToken(ctx, params.UserID, params.ClientID, params.Scopes)
+func (s *TokenServiceImpl) CreateTokens(ctx context.Context, params domain.CreateTokenParams) (*domain.TokenResponse, error) {
+	client, subject, err := s.getClientAndSubject(ctx, params.ClientID, params.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("generate access token: %w", err)
+		return nil, err
+	}
+
+	if err := verifyDPoPBinding(client, params.DPoPJKT); err != nil {
+		return nil, err
 	}
 
-	refreshToken, err := s.tokenGenerator.GenerateRefreshToken(ctx)
+	params.CertificateThumbprint, err = certificateBinding(client, params.CertificateThumbprint)
 	if err != nil {
-		return nil, fmt.Errorf("generate refresh token: %w", err)
+		return nil, err
 	}
 
-	var idToken string
-	if slices.Contains(params.Scopes, "openid") {
-		user, err := s.userRepository.GetByID(ctx, params.UserID)
+	policy := s.tokenPolicy(client)
+
+	refreshTokenLifetime := policy.RefreshTokenLifetime
+	if slices.Contains(params.Scopes, domain.ScopeOfflineAccess) && s.config.JWT.OfflineTokenDuration > 0 {
+		refreshTokenLifetime = s.config.JWT.OfflineTokenDuration
+	}
+
+	return s.issueTokens(ctx, params, client, subject, policy, refreshTokenLifetime)
+}
+
+// RefreshTokens rotates a refresh token.
+func (s *TokenServiceImpl) RefreshTokens(ctx context.Context, params domain.RefreshTokenParams) (*domain.TokenResponse, error) {
+	token, err := s.tokenRepository.GetByRefreshTokenHash(ctx, domain.HashToken(params.RefreshToken))
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrInvalidToken
+		}
+
+		return nil, fmt.Errorf("get token by refresh token: %w", err)
+	}
+
+	if token.ClientID != params.ClientID {
+		return nil, domain.ErrUnauthorizedClient
+	}
+
+	if token.IsRevoked() {
+		return nil, s.revokeReusedToken(ctx, token)
+	}
+
+	resources, err := domain.NarrowResources(token.Resources, params.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationDetails, err := domain.NarrowAuthorizationDetails(token.AuthorizationDetails, params.AuthorizationDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	if !token.CanRefresh() {
+		return nil, domain.ErrRefreshExpired
+	}
+
+	client, subject, err := s.getClientAndSubject(ctx, token.ClientID, token.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refresh tokens of public clients require a proof of their DPoP key (RFC 9449 section 5).
+	if token.IsDPoPBound() && client.IsPublic() && params.DPoPJKT != token.DPoPJKT {
+		return nil, fmt.Errorf("%w: refresh token bound to another key", domain.ErrInvalidDPoPProof)
+	}
+
+	// The same goes for the certificate of a public client's mutual TLS connection (RFC 8705 section 4).
+	if token.IsCertificateBound() && client.IsPublic() && params.CertificateThumbprint != token.CertificateThumbprint {
+		return nil, fmt.Errorf("%w: refresh token bound to another certificate", domain.ErrInvalidClientCertificate)
+	}
+
+	if err := verifyDPoPBinding(client, params.DPoPJKT); err != nil {
+		return nil, err
+	}
+
+	params.CertificateThumbprint, err = certificateBinding(client, params.CertificateThumbprint)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := s.tokenPolicy(client)
+	if !policy.IssueRefreshTokens {
+		return nil, domain.ErrNoRefreshToken
+	}
+
+	if token.IsRefreshTokenIdle(policy.RefreshTokenIdleTimeout) {
+		return nil, domain.ErrRefreshExpired
+	}
+
+	if !token.Offline {
+		if err := s.verifyTokenSession(ctx, token); err != nil {
+			return nil, err
+		}
+	}
+
+	rotated, err := s.tokenRepository.RevokeActive(ctx, token.ID, "refresh token rotated")
+	if err != nil {
+		return nil, fmt.Errorf("revoke rotated token: %w", err)
+	}
+
+	// Another request rotated the token first.
+	if !rotated {
+		return nil, s.revokeReusedToken(ctx, token)
+	}
+
+	tokenParams := domain.CreateTokenParams{
+		UserID:                token.UserID,
+		ClientID:              token.ClientID,
+		Scopes:                token.Scopes,
+		AuthorizationCode:     token.AuthorizationCode,
+		Claims:                token.Claims,
+		SessionID:             token.SessionID,
+		Resources:             resources,
+		AuthTime:              token.AuthTime,
+		ACR:                   token.ACR,
+		AMR:                   token.AMR,
+		DPoPJKT:               params.DPoPJKT,
+		CertificateThumbprint: params.CertificateThumbprint,
+		AuthorizationDetails:  authorizationDetails,
+		FamilyID:              token.FamilyID,
+	}
+
+	return s.issueTokens(ctx, tokenParams, client, subject, policy, time.Until(token.RefreshTokenExpiresAt))
+}
+
+// revokeReusedToken revokes the whole grant of a refresh token presented after rotation.
+func (s *TokenServiceImpl) revokeReusedToken(ctx context.Context, token *domain.Token) error {
+	if err := s.tokenRepository.RevokeFamily(ctx, token.FamilyID, "refresh token reused"); err != nil {
+		return fmt.Errorf("revoke token family: %w", err)
+	}
+
+	return domain.ErrInvalidToken
+}
+
+func (s *TokenServiceImpl) issueTokens(
+	ctx context.Context,
+	params domain.CreateTokenParams,
+	client *domain.Client,
+	subject string,
+	policy domain.TokenPolicy,
+	refreshTokenLifetime time.Duration,
+) (*domain.TokenResponse, error) {
+	accessToken, err := s.generateAccessToken(ctx, params, subject, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refresh tokens are either offline grants or bound to the user's session.
+	offline := slices.Contains(params.Scopes, domain.ScopeOfflineAccess)
+
+	var refreshToken string
+	if policy.IssueRefreshTokens && (offline || params.SessionID != uuid.Nil) {
+		refreshToken, err = s.tokenGenerator.GenerateRefreshToken(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("get user for ID token: %w", err)
+			return nil, fmt.Errorf("generate refresh token: %w", err)
 		}
+	} else {
+		refreshTokenLifetime = 0
+	}
 
-		idToken, err = s.tokenGenerator.GenerateIDToken(ctx, user, params.ClientID, params.Nonce, params.Scopes)
+	var idToken string
+	if slices.Contains(params.Scopes, domain.ScopeOpenID) {
+		idToken, err = s.createIDToken(ctx, params, client, subject, policy.IDTokenLifetime, accessToken, "")
 		if err != nil {
-			return nil, fmt.Errorf("generate ID token: %w", err)
+			return nil, err
 		}
 	}
 
@@ -74,24 +240,308 @@ func (s *TokenServiceImpl) CreateTokens(ctx context.Context, params domain.Creat
 		params.ClientID,
 		params.UserID,
 		params.Scopes,
-		s.config.JWT.AccessTokenDuration,
-		s.config.JWT.RefreshTokenDuration,
+		policy.AccessTokenLifetime,
+		refreshTokenLifetime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create token domain: %w", err)
+	}
+
+	token.Claims = params.Claims
+	token.Resources = params.Resources
+	token.AuthTime = params.AuthTime
+	token.ACR = params.ACR
+	token.AMR = params.AMR
+	token.AuthorizationDetails = params.AuthorizationDetails
+	token.BindDPoPKey(params.DPoPJKT)
+	token.BindCertificate(params.CertificateThumbprint)
+	if params.FamilyID != uuid.Nil {
+		token.FamilyID = params.FamilyID
+	}
+	token.Offline = offline && refreshToken != ""
+	if !token.Offline {
+		token.SessionID = params.SessionID
+	}
+
+	if err := s.tokenRepository.Create(ctx, token); err != nil {
+		return nil, fmt.Errorf("save token: %w", err)
+	}
+
+	response := &domain.TokenResponse{
+		AccessToken:          accessToken,
+		TokenType:            token.TokenType,
+		ExpiresIn:            int64(policy.AccessTokenLifetime.Seconds()),
+		RefreshToken:         refreshToken,
+		IDToken:              idToken,
+		AuthorizationDetails: token.AuthorizationDetails,
+	}
+
+	return response, nil
+}
+
+func (s *TokenServiceImpl) CreateAccessToken(ctx context.Context, params domain.CreateTokenParams) (*domain.TokenResponse, error) {
+	client, subject, err := s.getClientAndSubject(ctx, params.ClientID, params.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyDPoPBinding(client, params.DPoPJKT); err != nil {
+		return nil, err
+	}
+
+	params.CertificateThumbprint, err = certificateBinding(client, params.CertificateThumbprint)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueAccessToken(ctx, params, subject, s.tokenPolicy(client))
+}
+
+// CreateExchangedToken issues the access token of a token exchange.
+func (s *TokenServiceImpl) CreateExchangedToken(ctx context.Context, params domain.CreateTokenParams, notAfter time.Time) (*domain.TokenResponse, error) {
+	client, subject, err := s.getClientAndSubject(ctx, params.ClientID, params.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyDPoPBinding(client, params.DPoPJKT); err != nil {
+		return nil, err
+	}
+
+	params.CertificateThumbprint, err = certificateBinding(client, params.CertificateThumbprint)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := s.tokenPolicy(client)
+	policy.AccessTokenLifetime = min(policy.AccessTokenLifetime, time.Until(notAfter).Truncate(time.Second))
+
+	response, err := s.issueAccessToken(ctx, params, subject, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	response.IssuedTokenType = domain.TokenTypeAccessToken
+	return response, nil
+}
+
+func (s *TokenServiceImpl) issueAccessToken(
+	ctx context.Context,
+	params domain.CreateTokenParams,
+	subject string,
+	policy domain.TokenPolicy,
+) (*domain.TokenResponse, error) {
+	accessToken, err := s.generateAccessToken(ctx, params, subject, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := domain.NewToken(
+		accessToken,
+		"",
+		params.AuthorizationCode,
+		params.ClientID,
+		params.UserID,
+		params.Scopes,
+		policy.AccessTokenLifetime,
+		0,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("create token domain: %w", err)
 	}
 
+	token.Claims = params.Claims
+	token.SessionID = params.SessionID
+	token.Resources = params.Resources
+	token.AuthTime = params.AuthTime
+	token.ACR = params.ACR
+	token.AMR = params.AMR
+	token.Actor = params.Actor
+	token.AuthorizationDetails = params.AuthorizationDetails
+	token.BindDPoPKey(params.DPoPJKT)
+	token.BindCertificate(params.CertificateThumbprint)
+
 	if err := s.tokenRepository.Create(ctx, token); err != nil {
 		return nil, fmt.Errorf("save token: %w", err)
 	}
 
-	response := &TokenResponse{
-		AccessToken:  accessToken,
-		TokenType:    domain.TokenTypeBearer,
-		ExpiresIn:    int64(s.config.JWT.AccessTokenDuration.Seconds()),
-		RefreshToken: refreshToken,
-		IDToken:      idToken,
+	response := &domain.TokenResponse{
+		AccessToken:          accessToken,
+		TokenType:            token.TokenType,
+		ExpiresIn:            int64(policy.AccessTokenLifetime.Seconds()),
+		AuthorizationDetails: token.AuthorizationDetails,
 	}
 
 	return response, nil
 }
+
+func (s *TokenServiceImpl) CreateIDToken(ctx context.Context, params domain.CreateTokenParams, accessToken, code string) (string, error) {
+	client, subject, err := s.getClientAndSubject(ctx, params.ClientID, params.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.createIDToken(ctx, params, client, subject, s.tokenPolicy(client).IDTokenLifetime, accessToken, code)
+}
+
+// createIDToken issues the ID token, nested in a JWE for clients that asked for encrypted ID tokens.
+func (s *TokenServiceImpl) createIDToken(ctx context.Context, params domain.CreateTokenParams, client *domain.Client, subject string, expiresIn time.Duration, accessToken, code string) (string, error) {
+	user, err := s.userRepository.GetByID(ctx, params.UserID)
+	if err != nil {
+		return "", fmt.Errorf("get user for ID token: %w", err)
+	}
+
+	scopeClaims, err := s.scopeService.GetScopeClaims(ctx, params.Scopes)
+	if err != nil {
+		return "", fmt.Errorf("get scope claims: %w", err)
+	}
+
+	idToken, err := s.tokenGenerator.GenerateIDToken(ctx, user, domain.IDTokenParams{
+		Subject:     subject,
+		ClientID:    params.ClientID,
+		ExpiresIn:   expiresIn,
+		Nonce:       params.Nonce,
+		Scopes:      params.Scopes,
+		Claims:      domain.ResolveClaims(user, scopeClaims, params.Claims.IDTokenClaims()),
+		AuthTime:    params.AuthTime,
+		ACR:         params.ACR,
+		AMR:         params.AMR,
+		SessionID:   params.SessionID,
+		AccessToken: accessToken,
+		Code:        code,
+	})
+	if err != nil {
+		return "", fmt.Errorf("generate ID token: %w", err)
+	}
+
+	if client.IDTokenEncryption.Enabled() {
+		idToken, err = s.encryptionService.EncryptIDToken(ctx, client, idToken)
+		if err != nil {
+			return "", fmt.Errorf("encrypt ID token: %w", err)
+		}
+	}
+
+	return idToken, nil
+}
+
+// generateAccessToken issues an access token in the format the resources of the request require, or else the client's.
+func (s *TokenServiceImpl) generateAccessToken(ctx context.Context, params domain.CreateTokenParams, subject string, policy domain.TokenPolicy) (string, error) {
+	var resources []*domain.APIResource
+	if len(params.Resources) > 0 {
+		var err error
+		resources, err = s.resourceService.GetResources(ctx, params.Resources)
+		if err != nil {
+			return "", fmt.Errorf("get token resources: %w", err)
+		}
+	}
+
+	format, err := domain.ResourceAccessTokenFormat(resources, policy.AccessTokenFormat)
+	if err != nil {
+		return "", err
+	}
+
+	if format == domain.AccessTokenFormatOpaque {
+		accessToken, err := s.tokenGenerator.GenerateOpaqueToken(ctx)
+		if err != nil {
+			return "", fmt.Errorf("generate opaque access token: %w", err)
+		}
+
+		return accessToken, nil
+	}
+
+	scopes := params.Scopes
+	if len(params.Resources) > 0 {
+		scopes = domain.ResourceScopes(resources, params.Scopes)
+	}
+
+	tokenGenerator := s.tokenGenerator
+	if format == domain.AccessTokenFormatPASETO {
+		tokenGenerator = s.pasetoTokenGenerator
+	}
+
+	accessToken, err := tokenGenerator.GenerateAccessToken(ctx, domain.AccessTokenParams{
+		Subject:               subject,
+		ClientID:              params.ClientID,
+		Scopes:                scopes,
+		Audience:              domain.ResourceAudience(params.Resources, s.config.JWT.Issuer),
+		AuthTime:              params.AuthTime,
+		ACR:                   params.ACR,
+		Actor:                 params.Actor,
+		DPoPJKT:               params.DPoPJKT,
+		CertificateThumbprint: params.CertificateThumbprint,
+		AuthorizationDetails:  params.AuthorizationDetails,
+		ExpiresIn:             policy.AccessTokenLifetime,
+	})
+	if err != nil {
+		return "", fmt.Errorf("generate access token: %w", err)
+	}
+
+	return accessToken, nil
+}
+
+// verifyDPoPBinding rejects requests without a DPoP proof from DPoP-bound clients.
+func verifyDPoPBinding(client *domain.Client, jkt string) error {
+	if client.DPoPBoundAccessTokens && jkt == "" {
+		return fmt.Errorf("%w: the client requires DPoP", domain.ErrInvalidDPoPProof)
+	}
+	return nil
+}
+
+// certificateBinding returns the thumbprint of the client certificate the tokens are bound to.
+func certificateBinding(client *domain.Client, thumbprint string) (string, error) {
+	if !client.TLSClientCertificateBoundAccessTokens {
+		return "", nil
+	}
+
+	if thumbprint == "" {
+		return "", fmt.Errorf("%w: the client requires certificate-bound tokens", domain.ErrInvalidClientCertificate)
+	}
+
+	return thumbprint, nil
+}
+
+// verifyTokenSession checks that the session a refresh token is bound to is still active for the token's user.
+func (s *TokenServiceImpl) verifyTokenSession(ctx context.Context, token *domain.Token) error {
+	if token.SessionID == uuid.Nil {
+		return domain.ErrRefreshExpired
+	}
+
+	session, err := s.sessionRepository.GetByID(ctx, token.SessionID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return domain.ErrRefreshExpired
+		}
+
+		return fmt.Errorf("get token session: %w", err)
+	}
+
+	if session.UserID != token.UserID || session.IsExpired() {
+		return domain.ErrRefreshExpired
+	}
+
+	return nil
+}
+
+func (s *TokenServiceImpl) getClientAndSubject(ctx context.Context, clientID string, userID uuid.UUID) (*domain.Client, string, error) {
+	client, err := s.clientRepository.GetByClientID(ctx, clientID)
+	if err != nil {
+		return nil, "", fmt.Errorf("get client for subject: %w", err)
+	}
+
+	subject, err := s.subjectService.GetSubject(ctx, client, userID)
+	if err != nil {
+		return nil, "", fmt.Errorf("get subject: %w", err)
+	}
+
+	return client, subject, nil
+}
+
+// tokenPolicy resolves the client's token policy against the server defaults.
+func (s *TokenServiceImpl) tokenPolicy(client *domain.Client) domain.TokenPolicy {
+	return client.TokenPolicy.Resolve(domain.TokenPolicy{
+		AccessTokenLifetime:     s.config.JWT.AccessTokenDuration,
+		RefreshTokenLifetime:    s.config.JWT.RefreshTokenDuration,
+		RefreshTokenIdleTimeout: s.config.JWT.RefreshTokenIdleTimeout,
+		IDTokenLifetime:         s.config.JWT.IDTokenDuration,
+	})
+}
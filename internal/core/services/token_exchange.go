@@ -0,0 +1,281 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type TokenExchangeService interface {
+	ExchangeToken(ctx context.Context, params domain.ExchangeTokenParams) (*domain.TokenResponse, error)
+}
+
+type TokenExchangeServiceImpl struct {
+	clientService     ClientService
+	clientRepository  ports.ClientRepository
+	tokenRepository   ports.TokenRepository
+	tokenGenerator    ports.TokenGenerator
+	subjectService    SubjectService
+	tokenService      TokenService
+	federationService FederationService
+	resourceService   ResourceService
+}
+
+func NewTokenExchangeService(
+	clientService ClientService,
+	clientRepository ports.ClientRepository,
+	tokenRepository ports.TokenRepository,
+	tokenGenerator ports.TokenGenerator,
+	subjectService SubjectService,
+	tokenService TokenService,
+	federationService FederationService,
+	resourceService ResourceService,
+) TokenExchangeService {
+	return &TokenExchangeServiceImpl{
+		clientService:     clientService,
+		clientRepository:  clientRepository,
+		tokenRepository:   tokenRepository,
+		tokenGenerator:    tokenGenerator,
+		subjectService:    subjectService,
+		tokenService:      tokenService,
+		federationService: federationService,
+		resourceService:   resourceService,
+	}
+}
+
+// ExchangeToken implements the RFC 8693 token exchange grant.
+func (s *TokenExchangeServiceImpl) ExchangeToken(ctx context.Context, params domain.ExchangeTokenParams) (*domain.TokenResponse, error) {
+	if params.SubjectTokenType == domain.TokenTypeJWT {
+		return s.exchangeFederatedToken(ctx, params)
+	}
+
+	client, err := s.clientService.AuthenticateClient(ctx, params.ClientCredentials())
+	if err != nil {
+		return nil, fmt.Errorf("authenticate exchanging client: %w", err)
+	}
+
+	if !client.SupportsGrantType(domain.GrantTypeTokenExchange) {
+		return nil, domain.ErrUnauthorizedClient
+	}
+
+	targets := params.ExchangeTargets()
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("%w: no audience requested", domain.ErrInvalidTarget)
+	}
+
+	for _, target := range targets {
+		if !client.CanExchangeFor(target) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInvalidTarget, target)
+		}
+	}
+
+	subject, err := s.resolveToken(ctx, params.SubjectToken, params.SubjectTokenType)
+	if err != nil {
+		return nil, err
+	}
+
+	if subject.UserID == uuid.Nil {
+		return nil, fmt.Errorf("%w: subject token has no user", domain.ErrInvalidSubjectToken)
+	}
+
+	if params.SubjectTokenType == domain.TokenTypeIDToken && subject.ClientID != client.ClientID {
+		return nil, fmt.Errorf("%w: ID token was issued to another client", domain.ErrInvalidSubjectToken)
+	}
+
+	var clientResources []*domain.APIResource
+	if subject.ClientID != client.ClientID {
+		clientResources, err = s.resourceService.ListResourcesByClientID(ctx, client.ClientID)
+		if err != nil {
+			return nil, fmt.Errorf("list resources of exchanging client: %w", err)
+		}
+	}
+
+	if !subject.CanBeExchangedBy(client, clientResources) {
+		return nil, fmt.Errorf("%w: access token was issued to another client", domain.ErrInvalidSubjectToken)
+	}
+
+	if !subject.IsConfirmedBy(domain.Confirmation{JKT: params.DPoPJKT, CertificateThumbprint: params.CertificateThumbprint()}) {
+		return nil, fmt.Errorf("%w: subject token is bound to another key", domain.ErrInvalidSubjectToken)
+	}
+
+	scopes, err := domain.DownscopeScopes(client.AllowedScopes(subject.Scopes), params.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	var actor *domain.Actor
+	if params.ActorToken != "" {
+		actor, err = s.resolveActor(ctx, client, params.ActorToken, params.ActorTokenType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tokenResponse, err := s.tokenService.CreateExchangedToken(ctx, domain.CreateTokenParams{
+		UserID:                subject.UserID,
+		ClientID:              client.ClientID,
+		Scopes:                scopes,
+		Resources:             targets,
+		AuthTime:              subject.AuthTime,
+		ACR:                   subject.ACR,
+		Actor:                 actor.Delegate(subject.Actor),
+		DPoPJKT:               params.DPoPJKT,
+		CertificateThumbprint: params.CertificateThumbprint(),
+	}, subject.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("create exchanged token: %w", err)
+	}
+
+	return tokenResponse, nil
+}
+
+// resolveActor identifies the party behind the actor token, as seen by the client the exchanged token is issued to.
+func (s *TokenExchangeServiceImpl) resolveActor(ctx context.Context, client *domain.Client, token, tokenType string) (*domain.Actor, error) {
+	exchanged, err := s.resolveToken(ctx, token, tokenType)
+	if err != nil {
+		return nil, err
+	}
+
+	// A client acting on its own behalf is identified by its client ID.
+	if exchanged.UserID == uuid.Nil {
+		return &domain.Actor{Subject: exchanged.ClientID, ClientID: exchanged.ClientID}, nil
+	}
+
+	subject, err := s.subjectService.GetSubject(ctx, client, exchanged.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get actor subject: %w", err)
+	}
+
+	return &domain.Actor{Subject: subject, ClientID: exchanged.ClientID}, nil
+}
+
+// exchangeFederatedToken implements workload identity federation.
+func (s *TokenExchangeServiceImpl) exchangeFederatedToken(ctx context.Context, params domain.ExchangeTokenParams) (*domain.TokenResponse, error) {
+	if params.ActorToken != "" {
+		return nil, fmt.Errorf("%w: actor tokens aren't supported with federated tokens", domain.ErrInvalidSubjectToken)
+	}
+
+	identity, err := s.federationService.ResolveFederatedIdentity(ctx, params.SubjectToken, params.ClientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUntrustedToken) {
+			return nil, fmt.Errorf("%w: %w", domain.ErrInvalidSubjectToken, err)
+		}
+
+		return nil, fmt.Errorf("resolve federated identity: %w", err)
+	}
+
+	client, err := s.clientRepository.GetByClientID(ctx, identity.Policy.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get federated client: %w", err)
+	}
+
+	if !client.SupportsGrantType(domain.GrantTypeTokenExchange) {
+		return nil, domain.ErrUnauthorizedClient
+	}
+
+	targets := params.ExchangeTargets()
+	for _, target := range targets {
+		if !client.CanExchangeFor(target) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInvalidTarget, target)
+		}
+	}
+
+	scopes, err := domain.DownscopeScopes(identity.Policy.Scopes, params.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenResponse, err := s.tokenService.CreateExchangedToken(ctx, domain.CreateTokenParams{
+		ClientID:              client.ClientID,
+		Scopes:                scopes,
+		Resources:             targets,
+		AuthTime:              time.Now().UTC(),
+		Actor:                 &domain.Actor{Subject: identity.Claims.Subject, Issuer: identity.Claims.Issuer},
+		DPoPJKT:               params.DPoPJKT,
+		CertificateThumbprint: params.CertificateThumbprint(),
+	}, identity.Claims.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("create federated token: %w", err)
+	}
+
+	return tokenResponse, nil
+}
+
+// resolveToken validates a subject or actor token issued by this server.
+func (s *TokenExchangeServiceImpl) resolveToken(ctx context.Context, token, tokenType string) (*domain.ExchangedToken, error) {
+	switch tokenType {
+	case domain.TokenTypeAccessToken:
+		return s.resolveAccessToken(ctx, token)
+	case domain.TokenTypeIDToken:
+		return s.resolveIDToken(ctx, token)
+	default:
+		return nil, fmt.Errorf("%w: unsupported token type %q", domain.ErrInvalidSubjectToken, tokenType)
+	}
+}
+
+func (s *TokenExchangeServiceImpl) resolveAccessToken(ctx context.Context, accessToken string) (*domain.ExchangedToken, error) {
+	token, err := s.tokenRepository.GetByAccessTokenHash(ctx, domain.HashToken(accessToken))
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrInvalidSubjectToken
+		}
+
+		return nil, fmt.Errorf("get exchanged access token: %w", err)
+	}
+
+	if !token.IsValid() {
+		return nil, domain.ErrInvalidSubjectToken
+	}
+
+	return &domain.ExchangedToken{
+		UserID:       token.UserID,
+		ClientID:     token.ClientID,
+		Scopes:       token.Scopes,
+		Resources:    token.Resources,
+		Actor:        token.Actor,
+		AuthTime:     token.AuthTime,
+		ACR:          token.ACR,
+		ExpiresAt:    token.AccessTokenExpiresAt,
+		Confirmation: token.Confirmation(),
+	}, nil
+}
+
+// resolveIDToken maps the subject of an ID token back to the user.
+func (s *TokenExchangeServiceImpl) resolveIDToken(ctx context.Context, idToken string) (*domain.ExchangedToken, error) {
+	claims, err := s.tokenGenerator.ParseIDToken(ctx, idToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidSubjectToken, err)
+	}
+
+	client, err := s.clientRepository.GetByClientID(ctx, claims.ClientID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrInvalidSubjectToken
+		}
+
+		return nil, fmt.Errorf("get ID token client: %w", err)
+	}
+
+	userID, err := s.subjectService.ResolveUserID(ctx, client, claims.Subject)
+	if err != nil {
+		if errors.Is(err, domain.ErrSubjectNotFound) {
+			return nil, domain.ErrInvalidSubjectToken
+		}
+
+		return nil, fmt.Errorf("resolve ID token subject: %w", err)
+	}
+
+	return &domain.ExchangedToken{
+		UserID:    userID,
+		ClientID:  client.ClientID,
+		Scopes:    []string{domain.ScopeOpenID},
+		AuthTime:  claims.AuthTime,
+		ACR:       claims.ACR,
+		ExpiresAt: claims.ExpiresAt,
+	}, nil
+}
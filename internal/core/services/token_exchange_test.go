@@ -0,0 +1,787 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeToken(t *testing.T) {
+	const audience = "https://payments.example.com"
+
+	credentials := domain.ClientCredentials{ClientID: "orders-service", ClientSecret: "secret"}
+
+	t.Run("should issue a downscoped token for the requested audience", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+		subjectToken := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("subject-token"),
+			ClientID:             "web-app",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid", "payments:read", "payments:write"},
+			Resources:            []string{"https://orders.example.com"},
+			ACR:                  domain.ACRPassword,
+			AccessTokenExpiresAt: time.Now().UTC().Add(10 * time.Minute),
+		}
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeAccessToken,
+			Audiences:        []string{audience},
+			Scopes:           []string{"payments:read"},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("subject-token")).Return(subjectToken, nil)
+
+		expected := &domain.TokenResponse{AccessToken: "exchanged-token", IssuedTokenType: domain.TokenTypeAccessToken}
+		mockTokenService := mocks.NewTokenServiceMock(t)
+		mockTokenService.EXPECT().
+			CreateExchangedToken(ctx, domain.CreateTokenParams{
+				UserID:    subjectToken.UserID,
+				ClientID:  client.ClientID,
+				Scopes:    []string{"payments:read"},
+				Resources: []string{audience},
+				ACR:       domain.ACRPassword,
+			}, subjectToken.AccessTokenExpiresAt).
+			Return(expected, nil)
+
+		mockResourceService := mocks.NewResourceServiceMock(t)
+		mockResourceService.EXPECT().
+			ListResourcesByClientID(ctx, client.ClientID).
+			Return([]*domain.APIResource{{Identifier: "https://orders.example.com", ClientID: client.ClientID}}, nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{
+			clientService:   mockClientService,
+			tokenRepository: mockTokenRepo,
+			tokenService:    mockTokenService,
+			resourceService: mockResourceService,
+		}
+
+		// Act
+		response, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, expected, response)
+	})
+
+	t.Run("should record the actor on top of the subject token delegation chain", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+		subjectToken := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("subject-token"),
+			ClientID:             "web-app",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid", "payments:read", "payments:write"},
+			Resources:            []string{"https://orders.example.com"},
+			ACR:                  domain.ACRPassword,
+			AccessTokenExpiresAt: time.Now().UTC().Add(10 * time.Minute),
+			Actor:                &domain.Actor{Subject: "gateway", ClientID: "api-gateway"},
+		}
+		actorToken := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("actor-token"),
+			ClientID:             "orders-service",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid", "payments:read", "payments:write"},
+			ACR:                  domain.ACRPassword,
+			AccessTokenExpiresAt: time.Now().UTC().Add(10 * time.Minute),
+		}
+
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeAccessToken,
+			Audiences:        []string{audience},
+			ActorToken:       "actor-token",
+			ActorTokenType:   domain.TokenTypeAccessToken,
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("subject-token")).Return(subjectToken, nil)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("actor-token")).Return(actorToken, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, actorToken.UserID).Return("orders-bot", nil)
+
+		var issued domain.CreateTokenParams
+		mockTokenService := mocks.NewTokenServiceMock(t)
+		mockTokenService.EXPECT().
+			CreateExchangedToken(ctx, mock.AnythingOfType("domain.CreateTokenParams"), subjectToken.AccessTokenExpiresAt).
+			Run(func(ctx context.Context, params domain.CreateTokenParams, notAfter time.Time) { issued = params }).
+			Return(&domain.TokenResponse{AccessToken: "exchanged-token"}, nil)
+
+		mockResourceService := mocks.NewResourceServiceMock(t)
+		mockResourceService.EXPECT().
+			ListResourcesByClientID(ctx, client.ClientID).
+			Return([]*domain.APIResource{{Identifier: "https://orders.example.com", ClientID: client.ClientID}}, nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{
+			clientService:   mockClientService,
+			tokenRepository: mockTokenRepo,
+			subjectService:  mockSubjectService,
+			tokenService:    mockTokenService,
+			resourceService: mockResourceService,
+		}
+
+		// Act
+		_, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, &domain.Actor{
+			Subject:  "orders-bot",
+			ClientID: "orders-service",
+			Actor:    &domain.Actor{Subject: "gateway", ClientID: "api-gateway"},
+		}, issued.Actor)
+		assert.Equal(t, subjectToken.Scopes, issued.Scopes)
+	})
+
+	t.Run("should resolve the user behind an ID token subject with only openid", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+		userID := uuid.New()
+		expiresAt := time.Now().UTC().Add(time.Hour)
+
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeIDToken,
+			Audiences:        []string{audience},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			ParseIDToken(ctx, "subject-token").
+			Return(&domain.IDTokenClaims{Subject: "pairwise-subject", ClientID: "orders-service", ExpiresAt: expiresAt}, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "orders-service").Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().ResolveUserID(ctx, client, "pairwise-subject").Return(userID, nil)
+
+		mockTokenService := mocks.NewTokenServiceMock(t)
+		mockTokenService.EXPECT().
+			CreateExchangedToken(ctx, domain.CreateTokenParams{
+				UserID:    userID,
+				ClientID:  client.ClientID,
+				Scopes:    []string{"openid"},
+				Resources: []string{audience},
+			}, expiresAt).
+			Return(&domain.TokenResponse{AccessToken: "exchanged-token"}, nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{
+			clientService:    mockClientService,
+			clientRepository: mockClientRepo,
+			tokenGenerator:   mockTokenGenerator,
+			subjectService:   mockSubjectService,
+			tokenService:     mockTokenService,
+		}
+
+		// Act
+		_, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject scopes beyond openid for an ID token subject", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeIDToken,
+			Audiences:        []string{audience},
+			Scopes:           []string{"payments:read"},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			ParseIDToken(ctx, "subject-token").
+			Return(&domain.IDTokenClaims{Subject: "pairwise-subject", ClientID: "orders-service"}, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "orders-service").Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().ResolveUserID(ctx, client, "pairwise-subject").Return(uuid.New(), nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{
+			clientService:    mockClientService,
+			clientRepository: mockClientRepo,
+			tokenGenerator:   mockTokenGenerator,
+			subjectService:   mockSubjectService,
+		}
+
+		// Act
+		_, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidScope)
+	})
+
+	t.Run("should reject an ID token issued to another client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		idTokenClient := &domain.Client{ClientID: "web-app", Scopes: []string{"openid", "payments:read"}}
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeIDToken,
+			Audiences:        []string{audience},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			ParseIDToken(ctx, "subject-token").
+			Return(&domain.IDTokenClaims{Subject: "pairwise-subject", ClientID: "web-app"}, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "web-app").Return(idTokenClient, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().ResolveUserID(ctx, idTokenClient, "pairwise-subject").Return(uuid.New(), nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{
+			clientService:    mockClientService,
+			clientRepository: mockClientRepo,
+			tokenGenerator:   mockTokenGenerator,
+			subjectService:   mockSubjectService,
+		}
+
+		// Act
+		_, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidSubjectToken)
+	})
+
+	t.Run("should drop subject scopes the exchanging client isn't registered for", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"payments:read"},
+			ExchangeAudiences: []string{audience},
+		}
+		subjectToken := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("subject-token"),
+			ClientID:             "web-app",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid", "payments:read", "payments:write"},
+			Resources:            []string{"https://orders.example.com"},
+			ACR:                  domain.ACRPassword,
+			AccessTokenExpiresAt: time.Now().UTC().Add(10 * time.Minute),
+		}
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeAccessToken,
+			Audiences:        []string{audience},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("subject-token")).Return(subjectToken, nil)
+
+		var issued domain.CreateTokenParams
+		mockTokenService := mocks.NewTokenServiceMock(t)
+		mockTokenService.EXPECT().
+			CreateExchangedToken(ctx, mock.AnythingOfType("domain.CreateTokenParams"), subjectToken.AccessTokenExpiresAt).
+			Run(func(ctx context.Context, params domain.CreateTokenParams, notAfter time.Time) { issued = params }).
+			Return(&domain.TokenResponse{AccessToken: "exchanged-token"}, nil)
+
+		mockResourceService := mocks.NewResourceServiceMock(t)
+		mockResourceService.EXPECT().
+			ListResourcesByClientID(ctx, client.ClientID).
+			Return([]*domain.APIResource{{Identifier: "https://orders.example.com", ClientID: client.ClientID}}, nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{
+			clientService:   mockClientService,
+			tokenRepository: mockTokenRepo,
+			tokenService:    mockTokenService,
+			resourceService: mockResourceService,
+		}
+
+		// Act
+		_, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"payments:read"}, issued.Scopes)
+	})
+
+	t.Run("should issue a client token for a workload from a trusted issuer", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+		expiresAt := time.Now().UTC().Add(5 * time.Minute)
+
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			SubjectToken:     "external-token",
+			SubjectTokenType: domain.TokenTypeJWT,
+			Audiences:        []string{audience},
+		}
+
+		mockFederationService := mocks.NewFederationServiceMock(t)
+		mockFederationService.EXPECT().
+			ResolveFederatedIdentity(ctx, "external-token", "").
+			Return(&domain.FederatedIdentity{
+				Claims: &domain.FederatedClaims{
+					Issuer:    "https://token.actions.githubusercontent.com",
+					Subject:   "repo:acme/orders:ref:refs/heads/main",
+					ExpiresAt: expiresAt,
+				},
+				Policy: &domain.TrustPolicy{ClientID: "orders-service", Scopes: []string{"payments:read"}},
+			}, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, "orders-service").Return(client, nil)
+
+		var issued domain.CreateTokenParams
+		mockTokenService := mocks.NewTokenServiceMock(t)
+		mockTokenService.EXPECT().
+			CreateExchangedToken(ctx, mock.AnythingOfType("domain.CreateTokenParams"), expiresAt).
+			Run(func(ctx context.Context, params domain.CreateTokenParams, notAfter time.Time) { issued = params }).
+			Return(&domain.TokenResponse{AccessToken: "exchanged-token"}, nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{
+			clientRepository:  mockClientRepo,
+			tokenService:      mockTokenService,
+			federationService: mockFederationService,
+		}
+
+		// Act
+		_, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, uuid.Nil, issued.UserID)
+		assert.Equal(t, "orders-service", issued.ClientID)
+		assert.Equal(t, []string{"payments:read"}, issued.Scopes)
+		assert.Equal(t, &domain.Actor{
+			Subject: "repo:acme/orders:ref:refs/heads/main",
+			Issuer:  "https://token.actions.githubusercontent.com",
+		}, issued.Actor)
+	})
+
+	t.Run("should reject a federated token no trust policy accepts", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			SubjectToken:     "external-token",
+			SubjectTokenType: domain.TokenTypeJWT,
+		}
+
+		mockFederationService := mocks.NewFederationServiceMock(t)
+		mockFederationService.EXPECT().
+			ResolveFederatedIdentity(ctx, "external-token", "").
+			Return(nil, domain.ErrUntrustedToken)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{federationService: mockFederationService}
+
+		// Act
+		response, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrInvalidSubjectToken)
+	})
+
+	t.Run("should reject an audience the client may not exchange for", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeAccessToken,
+			Audiences:        []string{"https://admin.example.com"},
+		}
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{clientService: mockClientService}
+
+		// Act
+		response, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrInvalidTarget)
+	})
+
+	t.Run("should reject a client not allowed to exchange tokens", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeAuthorizationCode},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeAccessToken,
+			Audiences:        []string{audience},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{clientService: mockClientService}
+
+		// Act
+		_, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedClient)
+	})
+
+	t.Run("should reject scopes beyond the subject token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeAccessToken,
+			Audiences:        []string{audience},
+			Scopes:           []string{"payments:admin"},
+		}
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+		subjectToken := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("subject-token"),
+			ClientID:             "web-app",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid", "payments:read", "payments:write"},
+			Resources:            []string{"https://orders.example.com"},
+			ACR:                  domain.ACRPassword,
+			AccessTokenExpiresAt: time.Now().UTC().Add(10 * time.Minute),
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("subject-token")).Return(subjectToken, nil)
+
+		mockResourceService := mocks.NewResourceServiceMock(t)
+		mockResourceService.EXPECT().
+			ListResourcesByClientID(ctx, client.ClientID).
+			Return([]*domain.APIResource{{Identifier: "https://orders.example.com", ClientID: client.ClientID}}, nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{
+			clientService:   mockClientService,
+			tokenRepository: mockTokenRepo,
+			resourceService: mockResourceService,
+		}
+
+		// Act
+		_, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidScope)
+	})
+
+	t.Run("should reject an access token the client was neither issued nor an audience of", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+		subjectToken := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("subject-token"),
+			ClientID:             "web-app",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid", "payments:read", "payments:write"},
+			Resources:            []string{"https://billing.example.com"},
+			ACR:                  domain.ACRPassword,
+			AccessTokenExpiresAt: time.Now().UTC().Add(10 * time.Minute),
+		}
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeAccessToken,
+			Audiences:        []string{audience},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("subject-token")).Return(subjectToken, nil)
+
+		mockResourceService := mocks.NewResourceServiceMock(t)
+		mockResourceService.EXPECT().
+			ListResourcesByClientID(ctx, client.ClientID).
+			Return([]*domain.APIResource{{Identifier: "https://orders.example.com", ClientID: client.ClientID}}, nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{
+			clientService:   mockClientService,
+			tokenRepository: mockTokenRepo,
+			resourceService: mockResourceService,
+		}
+
+		// Act
+		_, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidSubjectToken)
+	})
+
+	t.Run("should reject a DPoP-bound subject token without a proof of its key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+		subjectToken := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("subject-token"),
+			ClientID:             "orders-service",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid", "payments:read", "payments:write"},
+			ACR:                  domain.ACRPassword,
+			AccessTokenExpiresAt: time.Now().UTC().Add(10 * time.Minute),
+		}
+		subjectToken.BindDPoPKey("client-key-thumbprint")
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeAccessToken,
+			Audiences:        []string{audience},
+			DPoPJKT:          "attacker-key-thumbprint",
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("subject-token")).Return(subjectToken, nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{
+			clientService:   mockClientService,
+			tokenRepository: mockTokenRepo,
+		}
+
+		// Act
+		_, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidSubjectToken)
+	})
+
+	t.Run("should exchange a DPoP-bound subject token with a proof of its key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+		subjectToken := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("subject-token"),
+			ClientID:             "orders-service",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid", "payments:read", "payments:write"},
+			ACR:                  domain.ACRPassword,
+			AccessTokenExpiresAt: time.Now().UTC().Add(10 * time.Minute),
+		}
+		subjectToken.BindDPoPKey("client-key-thumbprint")
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeAccessToken,
+			Audiences:        []string{audience},
+			DPoPJKT:          "client-key-thumbprint",
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("subject-token")).Return(subjectToken, nil)
+
+		var issued domain.CreateTokenParams
+		mockTokenService := mocks.NewTokenServiceMock(t)
+		mockTokenService.EXPECT().
+			CreateExchangedToken(ctx, mock.AnythingOfType("domain.CreateTokenParams"), subjectToken.AccessTokenExpiresAt).
+			Run(func(ctx context.Context, params domain.CreateTokenParams, notAfter time.Time) { issued = params }).
+			Return(&domain.TokenResponse{AccessToken: "exchanged-token"}, nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{
+			clientService:   mockClientService,
+			tokenRepository: mockTokenRepo,
+			tokenService:    mockTokenService,
+		}
+
+		// Act
+		_, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "client-key-thumbprint", issued.DPoPJKT)
+	})
+
+	t.Run("should reject a revoked subject token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		subjectToken := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("subject-token"),
+			ClientID:             "web-app",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid", "payments:read", "payments:write"},
+			ACR:                  domain.ACRPassword,
+			AccessTokenExpiresAt: time.Now().UTC().Add(10 * time.Minute),
+		}
+		subjectToken.Revoke("logout")
+		client := &domain.Client{
+			ClientID:          "orders-service",
+			GrantTypes:        []string{domain.GrantTypeTokenExchange},
+			Scopes:            []string{"openid", "payments:read", "payments:write"},
+			ExchangeAudiences: []string{audience},
+		}
+		params := domain.ExchangeTokenParams{
+			GrantType:        domain.GrantTypeTokenExchange,
+			ClientID:         "orders-service",
+			ClientSecret:     "secret",
+			SubjectToken:     "subject-token",
+			SubjectTokenType: domain.TokenTypeAccessToken,
+			Audiences:        []string{audience},
+		}
+
+		mockClientService := mocks.NewClientServiceMock(t)
+		mockClientService.EXPECT().AuthenticateClient(ctx, credentials).Return(client, nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("subject-token")).Return(subjectToken, nil)
+
+		tokenExchangeService := &TokenExchangeServiceImpl{
+			clientService:   mockClientService,
+			tokenRepository: mockTokenRepo,
+		}
+
+		// Act
+		_, err := tokenExchangeService.ExchangeToken(ctx, params)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidSubjectToken)
+	})
+}
@@ -0,0 +1,1301 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTokens(t *testing.T) {
+	t.Run("should apply the client token lifetimes over the defaults", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{
+			ClientID: "banking-client",
+			TokenPolicy: domain.TokenPolicy{
+				AccessTokenLifetime: 5 * time.Minute,
+				IssueRefreshTokens:  true,
+			},
+		}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			GenerateAccessToken(ctx, domain.AccessTokenParams{
+				Subject:   userID.String(),
+				ClientID:  client.ClientID,
+				Scopes:    []string{"email"},
+				Audience:  []string{"https://auth.example.com"},
+				ExpiresIn: 5 * time.Minute,
+			}).
+			Return("access-token", nil)
+		mockTokenGenerator.EXPECT().GenerateRefreshToken(ctx).Return("refresh-token", nil)
+
+		var storedToken *domain.Token
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Token")).
+			Run(func(ctx context.Context, token *domain.Token) { storedToken = token }).
+			Return(nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			tokenGenerator:   mockTokenGenerator,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		sessionID := uuid.New()
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:    userID,
+			ClientID:  client.ClientID,
+			Scopes:    []string{"email"},
+			SessionID: sessionID,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(300), response.ExpiresIn)
+		assert.Equal(t, "refresh-token", response.RefreshToken)
+		assert.Equal(t, sessionID, storedToken.SessionID)
+		assert.False(t, storedToken.Offline)
+		assert.WithinDuration(t, time.Now().Add(5*time.Minute), storedToken.AccessTokenExpiresAt, time.Minute)
+		assert.WithinDuration(t, time.Now().Add(30*24*time.Hour), storedToken.RefreshTokenExpiresAt, time.Minute)
+	})
+
+	t.Run("should issue an offline refresh token outliving the session for offline_access", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{
+			ClientID:    "client-123",
+			TokenPolicy: domain.TokenPolicy{IssueRefreshTokens: true},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			GenerateAccessToken(ctx, mock.AnythingOfType("domain.AccessTokenParams")).
+			Return("access-token", nil)
+		mockTokenGenerator.EXPECT().GenerateRefreshToken(ctx).Return("refresh-token", nil)
+
+		var storedToken *domain.Token
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Token")).
+			Run(func(ctx context.Context, token *domain.Token) { storedToken = token }).
+			Return(nil)
+
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+				OfflineTokenDuration: 90 * 24 * time.Hour,
+			},
+		}
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			tokenGenerator:   mockTokenGenerator,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:    userID,
+			ClientID:  client.ClientID,
+			Scopes:    []string{"email", "offline_access"},
+			SessionID: uuid.New(),
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "refresh-token", response.RefreshToken)
+		assert.True(t, storedToken.Offline)
+		assert.Equal(t, uuid.Nil, storedToken.SessionID)
+		assert.WithinDuration(t, time.Now().Add(90*24*time.Hour), storedToken.RefreshTokenExpiresAt, time.Minute)
+	})
+
+	t.Run("should not issue a refresh token without a session or offline_access", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{
+			ClientID:    "client-123",
+			TokenPolicy: domain.TokenPolicy{IssueRefreshTokens: true},
+		}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			GenerateAccessToken(ctx, mock.AnythingOfType("domain.AccessTokenParams")).
+			Return("access-token", nil)
+
+		var storedToken *domain.Token
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Token")).
+			Run(func(ctx context.Context, token *domain.Token) { storedToken = token }).
+			Return(nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			tokenGenerator:   mockTokenGenerator,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:   userID,
+			ClientID: client.ClientID,
+			Scopes:   []string{"email"},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, response.RefreshToken)
+		assert.False(t, storedToken.HasRefreshToken())
+	})
+
+	t.Run("should not issue a refresh token when the client disables them", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{ClientID: "client-123"}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			GenerateAccessToken(ctx, mock.AnythingOfType("domain.AccessTokenParams")).
+			Return("access-token", nil)
+
+		var storedToken *domain.Token
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Token")).
+			Run(func(ctx context.Context, token *domain.Token) { storedToken = token }).
+			Return(nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			tokenGenerator:   mockTokenGenerator,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:   userID,
+			ClientID: client.ClientID,
+			Scopes:   []string{"email"},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int64(3600), response.ExpiresIn)
+		assert.Empty(t, response.RefreshToken)
+		assert.False(t, storedToken.HasRefreshToken())
+	})
+
+	t.Run("should issue an opaque access token when the client asks for that format", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{
+			ClientID:    "client-123",
+			TokenPolicy: domain.TokenPolicy{AccessTokenFormat: domain.AccessTokenFormatOpaque},
+		}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().GenerateOpaqueToken(ctx).Return("opaque-token", nil)
+
+		var storedToken *domain.Token
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Token")).
+			Run(func(ctx context.Context, token *domain.Token) { storedToken = token }).
+			Return(nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			tokenGenerator:   mockTokenGenerator,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:   userID,
+			ClientID: client.ClientID,
+			Scopes:   []string{"email"},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "opaque-token", response.AccessToken)
+		assert.True(t, storedToken.ValidateAccessToken("opaque-token"))
+	})
+
+	t.Run("should issue a PASETO access token when the client asks for that format", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{
+			ClientID:    "client-123",
+			TokenPolicy: domain.TokenPolicy{AccessTokenFormat: domain.AccessTokenFormatPASETO},
+		}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		mockPASETOTokenGenerator := mocks.NewPASETOTokenGeneratorMock(t)
+		mockPASETOTokenGenerator.EXPECT().
+			GenerateAccessToken(ctx, domain.AccessTokenParams{
+				Subject:   userID.String(),
+				ClientID:  client.ClientID,
+				Scopes:    []string{"email"},
+				Audience:  []string{"https://auth.example.com"},
+				ExpiresIn: time.Hour,
+			}).
+			Return("v4.public.token", nil)
+
+		var storedToken *domain.Token
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Token")).
+			Run(func(ctx context.Context, token *domain.Token) { storedToken = token }).
+			Return(nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:      mockTokenRepo,
+			tokenGenerator:       mocks.NewTokenGeneratorMock(t),
+			pasetoTokenGenerator: mockPASETOTokenGenerator,
+			clientRepository:     mockClientRepo,
+			subjectService:       mockSubjectService,
+			config:               cfg,
+		}
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:   userID,
+			ClientID: client.ClientID,
+			Scopes:   []string{"email"},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "v4.public.token", response.AccessToken)
+		assert.True(t, storedToken.ValidateAccessToken("v4.public.token"))
+	})
+
+	t.Run("should issue the access token in the format the resource requires", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{ClientID: "client-123"}
+		resources := []string{"https://api.example.com"}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		mockResourceService := mocks.NewResourceServiceMock(t)
+		mockResourceService.EXPECT().
+			GetResources(ctx, resources).
+			Return([]*domain.APIResource{{
+				Identifier:        resources[0],
+				Scopes:            []string{"payments"},
+				AccessTokenFormat: domain.AccessTokenFormatPASETO,
+			}}, nil)
+
+		mockPASETOTokenGenerator := mocks.NewPASETOTokenGeneratorMock(t)
+		mockPASETOTokenGenerator.EXPECT().
+			GenerateAccessToken(ctx, domain.AccessTokenParams{
+				Subject:   userID.String(),
+				ClientID:  client.ClientID,
+				Scopes:    []string{"payments"},
+				Audience:  resources,
+				ExpiresIn: time.Hour,
+			}).
+			Return("v4.public.token", nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().Create(ctx, mock.AnythingOfType("*domain.Token")).Return(nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:      mockTokenRepo,
+			tokenGenerator:       mocks.NewTokenGeneratorMock(t),
+			pasetoTokenGenerator: mockPASETOTokenGenerator,
+			clientRepository:     mockClientRepo,
+			subjectService:       mockSubjectService,
+			resourceService:      mockResourceService,
+			config:               cfg,
+		}
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:    userID,
+			ClientID:  client.ClientID,
+			Scopes:    []string{"payments"},
+			Resources: resources,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "v4.public.token", response.AccessToken)
+	})
+
+	t.Run("should return ErrInvalidTarget when the resources require different formats", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{ClientID: "client-123"}
+		resources := []string{"https://api.example.com", "https://legacy.example.com"}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		mockResourceService := mocks.NewResourceServiceMock(t)
+		mockResourceService.EXPECT().
+			GetResources(ctx, resources).
+			Return([]*domain.APIResource{
+				{Identifier: resources[0], AccessTokenFormat: domain.AccessTokenFormatPASETO},
+				{Identifier: resources[1], AccessTokenFormat: domain.AccessTokenFormatJWT},
+			}, nil)
+
+		tokenService := &TokenServiceImpl{
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			resourceService:  mockResourceService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:    userID,
+			ClientID:  client.ClientID,
+			Resources: resources,
+		})
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrInvalidTarget)
+	})
+
+	t.Run("should audience-restrict the access token to the requested resources", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		authTime := time.Now().UTC().Add(-time.Minute)
+		client := &domain.Client{ClientID: "client-123"}
+		resources := []string{"https://api.example.com"}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		mockResourceService := mocks.NewResourceServiceMock(t)
+		mockResourceService.EXPECT().
+			GetResources(ctx, resources).
+			Return([]*domain.APIResource{{Identifier: resources[0], Scopes: []string{"payments"}}}, nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			GenerateAccessToken(ctx, domain.AccessTokenParams{
+				Subject:   userID.String(),
+				ClientID:  client.ClientID,
+				Scopes:    []string{"payments"},
+				Audience:  resources,
+				AuthTime:  authTime,
+				ACR:       domain.ACRPassword,
+				ExpiresIn: time.Hour,
+			}).
+			Return("access-token", nil)
+
+		var storedToken *domain.Token
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Token")).
+			Run(func(ctx context.Context, token *domain.Token) { storedToken = token }).
+			Return(nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			tokenGenerator:   mockTokenGenerator,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			resourceService:  mockResourceService,
+			config:           cfg,
+		}
+
+		// Act
+		_, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:    userID,
+			ClientID:  client.ClientID,
+			Scopes:    []string{"email", "payments"},
+			Resources: resources,
+			AuthTime:  authTime,
+			ACR:       domain.ACRPassword,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, resources, storedToken.Resources)
+		assert.Equal(t, authTime, storedToken.AuthTime)
+		assert.Equal(t, domain.ACRPassword, storedToken.ACR)
+	})
+
+	t.Run("should bind the access token to the DPoP key of the request", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{ClientID: "spa-client", TokenPolicy: domain.TokenPolicy{IssueRefreshTokens: true}}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			GenerateAccessToken(ctx, domain.AccessTokenParams{
+				Subject:   userID.String(),
+				ClientID:  client.ClientID,
+				Scopes:    []string{"email"},
+				Audience:  []string{"https://auth.example.com"},
+				DPoPJKT:   "key-thumbprint",
+				ExpiresIn: time.Hour,
+			}).
+			Return("access-token", nil)
+		mockTokenGenerator.EXPECT().GenerateRefreshToken(ctx).Return("refresh-token", nil)
+
+		var storedToken *domain.Token
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Token")).
+			Run(func(ctx context.Context, token *domain.Token) { storedToken = token }).
+			Return(nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			tokenGenerator:   mockTokenGenerator,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:    userID,
+			ClientID:  client.ClientID,
+			Scopes:    []string{"email"},
+			SessionID: uuid.New(),
+			DPoPJKT:   "key-thumbprint",
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.TokenTypeDPoP, response.TokenType)
+		assert.Equal(t, "key-thumbprint", storedToken.DPoPJKT)
+	})
+
+	t.Run("should require a DPoP proof from a client registered for bound tokens", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{ClientID: "spa-client", DPoPBoundAccessTokens: true}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		tokenService := &TokenServiceImpl{
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:   userID,
+			ClientID: client.ClientID,
+			Scopes:   []string{"email"},
+		})
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+	})
+
+	t.Run("should bind the access token to the client certificate when the client asks for it", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{ClientID: "partner-client", TLSClientCertificateBoundAccessTokens: true}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			GenerateAccessToken(ctx, domain.AccessTokenParams{
+				Subject:               userID.String(),
+				ClientID:              client.ClientID,
+				Scopes:                []string{"email"},
+				Audience:              []string{"https://auth.example.com"},
+				CertificateThumbprint: "certificate-thumbprint",
+				ExpiresIn:             time.Hour,
+			}).
+			Return("access-token", nil)
+
+		var storedToken *domain.Token
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Token")).
+			Run(func(ctx context.Context, token *domain.Token) { storedToken = token }).
+			Return(nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			tokenGenerator:   mockTokenGenerator,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:                userID,
+			ClientID:              client.ClientID,
+			Scopes:                []string{"email"},
+			CertificateThumbprint: "certificate-thumbprint",
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, domain.TokenTypeBearer, response.TokenType)
+		assert.Equal(t, &domain.Confirmation{CertificateThumbprint: "certificate-thumbprint"}, storedToken.Confirmation())
+	})
+
+	t.Run("should require a client certificate from a client registered for bound tokens", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		client := &domain.Client{ClientID: "partner-client", TLSClientCertificateBoundAccessTokens: true}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, userID).Return(userID.String(), nil)
+
+		tokenService := &TokenServiceImpl{
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:   userID,
+			ClientID: client.ClientID,
+			Scopes:   []string{"email"},
+		})
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrInvalidClientCertificate)
+	})
+}
+
+func TestRefreshTokens(t *testing.T) {
+	t.Run("should rotate the refresh token and keep its absolute expiry", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:    "client-123",
+			TokenPolicy: domain.TokenPolicy{IssueRefreshTokens: true, RefreshTokenIdleTimeout: 24 * time.Hour},
+		}
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              client.ClientID,
+			UserID:                uuid.New(),
+			Scopes:                []string{"email"},
+			SessionID:             uuid.New(),
+			RefreshTokenExpiresAt: time.Now().UTC().Add(7 * 24 * time.Hour),
+			CreatedAt:             time.Now().UTC().Add(-time.Hour),
+			FamilyID:              uuid.New(),
+		}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, token.UserID).Return(token.UserID.String(), nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			GenerateAccessToken(ctx, mock.AnythingOfType("domain.AccessTokenParams")).
+			Return("new-access-token", nil)
+		mockTokenGenerator.EXPECT().GenerateRefreshToken(ctx).Return("new-refresh-token", nil)
+
+		mockSessionRepo := mocks.NewSessionRepositoryMock(t)
+		mockSessionRepo.EXPECT().
+			GetByID(ctx, token.SessionID).
+			Return(&domain.Session{ID: token.SessionID, UserID: token.UserID, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+
+		var storedToken *domain.Token
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByRefreshTokenHash(ctx, domain.HashToken("refresh-token")).Return(token, nil)
+		mockTokenRepo.EXPECT().RevokeActive(ctx, token.ID, "refresh token rotated").Return(true, nil)
+		mockTokenRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Token")).
+			Run(func(ctx context.Context, token *domain.Token) { storedToken = token }).
+			Return(nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:   mockTokenRepo,
+			tokenGenerator:    mockTokenGenerator,
+			clientRepository:  mockClientRepo,
+			sessionRepository: mockSessionRepo,
+			subjectService:    mockSubjectService,
+			config:            cfg,
+		}
+
+		// Act
+		response, err := tokenService.RefreshTokens(ctx, domain.RefreshTokenParams{
+			RefreshToken: "refresh-token",
+			ClientID:     client.ClientID,
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "new-refresh-token", response.RefreshToken)
+		assert.Equal(t, token.UserID, storedToken.UserID)
+		assert.Equal(t, token.SessionID, storedToken.SessionID)
+		assert.WithinDuration(t, token.RefreshTokenExpiresAt, storedToken.RefreshTokenExpiresAt, time.Second)
+		assert.Equal(t, token.FamilyID, storedToken.FamilyID)
+	})
+
+	t.Run("should revoke the token chain when a rotated refresh token is reused", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			FamilyID:              uuid.New(),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              "client-123",
+			RefreshTokenExpiresAt: time.Now().UTC().Add(time.Hour),
+		}
+		token.Revoke("refresh token rotated")
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByRefreshTokenHash(ctx, domain.HashToken("refresh-token")).Return(token, nil)
+		mockTokenRepo.EXPECT().RevokeFamily(ctx, token.FamilyID, "refresh token reused").Return(nil)
+
+		tokenService := &TokenServiceImpl{tokenRepository: mockTokenRepo}
+
+		// Act
+		response, err := tokenService.RefreshTokens(ctx, domain.RefreshTokenParams{
+			RefreshToken: "refresh-token",
+			ClientID:     "client-123",
+		})
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrInvalidToken)
+	})
+
+	t.Run("should revoke the token chain when another request rotated the token first", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:    "client-123",
+			TokenPolicy: domain.TokenPolicy{IssueRefreshTokens: true},
+		}
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			FamilyID:              uuid.New(),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              client.ClientID,
+			UserID:                uuid.New(),
+			Offline:               true,
+			RefreshTokenExpiresAt: time.Now().UTC().Add(time.Hour),
+			CreatedAt:             time.Now().UTC(),
+		}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, token.UserID).Return(token.UserID.String(), nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByRefreshTokenHash(ctx, domain.HashToken("refresh-token")).Return(token, nil)
+		mockTokenRepo.EXPECT().RevokeActive(ctx, token.ID, "refresh token rotated").Return(false, nil)
+		mockTokenRepo.EXPECT().RevokeFamily(ctx, token.FamilyID, "refresh token reused").Return(nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.RefreshTokens(ctx, domain.RefreshTokenParams{
+			RefreshToken: "refresh-token",
+			ClientID:     client.ClientID,
+		})
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrInvalidToken)
+	})
+
+	t.Run("should reject a refresh token left idle past the client timeout", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:    "client-123",
+			TokenPolicy: domain.TokenPolicy{IssueRefreshTokens: true, RefreshTokenIdleTimeout: 30 * time.Minute},
+		}
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              client.ClientID,
+			UserID:                uuid.New(),
+			Scopes:                []string{"email"},
+			SessionID:             uuid.New(),
+			RefreshTokenExpiresAt: time.Now().UTC().Add(7 * 24 * time.Hour),
+			CreatedAt:             time.Now().UTC().Add(-time.Hour),
+		}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, token.UserID).Return(token.UserID.String(), nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByRefreshTokenHash(ctx, domain.HashToken("refresh-token")).Return(token, nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.RefreshTokens(ctx, domain.RefreshTokenParams{
+			RefreshToken: "refresh-token",
+			ClientID:     client.ClientID,
+		})
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrRefreshExpired)
+	})
+
+	t.Run("should reject a session-bound refresh token once the session ended", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:    "client-123",
+			TokenPolicy: domain.TokenPolicy{IssueRefreshTokens: true},
+		}
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              client.ClientID,
+			UserID:                uuid.New(),
+			Scopes:                []string{"email"},
+			SessionID:             uuid.New(),
+			RefreshTokenExpiresAt: time.Now().UTC().Add(7 * 24 * time.Hour),
+			CreatedAt:             time.Now().UTC().Add(-time.Hour),
+		}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, token.UserID).Return(token.UserID.String(), nil)
+
+		mockSessionRepo := mocks.NewSessionRepositoryMock(t)
+		mockSessionRepo.EXPECT().GetByID(ctx, token.SessionID).Return(nil, ports.ErrNotFound)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByRefreshTokenHash(ctx, domain.HashToken("refresh-token")).Return(token, nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:   mockTokenRepo,
+			clientRepository:  mockClientRepo,
+			sessionRepository: mockSessionRepo,
+			subjectService:    mockSubjectService,
+			config:            cfg,
+		}
+
+		// Act
+		response, err := tokenService.RefreshTokens(ctx, domain.RefreshTokenParams{
+			RefreshToken: "refresh-token",
+			ClientID:     client.ClientID,
+		})
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrRefreshExpired)
+	})
+
+	t.Run("should reject a refresh token presented by another client", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              "client-123",
+			UserID:                uuid.New(),
+			Scopes:                []string{"email"},
+			SessionID:             uuid.New(),
+			RefreshTokenExpiresAt: time.Now().UTC().Add(7 * 24 * time.Hour),
+			CreatedAt:             time.Now().UTC().Add(-time.Hour),
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByRefreshTokenHash(ctx, domain.HashToken("refresh-token")).Return(token, nil)
+
+		tokenService := &TokenServiceImpl{tokenRepository: mockTokenRepo}
+
+		// Act
+		_, err := tokenService.RefreshTokens(ctx, domain.RefreshTokenParams{
+			RefreshToken: "refresh-token",
+			ClientID:     "other-client",
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrUnauthorizedClient)
+	})
+
+	t.Run("should reject authorization details that weren't granted", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              "client-123",
+			UserID:                uuid.New(),
+			Scopes:                []string{"email"},
+			SessionID:             uuid.New(),
+			RefreshTokenExpiresAt: time.Now().UTC().Add(7 * 24 * time.Hour),
+			CreatedAt:             time.Now().UTC().Add(-time.Hour),
+		}
+		token.AuthorizationDetails = domain.AuthorizationDetails{
+			{"type": "payment_initiation", "creditorName": "Merchant A"},
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByRefreshTokenHash(ctx, domain.HashToken("refresh-token")).Return(token, nil)
+
+		tokenService := &TokenServiceImpl{tokenRepository: mockTokenRepo}
+
+		// Act
+		_, err := tokenService.RefreshTokens(ctx, domain.RefreshTokenParams{
+			RefreshToken: "refresh-token",
+			ClientID:     token.ClientID,
+			AuthorizationDetails: domain.AuthorizationDetails{
+				{"type": "payment_initiation", "creditorName": "Merchant B"},
+			},
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidAuthorizationDetails)
+	})
+
+	t.Run("should reject a public client's refresh token without a proof of its DPoP key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		client := &domain.Client{
+			ClientID:                "spa-client",
+			TokenEndpointAuthMethod: domain.TokenEndpointAuthMethodNone,
+			TokenPolicy:             domain.TokenPolicy{IssueRefreshTokens: true},
+		}
+		token := &domain.Token{
+			ID:                    uuid.New(),
+			RefreshTokenHash:      domain.HashToken("refresh-token"),
+			ClientID:              client.ClientID,
+			UserID:                uuid.New(),
+			Scopes:                []string{"email"},
+			SessionID:             uuid.New(),
+			RefreshTokenExpiresAt: time.Now().UTC().Add(7 * 24 * time.Hour),
+			CreatedAt:             time.Now().UTC().Add(-time.Hour),
+		}
+		token.BindDPoPKey("key-thumbprint")
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, token.UserID).Return(token.UserID.String(), nil)
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByRefreshTokenHash(ctx, domain.HashToken("refresh-token")).Return(token, nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.RefreshTokens(ctx, domain.RefreshTokenParams{
+			RefreshToken: "refresh-token",
+			ClientID:     client.ClientID,
+			DPoPJKT:      "other-thumbprint",
+		})
+
+		// Assert
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, domain.ErrInvalidDPoPProof)
+	})
+}
+
+func TestCreateIDToken(t *testing.T) {
+	t.Run("should nest the ID token in a JWE for clients asking for encryption", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{ID: uuid.New(), Email: "jane@example.com"}
+		client := &domain.Client{
+			ClientID: "health-client",
+			IDTokenEncryption: domain.ResponseEncryption{
+				Alg: domain.KeyManagementAlgRSAOAEP256,
+				Enc: domain.ContentEncryptionA256GCM,
+			},
+		}
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, user.ID).Return(user.ID.String(), nil)
+
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		mockUserRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		mockScopeService := mocks.NewScopeServiceMock(t)
+		mockScopeService.EXPECT().GetScopeClaims(ctx, []string{"openid"}).Return(nil, nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().
+			GenerateIDToken(ctx, user, mock.AnythingOfType("domain.IDTokenParams")).
+			Return("signed-id-token", nil)
+
+		mockEncryptionService := mocks.NewResponseEncryptionServiceMock(t)
+		mockEncryptionService.EXPECT().EncryptIDToken(ctx, client, "signed-id-token").Return("encrypted-id-token", nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenGenerator:    mockTokenGenerator,
+			userRepository:    mockUserRepo,
+			clientRepository:  mockClientRepo,
+			subjectService:    mockSubjectService,
+			scopeService:      mockScopeService,
+			encryptionService: mockEncryptionService,
+			config:            cfg,
+		}
+
+		// Act
+		idToken, err := tokenService.CreateIDToken(ctx, domain.CreateTokenParams{
+			UserID:   user.ID,
+			ClientID: client.ClientID,
+			Scopes:   []string{"openid"},
+		}, "", "")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "encrypted-id-token", idToken)
+	})
+}
+
+func TestCreateTokensIDToken(t *testing.T) {
+	t.Run("should issue the ID token with the authentication and the access token hash", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{ID: uuid.New(), Email: "jane@example.com"}
+		client := &domain.Client{ClientID: "banking-client"}
+		sessionID := uuid.New()
+		authTime := time.Now().UTC().Add(-time.Minute)
+		cfg := &config.Config{
+			JWT: config.JWT{
+				Issuer:               "https://auth.example.com",
+				AccessTokenDuration:  time.Hour,
+				RefreshTokenDuration: 30 * 24 * time.Hour,
+				IDTokenDuration:      time.Hour,
+			},
+		}
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, user.ID).Return(user.ID.String(), nil)
+
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		mockUserRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		mockScopeService := mocks.NewScopeServiceMock(t)
+		mockScopeService.EXPECT().GetScopeClaims(ctx, []string{"openid"}).Return(nil, nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().GenerateAccessToken(ctx, mock.AnythingOfType("domain.AccessTokenParams")).Return("access-token", nil)
+		mockTokenGenerator.EXPECT().
+			GenerateIDToken(ctx, user, mock.MatchedBy(func(params domain.IDTokenParams) bool {
+				return params.AuthTime.Equal(authTime) &&
+					params.ACR == domain.ACRPassword &&
+					assert.ObjectsAreEqual([]string{domain.AMRPassword, domain.AMROTP}, params.AMR) &&
+					params.SessionID == sessionID &&
+					params.AccessToken == "access-token"
+			})).
+			Return("id-token", nil)
+
+		var storedToken *domain.Token
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.Token")).
+			Run(func(ctx context.Context, token *domain.Token) { storedToken = token }).
+			Return(nil)
+
+		tokenService := &TokenServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			tokenGenerator:   mockTokenGenerator,
+			userRepository:   mockUserRepo,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			scopeService:     mockScopeService,
+			config:           cfg,
+		}
+
+		// Act
+		response, err := tokenService.CreateTokens(ctx, domain.CreateTokenParams{
+			UserID:    user.ID,
+			ClientID:  client.ClientID,
+			Scopes:    []string{"openid"},
+			SessionID: sessionID,
+			AuthTime:  authTime,
+			ACR:       domain.ACRPassword,
+			AMR:       []string{domain.AMRPassword, domain.AMROTP},
+		})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "id-token", response.IDToken)
+		assert.Equal(t, []string{domain.AMRPassword, domain.AMROTP}, storedToken.AMR)
+	})
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+const totpAttemptsCacheKeyPrefix = "totp:attempts:"
+
+type TOTPService interface {
+	Enroll(ctx context.Context, userID uuid.UUID) (*domain.TOTPEnrollment, error)
+	ConfirmEnrollment(ctx context.Context, userID uuid.UUID, code string) ([]string, error)
+	IsEnrolled(ctx context.Context, userID uuid.UUID) (bool, error)
+	Verify(ctx context.Context, userID uuid.UUID, code string) error
+}
+
+type TOTPServiceImpl struct {
+	credentialRepository ports.TOTPCredentialRepository
+	userRepository       ports.UserRepository
+	recoveryCodeService  RecoveryCodeService
+	keyEncrypter         ports.KeyEncrypter
+	cache                ports.Cache
+	issuer               string
+	// now is the clock codes are checked against.
+	now func() time.Time
+}
+
+func NewTOTPService(
+	credentialRepository ports.TOTPCredentialRepository,
+	userRepository ports.UserRepository,
+	recoveryCodeService RecoveryCodeService,
+	keyEncrypter ports.KeyEncrypter,
+	cache ports.Cache,
+	config *config.Config,
+) TOTPService {
+	return &TOTPServiceImpl{
+		credentialRepository: credentialRepository,
+		userRepository:       userRepository,
+		recoveryCodeService:  recoveryCodeService,
+		keyEncrypter:         keyEncrypter,
+		cache:                cache,
+		issuer:               totpIssuer(config.JWT.Issuer),
+		now:                  time.Now,
+	}
+}
+
+// Enroll starts a TOTP enrollment with a new secret, replacing an earlier enrollment the user never confirmed.
+func (s *TOTPServiceImpl) Enroll(ctx context.Context, userID uuid.UUID) (*domain.TOTPEnrollment, error) {
+	credential, err := s.credentialRepository.GetByUserID(ctx, userID)
+	if err != nil && !errors.Is(err, ports.ErrNotFound) {
+		return nil, fmt.Errorf("get TOTP credential: %w", err)
+	}
+
+	if credential != nil {
+		if credential.IsConfirmed() {
+			return nil, domain.ErrTOTPAlreadyEnrolled
+		}
+
+		if err := s.credentialRepository.Delete(ctx, credential.ID); err != nil {
+			return nil, fmt.Errorf("delete pending TOTP credential: %w", err)
+		}
+	}
+
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	secret, err := domain.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedSecret, err := s.keyEncrypter.Encrypt(ctx, secret)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt TOTP secret: %w", err)
+	}
+
+	credential, err = domain.NewTOTPCredential(userID, encryptedSecret)
+	if err != nil {
+		return nil, fmt.Errorf("create TOTP credential: %w", err)
+	}
+
+	if err := s.credentialRepository.Create(ctx, credential); err != nil {
+		return nil, fmt.Errorf("store TOTP credential: %w", err)
+	}
+
+	return &domain.TOTPEnrollment{
+		Secret: domain.EncodeTOTPSecret(secret),
+		URI:    domain.TOTPURI(s.issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmEnrollment completes the enrollment with a first code, which shows the authenticator app holds the secret.
+func (s *TOTPServiceImpl) ConfirmEnrollment(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	credential, err := s.getCredential(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if credential.IsConfirmed() {
+		return nil, domain.ErrTOTPAlreadyEnrolled
+	}
+
+	step, err := s.matchCode(ctx, credential, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.credentialRepository.Confirm(ctx, credential.ID, step); err != nil {
+		return nil, fmt.Errorf("confirm TOTP credential: %w", err)
+	}
+
+	recoveryCodes, err := s.recoveryCodeService.Provision(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("provision recovery codes: %w", err)
+	}
+
+	return recoveryCodes, nil
+}
+
+func (s *TOTPServiceImpl) IsEnrolled(ctx context.Context, userID uuid.UUID) (bool, error) {
+	credential, err := s.credentialRepository.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("get TOTP credential: %w", err)
+	}
+
+	return credential.IsConfirmed(), nil
+}
+
+// Verify checks a code of the user's confirmed credential.
+func (s *TOTPServiceImpl) Verify(ctx context.Context, userID uuid.UUID, code string) error {
+	credential, err := s.getCredential(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !credential.IsConfirmed() {
+		return domain.ErrTOTPNotEnrolled
+	}
+
+	step, err := s.matchCode(ctx, credential, code)
+	if err != nil {
+		return err
+	}
+
+	used, err := s.credentialRepository.UseStep(ctx, credential.ID, step)
+	if err != nil {
+		return fmt.Errorf("record TOTP step: %w", err)
+	}
+
+	if !used {
+		return domain.ErrInvalidTOTPCode
+	}
+
+	return nil
+}
+
+func (s *TOTPServiceImpl) getCredential(ctx context.Context, userID uuid.UUID) (*domain.TOTPCredential, error) {
+	credential, err := s.credentialRepository.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrTOTPNotEnrolled
+		}
+
+		return nil, fmt.Errorf("get TOTP credential: %w", err)
+	}
+
+	return credential, nil
+}
+
+// matchCode counts the attempt against the user's limit and returns the time step the code belongs to.
+func (s *TOTPServiceImpl) matchCode(ctx context.Context, credential *domain.TOTPCredential, code string) (int64, error) {
+	key := totpAttemptsCacheKeyPrefix + credential.UserID.String()
+
+	attempts, err := s.cache.Increment(ctx, key)
+	if err != nil {
+		return 0, fmt.Errorf("count TOTP attempt: %w", err)
+	}
+
+	if attempts == 1 {
+		if err := s.cache.Expire(ctx, key, domain.TOTPAttemptWindow); err != nil {
+			return 0, fmt.Errorf("expire TOTP attempts: %w", err)
+		}
+	}
+
+	if attempts > domain.MaxTOTPAttempts {
+		return 0, domain.ErrTooManyTOTPAttempts
+	}
+
+	secret, err := s.keyEncrypter.Decrypt(ctx, credential.EncryptedSecret)
+	if err != nil {
+		return 0, fmt.Errorf("decrypt TOTP secret: %w", err)
+	}
+
+	step, ok := domain.MatchTOTPCode(secret, code, s.now(), credential.LastUsedStep)
+	if !ok {
+		return 0, domain.ErrInvalidTOTPCode
+	}
+
+	if err := s.cache.Delete(ctx, key); err != nil {
+		return 0, fmt.Errorf("reset TOTP attempts: %w", err)
+	}
+
+	return step, nil
+}
+
+// totpIssuer names the server in authenticator apps.
+func totpIssuer(issuer string) string {
+	if u, err := url.Parse(issuer); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+
+	return issuer
+}
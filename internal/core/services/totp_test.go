@@ -0,0 +1,324 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// rfc6238Secret is the SHA-1 seed of the RFC 6238 test vectors.
+var rfc6238Secret = []byte("12345678901234567890")
+
+func TestEnrollTOTP(t *testing.T) {
+	t.Run("should store the secret encrypted and return an otpauth URI", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{ID: uuid.New(), Email: "john.doe@example.com"}
+
+		var stored *domain.TOTPCredential
+		credentialRepository := mocks.NewTOTPCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().GetByUserID(ctx, user.ID).Return(nil, ports.ErrNotFound)
+		credentialRepository.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.TOTPCredential")).
+			Run(func(ctx context.Context, credential *domain.TOTPCredential) { stored = credential }).
+			Return(nil)
+
+		userRepository := mocks.NewUserRepositoryMock(t)
+		userRepository.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		keyEncrypter := mocks.NewKeyEncrypterMock(t)
+		keyEncrypter.EXPECT().Encrypt(ctx, mock.Anything).Return([]byte("encrypted"), nil)
+
+		totpService := &TOTPServiceImpl{
+			credentialRepository: credentialRepository,
+			userRepository:       userRepository,
+			keyEncrypter:         keyEncrypter,
+			issuer:               "auth.example.com",
+		}
+
+		// Act
+		enrollment, err := totpService.Enroll(ctx, user.ID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []byte("encrypted"), stored.EncryptedSecret)
+		assert.False(t, stored.IsConfirmed())
+
+		uri, err := url.Parse(enrollment.URI)
+		require.NoError(t, err)
+		assert.Equal(t, "otpauth", uri.Scheme)
+		assert.Equal(t, "totp", uri.Host)
+		assert.Equal(t, "/auth.example.com:john.doe@example.com", uri.Path)
+		assert.Equal(t, enrollment.Secret, uri.Query().Get("secret"))
+		assert.Equal(t, "auth.example.com", uri.Query().Get("issuer"))
+	})
+
+	t.Run("should return ErrTOTPAlreadyEnrolled for a confirmed credential", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		confirmedAt := time.Now()
+
+		credentialRepository := mocks.NewTOTPCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().
+			GetByUserID(ctx, userID).
+			Return(&domain.TOTPCredential{ID: uuid.New(), UserID: userID, ConfirmedAt: &confirmedAt}, nil)
+
+		totpService := &TOTPServiceImpl{credentialRepository: credentialRepository}
+
+		// Act
+		enrollment, err := totpService.Enroll(ctx, userID)
+
+		// Assert
+		assert.Nil(t, enrollment)
+		assert.ErrorIs(t, err, domain.ErrTOTPAlreadyEnrolled)
+	})
+}
+
+func TestConfirmTOTPEnrollment(t *testing.T) {
+	t.Run("should confirm the credential with the code of the current step", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Unix(1111111109, 0)
+		credential := &domain.TOTPCredential{ID: uuid.New(), UserID: uuid.New(), EncryptedSecret: []byte("encrypted")}
+		recoveryCodes := []string{"k3tqa-7mzbx"}
+
+		credentialRepository := mocks.NewTOTPCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().GetByUserID(ctx, credential.UserID).Return(credential, nil)
+		credentialRepository.EXPECT().Confirm(ctx, credential.ID, domain.TOTPStep(now)).Return(nil)
+
+		recoveryCodeService := mocks.NewRecoveryCodeServiceMock(t)
+		recoveryCodeService.EXPECT().Provision(ctx, credential.UserID).Return(recoveryCodes, nil)
+
+		keyEncrypter := mocks.NewKeyEncrypterMock(t)
+		keyEncrypter.EXPECT().Decrypt(ctx, []byte("encrypted")).Return(rfc6238Secret, nil)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, totpAttemptsCacheKeyPrefix+credential.UserID.String()).Return(1, nil)
+		cache.EXPECT().Expire(ctx, totpAttemptsCacheKeyPrefix+credential.UserID.String(), domain.TOTPAttemptWindow).Return(nil)
+		cache.EXPECT().Delete(ctx, totpAttemptsCacheKeyPrefix+credential.UserID.String()).Return(nil)
+
+		totpService := &TOTPServiceImpl{
+			credentialRepository: credentialRepository,
+			recoveryCodeService:  recoveryCodeService,
+			keyEncrypter:         keyEncrypter,
+			cache:                cache,
+			now:                  func() time.Time { return now },
+		}
+
+		// Act
+		// RFC 6238 gives 07081804 for this time, of which six digits are used.
+		codes, err := totpService.ConfirmEnrollment(ctx, credential.UserID, "081804")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, recoveryCodes, codes)
+	})
+}
+
+func TestVerifyTOTP(t *testing.T) {
+	t.Run("should accept a code of the previous step to absorb clock drift", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Unix(1111111109, 0).Add(domain.TOTPPeriod)
+		confirmedAt := time.Unix(1111111000, 0)
+		credential := &domain.TOTPCredential{
+			ID:              uuid.New(),
+			UserID:          uuid.New(),
+			EncryptedSecret: []byte("encrypted"),
+			ConfirmedAt:     &confirmedAt,
+		}
+
+		credentialRepository := mocks.NewTOTPCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().GetByUserID(ctx, credential.UserID).Return(credential, nil)
+		credentialRepository.EXPECT().UseStep(ctx, credential.ID, domain.TOTPStep(now)-1).Return(true, nil)
+
+		keyEncrypter := mocks.NewKeyEncrypterMock(t)
+		keyEncrypter.EXPECT().Decrypt(ctx, []byte("encrypted")).Return(rfc6238Secret, nil)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, totpAttemptsCacheKeyPrefix+credential.UserID.String()).Return(1, nil)
+		cache.EXPECT().Expire(ctx, totpAttemptsCacheKeyPrefix+credential.UserID.String(), domain.TOTPAttemptWindow).Return(nil)
+		cache.EXPECT().Delete(ctx, totpAttemptsCacheKeyPrefix+credential.UserID.String()).Return(nil)
+
+		totpService := &TOTPServiceImpl{
+			credentialRepository: credentialRepository,
+			keyEncrypter:         keyEncrypter,
+			cache:                cache,
+			now:                  func() time.Time { return now },
+		}
+
+		// Act
+		err := totpService.Verify(ctx, credential.UserID, "081804")
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject a code outside the accepted window", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Unix(1111111109, 0).Add(2 * domain.TOTPPeriod)
+		confirmedAt := time.Unix(1111111000, 0)
+		credential := &domain.TOTPCredential{
+			ID:              uuid.New(),
+			UserID:          uuid.New(),
+			EncryptedSecret: []byte("encrypted"),
+			ConfirmedAt:     &confirmedAt,
+		}
+
+		credentialRepository := mocks.NewTOTPCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().GetByUserID(ctx, credential.UserID).Return(credential, nil)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, totpAttemptsCacheKeyPrefix+credential.UserID.String()).Return(2, nil)
+
+		keyEncrypter := mocks.NewKeyEncrypterMock(t)
+		keyEncrypter.EXPECT().Decrypt(ctx, []byte("encrypted")).Return(rfc6238Secret, nil)
+
+		totpService := &TOTPServiceImpl{
+			credentialRepository: credentialRepository,
+			keyEncrypter:         keyEncrypter,
+			cache:                cache,
+			now:                  func() time.Time { return now },
+		}
+
+		// Act
+		err := totpService.Verify(ctx, credential.UserID, "081804")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidTOTPCode)
+	})
+
+	t.Run("should reject a code whose step was already used", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Unix(1111111109, 0)
+		confirmedAt := time.Unix(1111111000, 0)
+		credential := &domain.TOTPCredential{
+			ID:              uuid.New(),
+			UserID:          uuid.New(),
+			EncryptedSecret: []byte("encrypted"),
+			ConfirmedAt:     &confirmedAt,
+			LastUsedStep:    domain.TOTPStep(now),
+		}
+
+		credentialRepository := mocks.NewTOTPCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().GetByUserID(ctx, credential.UserID).Return(credential, nil)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, totpAttemptsCacheKeyPrefix+credential.UserID.String()).Return(2, nil)
+
+		keyEncrypter := mocks.NewKeyEncrypterMock(t)
+		keyEncrypter.EXPECT().Decrypt(ctx, []byte("encrypted")).Return(rfc6238Secret, nil)
+
+		totpService := &TOTPServiceImpl{
+			credentialRepository: credentialRepository,
+			keyEncrypter:         keyEncrypter,
+			cache:                cache,
+			now:                  func() time.Time { return now },
+		}
+
+		// Act
+		err := totpService.Verify(ctx, credential.UserID, "081804")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidTOTPCode)
+	})
+
+	t.Run("should reject a code a concurrent request used first", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		now := time.Unix(1111111109, 0)
+		confirmedAt := time.Unix(1111111000, 0)
+		credential := &domain.TOTPCredential{
+			ID:              uuid.New(),
+			UserID:          uuid.New(),
+			EncryptedSecret: []byte("encrypted"),
+			ConfirmedAt:     &confirmedAt,
+		}
+
+		credentialRepository := mocks.NewTOTPCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().GetByUserID(ctx, credential.UserID).Return(credential, nil)
+		credentialRepository.EXPECT().UseStep(ctx, credential.ID, domain.TOTPStep(now)).Return(false, nil)
+
+		keyEncrypter := mocks.NewKeyEncrypterMock(t)
+		keyEncrypter.EXPECT().Decrypt(ctx, []byte("encrypted")).Return(rfc6238Secret, nil)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().Increment(ctx, totpAttemptsCacheKeyPrefix+credential.UserID.String()).Return(1, nil)
+		cache.EXPECT().Expire(ctx, totpAttemptsCacheKeyPrefix+credential.UserID.String(), domain.TOTPAttemptWindow).Return(nil)
+		cache.EXPECT().Delete(ctx, totpAttemptsCacheKeyPrefix+credential.UserID.String()).Return(nil)
+
+		totpService := &TOTPServiceImpl{
+			credentialRepository: credentialRepository,
+			keyEncrypter:         keyEncrypter,
+			cache:                cache,
+			now:                  func() time.Time { return now },
+		}
+
+		// Act
+		err := totpService.Verify(ctx, credential.UserID, "081804")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidTOTPCode)
+	})
+
+	t.Run("should return ErrTooManyTOTPAttempts past the attempt limit", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		confirmedAt := time.Unix(1111111000, 0)
+		credential := &domain.TOTPCredential{
+			ID:              uuid.New(),
+			UserID:          uuid.New(),
+			EncryptedSecret: []byte("encrypted"),
+			ConfirmedAt:     &confirmedAt,
+		}
+
+		credentialRepository := mocks.NewTOTPCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().GetByUserID(ctx, credential.UserID).Return(credential, nil)
+
+		cache := mocks.NewCacheMock(t)
+		cache.EXPECT().
+			Increment(ctx, totpAttemptsCacheKeyPrefix+credential.UserID.String()).
+			Return(domain.MaxTOTPAttempts+1, nil)
+
+		totpService := &TOTPServiceImpl{
+			credentialRepository: credentialRepository,
+			cache:                cache,
+			now:                  time.Now,
+		}
+
+		// Act
+		err := totpService.Verify(ctx, credential.UserID, "081804")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrTooManyTOTPAttempts)
+	})
+
+	t.Run("should return ErrTOTPNotEnrolled for an unconfirmed credential", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		credential := &domain.TOTPCredential{ID: uuid.New(), UserID: uuid.New()}
+
+		credentialRepository := mocks.NewTOTPCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().GetByUserID(ctx, credential.UserID).Return(credential, nil)
+
+		totpService := &TOTPServiceImpl{credentialRepository: credentialRepository}
+
+		// Act
+		err := totpService.Verify(ctx, credential.UserID, "081804")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrTOTPNotEnrolled)
+	})
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+)
+
+type UserInfoService interface {
+	GetUserInfo(ctx context.Context, accessToken string, proof domain.Confirmation) (*domain.UserInfo, error)
+}
+
+type UserInfoServiceImpl struct {
+	tokenRepository   ports.TokenRepository
+	userRepository    ports.UserRepository
+	clientRepository  ports.ClientRepository
+	tokenGenerator    ports.TokenGenerator
+	subjectService    SubjectService
+	scopeService      ScopeService
+	encryptionService ResponseEncryptionService
+	config            *config.Config
+}
+
+func NewUserInfoService(
+	tokenRepository ports.TokenRepository,
+	userRepository ports.UserRepository,
+	clientRepository ports.ClientRepository,
+	tokenGenerator ports.TokenGenerator,
+	subjectService SubjectService,
+	scopeService ScopeService,
+	encryptionService ResponseEncryptionService,
+	config *config.Config,
+) UserInfoService {
+	return &UserInfoServiceImpl{
+		tokenRepository:   tokenRepository,
+		userRepository:    userRepository,
+		clientRepository:  clientRepository,
+		tokenGenerator:    tokenGenerator,
+		subjectService:    subjectService,
+		scopeService:      scopeService,
+		encryptionService: encryptionService,
+		config:            config,
+	}
+}
+
+// GetUserInfo returns the claims released for the access token.
+func (s *UserInfoServiceImpl) GetUserInfo(ctx context.Context, accessToken string, proof domain.Confirmation) (*domain.UserInfo, error) {
+	token, err := s.tokenRepository.GetByAccessTokenHash(ctx, domain.HashToken(accessToken))
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrInvalidToken
+		}
+
+		return nil, fmt.Errorf("get token by access token: %w", err)
+	}
+
+	if !token.IsValid() || token.IsClientToken() {
+		return nil, domain.ErrInvalidToken
+	}
+
+	if !token.IsConfirmedBy(proof) {
+		return nil, domain.ErrInvalidToken
+	}
+
+	// Tokens issued for other resource servers aren't accepted here.
+	if !slices.Contains(token.Audience(s.config.JWT.Issuer), s.config.JWT.Issuer) {
+		return nil, domain.ErrInvalidToken
+	}
+
+	if !token.HasScope(domain.ScopeOpenID) {
+		return nil, domain.ErrInsufficientScope
+	}
+
+	user, err := s.userRepository.GetByID(ctx, token.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get user for userinfo: %w", err)
+	}
+
+	client, err := s.clientRepository.GetByClientID(ctx, token.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("get client for userinfo: %w", err)
+	}
+
+	subject, err := s.subjectService.GetSubject(ctx, client, token.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get subject: %w", err)
+	}
+
+	scopeClaims, err := s.scopeService.GetScopeClaims(ctx, token.Scopes)
+	if err != nil {
+		return nil, fmt.Errorf("get scope claims: %w", err)
+	}
+
+	claims := domain.ResolveClaims(user, scopeClaims, token.Claims.UserInfoClaims())
+	claims["sub"] = subject
+
+	if !client.UserInfoEncryption.Enabled() {
+		return &domain.UserInfo{Claims: claims}, nil
+	}
+
+	signed, err := s.tokenGenerator.GenerateUserInfoResponse(ctx, client.ClientID, claims)
+	if err != nil {
+		return nil, fmt.Errorf("sign userinfo response: %w", err)
+	}
+
+	encrypted, err := s.encryptionService.EncryptUserInfo(ctx, client, signed)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt userinfo response: %w", err)
+	}
+
+	return &domain.UserInfo{Claims: claims, JWT: encrypted}, nil
+}
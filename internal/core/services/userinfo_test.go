@@ -0,0 +1,372 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserInfo(t *testing.T) {
+	cfg := &config.Config{JWT: config.JWT{Issuer: "https://auth.example.com"}}
+
+	t.Run("should return the claims of the granted scopes", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{
+			ID:            uuid.New(),
+			Name:          "Jane Doe",
+			Email:         "jane@example.com",
+			EmailVerified: true,
+			UpdatedAt:     time.Unix(1700000000, 0),
+		}
+		client := &domain.Client{ClientID: "client-123"}
+		token := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("access-token"),
+			ClientID:             "client-123",
+			UserID:               user.ID,
+			Scopes:               []string{"openid", "email"},
+			AccessTokenExpiresAt: time.Now().UTC().Add(time.Hour),
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("access-token")).Return(token, nil)
+
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		mockUserRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, user.ID).Return(user.ID.String(), nil)
+
+		mockScopeService := mocks.NewScopeServiceMock(t)
+		mockScopeService.EXPECT().GetScopeClaims(ctx, token.Scopes).Return([]string{"email", "email_verified"}, nil)
+
+		userInfoService := &UserInfoServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			userRepository:   mockUserRepo,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			scopeService:     mockScopeService,
+			config:           cfg,
+		}
+
+		// Act
+		userInfo, err := userInfoService.GetUserInfo(ctx, "access-token", domain.Confirmation{})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"sub":            user.ID.String(),
+			"email":          user.Email,
+			"email_verified": true,
+		}, userInfo.Claims)
+	})
+
+	t.Run("should add individually requested claims and honor value constraints", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{
+			ID:            uuid.New(),
+			Name:          "Jane Doe",
+			Email:         "jane@example.com",
+			EmailVerified: true,
+			UpdatedAt:     time.Unix(1700000000, 0),
+		}
+		client := &domain.Client{ClientID: "client-123"}
+		claimsRequest, err := domain.ParseClaimsRequest(`{"userinfo":{"name":{"essential":true},"email_verified":{"value":false},"picture":null}}`)
+		require.NoError(t, err)
+		token := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("access-token"),
+			ClientID:             "client-123",
+			UserID:               user.ID,
+			Scopes:               []string{"openid", "email"},
+			Claims:               claimsRequest,
+			AccessTokenExpiresAt: time.Now().UTC().Add(time.Hour),
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("access-token")).Return(token, nil)
+
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		mockUserRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, user.ID).Return("pairwise-sub", nil)
+
+		mockScopeService := mocks.NewScopeServiceMock(t)
+		mockScopeService.EXPECT().GetScopeClaims(ctx, token.Scopes).Return([]string{"email", "email_verified"}, nil)
+
+		userInfoService := &UserInfoServiceImpl{
+			tokenRepository:  mockTokenRepo,
+			userRepository:   mockUserRepo,
+			clientRepository: mockClientRepo,
+			subjectService:   mockSubjectService,
+			scopeService:     mockScopeService,
+			config:           cfg,
+		}
+
+		// Act
+		userInfo, err := userInfoService.GetUserInfo(ctx, "access-token", domain.Confirmation{})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{
+			"sub":   "pairwise-sub",
+			"name":  user.Name,
+			"email": user.Email,
+		}, userInfo.Claims)
+	})
+
+	t.Run("should return a signed and encrypted JWT for clients asking for encryption", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{
+			ID:            uuid.New(),
+			Name:          "Jane Doe",
+			Email:         "jane@example.com",
+			EmailVerified: true,
+			UpdatedAt:     time.Unix(1700000000, 0),
+		}
+		client := &domain.Client{
+			ClientID: "client-123",
+			UserInfoEncryption: domain.ResponseEncryption{
+				Alg: domain.KeyManagementAlgECDHES,
+				Enc: domain.ContentEncryptionA256GCM,
+			},
+		}
+		token := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("access-token"),
+			ClientID:             "client-123",
+			UserID:               user.ID,
+			Scopes:               []string{"openid", "email"},
+			AccessTokenExpiresAt: time.Now().UTC().Add(time.Hour),
+		}
+		expectedClaims := map[string]any{
+			"sub":            user.ID.String(),
+			"email":          user.Email,
+			"email_verified": true,
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("access-token")).Return(token, nil)
+
+		mockUserRepo := mocks.NewUserRepositoryMock(t)
+		mockUserRepo.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		mockClientRepo := mocks.NewClientRepositoryMock(t)
+		mockClientRepo.EXPECT().GetByClientID(ctx, client.ClientID).Return(client, nil)
+
+		mockSubjectService := mocks.NewSubjectServiceMock(t)
+		mockSubjectService.EXPECT().GetSubject(ctx, client, user.ID).Return(user.ID.String(), nil)
+
+		mockScopeService := mocks.NewScopeServiceMock(t)
+		mockScopeService.EXPECT().GetScopeClaims(ctx, token.Scopes).Return([]string{"email", "email_verified"}, nil)
+
+		mockTokenGenerator := mocks.NewTokenGeneratorMock(t)
+		mockTokenGenerator.EXPECT().GenerateUserInfoResponse(ctx, client.ClientID, expectedClaims).Return("signed-userinfo", nil)
+
+		mockEncryptionService := mocks.NewResponseEncryptionServiceMock(t)
+		mockEncryptionService.EXPECT().EncryptUserInfo(ctx, client, "signed-userinfo").Return("encrypted-userinfo", nil)
+
+		userInfoService := &UserInfoServiceImpl{
+			tokenRepository:   mockTokenRepo,
+			userRepository:    mockUserRepo,
+			clientRepository:  mockClientRepo,
+			tokenGenerator:    mockTokenGenerator,
+			subjectService:    mockSubjectService,
+			scopeService:      mockScopeService,
+			encryptionService: mockEncryptionService,
+			config:            cfg,
+		}
+
+		// Act
+		userInfo, err := userInfoService.GetUserInfo(ctx, "access-token", domain.Confirmation{})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "encrypted-userinfo", userInfo.JWT)
+		assert.Equal(t, expectedClaims, userInfo.Claims)
+	})
+
+	t.Run("should reject an unknown access token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("access-token")).Return(nil, ports.ErrNotFound)
+
+		userInfoService := &UserInfoServiceImpl{tokenRepository: mockTokenRepo}
+
+		// Act
+		userInfo, err := userInfoService.GetUserInfo(ctx, "access-token", domain.Confirmation{})
+
+		// Assert
+		assert.Nil(t, userInfo)
+		assert.ErrorIs(t, err, domain.ErrInvalidToken)
+	})
+
+	t.Run("should reject a revoked access token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("access-token"),
+			ClientID:             "client-123",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid"},
+			AccessTokenExpiresAt: time.Now().UTC().Add(time.Hour),
+		}
+		token.Revoke("logout")
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("access-token")).Return(token, nil)
+
+		userInfoService := &UserInfoServiceImpl{tokenRepository: mockTokenRepo}
+
+		// Act
+		_, err := userInfoService.GetUserInfo(ctx, "access-token", domain.Confirmation{})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidToken)
+	})
+
+	t.Run("should require the openid scope", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("access-token"),
+			ClientID:             "client-123",
+			UserID:               uuid.New(),
+			Scopes:               []string{"email"},
+			AccessTokenExpiresAt: time.Now().UTC().Add(time.Hour),
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("access-token")).Return(token, nil)
+
+		userInfoService := &UserInfoServiceImpl{tokenRepository: mockTokenRepo, config: cfg}
+
+		// Act
+		_, err := userInfoService.GetUserInfo(ctx, "access-token", domain.Confirmation{})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInsufficientScope)
+	})
+
+	t.Run("should reject an access token issued for another resource", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("access-token"),
+			ClientID:             "client-123",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid"},
+			AccessTokenExpiresAt: time.Now().UTC().Add(time.Hour),
+			Resources:            []string{"https://api.example.com"},
+		}
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("access-token")).Return(token, nil)
+
+		userInfoService := &UserInfoServiceImpl{tokenRepository: mockTokenRepo, config: cfg}
+
+		// Act
+		_, err := userInfoService.GetUserInfo(ctx, "access-token", domain.Confirmation{})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidToken)
+	})
+
+	t.Run("should reject a DPoP-bound token presented as a bearer token", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("access-token"),
+			ClientID:             "client-123",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid"},
+			AccessTokenExpiresAt: time.Now().UTC().Add(time.Hour),
+		}
+		token.BindDPoPKey("key-thumbprint")
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("access-token")).Return(token, nil)
+
+		userInfoService := &UserInfoServiceImpl{tokenRepository: mockTokenRepo, config: cfg}
+
+		// Act
+		_, err := userInfoService.GetUserInfo(ctx, "access-token", domain.Confirmation{})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidToken)
+	})
+
+	t.Run("should reject a DPoP proof of another key", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("access-token"),
+			ClientID:             "client-123",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid"},
+			AccessTokenExpiresAt: time.Now().UTC().Add(time.Hour),
+		}
+		token.BindDPoPKey("key-thumbprint")
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("access-token")).Return(token, nil)
+
+		userInfoService := &UserInfoServiceImpl{tokenRepository: mockTokenRepo, config: cfg}
+
+		// Act
+		_, err := userInfoService.GetUserInfo(ctx, "access-token", domain.Confirmation{JKT: "other-thumbprint"})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidToken)
+	})
+
+	t.Run("should reject a certificate-bound token sent over a connection with another certificate", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		token := &domain.Token{
+			ID:                   uuid.New(),
+			AccessTokenHash:      domain.HashToken("access-token"),
+			ClientID:             "client-123",
+			UserID:               uuid.New(),
+			Scopes:               []string{"openid"},
+			AccessTokenExpiresAt: time.Now().UTC().Add(time.Hour),
+		}
+		token.BindCertificate("certificate-thumbprint")
+
+		mockTokenRepo := mocks.NewTokenRepositoryMock(t)
+		mockTokenRepo.EXPECT().GetByAccessTokenHash(ctx, domain.HashToken("access-token")).Return(token, nil)
+
+		userInfoService := &UserInfoServiceImpl{tokenRepository: mockTokenRepo, config: cfg}
+
+		// Act
+		_, err := userInfoService.GetUserInfo(ctx, "access-token", domain.Confirmation{CertificateThumbprint: "other-thumbprint"})
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidToken)
+	})
+}
@@ -0,0 +1,282 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/g-villarinho/oidc-server/internal/config"
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/google/uuid"
+)
+
+type WebAuthnService interface {
+	BeginRegistration(ctx context.Context, userID uuid.UUID) (*domain.WebAuthnRegistrationOptions, error)
+	FinishRegistration(ctx context.Context, userID uuid.UUID, challengeID, name string, attestation *domain.WebAuthnAttestation) (*domain.WebAuthnCredential, []string, error)
+	BeginAuthentication(ctx context.Context, userID *uuid.UUID) (*domain.WebAuthnLoginOptions, error)
+	FinishAuthentication(ctx context.Context, challengeID string, userID *uuid.UUID, assertion *domain.WebAuthnAssertion) (*domain.WebAuthnCredential, error)
+	HasCredentials(ctx context.Context, userID uuid.UUID) (bool, error)
+	ListCredentials(ctx context.Context, userID uuid.UUID) ([]*domain.WebAuthnCredential, error)
+	RenameCredential(ctx context.Context, userID uuid.UUID, id uuid.UUID, name string) error
+	DeleteCredential(ctx context.Context, userID uuid.UUID, id uuid.UUID) error
+}
+
+type WebAuthnServiceImpl struct {
+	credentialRepository ports.WebAuthnCredentialRepository
+	challengeRepository  ports.WebAuthnChallengeRepository
+	userRepository       ports.UserRepository
+	recoveryCodeService  RecoveryCodeService
+	verifier             ports.WebAuthnVerifier
+	rpID                 string
+	rpName               string
+}
+
+func NewWebAuthnService(
+	credentialRepository ports.WebAuthnCredentialRepository,
+	challengeRepository ports.WebAuthnChallengeRepository,
+	userRepository ports.UserRepository,
+	recoveryCodeService RecoveryCodeService,
+	verifier ports.WebAuthnVerifier,
+	config *config.Config,
+) WebAuthnService {
+	rpID := config.WebAuthnRPID()
+	rpName := config.WebAuthn.RPName
+	if rpName == "" {
+		rpName = rpID
+	}
+
+	return &WebAuthnServiceImpl{
+		credentialRepository: credentialRepository,
+		challengeRepository:  challengeRepository,
+		userRepository:       userRepository,
+		recoveryCodeService:  recoveryCodeService,
+		verifier:             verifier,
+		rpID:                 rpID,
+		rpName:               rpName,
+	}
+}
+
+// BeginRegistration hands out the options to register a new credential for the user.
+func (s *WebAuthnServiceImpl) BeginRegistration(ctx context.Context, userID uuid.UUID) (*domain.WebAuthnRegistrationOptions, error) {
+	user, err := s.userRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	credentials, err := s.credentialRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list webauthn credentials: %w", err)
+	}
+
+	challenge, err := s.createChallenge(ctx, domain.WebAuthnCeremonyCreate, &userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.WebAuthnRegistrationOptions{
+		ChallengeID:        challenge.ID,
+		Challenge:          challenge.Challenge,
+		RPID:               s.rpID,
+		RPName:             s.rpName,
+		User:               user,
+		ExcludeCredentials: credentials,
+		Timeout:            domain.WebAuthnChallengeExpiry,
+	}, nil
+}
+
+// FinishRegistration stores the credential of a verified registration.
+func (s *WebAuthnServiceImpl) FinishRegistration(ctx context.Context, userID uuid.UUID, challengeID, name string, attestation *domain.WebAuthnAttestation) (*domain.WebAuthnCredential, []string, error) {
+	challenge, err := s.consumeChallenge(ctx, challengeID, domain.WebAuthnCeremonyCreate, &userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attested, err := s.verifier.VerifyAttestation(ctx, attestation, challenge.Challenge)
+	if err != nil {
+		return nil, nil, fmt.Errorf("verify attestation: %w", err)
+	}
+
+	credential, err := domain.NewWebAuthnCredential(userID, name, attested, attestation.Transports)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create webauthn credential: %w", err)
+	}
+
+	if err := s.credentialRepository.Create(ctx, credential); err != nil {
+		if errors.Is(err, ports.ErrUniqueKeyViolation) {
+			return nil, nil, domain.ErrWebAuthnCredentialExists
+		}
+
+		return nil, nil, fmt.Errorf("store webauthn credential: %w", err)
+	}
+
+	recoveryCodes, err := s.recoveryCodeService.Provision(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("provision recovery codes: %w", err)
+	}
+
+	return credential, recoveryCodes, nil
+}
+
+// BeginAuthentication hands out the options to log in with a credential of the user.
+func (s *WebAuthnServiceImpl) BeginAuthentication(ctx context.Context, userID *uuid.UUID) (*domain.WebAuthnLoginOptions, error) {
+	var credentials []*domain.WebAuthnCredential
+	userVerification := domain.WebAuthnUserVerificationRequired
+
+	if userID != nil {
+		var err error
+		credentials, err = s.credentialRepository.ListByUserID(ctx, *userID)
+		if err != nil {
+			return nil, fmt.Errorf("list webauthn credentials: %w", err)
+		}
+
+		if len(credentials) == 0 {
+			return nil, domain.ErrWebAuthnCredentialNotFound
+		}
+
+		userVerification = domain.WebAuthnUserVerificationPreferred
+	}
+
+	challenge, err := s.createChallenge(ctx, domain.WebAuthnCeremonyGet, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.WebAuthnLoginOptions{
+		ChallengeID:      challenge.ID,
+		Challenge:        challenge.Challenge,
+		RPID:             s.rpID,
+		AllowCredentials: credentials,
+		UserVerification: userVerification,
+		Timeout:          domain.WebAuthnChallengeExpiry,
+	}, nil
+}
+
+// FinishAuthentication verifies a login response and returns the credential it was signed with.
+func (s *WebAuthnServiceImpl) FinishAuthentication(ctx context.Context, challengeID string, userID *uuid.UUID, assertion *domain.WebAuthnAssertion) (*domain.WebAuthnCredential, error) {
+	challenge, err := s.consumeChallenge(ctx, challengeID, domain.WebAuthnCeremonyGet, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := s.credentialRepository.GetByCredentialID(ctx, assertion.CredentialID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, fmt.Errorf("%w: unknown credential", domain.ErrInvalidWebAuthnResponse)
+		}
+
+		return nil, fmt.Errorf("get webauthn credential: %w", err)
+	}
+
+	if userID != nil && credential.UserID != *userID {
+		return nil, fmt.Errorf("%w: credential of another user", domain.ErrInvalidWebAuthnResponse)
+	}
+
+	// A passkey names the user it was registered for, which must be the credential's owner.
+	if userID == nil && !bytes.Equal(assertion.UserHandle, credential.UserID[:]) {
+		return nil, fmt.Errorf("%w: user handle mismatch", domain.ErrInvalidWebAuthnResponse)
+	}
+
+	verified, err := s.verifier.VerifyAssertion(ctx, assertion, challenge.Challenge, credential.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("verify assertion: %w", err)
+	}
+
+	if userID == nil && !verified.UserVerified {
+		return nil, fmt.Errorf("%w: user not verified", domain.ErrInvalidWebAuthnResponse)
+	}
+
+	if !credential.AcceptsSignCount(verified.SignCount) {
+		return nil, fmt.Errorf("%w: sign count did not increase", domain.ErrInvalidWebAuthnResponse)
+	}
+
+	if err := s.credentialRepository.UpdateSignCount(ctx, credential.ID, verified.SignCount); err != nil {
+		return nil, fmt.Errorf("update webauthn credential: %w", err)
+	}
+
+	credential.SignCount = verified.SignCount
+
+	return credential, nil
+}
+
+func (s *WebAuthnServiceImpl) HasCredentials(ctx context.Context, userID uuid.UUID) (bool, error) {
+	credentials, err := s.credentialRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("list webauthn credentials: %w", err)
+	}
+
+	return len(credentials) > 0, nil
+}
+
+func (s *WebAuthnServiceImpl) ListCredentials(ctx context.Context, userID uuid.UUID) ([]*domain.WebAuthnCredential, error) {
+	credentials, err := s.credentialRepository.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list webauthn credentials: %w", err)
+	}
+
+	return credentials, nil
+}
+
+func (s *WebAuthnServiceImpl) RenameCredential(ctx context.Context, userID uuid.UUID, id uuid.UUID, name string) error {
+	if err := s.credentialRepository.Rename(ctx, userID, id, name); err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return domain.ErrWebAuthnCredentialNotFound
+		}
+
+		return fmt.Errorf("rename webauthn credential: %w", err)
+	}
+
+	return nil
+}
+
+func (s *WebAuthnServiceImpl) DeleteCredential(ctx context.Context, userID uuid.UUID, id uuid.UUID) error {
+	if err := s.credentialRepository.Delete(ctx, userID, id); err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return domain.ErrWebAuthnCredentialNotFound
+		}
+
+		return fmt.Errorf("delete webauthn credential: %w", err)
+	}
+
+	return nil
+}
+
+func (s *WebAuthnServiceImpl) createChallenge(ctx context.Context, ceremony string, userID *uuid.UUID) (*domain.WebAuthnChallenge, error) {
+	challenge, err := domain.NewWebAuthnChallenge(ceremony, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.challengeRepository.Create(ctx, challenge); err != nil {
+		return nil, fmt.Errorf("store webauthn challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+// consumeChallenge loads a challenge and deletes it, so each is answered once.
+func (s *WebAuthnServiceImpl) consumeChallenge(ctx context.Context, challengeID, ceremony string, userID *uuid.UUID) (*domain.WebAuthnChallenge, error) {
+	challenge, err := s.challengeRepository.GetByID(ctx, challengeID)
+	if err != nil {
+		if errors.Is(err, ports.ErrNotFound) {
+			return nil, domain.ErrWebAuthnChallengeNotFound
+		}
+
+		return nil, fmt.Errorf("get webauthn challenge: %w", err)
+	}
+
+	if err := s.challengeRepository.Delete(ctx, challenge.ID); err != nil {
+		return nil, fmt.Errorf("delete webauthn challenge: %w", err)
+	}
+
+	if challenge.IsExpired() || !challenge.IsFor(ceremony, userID) {
+		return nil, domain.ErrWebAuthnChallengeNotFound
+	}
+
+	return challenge, nil
+}
@@ -0,0 +1,435 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/g-villarinho/oidc-server/internal/core/domain"
+	"github.com/g-villarinho/oidc-server/internal/core/ports"
+	"github.com/g-villarinho/oidc-server/internal/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBeginWebAuthnRegistration(t *testing.T) {
+	t.Run("should exclude the credentials the user already registered", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		user := &domain.User{ID: uuid.New(), Email: "john.doe@example.com"}
+		existing := []*domain.WebAuthnCredential{{ID: uuid.New(), UserID: user.ID, CredentialID: []byte("credential-1")}}
+
+		userRepository := mocks.NewUserRepositoryMock(t)
+		userRepository.EXPECT().GetByID(ctx, user.ID).Return(user, nil)
+
+		credentialRepository := mocks.NewWebAuthnCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().ListByUserID(ctx, user.ID).Return(existing, nil)
+
+		var stored *domain.WebAuthnChallenge
+		challengeRepository := mocks.NewWebAuthnChallengeRepositoryMock(t)
+		challengeRepository.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.WebAuthnChallenge")).
+			Run(func(ctx context.Context, challenge *domain.WebAuthnChallenge) { stored = challenge }).
+			Return(nil)
+
+		webAuthnService := &WebAuthnServiceImpl{
+			credentialRepository: credentialRepository,
+			challengeRepository:  challengeRepository,
+			userRepository:       userRepository,
+			rpID:                 "auth.example.com",
+			rpName:               "Example",
+		}
+
+		// Act
+		options, err := webAuthnService.BeginRegistration(ctx, user.ID)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, stored.ID, options.ChallengeID)
+		assert.Equal(t, stored.Challenge, options.Challenge)
+		assert.Equal(t, domain.WebAuthnCeremonyCreate, stored.Ceremony)
+		assert.Equal(t, &user.ID, stored.UserID)
+		assert.Equal(t, "auth.example.com", options.RPID)
+		assert.Equal(t, existing, options.ExcludeCredentials)
+	})
+}
+
+func TestFinishWebAuthnRegistration(t *testing.T) {
+	attestation := &domain.WebAuthnAttestation{Transports: []string{"internal"}}
+
+	t.Run("should store the verified credential", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		challenge := &domain.WebAuthnChallenge{
+			ID:        "challenge-1",
+			Challenge: []byte("challenge"),
+			Ceremony:  domain.WebAuthnCeremonyCreate,
+			UserID:    &userID,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		challengeRepository := mocks.NewWebAuthnChallengeRepositoryMock(t)
+		challengeRepository.EXPECT().GetByID(ctx, challenge.ID).Return(challenge, nil)
+		challengeRepository.EXPECT().Delete(ctx, challenge.ID).Return(nil)
+
+		verifier := mocks.NewWebAuthnVerifierMock(t)
+		verifier.EXPECT().
+			VerifyAttestation(ctx, attestation, challenge.Challenge).
+			Return(&domain.WebAuthnAttestedCredential{CredentialID: []byte("credential-1"), PublicKey: []byte("key")}, nil)
+
+		credentialRepository := mocks.NewWebAuthnCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().Create(ctx, mock.AnythingOfType("*domain.WebAuthnCredential")).Return(nil)
+
+		recoveryCodeService := mocks.NewRecoveryCodeServiceMock(t)
+		recoveryCodeService.EXPECT().Provision(ctx, userID).Return(nil, nil)
+
+		webAuthnService := &WebAuthnServiceImpl{
+			credentialRepository: credentialRepository,
+			challengeRepository:  challengeRepository,
+			recoveryCodeService:  recoveryCodeService,
+			verifier:             verifier,
+		}
+
+		// Act
+		credential, recoveryCodes, err := webAuthnService.FinishRegistration(ctx, userID, challenge.ID, "", attestation)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Nil(t, recoveryCodes)
+		assert.Equal(t, userID, credential.UserID)
+		assert.Equal(t, []byte("credential-1"), credential.CredentialID)
+		assert.Equal(t, []byte("key"), credential.PublicKey)
+		assert.Equal(t, []string{"internal"}, credential.Transports)
+		assert.Equal(t, domain.DefaultWebAuthnCredentialName, credential.Name)
+	})
+
+	t.Run("should reject a challenge handed out to another user", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		otherUserID := uuid.New()
+		challenge := &domain.WebAuthnChallenge{
+			ID:        "challenge-1",
+			Challenge: []byte("challenge"),
+			Ceremony:  domain.WebAuthnCeremonyCreate,
+			UserID:    &otherUserID,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		challengeRepository := mocks.NewWebAuthnChallengeRepositoryMock(t)
+		challengeRepository.EXPECT().GetByID(ctx, challenge.ID).Return(challenge, nil)
+		challengeRepository.EXPECT().Delete(ctx, challenge.ID).Return(nil)
+
+		webAuthnService := &WebAuthnServiceImpl{challengeRepository: challengeRepository}
+
+		// Act
+		credential, _, err := webAuthnService.FinishRegistration(ctx, uuid.New(), challenge.ID, "", attestation)
+
+		// Assert
+		assert.Nil(t, credential)
+		assert.ErrorIs(t, err, domain.ErrWebAuthnChallengeNotFound)
+	})
+
+	t.Run("should return ErrWebAuthnCredentialExists for a registered authenticator", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		challenge := &domain.WebAuthnChallenge{
+			ID:        "challenge-1",
+			Challenge: []byte("challenge"),
+			Ceremony:  domain.WebAuthnCeremonyCreate,
+			UserID:    &userID,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		challengeRepository := mocks.NewWebAuthnChallengeRepositoryMock(t)
+		challengeRepository.EXPECT().GetByID(ctx, challenge.ID).Return(challenge, nil)
+		challengeRepository.EXPECT().Delete(ctx, challenge.ID).Return(nil)
+
+		verifier := mocks.NewWebAuthnVerifierMock(t)
+		verifier.EXPECT().
+			VerifyAttestation(ctx, attestation, challenge.Challenge).
+			Return(&domain.WebAuthnAttestedCredential{CredentialID: []byte("credential-1")}, nil)
+
+		credentialRepository := mocks.NewWebAuthnCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().
+			Create(ctx, mock.AnythingOfType("*domain.WebAuthnCredential")).
+			Return(ports.ErrUniqueKeyViolation)
+
+		webAuthnService := &WebAuthnServiceImpl{
+			credentialRepository: credentialRepository,
+			challengeRepository:  challengeRepository,
+			verifier:             verifier,
+		}
+
+		// Act
+		credential, _, err := webAuthnService.FinishRegistration(ctx, userID, challenge.ID, "YubiKey", attestation)
+
+		// Assert
+		assert.Nil(t, credential)
+		assert.ErrorIs(t, err, domain.ErrWebAuthnCredentialExists)
+	})
+}
+
+func TestBeginWebAuthnAuthentication(t *testing.T) {
+	t.Run("should require user verification for a passwordless login", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+
+		challengeRepository := mocks.NewWebAuthnChallengeRepositoryMock(t)
+		challengeRepository.EXPECT().Create(ctx, mock.AnythingOfType("*domain.WebAuthnChallenge")).Return(nil)
+
+		webAuthnService := &WebAuthnServiceImpl{challengeRepository: challengeRepository}
+
+		// Act
+		options, err := webAuthnService.BeginAuthentication(ctx, nil)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Empty(t, options.AllowCredentials)
+		assert.Equal(t, domain.WebAuthnUserVerificationRequired, options.UserVerification)
+	})
+
+	t.Run("should return ErrWebAuthnCredentialNotFound for a user without credentials", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+
+		credentialRepository := mocks.NewWebAuthnCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().ListByUserID(ctx, userID).Return([]*domain.WebAuthnCredential{}, nil)
+
+		webAuthnService := &WebAuthnServiceImpl{credentialRepository: credentialRepository}
+
+		// Act
+		options, err := webAuthnService.BeginAuthentication(ctx, &userID)
+
+		// Assert
+		assert.Nil(t, options)
+		assert.ErrorIs(t, err, domain.ErrWebAuthnCredentialNotFound)
+	})
+}
+
+func TestFinishWebAuthnAuthentication(t *testing.T) {
+	t.Run("should return the credential of a passwordless login and record its sign count", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		credential := &domain.WebAuthnCredential{
+			ID:           uuid.New(),
+			UserID:       uuid.New(),
+			CredentialID: []byte("credential-1"),
+			PublicKey:    []byte("key"),
+			SignCount:    5,
+		}
+		challenge := &domain.WebAuthnChallenge{
+			ID:        "challenge-1",
+			Challenge: []byte("challenge"),
+			Ceremony:  domain.WebAuthnCeremonyGet,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+		assertion := &domain.WebAuthnAssertion{CredentialID: credential.CredentialID, UserHandle: credential.UserID[:]}
+
+		challengeRepository := mocks.NewWebAuthnChallengeRepositoryMock(t)
+		challengeRepository.EXPECT().GetByID(ctx, challenge.ID).Return(challenge, nil)
+		challengeRepository.EXPECT().Delete(ctx, challenge.ID).Return(nil)
+
+		credentialRepository := mocks.NewWebAuthnCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().GetByCredentialID(ctx, credential.CredentialID).Return(credential, nil)
+		credentialRepository.EXPECT().UpdateSignCount(ctx, credential.ID, uint32(6)).Return(nil)
+
+		verifier := mocks.NewWebAuthnVerifierMock(t)
+		verifier.EXPECT().
+			VerifyAssertion(ctx, assertion, challenge.Challenge, credential.PublicKey).
+			Return(&domain.WebAuthnVerifiedAssertion{SignCount: 6, UserVerified: true}, nil)
+
+		webAuthnService := &WebAuthnServiceImpl{
+			credentialRepository: credentialRepository,
+			challengeRepository:  challengeRepository,
+			verifier:             verifier,
+		}
+
+		// Act
+		verified, err := webAuthnService.FinishAuthentication(ctx, challenge.ID, nil, assertion)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, credential.UserID, verified.UserID)
+		assert.Equal(t, uint32(6), verified.SignCount)
+	})
+
+	t.Run("should reject a passwordless login without user verification", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		credential := &domain.WebAuthnCredential{
+			ID:           uuid.New(),
+			UserID:       uuid.New(),
+			CredentialID: []byte("credential-1"),
+			PublicKey:    []byte("key"),
+			SignCount:    5,
+		}
+		challenge := &domain.WebAuthnChallenge{
+			ID:        "challenge-1",
+			Challenge: []byte("challenge"),
+			Ceremony:  domain.WebAuthnCeremonyGet,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+		assertion := &domain.WebAuthnAssertion{CredentialID: credential.CredentialID, UserHandle: credential.UserID[:]}
+
+		challengeRepository := mocks.NewWebAuthnChallengeRepositoryMock(t)
+		challengeRepository.EXPECT().GetByID(ctx, challenge.ID).Return(challenge, nil)
+		challengeRepository.EXPECT().Delete(ctx, challenge.ID).Return(nil)
+
+		credentialRepository := mocks.NewWebAuthnCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().GetByCredentialID(ctx, credential.CredentialID).Return(credential, nil)
+
+		verifier := mocks.NewWebAuthnVerifierMock(t)
+		verifier.EXPECT().
+			VerifyAssertion(ctx, assertion, challenge.Challenge, credential.PublicKey).
+			Return(&domain.WebAuthnVerifiedAssertion{SignCount: 6}, nil)
+
+		webAuthnService := &WebAuthnServiceImpl{
+			credentialRepository: credentialRepository,
+			challengeRepository:  challengeRepository,
+			verifier:             verifier,
+		}
+
+		// Act
+		verified, err := webAuthnService.FinishAuthentication(ctx, challenge.ID, nil, assertion)
+
+		// Assert
+		assert.Nil(t, verified)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("should reject a sign count that did not increase", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		credential := &domain.WebAuthnCredential{
+			ID:           uuid.New(),
+			UserID:       uuid.New(),
+			CredentialID: []byte("credential-1"),
+			PublicKey:    []byte("key"),
+			SignCount:    5,
+		}
+		challenge := &domain.WebAuthnChallenge{
+			ID:        "challenge-1",
+			Challenge: []byte("challenge"),
+			Ceremony:  domain.WebAuthnCeremonyGet,
+			UserID:    &credential.UserID,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+		assertion := &domain.WebAuthnAssertion{CredentialID: credential.CredentialID}
+
+		challengeRepository := mocks.NewWebAuthnChallengeRepositoryMock(t)
+		challengeRepository.EXPECT().GetByID(ctx, challenge.ID).Return(challenge, nil)
+		challengeRepository.EXPECT().Delete(ctx, challenge.ID).Return(nil)
+
+		credentialRepository := mocks.NewWebAuthnCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().GetByCredentialID(ctx, credential.CredentialID).Return(credential, nil)
+
+		verifier := mocks.NewWebAuthnVerifierMock(t)
+		verifier.EXPECT().
+			VerifyAssertion(ctx, assertion, challenge.Challenge, credential.PublicKey).
+			Return(&domain.WebAuthnVerifiedAssertion{SignCount: 5}, nil)
+
+		webAuthnService := &WebAuthnServiceImpl{
+			credentialRepository: credentialRepository,
+			challengeRepository:  challengeRepository,
+			verifier:             verifier,
+		}
+
+		// Act
+		verified, err := webAuthnService.FinishAuthentication(ctx, challenge.ID, &credential.UserID, assertion)
+
+		// Assert
+		assert.Nil(t, verified)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("should reject a credential of another user than the login's", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		credential := &domain.WebAuthnCredential{
+			ID:           uuid.New(),
+			UserID:       uuid.New(),
+			CredentialID: []byte("credential-1"),
+			PublicKey:    []byte("key"),
+			SignCount:    5,
+		}
+		userID := uuid.New()
+		challenge := &domain.WebAuthnChallenge{
+			ID:        "challenge-1",
+			Challenge: []byte("challenge"),
+			Ceremony:  domain.WebAuthnCeremonyGet,
+			UserID:    &userID,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+		assertion := &domain.WebAuthnAssertion{CredentialID: credential.CredentialID}
+
+		challengeRepository := mocks.NewWebAuthnChallengeRepositoryMock(t)
+		challengeRepository.EXPECT().GetByID(ctx, challenge.ID).Return(challenge, nil)
+		challengeRepository.EXPECT().Delete(ctx, challenge.ID).Return(nil)
+
+		credentialRepository := mocks.NewWebAuthnCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().GetByCredentialID(ctx, credential.CredentialID).Return(credential, nil)
+
+		webAuthnService := &WebAuthnServiceImpl{
+			credentialRepository: credentialRepository,
+			challengeRepository:  challengeRepository,
+		}
+
+		// Act
+		verified, err := webAuthnService.FinishAuthentication(ctx, challenge.ID, &userID, assertion)
+
+		// Assert
+		assert.Nil(t, verified)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebAuthnResponse)
+	})
+
+	t.Run("should reject a second factor challenge used for a passwordless login", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		challenge := &domain.WebAuthnChallenge{
+			ID:        "challenge-1",
+			Challenge: []byte("challenge"),
+			Ceremony:  domain.WebAuthnCeremonyGet,
+			UserID:    &userID,
+			ExpiresAt: time.Now().Add(time.Minute),
+		}
+
+		challengeRepository := mocks.NewWebAuthnChallengeRepositoryMock(t)
+		challengeRepository.EXPECT().GetByID(ctx, challenge.ID).Return(challenge, nil)
+		challengeRepository.EXPECT().Delete(ctx, challenge.ID).Return(nil)
+
+		webAuthnService := &WebAuthnServiceImpl{challengeRepository: challengeRepository}
+
+		// Act
+		verified, err := webAuthnService.FinishAuthentication(ctx, challenge.ID, nil, &domain.WebAuthnAssertion{})
+
+		// Assert
+		assert.Nil(t, verified)
+		assert.ErrorIs(t, err, domain.ErrWebAuthnChallengeNotFound)
+	})
+}
+
+func TestDeleteWebAuthnCredential(t *testing.T) {
+	t.Run("should return ErrWebAuthnCredentialNotFound for a credential the user doesn't own", func(t *testing.T) {
+		// Arrange
+		ctx := context.Background()
+		userID := uuid.New()
+		id := uuid.New()
+
+		credentialRepository := mocks.NewWebAuthnCredentialRepositoryMock(t)
+		credentialRepository.EXPECT().Delete(ctx, userID, id).Return(ports.ErrNotFound)
+
+		webAuthnService := &WebAuthnServiceImpl{credentialRepository: credentialRepository}
+
+		// Act
+		err := webAuthnService.DeleteCredential(ctx, userID, id)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrWebAuthnCredentialNotFound)
+	})
+}
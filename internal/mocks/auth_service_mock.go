@@ -39,6 +39,74 @@ func (_m *AuthServiceMock) EXPECT() *AuthServiceMock_Expecter {
 	return &AuthServiceMock_Expecter{mock: &_m.Mock}
 }
 
+// BeginWebAuthnLogin provides a mock function for the type AuthServiceMock
+func (_mock *AuthServiceMock) BeginWebAuthnLogin(ctx context.Context, ticketID string) (*domain.WebAuthnLoginOptions, error) {
+	ret := _mock.Called(ctx, ticketID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginWebAuthnLogin")
+	}
+
+	var r0 *domain.WebAuthnLoginOptions
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.WebAuthnLoginOptions, error)); ok {
+		return returnFunc(ctx, ticketID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.WebAuthnLoginOptions); ok {
+		r0 = returnFunc(ctx, ticketID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WebAuthnLoginOptions)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, ticketID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthServiceMock_BeginWebAuthnLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginWebAuthnLogin'
+type AuthServiceMock_BeginWebAuthnLogin_Call struct {
+	*mock.Call
+}
+
+// BeginWebAuthnLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID string
+func (_e *AuthServiceMock_Expecter) BeginWebAuthnLogin(ctx interface{}, ticketID interface{}) *AuthServiceMock_BeginWebAuthnLogin_Call {
+	return &AuthServiceMock_BeginWebAuthnLogin_Call{Call: _e.mock.On("BeginWebAuthnLogin", ctx, ticketID)}
+}
+
+func (_c *AuthServiceMock_BeginWebAuthnLogin_Call) Run(run func(ctx context.Context, ticketID string)) *AuthServiceMock_BeginWebAuthnLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_BeginWebAuthnLogin_Call) Return(webAuthnLoginOptions *domain.WebAuthnLoginOptions, err error) *AuthServiceMock_BeginWebAuthnLogin_Call {
+	_c.Call.Return(webAuthnLoginOptions, err)
+	return _c
+}
+
+func (_c *AuthServiceMock_BeginWebAuthnLogin_Call) RunAndReturn(run func(ctx context.Context, ticketID string) (*domain.WebAuthnLoginOptions, error)) *AuthServiceMock_BeginWebAuthnLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSessionUser provides a mock function for the type AuthServiceMock
 func (_mock *AuthServiceMock) GetSessionUser(ctx context.Context, sessionID uuid.UUID) (*domain.User, error) {
 	ret := _mock.Called(ctx, sessionID)
@@ -108,55 +176,129 @@ func (_c *AuthServiceMock_GetSessionUser_Call) RunAndReturn(run func(ctx context
 }
 
 // Login provides a mock function for the type AuthServiceMock
-func (_mock *AuthServiceMock) Login(ctx context.Context, email string, password string) (*domain.Session, *domain.User, error) {
+func (_mock *AuthServiceMock) Login(ctx context.Context, email string, password string) (*domain.LoginResult, error) {
 	ret := _mock.Called(ctx, email, password)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
 	}
 
+	var r0 *domain.LoginResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.LoginResult, error)); ok {
+		return returnFunc(ctx, email, password)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.LoginResult); ok {
+		r0 = returnFunc(ctx, email, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LoginResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, email, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// AuthServiceMock_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type AuthServiceMock_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - email string
+//   - password string
+func (_e *AuthServiceMock_Expecter) Login(ctx interface{}, email interface{}, password interface{}) *AuthServiceMock_Login_Call {
+	return &AuthServiceMock_Login_Call{Call: _e.mock.On("Login", ctx, email, password)}
+}
+
+func (_c *AuthServiceMock_Login_Call) Run(run func(ctx context.Context, email string, password string)) *AuthServiceMock_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_Login_Call) Return(loginResult *domain.LoginResult, err error) *AuthServiceMock_Login_Call {
+	_c.Call.Return(loginResult, err)
+	return _c
+}
+
+func (_c *AuthServiceMock_Login_Call) RunAndReturn(run func(ctx context.Context, email string, password string) (*domain.LoginResult, error)) *AuthServiceMock_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginWithRecoveryCode provides a mock function for the type AuthServiceMock
+func (_mock *AuthServiceMock) LoginWithRecoveryCode(ctx context.Context, ticketID string, code string) (*domain.Session, *domain.User, error) {
+	ret := _mock.Called(ctx, ticketID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginWithRecoveryCode")
+	}
+
 	var r0 *domain.Session
 	var r1 *domain.User
 	var r2 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Session, *domain.User, error)); ok {
-		return returnFunc(ctx, email, password)
+		return returnFunc(ctx, ticketID, code)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.Session); ok {
-		r0 = returnFunc(ctx, email, password)
+		r0 = returnFunc(ctx, ticketID, code)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Session)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *domain.User); ok {
-		r1 = returnFunc(ctx, email, password)
+		r1 = returnFunc(ctx, ticketID, code)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(*domain.User)
 		}
 	}
 	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
-		r2 = returnFunc(ctx, email, password)
+		r2 = returnFunc(ctx, ticketID, code)
 	} else {
 		r2 = ret.Error(2)
 	}
 	return r0, r1, r2
 }
 
-// AuthServiceMock_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type AuthServiceMock_Login_Call struct {
+// AuthServiceMock_LoginWithRecoveryCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginWithRecoveryCode'
+type AuthServiceMock_LoginWithRecoveryCode_Call struct {
 	*mock.Call
 }
 
-// Login is a helper method to define mock.On call
+// LoginWithRecoveryCode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - email string
-//   - password string
-func (_e *AuthServiceMock_Expecter) Login(ctx interface{}, email interface{}, password interface{}) *AuthServiceMock_Login_Call {
-	return &AuthServiceMock_Login_Call{Call: _e.mock.On("Login", ctx, email, password)}
+//   - ticketID string
+//   - code string
+func (_e *AuthServiceMock_Expecter) LoginWithRecoveryCode(ctx interface{}, ticketID interface{}, code interface{}) *AuthServiceMock_LoginWithRecoveryCode_Call {
+	return &AuthServiceMock_LoginWithRecoveryCode_Call{Call: _e.mock.On("LoginWithRecoveryCode", ctx, ticketID, code)}
 }
 
-func (_c *AuthServiceMock_Login_Call) Run(run func(ctx context.Context, email string, password string)) *AuthServiceMock_Login_Call {
+func (_c *AuthServiceMock_LoginWithRecoveryCode_Call) Run(run func(ctx context.Context, ticketID string, code string)) *AuthServiceMock_LoginWithRecoveryCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -179,12 +321,239 @@ func (_c *AuthServiceMock_Login_Call) Run(run func(ctx context.Context, email st
 	return _c
 }
 
-func (_c *AuthServiceMock_Login_Call) Return(session *domain.Session, user *domain.User, err error) *AuthServiceMock_Login_Call {
+func (_c *AuthServiceMock_LoginWithRecoveryCode_Call) Return(session *domain.Session, user *domain.User, err error) *AuthServiceMock_LoginWithRecoveryCode_Call {
 	_c.Call.Return(session, user, err)
 	return _c
 }
 
-func (_c *AuthServiceMock_Login_Call) RunAndReturn(run func(ctx context.Context, email string, password string) (*domain.Session, *domain.User, error)) *AuthServiceMock_Login_Call {
+func (_c *AuthServiceMock_LoginWithRecoveryCode_Call) RunAndReturn(run func(ctx context.Context, ticketID string, code string) (*domain.Session, *domain.User, error)) *AuthServiceMock_LoginWithRecoveryCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginWithTOTP provides a mock function for the type AuthServiceMock
+func (_mock *AuthServiceMock) LoginWithTOTP(ctx context.Context, ticketID string, code string) (*domain.Session, *domain.User, error) {
+	ret := _mock.Called(ctx, ticketID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginWithTOTP")
+	}
+
+	var r0 *domain.Session
+	var r1 *domain.User
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Session, *domain.User, error)); ok {
+		return returnFunc(ctx, ticketID, code)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.Session); ok {
+		r0 = returnFunc(ctx, ticketID, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) *domain.User); ok {
+		r1 = returnFunc(ctx, ticketID, code)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = returnFunc(ctx, ticketID, code)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// AuthServiceMock_LoginWithTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginWithTOTP'
+type AuthServiceMock_LoginWithTOTP_Call struct {
+	*mock.Call
+}
+
+// LoginWithTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID string
+//   - code string
+func (_e *AuthServiceMock_Expecter) LoginWithTOTP(ctx interface{}, ticketID interface{}, code interface{}) *AuthServiceMock_LoginWithTOTP_Call {
+	return &AuthServiceMock_LoginWithTOTP_Call{Call: _e.mock.On("LoginWithTOTP", ctx, ticketID, code)}
+}
+
+func (_c *AuthServiceMock_LoginWithTOTP_Call) Run(run func(ctx context.Context, ticketID string, code string)) *AuthServiceMock_LoginWithTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_LoginWithTOTP_Call) Return(session *domain.Session, user *domain.User, err error) *AuthServiceMock_LoginWithTOTP_Call {
+	_c.Call.Return(session, user, err)
+	return _c
+}
+
+func (_c *AuthServiceMock_LoginWithTOTP_Call) RunAndReturn(run func(ctx context.Context, ticketID string, code string) (*domain.Session, *domain.User, error)) *AuthServiceMock_LoginWithTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginWithWebAuthn provides a mock function for the type AuthServiceMock
+func (_mock *AuthServiceMock) LoginWithWebAuthn(ctx context.Context, ticketID string, challengeID string, assertion *domain.WebAuthnAssertion) (*domain.Session, *domain.User, error) {
+	ret := _mock.Called(ctx, ticketID, challengeID, assertion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginWithWebAuthn")
+	}
+
+	var r0 *domain.Session
+	var r1 *domain.User
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *domain.WebAuthnAssertion) (*domain.Session, *domain.User, error)); ok {
+		return returnFunc(ctx, ticketID, challengeID, assertion)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *domain.WebAuthnAssertion) *domain.Session); ok {
+		r0 = returnFunc(ctx, ticketID, challengeID, assertion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Session)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, *domain.WebAuthnAssertion) *domain.User); ok {
+		r1 = returnFunc(ctx, ticketID, challengeID, assertion)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, string, *domain.WebAuthnAssertion) error); ok {
+		r2 = returnFunc(ctx, ticketID, challengeID, assertion)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// AuthServiceMock_LoginWithWebAuthn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginWithWebAuthn'
+type AuthServiceMock_LoginWithWebAuthn_Call struct {
+	*mock.Call
+}
+
+// LoginWithWebAuthn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticketID string
+//   - challengeID string
+//   - assertion *domain.WebAuthnAssertion
+func (_e *AuthServiceMock_Expecter) LoginWithWebAuthn(ctx interface{}, ticketID interface{}, challengeID interface{}, assertion interface{}) *AuthServiceMock_LoginWithWebAuthn_Call {
+	return &AuthServiceMock_LoginWithWebAuthn_Call{Call: _e.mock.On("LoginWithWebAuthn", ctx, ticketID, challengeID, assertion)}
+}
+
+func (_c *AuthServiceMock_LoginWithWebAuthn_Call) Run(run func(ctx context.Context, ticketID string, challengeID string, assertion *domain.WebAuthnAssertion)) *AuthServiceMock_LoginWithWebAuthn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 *domain.WebAuthnAssertion
+		if args[3] != nil {
+			arg3 = args[3].(*domain.WebAuthnAssertion)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_LoginWithWebAuthn_Call) Return(session *domain.Session, user *domain.User, err error) *AuthServiceMock_LoginWithWebAuthn_Call {
+	_c.Call.Return(session, user, err)
+	return _c
+}
+
+func (_c *AuthServiceMock_LoginWithWebAuthn_Call) RunAndReturn(run func(ctx context.Context, ticketID string, challengeID string, assertion *domain.WebAuthnAssertion) (*domain.Session, *domain.User, error)) *AuthServiceMock_LoginWithWebAuthn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function for the type AuthServiceMock
+func (_mock *AuthServiceMock) Logout(ctx context.Context, sessionID uuid.UUID) error {
+	ret := _mock.Called(ctx, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthServiceMock_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type AuthServiceMock_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sessionID uuid.UUID
+func (_e *AuthServiceMock_Expecter) Logout(ctx interface{}, sessionID interface{}) *AuthServiceMock_Logout_Call {
+	return &AuthServiceMock_Logout_Call{Call: _e.mock.On("Logout", ctx, sessionID)}
+}
+
+func (_c *AuthServiceMock_Logout_Call) Run(run func(ctx context.Context, sessionID uuid.UUID)) *AuthServiceMock_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_Logout_Call) Return(err error) *AuthServiceMock_Logout_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthServiceMock_Logout_Call) RunAndReturn(run func(ctx context.Context, sessionID uuid.UUID) error) *AuthServiceMock_Logout_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -219,3 +219,60 @@ func (_c *AuthorizationCodeRepositoryMock_GetByCode_Call) RunAndReturn(run func(
 	_c.Call.Return(run)
 	return _c
 }
+
+// MarkAsUsed provides a mock function for the type AuthorizationCodeRepositoryMock
+func (_mock *AuthorizationCodeRepositoryMock) MarkAsUsed(ctx context.Context, code string) error {
+	ret := _mock.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAsUsed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// AuthorizationCodeRepositoryMock_MarkAsUsed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAsUsed'
+type AuthorizationCodeRepositoryMock_MarkAsUsed_Call struct {
+	*mock.Call
+}
+
+// MarkAsUsed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *AuthorizationCodeRepositoryMock_Expecter) MarkAsUsed(ctx interface{}, code interface{}) *AuthorizationCodeRepositoryMock_MarkAsUsed_Call {
+	return &AuthorizationCodeRepositoryMock_MarkAsUsed_Call{Call: _e.mock.On("MarkAsUsed", ctx, code)}
+}
+
+func (_c *AuthorizationCodeRepositoryMock_MarkAsUsed_Call) Run(run func(ctx context.Context, code string)) *AuthorizationCodeRepositoryMock_MarkAsUsed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *AuthorizationCodeRepositoryMock_MarkAsUsed_Call) Return(err error) *AuthorizationCodeRepositoryMock_MarkAsUsed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *AuthorizationCodeRepositoryMock_MarkAsUsed_Call) RunAndReturn(run func(ctx context.Context, code string) error) *AuthorizationCodeRepositoryMock_MarkAsUsed_Call {
+	_c.Call.Return(run)
+	return _c
+}
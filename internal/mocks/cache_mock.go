@@ -161,6 +161,78 @@ func (_c *CacheMock_Delete_Call) RunAndReturn(run func(ctx context.Context, key
 	return _c
 }
 
+// DeleteIfEqual provides a mock function for the type CacheMock
+func (_mock *CacheMock) DeleteIfEqual(ctx context.Context, key string, value string) (bool, error) {
+	ret := _mock.Called(ctx, key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteIfEqual")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, key, value)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, key, value)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, key, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// CacheMock_DeleteIfEqual_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteIfEqual'
+type CacheMock_DeleteIfEqual_Call struct {
+	*mock.Call
+}
+
+// DeleteIfEqual is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - value string
+func (_e *CacheMock_Expecter) DeleteIfEqual(ctx interface{}, key interface{}, value interface{}) *CacheMock_DeleteIfEqual_Call {
+	return &CacheMock_DeleteIfEqual_Call{Call: _e.mock.On("DeleteIfEqual", ctx, key, value)}
+}
+
+func (_c *CacheMock_DeleteIfEqual_Call) Run(run func(ctx context.Context, key string, value string)) *CacheMock_DeleteIfEqual_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *CacheMock_DeleteIfEqual_Call) Return(b bool, err error) *CacheMock_DeleteIfEqual_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *CacheMock_DeleteIfEqual_Call) RunAndReturn(run func(ctx context.Context, key string, value string) (bool, error)) *CacheMock_DeleteIfEqual_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Exists provides a mock function for the type CacheMock
 func (_mock *CacheMock) Exists(ctx context.Context, key string) (bool, error) {
 	ret := _mock.Called(ctx, key)
@@ -39,8 +39,76 @@ func (_m *ClientServiceMock) EXPECT() *ClientServiceMock_Expecter {
 	return &ClientServiceMock_Expecter{mock: &_m.Mock}
 }
 
+// AuthenticateClient provides a mock function for the type ClientServiceMock
+func (_mock *ClientServiceMock) AuthenticateClient(ctx context.Context, credentials domain.ClientCredentials) (*domain.Client, error) {
+	ret := _mock.Called(ctx, credentials)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuthenticateClient")
+	}
+
+	var r0 *domain.Client
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ClientCredentials) (*domain.Client, error)); ok {
+		return returnFunc(ctx, credentials)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ClientCredentials) *domain.Client); ok {
+		r0 = returnFunc(ctx, credentials)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Client)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ClientCredentials) error); ok {
+		r1 = returnFunc(ctx, credentials)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ClientServiceMock_AuthenticateClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthenticateClient'
+type ClientServiceMock_AuthenticateClient_Call struct {
+	*mock.Call
+}
+
+// AuthenticateClient is a helper method to define mock.On call
+//   - ctx context.Context
+//   - credentials domain.ClientCredentials
+func (_e *ClientServiceMock_Expecter) AuthenticateClient(ctx interface{}, credentials interface{}) *ClientServiceMock_AuthenticateClient_Call {
+	return &ClientServiceMock_AuthenticateClient_Call{Call: _e.mock.On("AuthenticateClient", ctx, credentials)}
+}
+
+func (_c *ClientServiceMock_AuthenticateClient_Call) Run(run func(ctx context.Context, credentials domain.ClientCredentials)) *ClientServiceMock_AuthenticateClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ClientCredentials
+		if args[1] != nil {
+			arg1 = args[1].(domain.ClientCredentials)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ClientServiceMock_AuthenticateClient_Call) Return(client *domain.Client, err error) *ClientServiceMock_AuthenticateClient_Call {
+	_c.Call.Return(client, err)
+	return _c
+}
+
+func (_c *ClientServiceMock_AuthenticateClient_Call) RunAndReturn(run func(ctx context.Context, credentials domain.ClientCredentials) (*domain.Client, error)) *ClientServiceMock_AuthenticateClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateClient provides a mock function for the type ClientServiceMock
-func (_mock *ClientServiceMock) CreateClient(ctx context.Context, params domain.CreateClientParams) (*domain.Client, error) {
+func (_mock *ClientServiceMock) CreateClient(ctx context.Context, params domain.CreateClientParams) (*domain.Client, string, error) {
 	ret := _mock.Called(ctx, params)
 
 	if len(ret) == 0 {
@@ -48,8 +116,9 @@ func (_mock *ClientServiceMock) CreateClient(ctx context.Context, params domain.
 	}
 
 	var r0 *domain.Client
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateClientParams) (*domain.Client, error)); ok {
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateClientParams) (*domain.Client, string, error)); ok {
 		return returnFunc(ctx, params)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateClientParams) *domain.Client); ok {
@@ -59,12 +128,17 @@ func (_mock *ClientServiceMock) CreateClient(ctx context.Context, params domain.
 			r0 = ret.Get(0).(*domain.Client)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CreateClientParams) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CreateClientParams) string); ok {
 		r1 = returnFunc(ctx, params)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(string)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.CreateClientParams) error); ok {
+		r2 = returnFunc(ctx, params)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // ClientServiceMock_CreateClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateClient'
@@ -97,12 +171,12 @@ func (_c *ClientServiceMock_CreateClient_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *ClientServiceMock_CreateClient_Call) Return(client *domain.Client, err error) *ClientServiceMock_CreateClient_Call {
-	_c.Call.Return(client, err)
+func (_c *ClientServiceMock_CreateClient_Call) Return(client *domain.Client, s string, err error) *ClientServiceMock_CreateClient_Call {
+	_c.Call.Return(client, s, err)
 	return _c
 }
 
-func (_c *ClientServiceMock_CreateClient_Call) RunAndReturn(run func(ctx context.Context, params domain.CreateClientParams) (*domain.Client, error)) *ClientServiceMock_CreateClient_Call {
+func (_c *ClientServiceMock_CreateClient_Call) RunAndReturn(run func(ctx context.Context, params domain.CreateClientParams) (*domain.Client, string, error)) *ClientServiceMock_CreateClient_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -295,8 +369,8 @@ func (_c *ClientServiceMock_ListClients_Call) RunAndReturn(run func(ctx context.
 }
 
 // UpdateClient provides a mock function for the type ClientServiceMock
-func (_mock *ClientServiceMock) UpdateClient(ctx context.Context, id uuid.UUID, clientName string, redirectURIs []string, grantTypes []string, responseTypes []string, scopes []string) (*domain.Client, error) {
-	ret := _mock.Called(ctx, id, clientName, redirectURIs, grantTypes, responseTypes, scopes)
+func (_mock *ClientServiceMock) UpdateClient(ctx context.Context, id uuid.UUID, params domain.UpdateClientParams) (*domain.Client, error) {
+	ret := _mock.Called(ctx, id, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateClient")
@@ -304,18 +378,18 @@ func (_mock *ClientServiceMock) UpdateClient(ctx context.Context, id uuid.UUID,
 
 	var r0 *domain.Client
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, []string, []string, []string) (*domain.Client, error)); ok {
-		return returnFunc(ctx, id, clientName, redirectURIs, grantTypes, responseTypes, scopes)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.UpdateClientParams) (*domain.Client, error)); ok {
+		return returnFunc(ctx, id, params)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, []string, []string, []string, []string) *domain.Client); ok {
-		r0 = returnFunc(ctx, id, clientName, redirectURIs, grantTypes, responseTypes, scopes)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.UpdateClientParams) *domain.Client); ok {
+		r0 = returnFunc(ctx, id, params)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Client)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, []string, []string, []string, []string) error); ok {
-		r1 = returnFunc(ctx, id, clientName, redirectURIs, grantTypes, responseTypes, scopes)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, domain.UpdateClientParams) error); ok {
+		r1 = returnFunc(ctx, id, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -330,16 +404,12 @@ type ClientServiceMock_UpdateClient_Call struct {
 // UpdateClient is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-//   - clientName string
-//   - redirectURIs []string
-//   - grantTypes []string
-//   - responseTypes []string
-//   - scopes []string
-func (_e *ClientServiceMock_Expecter) UpdateClient(ctx interface{}, id interface{}, clientName interface{}, redirectURIs interface{}, grantTypes interface{}, responseTypes interface{}, scopes interface{}) *ClientServiceMock_UpdateClient_Call {
-	return &ClientServiceMock_UpdateClient_Call{Call: _e.mock.On("UpdateClient", ctx, id, clientName, redirectURIs, grantTypes, responseTypes, scopes)}
+//   - params domain.UpdateClientParams
+func (_e *ClientServiceMock_Expecter) UpdateClient(ctx interface{}, id interface{}, params interface{}) *ClientServiceMock_UpdateClient_Call {
+	return &ClientServiceMock_UpdateClient_Call{Call: _e.mock.On("UpdateClient", ctx, id, params)}
 }
 
-func (_c *ClientServiceMock_UpdateClient_Call) Run(run func(ctx context.Context, id uuid.UUID, clientName string, redirectURIs []string, grantTypes []string, responseTypes []string, scopes []string)) *ClientServiceMock_UpdateClient_Call {
+func (_c *ClientServiceMock_UpdateClient_Call) Run(run func(ctx context.Context, id uuid.UUID, params domain.UpdateClientParams)) *ClientServiceMock_UpdateClient_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -349,34 +419,14 @@ func (_c *ClientServiceMock_UpdateClient_Call) Run(run func(ctx context.Context,
 		if args[1] != nil {
 			arg1 = args[1].(uuid.UUID)
 		}
-		var arg2 string
+		var arg2 domain.UpdateClientParams
 		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 []string
-		if args[3] != nil {
-			arg3 = args[3].([]string)
-		}
-		var arg4 []string
-		if args[4] != nil {
-			arg4 = args[4].([]string)
-		}
-		var arg5 []string
-		if args[5] != nil {
-			arg5 = args[5].([]string)
-		}
-		var arg6 []string
-		if args[6] != nil {
-			arg6 = args[6].([]string)
+			arg2 = args[2].(domain.UpdateClientParams)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
-			arg4,
-			arg5,
-			arg6,
 		)
 	})
 	return _c
@@ -387,7 +437,7 @@ func (_c *ClientServiceMock_UpdateClient_Call) Return(client *domain.Client, err
 	return _c
 }
 
-func (_c *ClientServiceMock_UpdateClient_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, clientName string, redirectURIs []string, grantTypes []string, responseTypes []string, scopes []string) (*domain.Client, error)) *ClientServiceMock_UpdateClient_Call {
+func (_c *ClientServiceMock_UpdateClient_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, params domain.UpdateClientParams) (*domain.Client, error)) *ClientServiceMock_UpdateClient_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,10 +6,8 @@ package mocks
 
 import (
 	"context"
-	"time"
 
 	"github.com/g-villarinho/oidc-server/internal/core/domain"
-	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -41,8 +39,8 @@ func (_m *TokenGeneratorMock) EXPECT() *TokenGeneratorMock_Expecter {
 }
 
 // GenerateAccessToken provides a mock function for the type TokenGeneratorMock
-func (_mock *TokenGeneratorMock) GenerateAccessToken(ctx context.Context, userID uuid.UUID, client *domain.Client, ttl time.Duration) (string, error) {
-	ret := _mock.Called(ctx, userID, client, ttl)
+func (_mock *TokenGeneratorMock) GenerateAccessToken(ctx context.Context, params domain.AccessTokenParams) (string, error) {
+	ret := _mock.Called(ctx, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateAccessToken")
@@ -50,16 +48,16 @@ func (_mock *TokenGeneratorMock) GenerateAccessToken(ctx context.Context, userID
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *domain.Client, time.Duration) (string, error)); ok {
-		return returnFunc(ctx, userID, client, ttl)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AccessTokenParams) (string, error)); ok {
+		return returnFunc(ctx, params)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *domain.Client, time.Duration) string); ok {
-		r0 = returnFunc(ctx, userID, client, ttl)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AccessTokenParams) string); ok {
+		r0 = returnFunc(ctx, params)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *domain.Client, time.Duration) error); ok {
-		r1 = returnFunc(ctx, userID, client, ttl)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AccessTokenParams) error); ok {
+		r1 = returnFunc(ctx, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,54 +71,114 @@ type TokenGeneratorMock_GenerateAccessToken_Call struct {
 
 // GenerateAccessToken is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID uuid.UUID
-//   - client *domain.Client
-//   - ttl time.Duration
-func (_e *TokenGeneratorMock_Expecter) GenerateAccessToken(ctx interface{}, userID interface{}, client interface{}, ttl interface{}) *TokenGeneratorMock_GenerateAccessToken_Call {
-	return &TokenGeneratorMock_GenerateAccessToken_Call{Call: _e.mock.On("GenerateAccessToken", ctx, userID, client, ttl)}
+//   - params domain.AccessTokenParams
+func (_e *TokenGeneratorMock_Expecter) GenerateAccessToken(ctx interface{}, params interface{}) *TokenGeneratorMock_GenerateAccessToken_Call {
+	return &TokenGeneratorMock_GenerateAccessToken_Call{Call: _e.mock.On("GenerateAccessToken", ctx, params)}
 }
 
-func (_c *TokenGeneratorMock_GenerateAccessToken_Call) Run(run func(ctx context.Context, userID uuid.UUID, client *domain.Client, ttl time.Duration)) *TokenGeneratorMock_GenerateAccessToken_Call {
+func (_c *TokenGeneratorMock_GenerateAccessToken_Call) Run(run func(ctx context.Context, params domain.AccessTokenParams)) *TokenGeneratorMock_GenerateAccessToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 uuid.UUID
+		var arg1 domain.AccessTokenParams
 		if args[1] != nil {
-			arg1 = args[1].(uuid.UUID)
+			arg1 = args[1].(domain.AccessTokenParams)
 		}
-		var arg2 *domain.Client
-		if args[2] != nil {
-			arg2 = args[2].(*domain.Client)
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenGeneratorMock_GenerateAccessToken_Call) Return(s string, err error) *TokenGeneratorMock_GenerateAccessToken_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *TokenGeneratorMock_GenerateAccessToken_Call) RunAndReturn(run func(ctx context.Context, params domain.AccessTokenParams) (string, error)) *TokenGeneratorMock_GenerateAccessToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateAuthorizationResponse provides a mock function for the type TokenGeneratorMock
+func (_mock *TokenGeneratorMock) GenerateAuthorizationResponse(ctx context.Context, clientID string, params map[string]string) (string, error) {
+	ret := _mock.Called(ctx, clientID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateAuthorizationResponse")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]string) (string, error)); ok {
+		return returnFunc(ctx, clientID, params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]string) string); ok {
+		r0 = returnFunc(ctx, clientID, params)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, map[string]string) error); ok {
+		r1 = returnFunc(ctx, clientID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenGeneratorMock_GenerateAuthorizationResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateAuthorizationResponse'
+type TokenGeneratorMock_GenerateAuthorizationResponse_Call struct {
+	*mock.Call
+}
+
+// GenerateAuthorizationResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - params map[string]string
+func (_e *TokenGeneratorMock_Expecter) GenerateAuthorizationResponse(ctx interface{}, clientID interface{}, params interface{}) *TokenGeneratorMock_GenerateAuthorizationResponse_Call {
+	return &TokenGeneratorMock_GenerateAuthorizationResponse_Call{Call: _e.mock.On("GenerateAuthorizationResponse", ctx, clientID, params)}
+}
+
+func (_c *TokenGeneratorMock_GenerateAuthorizationResponse_Call) Run(run func(ctx context.Context, clientID string, params map[string]string)) *TokenGeneratorMock_GenerateAuthorizationResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
 		}
-		var arg3 time.Duration
-		if args[3] != nil {
-			arg3 = args[3].(time.Duration)
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[string]string
+		if args[2] != nil {
+			arg2 = args[2].(map[string]string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *TokenGeneratorMock_GenerateAccessToken_Call) Return(s string, err error) *TokenGeneratorMock_GenerateAccessToken_Call {
+func (_c *TokenGeneratorMock_GenerateAuthorizationResponse_Call) Return(s string, err error) *TokenGeneratorMock_GenerateAuthorizationResponse_Call {
 	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *TokenGeneratorMock_GenerateAccessToken_Call) RunAndReturn(run func(ctx context.Context, userID uuid.UUID, client *domain.Client, ttl time.Duration) (string, error)) *TokenGeneratorMock_GenerateAccessToken_Call {
+func (_c *TokenGeneratorMock_GenerateAuthorizationResponse_Call) RunAndReturn(run func(ctx context.Context, clientID string, params map[string]string) (string, error)) *TokenGeneratorMock_GenerateAuthorizationResponse_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GenerateIDToken provides a mock function for the type TokenGeneratorMock
-func (_mock *TokenGeneratorMock) GenerateIDToken(ctx context.Context, user *domain.User, client *domain.Client, nonce string, ttl time.Duration) (string, error) {
-	ret := _mock.Called(ctx, user, client, nonce, ttl)
+func (_mock *TokenGeneratorMock) GenerateIDToken(ctx context.Context, user *domain.User, params domain.IDTokenParams) (string, error) {
+	ret := _mock.Called(ctx, user, params)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateIDToken")
@@ -128,16 +186,16 @@ func (_mock *TokenGeneratorMock) GenerateIDToken(ctx context.Context, user *doma
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, *domain.Client, string, time.Duration) (string, error)); ok {
-		return returnFunc(ctx, user, client, nonce, ttl)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, domain.IDTokenParams) (string, error)); ok {
+		return returnFunc(ctx, user, params)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, *domain.Client, string, time.Duration) string); ok {
-		r0 = returnFunc(ctx, user, client, nonce, ttl)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.User, domain.IDTokenParams) string); ok {
+		r0 = returnFunc(ctx, user, params)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.User, *domain.Client, string, time.Duration) error); ok {
-		r1 = returnFunc(ctx, user, client, nonce, ttl)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.User, domain.IDTokenParams) error); ok {
+		r1 = returnFunc(ctx, user, params)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -152,14 +210,12 @@ type TokenGeneratorMock_GenerateIDToken_Call struct {
 // GenerateIDToken is a helper method to define mock.On call
 //   - ctx context.Context
 //   - user *domain.User
-//   - client *domain.Client
-//   - nonce string
-//   - ttl time.Duration
-func (_e *TokenGeneratorMock_Expecter) GenerateIDToken(ctx interface{}, user interface{}, client interface{}, nonce interface{}, ttl interface{}) *TokenGeneratorMock_GenerateIDToken_Call {
-	return &TokenGeneratorMock_GenerateIDToken_Call{Call: _e.mock.On("GenerateIDToken", ctx, user, client, nonce, ttl)}
+//   - params domain.IDTokenParams
+func (_e *TokenGeneratorMock_Expecter) GenerateIDToken(ctx interface{}, user interface{}, params interface{}) *TokenGeneratorMock_GenerateIDToken_Call {
+	return &TokenGeneratorMock_GenerateIDToken_Call{Call: _e.mock.On("GenerateIDToken", ctx, user, params)}
 }
 
-func (_c *TokenGeneratorMock_GenerateIDToken_Call) Run(run func(ctx context.Context, user *domain.User, client *domain.Client, nonce string, ttl time.Duration)) *TokenGeneratorMock_GenerateIDToken_Call {
+func (_c *TokenGeneratorMock_GenerateIDToken_Call) Run(run func(ctx context.Context, user *domain.User, params domain.IDTokenParams)) *TokenGeneratorMock_GenerateIDToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -169,24 +225,14 @@ func (_c *TokenGeneratorMock_GenerateIDToken_Call) Run(run func(ctx context.Cont
 		if args[1] != nil {
 			arg1 = args[1].(*domain.User)
 		}
-		var arg2 *domain.Client
+		var arg2 domain.IDTokenParams
 		if args[2] != nil {
-			arg2 = args[2].(*domain.Client)
-		}
-		var arg3 string
-		if args[3] != nil {
-			arg3 = args[3].(string)
-		}
-		var arg4 time.Duration
-		if args[4] != nil {
-			arg4 = args[4].(time.Duration)
+			arg2 = args[2].(domain.IDTokenParams)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
-			arg4,
 		)
 	})
 	return _c
@@ -197,7 +243,67 @@ func (_c *TokenGeneratorMock_GenerateIDToken_Call) Return(s string, err error) *
 	return _c
 }
 
-func (_c *TokenGeneratorMock_GenerateIDToken_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, client *domain.Client, nonce string, ttl time.Duration) (string, error)) *TokenGeneratorMock_GenerateIDToken_Call {
+func (_c *TokenGeneratorMock_GenerateIDToken_Call) RunAndReturn(run func(ctx context.Context, user *domain.User, params domain.IDTokenParams) (string, error)) *TokenGeneratorMock_GenerateIDToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateOpaqueToken provides a mock function for the type TokenGeneratorMock
+func (_mock *TokenGeneratorMock) GenerateOpaqueToken(ctx context.Context) (string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateOpaqueToken")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenGeneratorMock_GenerateOpaqueToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateOpaqueToken'
+type TokenGeneratorMock_GenerateOpaqueToken_Call struct {
+	*mock.Call
+}
+
+// GenerateOpaqueToken is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TokenGeneratorMock_Expecter) GenerateOpaqueToken(ctx interface{}) *TokenGeneratorMock_GenerateOpaqueToken_Call {
+	return &TokenGeneratorMock_GenerateOpaqueToken_Call{Call: _e.mock.On("GenerateOpaqueToken", ctx)}
+}
+
+func (_c *TokenGeneratorMock_GenerateOpaqueToken_Call) Run(run func(ctx context.Context)) *TokenGeneratorMock_GenerateOpaqueToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenGeneratorMock_GenerateOpaqueToken_Call) Return(s string, err error) *TokenGeneratorMock_GenerateOpaqueToken_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *TokenGeneratorMock_GenerateOpaqueToken_Call) RunAndReturn(run func(ctx context.Context) (string, error)) *TokenGeneratorMock_GenerateOpaqueToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -261,3 +367,205 @@ func (_c *TokenGeneratorMock_GenerateRefreshToken_Call) RunAndReturn(run func(ct
 	_c.Call.Return(run)
 	return _c
 }
+
+// GenerateUserInfoResponse provides a mock function for the type TokenGeneratorMock
+func (_mock *TokenGeneratorMock) GenerateUserInfoResponse(ctx context.Context, clientID string, claims map[string]any) (string, error) {
+	ret := _mock.Called(ctx, clientID, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateUserInfoResponse")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]any) (string, error)); ok {
+		return returnFunc(ctx, clientID, claims)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]any) string); ok {
+		r0 = returnFunc(ctx, clientID, claims)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, map[string]any) error); ok {
+		r1 = returnFunc(ctx, clientID, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenGeneratorMock_GenerateUserInfoResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateUserInfoResponse'
+type TokenGeneratorMock_GenerateUserInfoResponse_Call struct {
+	*mock.Call
+}
+
+// GenerateUserInfoResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID string
+//   - claims map[string]any
+func (_e *TokenGeneratorMock_Expecter) GenerateUserInfoResponse(ctx interface{}, clientID interface{}, claims interface{}) *TokenGeneratorMock_GenerateUserInfoResponse_Call {
+	return &TokenGeneratorMock_GenerateUserInfoResponse_Call{Call: _e.mock.On("GenerateUserInfoResponse", ctx, clientID, claims)}
+}
+
+func (_c *TokenGeneratorMock_GenerateUserInfoResponse_Call) Run(run func(ctx context.Context, clientID string, claims map[string]any)) *TokenGeneratorMock_GenerateUserInfoResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[string]any
+		if args[2] != nil {
+			arg2 = args[2].(map[string]any)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenGeneratorMock_GenerateUserInfoResponse_Call) Return(s string, err error) *TokenGeneratorMock_GenerateUserInfoResponse_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *TokenGeneratorMock_GenerateUserInfoResponse_Call) RunAndReturn(run func(ctx context.Context, clientID string, claims map[string]any) (string, error)) *TokenGeneratorMock_GenerateUserInfoResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJSONWebKeySet provides a mock function for the type TokenGeneratorMock
+func (_mock *TokenGeneratorMock) GetJSONWebKeySet(ctx context.Context) (*domain.JSONWebKeySet, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJSONWebKeySet")
+	}
+
+	var r0 *domain.JSONWebKeySet
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*domain.JSONWebKeySet, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *domain.JSONWebKeySet); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.JSONWebKeySet)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenGeneratorMock_GetJSONWebKeySet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJSONWebKeySet'
+type TokenGeneratorMock_GetJSONWebKeySet_Call struct {
+	*mock.Call
+}
+
+// GetJSONWebKeySet is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TokenGeneratorMock_Expecter) GetJSONWebKeySet(ctx interface{}) *TokenGeneratorMock_GetJSONWebKeySet_Call {
+	return &TokenGeneratorMock_GetJSONWebKeySet_Call{Call: _e.mock.On("GetJSONWebKeySet", ctx)}
+}
+
+func (_c *TokenGeneratorMock_GetJSONWebKeySet_Call) Run(run func(ctx context.Context)) *TokenGeneratorMock_GetJSONWebKeySet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenGeneratorMock_GetJSONWebKeySet_Call) Return(jsonWebKeySet *domain.JSONWebKeySet, err error) *TokenGeneratorMock_GetJSONWebKeySet_Call {
+	_c.Call.Return(jsonWebKeySet, err)
+	return _c
+}
+
+func (_c *TokenGeneratorMock_GetJSONWebKeySet_Call) RunAndReturn(run func(ctx context.Context) (*domain.JSONWebKeySet, error)) *TokenGeneratorMock_GetJSONWebKeySet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParseIDToken provides a mock function for the type TokenGeneratorMock
+func (_mock *TokenGeneratorMock) ParseIDToken(ctx context.Context, idToken string) (*domain.IDTokenClaims, error) {
+	ret := _mock.Called(ctx, idToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseIDToken")
+	}
+
+	var r0 *domain.IDTokenClaims
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.IDTokenClaims, error)); ok {
+		return returnFunc(ctx, idToken)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.IDTokenClaims); ok {
+		r0 = returnFunc(ctx, idToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.IDTokenClaims)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, idToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TokenGeneratorMock_ParseIDToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseIDToken'
+type TokenGeneratorMock_ParseIDToken_Call struct {
+	*mock.Call
+}
+
+// ParseIDToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - idToken string
+func (_e *TokenGeneratorMock_Expecter) ParseIDToken(ctx interface{}, idToken interface{}) *TokenGeneratorMock_ParseIDToken_Call {
+	return &TokenGeneratorMock_ParseIDToken_Call{Call: _e.mock.On("ParseIDToken", ctx, idToken)}
+}
+
+func (_c *TokenGeneratorMock_ParseIDToken_Call) Run(run func(ctx context.Context, idToken string)) *TokenGeneratorMock_ParseIDToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *TokenGeneratorMock_ParseIDToken_Call) Return(idTokenClaims *domain.IDTokenClaims, err error) *TokenGeneratorMock_ParseIDToken_Call {
+	_c.Call.Return(idTokenClaims, err)
+	return _c
+}
+
+func (_c *TokenGeneratorMock_ParseIDToken_Call) RunAndReturn(run func(ctx context.Context, idToken string) (*domain.IDTokenClaims, error)) *TokenGeneratorMock_ParseIDToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -288,3 +288,60 @@ func (_c *UserRepositoryMock_Update_Call) RunAndReturn(run func(ctx context.Cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// VerifyEmail provides a mock function for the type UserRepositoryMock
+func (_mock *UserRepositoryMock) VerifyEmail(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// UserRepositoryMock_VerifyEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmail'
+type UserRepositoryMock_VerifyEmail_Call struct {
+	*mock.Call
+}
+
+// VerifyEmail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *UserRepositoryMock_Expecter) VerifyEmail(ctx interface{}, id interface{}) *UserRepositoryMock_VerifyEmail_Call {
+	return &UserRepositoryMock_VerifyEmail_Call{Call: _e.mock.On("VerifyEmail", ctx, id)}
+}
+
+func (_c *UserRepositoryMock_VerifyEmail_Call) Run(run func(ctx context.Context, id uuid.UUID)) *UserRepositoryMock_VerifyEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_VerifyEmail_Call) Return(err error) *UserRepositoryMock_VerifyEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *UserRepositoryMock_VerifyEmail_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *UserRepositoryMock_VerifyEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"testing"
 
+	appcontext "github.com/g-villarinho/oidc-server/internal/adapters/primary/server/context"
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/handlers"
 	"github.com/g-villarinho/oidc-server/internal/adapters/primary/server/models"
 	"github.com/g-villarinho/oidc-server/internal/core/services"
@@ -24,7 +25,7 @@ func TestLogin_Endpoint(t *testing.T) {
 	// Setup handler
 	cookieService := services.NewCookieService(server.Config)
 	cookieHandler := handlers.NewCookieHandler(cookieService, server.Config)
-	authHandler := handlers.NewAuthHandler(server.Services.AuthService, cookieHandler, server.Logger)
+	authHandler := handlers.NewAuthHandler(server.Services.AuthService, cookieHandler, appcontext.NewEchoContext(), server.Logger)
 
 	t.Run("should login successfully with valid credentials", func(t *testing.T) {
 		env.Reset(t)
@@ -298,7 +299,7 @@ func TestRegister_Endpoint(t *testing.T) {
 	// Setup handler
 	cookieService := services.NewCookieService(server.Config)
 	cookieHandler := handlers.NewCookieHandler(cookieService, server.Config)
-	authHandler := handlers.NewAuthHandler(server.Services.AuthService, cookieHandler, server.Logger)
+	authHandler := handlers.NewAuthHandler(server.Services.AuthService, cookieHandler, appcontext.NewEchoContext(), server.Logger)
 
 	t.Run("should register user successfully with valid data", func(t *testing.T) {
 		env.Reset(t)
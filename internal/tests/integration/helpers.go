@@ -11,9 +11,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/aesgcm"
 	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/argon2"
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/mail"
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/notification"
 	pgRepo "github.com/g-villarinho/oidc-server/internal/adapters/secondary/postgres/repositories"
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/redis"
 	redisRepo "github.com/g-villarinho/oidc-server/internal/adapters/secondary/redis/repositories"
+	"github.com/g-villarinho/oidc-server/internal/adapters/secondary/webauthn"
 	"github.com/g-villarinho/oidc-server/internal/config"
 	"github.com/g-villarinho/oidc-server/internal/core/domain"
 	"github.com/g-villarinho/oidc-server/internal/core/ports"
@@ -289,6 +294,12 @@ func NewTestConfig() *config.Config {
 				Secure: false,
 			},
 		},
+		Key: config.Key{
+			EncryptionKey: "dGVzdC1rZXktZW5jcnlwdGlvbi1rZXktMzItYnl0ZXM=",
+		},
+		EmailVerification: config.EmailVerification{
+			Secret: "test-email-verification-secret-for-integration-tests",
+		},
 	}
 }
 
@@ -297,13 +308,35 @@ func SetupTestServices(t *testing.T, env *TestEnv) *TestServices {
 
 	userRepo := pgRepo.NewUserRepository(env.DB.Pool)
 	sessionRepo := redisRepo.NewSessionRepository(env.Redis.Client)
+	tokenRepo := pgRepo.NewTokenRepository(env.DB.Pool)
+	loginTicketRepo := redisRepo.NewLoginTicketRepository(env.Redis.Client)
+	totpCredentialRepo := pgRepo.NewTOTPCredentialRepository(env.DB.Pool)
+	webAuthnCredentialRepo := pgRepo.NewWebAuthnCredentialRepository(env.DB.Pool)
+	webAuthnChallengeRepo := redisRepo.NewWebAuthnChallengeRepository(env.Redis.Client)
+	recoveryCodeRepo := pgRepo.NewRecoveryCodeRepository(env.DB.Pool)
+	auditEventRepo := pgRepo.NewAuditEventRepository(env.DB.Pool)
 
 	hasher := NewTestHasher()
 	logger := NewTestLogger()
 	cfg := NewTestConfig()
 
+	keyEncrypter, err := aesgcm.NewKeyEncrypter(cfg)
+	if err != nil {
+		t.Fatalf("failed to create key encrypter: %v", err)
+	}
+
+	cache := redis.NewCache(env.Redis.Client)
+
 	userService := services.NewUserService(userRepo, hasher, logger)
-	authService := services.NewAuthService(userService, userRepo, sessionRepo, cfg)
+	recoveryCodeService := services.NewRecoveryCodeService(recoveryCodeRepo, auditEventRepo, hasher, cache, notification.NewLocalAccountNotifier(logger), logger)
+	totpService := services.NewTOTPService(totpCredentialRepo, userRepo, recoveryCodeService, keyEncrypter, cache, cfg)
+	webAuthnService := services.NewWebAuthnService(webAuthnCredentialRepo, webAuthnChallengeRepo, userRepo, recoveryCodeService, webauthn.NewWebAuthnVerifier(cfg), cfg)
+	emailVerificationService, err := services.NewEmailVerificationService(userRepo, mail.NewLocalMailer(cfg, logger), cache, cfg)
+	if err != nil {
+		t.Fatalf("failed to create email verification service: %v", err)
+	}
+
+	authService := services.NewAuthService(userService, totpService, webAuthnService, recoveryCodeService, emailVerificationService, userRepo, sessionRepo, loginTicketRepo, tokenRepo, cfg)
 
 	return &TestServices{
 		UserService: userService,
@@ -5,3 +5,15 @@ import "fmt"
 func SessionKey(sessionID string) string {
 	return fmt.Sprintf("session:%s", sessionID)
 }
+
+func BackchannelAuthenticationKey(authReqID string) string {
+	return fmt.Sprintf("ciba:%s", authReqID)
+}
+
+func LoginTicketKey(ticketID string) string {
+	return fmt.Sprintf("login_ticket:%s", ticketID)
+}
+
+func WebAuthnChallengeKey(challengeID string) string {
+	return fmt.Sprintf("webauthn_challenge:%s", challengeID)
+}
@@ -0,0 +1,58 @@
+package oauth
+
+import (
+	"bytes"
+	"html/template"
+	"slices"
+)
+
+var formPostTemplate = template.Must(template.New("form_post").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Submit This Form</title>
+</head>
+<body onload="javascript:document.forms[0].submit()">
+<form method="post" action="{{.Action}}">
+{{- range .Fields}}
+<input type="hidden" name="{{.Name}}" value="{{.Value}}"/>
+{{- end}}
+<noscript><button type="submit">Continue</button></noscript>
+</form>
+</body>
+</html>
+`))
+
+type formPostField struct {
+	Name  string
+	Value string
+}
+
+// GenerateFormPostHTML renders the auto-submitting page of the form_post response mode.
+func GenerateFormPostHTML(redirectURI string, params CallbackParams) (string, error) {
+	values := params.Values()
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	fields := make([]formPostField, 0, len(names))
+	for _, name := range names {
+		fields = append(fields, formPostField{Name: name, Value: values.Get(name)})
+	}
+
+	var buf bytes.Buffer
+	if err := formPostTemplate.Execute(&buf, struct {
+		Action string
+		Fields []formPostField
+	}{
+		Action: redirectURI,
+		Fields: fields,
+	}); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
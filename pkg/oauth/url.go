@@ -2,6 +2,7 @@ package oauth
 
 import (
 	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -9,11 +10,19 @@ type ContinueURLParams struct {
 	ClientID            string
 	RedirectURI         string
 	ResponseType        string
+	ResponseMode        string
 	Scopes              []string
 	State               string
 	Nonce               string
 	CodeChallenge       string
 	CodeChallengeMethod string
+	Claims              string
+	Prompt              string
+	Resources           []string
+	// AuthorizationDetails is passed on so the login app can show the user the details they are asked to approve.
+	AuthorizationDetails string
+	// ACRValues tells the login app which authentication context the user has to reach.
+	ACRValues []string
 }
 
 func GenerateContinueURL(baseURL string, params ContinueURLParams) string {
@@ -30,6 +39,10 @@ func GenerateContinueURL(baseURL string, params ContinueURLParams) string {
 	q.Set("redirect_uri", params.RedirectURI)
 	q.Set("response_type", params.ResponseType)
 
+	if params.ResponseMode != "" {
+		q.Set("response_mode", params.ResponseMode)
+	}
+
 	if len(params.Scopes) > 0 {
 		q.Set("scope", strings.Join(params.Scopes, " "))
 	}
@@ -52,25 +65,100 @@ func GenerateContinueURL(baseURL string, params ContinueURLParams) string {
 		q.Set("code_challenge_method", method)
 	}
 
+	if params.Claims != "" {
+		q.Set("claims", params.Claims)
+	}
+
+	if params.Prompt != "" {
+		q.Set("prompt", params.Prompt)
+	}
+
+	for _, resource := range params.Resources {
+		q.Add("resource", resource)
+	}
+
+	if params.AuthorizationDetails != "" {
+		q.Set("authorization_details", params.AuthorizationDetails)
+	}
+
+	if len(params.ACRValues) > 0 {
+		q.Set("acr_values", strings.Join(params.ACRValues, " "))
+	}
+
 	u.RawQuery = q.Encode()
 
 	return u.String()
 }
 
-func GenerateCallbackURL(redirectURI, code, state string) string {
+type CallbackParams struct {
+	Code        string
+	AccessToken string
+	TokenType   string
+	ExpiresIn   int64
+	IDToken     string
+	Error       string
+	State       string
+	Response    string
+}
+
+func (p CallbackParams) Values() url.Values {
+	values := url.Values{}
+
+	// JWT-secured responses carry every other parameter inside the token.
+	if p.Response != "" {
+		values.Set("response", p.Response)
+		return values
+	}
+
+	if p.Code != "" {
+		values.Set("code", p.Code)
+	}
+
+	if p.AccessToken != "" {
+		values.Set("access_token", p.AccessToken)
+		values.Set("token_type", p.TokenType)
+		values.Set("expires_in", strconv.FormatInt(p.ExpiresIn, 10))
+	}
+
+	if p.IDToken != "" {
+		values.Set("id_token", p.IDToken)
+	}
+
+	if p.Error != "" {
+		values.Set("error", p.Error)
+	}
+
+	if p.State != "" {
+		values.Set("state", p.State)
+	}
+
+	return values
+}
+
+func GenerateCallbackURL(redirectURI string, params CallbackParams) string {
 	u, err := url.Parse(redirectURI)
 	if err != nil {
 		return redirectURI
 	}
 
 	q := u.Query()
-	q.Set("code", code)
-
-	if state != "" {
-		q.Set("state", state)
+	for key, values := range params.Values() {
+		q[key] = values
 	}
 
 	u.RawQuery = q.Encode()
 
 	return u.String()
 }
+
+func GenerateFragmentCallbackURL(redirectURI string, params CallbackParams) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		return redirectURI
+	}
+
+	u.Fragment = ""
+	u.RawFragment = ""
+
+	return u.String() + "#" + params.Values().Encode()
+}
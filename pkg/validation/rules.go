@@ -2,6 +2,8 @@ package validation
 
 import (
 	"regexp"
+	"slices"
+	"strings"
 
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
@@ -11,6 +13,8 @@ const (
 	passwordLenght = 8
 )
 
+var responseTypeValues = []string{"code", "token", "id_token"}
+
 func registerCustomRules(v *validator.Validate) error {
 	if err := v.RegisterValidation("strong_password", validateStrongPassword); err != nil {
 		return err
@@ -20,6 +24,10 @@ func registerCustomRules(v *validator.Validate) error {
 		return err
 	}
 
+	if err := v.RegisterValidation("response_type", validateResponseType); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -42,6 +50,15 @@ func registerCustomTranslations(v *validator.Validate, trans ut.Translator) erro
 		return err
 	}
 
+	if err := v.RegisterTranslation("response_type", trans, func(ut ut.Translator) error {
+		return ut.Add("response_type", "Response type must be a space-separated combination of code, token and id_token", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("response_type")
+		return t
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -77,3 +94,20 @@ func validateBrazilianPhone(fl validator.FieldLevel) bool {
 
 	return false
 }
+
+func validateResponseType(fl validator.FieldLevel) bool {
+	values := strings.Fields(fl.Field().String())
+	if len(values) == 0 {
+		return false
+	}
+
+	seen := make([]string, 0, len(values))
+	for _, value := range values {
+		if !slices.Contains(responseTypeValues, value) || slices.Contains(seen, value) {
+			return false
+		}
+		seen = append(seen, value)
+	}
+
+	return true
+}